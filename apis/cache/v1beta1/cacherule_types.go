@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // CacheRuleParameters define the desired state of a Cloudflare Cache Rule
@@ -269,7 +270,15 @@ type CacheRuleObservation struct {
 
 // A CacheRuleSpec defines the desired state of a Cache Rule.
 type CacheRuleSpec struct {
-	xpv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       CacheRuleParameters `json:"forProvider"`
 }
 
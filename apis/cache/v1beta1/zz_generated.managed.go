@@ -24,23 +24,18 @@ func (mg *CacheRule) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
 	return mg.Status.GetCondition(ct)
 }
 
-// GetDeletionPolicy of this CacheRule.
-func (mg *CacheRule) GetDeletionPolicy() xpv1.DeletionPolicy {
-	return mg.Spec.DeletionPolicy
-}
-
 // GetManagementPolicies of this CacheRule.
 func (mg *CacheRule) GetManagementPolicies() xpv1.ManagementPolicies {
 	return mg.Spec.ManagementPolicies
 }
 
 // GetProviderConfigReference of this CacheRule.
-func (mg *CacheRule) GetProviderConfigReference() *xpv1.Reference {
+func (mg *CacheRule) GetProviderConfigReference() *xpv1.ProviderConfigReference {
 	return mg.Spec.ProviderConfigReference
 }
 
 // GetWriteConnectionSecretToReference of this CacheRule.
-func (mg *CacheRule) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+func (mg *CacheRule) GetWriteConnectionSecretToReference() *xpv1.LocalSecretReference {
 	return mg.Spec.WriteConnectionSecretToReference
 }
 
@@ -49,22 +44,17 @@ func (mg *CacheRule) SetConditions(c ...xpv1.Condition) {
 	mg.Status.SetConditions(c...)
 }
 
-// SetDeletionPolicy of this CacheRule.
-func (mg *CacheRule) SetDeletionPolicy(r xpv1.DeletionPolicy) {
-	mg.Spec.DeletionPolicy = r
-}
-
 // SetManagementPolicies of this CacheRule.
 func (mg *CacheRule) SetManagementPolicies(r xpv1.ManagementPolicies) {
 	mg.Spec.ManagementPolicies = r
 }
 
 // SetProviderConfigReference of this CacheRule.
-func (mg *CacheRule) SetProviderConfigReference(r *xpv1.Reference) {
+func (mg *CacheRule) SetProviderConfigReference(r *xpv1.ProviderConfigReference) {
 	mg.Spec.ProviderConfigReference = r
 }
 
 // SetWriteConnectionSecretToReference of this CacheRule.
-func (mg *CacheRule) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+func (mg *CacheRule) SetWriteConnectionSecretToReference(r *xpv1.LocalSecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
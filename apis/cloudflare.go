@@ -25,6 +25,7 @@ import (
 	dnsv1beta1 "github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
 	emailroutingv1beta1 "github.com/rossigee/provider-cloudflare/apis/emailrouting/v1beta1"
 	firewallv1beta1 "github.com/rossigee/provider-cloudflare/apis/firewall/v1beta1"
+	listsv1beta1 "github.com/rossigee/provider-cloudflare/apis/lists/v1beta1"
 	loadbalancingv1beta1 "github.com/rossigee/provider-cloudflare/apis/loadbalancing/v1beta1"
 	logpushv1beta1 "github.com/rossigee/provider-cloudflare/apis/logpush/v1beta1"
 	originsslv1beta1 "github.com/rossigee/provider-cloudflare/apis/originssl/v1beta1"
@@ -62,6 +63,7 @@ func init() {
 		loadbalancingv1beta1.SchemeBuilder.AddToScheme,
 		logpushv1beta1.SchemeBuilder.AddToScheme,
 		r2v1beta1.SchemeBuilder.AddToScheme,
+		listsv1beta1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -95,6 +97,8 @@ func VerifySchemeRegistration() error {
 		&zonev1beta1.ZoneList{},
 		&dnsv1beta1.Record{},
 		&dnsv1beta1.RecordList{},
+		&dnsv1beta1.RecordSet{},
+		&dnsv1beta1.RecordSetList{},
 
 		// Load balancing
 		&loadbalancingv1beta1.LoadBalancer{},
@@ -135,6 +139,12 @@ func VerifySchemeRegistration() error {
 		&rulesetsv1beta1.RulesetList{},
 		&transformv1beta1.Rule{},
 		&transformv1beta1.RuleList{},
+		&listsv1beta1.List{},
+		&listsv1beta1.ListList{},
+		&listsv1beta1.ListItem{},
+		&listsv1beta1.ListItemList{},
+		&listsv1beta1.BulkRedirect{},
+		&listsv1beta1.BulkRedirectList{},
 
 		// Workers and edge computing
 		&workersv1beta1.CronTrigger{},
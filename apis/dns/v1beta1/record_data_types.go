@@ -0,0 +1,320 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// RecordData is the structured value of a DNS Record, for the types whose
+// value Cloudflare holds as fields rather than as content. Only the block
+// named for the type of the record may be set, such as srv for an SRV
+// record. The fields of each block are named as Cloudflare names them.
+type RecordData struct {
+	// CAA is the data of a CAA record.
+	// +optional
+	CAA *CAARecordData `json:"caa,omitempty"`
+
+	// CERT is the data of a CERT record.
+	// +optional
+	CERT *CERTRecordData `json:"cert,omitempty"`
+
+	// DNSKEY is the data of a DNSKEY record.
+	// +optional
+	DNSKEY *DNSKEYRecordData `json:"dnskey,omitempty"`
+
+	// DS is the data of a DS record.
+	// +optional
+	DS *DSRecordData `json:"ds,omitempty"`
+
+	// LOC is the data of a LOC record.
+	// +optional
+	LOC *LOCRecordData `json:"loc,omitempty"`
+
+	// NAPTR is the data of a NAPTR record.
+	// +optional
+	NAPTR *NAPTRRecordData `json:"naptr,omitempty"`
+
+	// SMIMEA is the data of an SMIMEA record.
+	// +optional
+	SMIMEA *TLSARecordData `json:"smimea,omitempty"`
+
+	// SRV is the data of an SRV record.
+	// +optional
+	SRV *SRVRecordData `json:"srv,omitempty"`
+
+	// SSHFP is the data of an SSHFP record.
+	// +optional
+	SSHFP *SSHFPRecordData `json:"sshfp,omitempty"`
+
+	// TLSA is the data of a TLSA record.
+	// +optional
+	TLSA *TLSARecordData `json:"tlsa,omitempty"`
+
+	// URI is the data of a URI record. Its priority is the priority of
+	// the record.
+	// +optional
+	URI *URIRecordData `json:"uri,omitempty"`
+}
+
+// CAARecordData is the data of a CAA record.
+type CAARecordData struct {
+	// Flags of the record.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Flags int32 `json:"flags"`
+
+	// Tag of the property, such as issue, issuewild or iodef.
+	// +kubebuilder:validation:MinLength=1
+	Tag string `json:"tag"`
+
+	// Value of the property, such as letsencrypt.org.
+	Value string `json:"value"`
+}
+
+// CERTRecordData is the data of a CERT record.
+type CERTRecordData struct {
+	// Type of the certificate.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Type int32 `json:"type"`
+
+	// KeyTag of the certificate.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	KeyTag int32 `json:"keyTag"`
+
+	// Algorithm of the certificate.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Algorithm int32 `json:"algorithm"`
+
+	// Certificate in base64.
+	// +kubebuilder:validation:MinLength=1
+	Certificate string `json:"certificate"`
+}
+
+// DNSKEYRecordData is the data of a DNSKEY record.
+type DNSKEYRecordData struct {
+	// Flags of the key.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Flags int32 `json:"flags"`
+
+	// Protocol of the key. It is always 3.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Protocol int32 `json:"protocol"`
+
+	// Algorithm of the key.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Algorithm int32 `json:"algorithm"`
+
+	// PublicKey in base64.
+	// +kubebuilder:validation:MinLength=1
+	PublicKey string `json:"publicKey"`
+}
+
+// DSRecordData is the data of a DS record.
+type DSRecordData struct {
+	// KeyTag of the key.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	KeyTag int32 `json:"keyTag"`
+
+	// Algorithm of the key.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Algorithm int32 `json:"algorithm"`
+
+	// DigestType of the digest.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	DigestType int32 `json:"digestType"`
+
+	// Digest of the key in hex.
+	// +kubebuilder:validation:MinLength=1
+	Digest string `json:"digest"`
+}
+
+// LOCRecordData is the data of a LOC record.
+type LOCRecordData struct {
+	// LatDegrees of the latitude.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=90
+	LatDegrees int32 `json:"latDegrees"`
+
+	// LatMinutes of the latitude.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=59
+	// +optional
+	LatMinutes int32 `json:"latMinutes,omitempty"`
+
+	// LatSeconds of the latitude.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=59.999
+	// +optional
+	LatSeconds float64 `json:"latSeconds,omitempty"`
+
+	// LatDirection of the latitude.
+	// +kubebuilder:validation:Enum=N;S
+	LatDirection string `json:"latDirection"`
+
+	// LongDegrees of the longitude.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=180
+	LongDegrees int32 `json:"longDegrees"`
+
+	// LongMinutes of the longitude.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=59
+	// +optional
+	LongMinutes int32 `json:"longMinutes,omitempty"`
+
+	// LongSeconds of the longitude.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=59.999
+	// +optional
+	LongSeconds float64 `json:"longSeconds,omitempty"`
+
+	// LongDirection of the longitude.
+	// +kubebuilder:validation:Enum=E;W
+	LongDirection string `json:"longDirection"`
+
+	// Altitude in meters.
+	// +kubebuilder:validation:Minimum=-100000
+	// +kubebuilder:validation:Maximum=42849672.95
+	// +optional
+	Altitude float64 `json:"altitude,omitempty"`
+
+	// Size of the location in meters.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=90000000
+	// +optional
+	Size float64 `json:"size,omitempty"`
+
+	// PrecisionHorz is the horizontal precision of the location in meters.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=90000000
+	// +optional
+	PrecisionHorz float64 `json:"precisionHorz,omitempty"`
+
+	// PrecisionVert is the vertical precision of the location in meters.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=90000000
+	// +optional
+	PrecisionVert float64 `json:"precisionVert,omitempty"`
+}
+
+// NAPTRRecordData is the data of a NAPTR record.
+type NAPTRRecordData struct {
+	// Order in which the records are processed.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Order int32 `json:"order"`
+
+	// Preference of records with the same order.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Preference int32 `json:"preference"`
+
+	// Flags of the record, such as U or S.
+	// +optional
+	Flags string `json:"flags,omitempty"`
+
+	// Service of the record, such as E2U+sip.
+	// +optional
+	Service string `json:"service,omitempty"`
+
+	// Regex that rewrites the query.
+	// +optional
+	Regex string `json:"regex,omitempty"`
+
+	// Replacement domain name of the query.
+	// +optional
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// SRVRecordData is the data of an SRV record.
+type SRVRecordData struct {
+	// Priority of the target.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Priority int32 `json:"priority"`
+
+	// Weight of targets with the same priority.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Weight int32 `json:"weight"`
+
+	// Port of the service on the target.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Target host of the service.
+	// +kubebuilder:validation:MinLength=1
+	Target string `json:"target"`
+}
+
+// SSHFPRecordData is the data of an SSHFP record.
+type SSHFPRecordData struct {
+	// Algorithm of the key.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Algorithm int32 `json:"algorithm"`
+
+	// Type of the fingerprint.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Type int32 `json:"type"`
+
+	// Fingerprint of the key in hex.
+	// +kubebuilder:validation:MinLength=1
+	Fingerprint string `json:"fingerprint"`
+}
+
+// TLSARecordData is the data of a TLSA or SMIMEA record.
+type TLSARecordData struct {
+	// Usage of the certificate.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Usage int32 `json:"usage"`
+
+	// Selector of the part of the certificate that is matched.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Selector int32 `json:"selector"`
+
+	// MatchingType of the certificate association data.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	MatchingType int32 `json:"matchingType"`
+
+	// Certificate association data in hex.
+	// +kubebuilder:validation:MinLength=1
+	Certificate string `json:"certificate"`
+}
+
+// URIRecordData is the data of a URI record.
+type URIRecordData struct {
+	// Weight of records with the same priority.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Weight int32 `json:"weight"`
+
+	// Target URI.
+	// +kubebuilder:validation:MinLength=1
+	Target string `json:"target"`
+}
@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/pkg/errors"
@@ -43,8 +44,14 @@ type RecordParameters struct {
 	// +kubebuilder:validation:MaxLength=255
 	Name string `json:"name"`
 
-	// Content of the DNS Record
-	Content string `json:"content"`
+	// Content of the DNS Record. It is required unless Data is set.
+	// +optional
+	Content string `json:"content,omitempty"`
+
+	// Data of the DNS Record, for the types whose value Cloudflare
+	// holds as fields. Content is computed by Cloudflare when it is set.
+	// +optional
+	Data *RecordData `json:"data,omitempty"`
 
 	// TTL of the DNS Record.
 	// +kubebuilder:default=1
@@ -74,6 +81,18 @@ type RecordParameters struct {
 	// +optional
 	Port *int32 `json:"port,omitempty"`
 
+	// Comment of the DNS Record, such as the team that owns it.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// Tags of the DNS Record, in the form name:value.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Settings of the DNS Record.
+	// +optional
+	Settings *RecordSettings `json:"settings,omitempty"`
+
 	// ZoneID this DNS Record is managed on.
 	// +immutable
 	// +optional
@@ -90,6 +109,24 @@ type RecordParameters struct {
 	ZoneSelector *xpv1.Selector `json:"zoneSelector,omitempty"`
 }
 
+// RecordSettings are the per-record settings of a DNS Record.
+type RecordSettings struct {
+	// FlattenCNAME flattens a CNAME Record, so that it resolves to the
+	// addresses of its target rather than to the target name.
+	// +optional
+	FlattenCNAME *bool `json:"flattenCNAME,omitempty"`
+
+	// IPv4Only makes a proxied Record resolve only to Cloudflare's IPv4
+	// addresses.
+	// +optional
+	IPv4Only *bool `json:"ipv4Only,omitempty"`
+
+	// IPv6Only makes a proxied Record resolve only to Cloudflare's IPv6
+	// addresses.
+	// +optional
+	IPv6Only *bool `json:"ipv6Only,omitempty"`
+}
+
 // RecordObservation is the observable fields of a DNS Record.
 type RecordObservation struct {
 	// Proxiable indicates whether this record _can be_ proxied
@@ -114,11 +151,34 @@ type RecordObservation struct {
 	// ModifiedOn indicates when this record was modified
 	// on Cloudflare.
 	ModifiedOn *metav1.Time `json:"modifiedOn,omitempty"`
+
+	// Comment of this record on Cloudflare.
+	Comment string `json:"comment,omitempty"`
+
+	// Tags of this record on Cloudflare.
+	Tags []string `json:"tags,omitempty"`
+
+	// Settings of this record on Cloudflare.
+	Settings *RecordSettings `json:"settings,omitempty"`
+
+	// Content of this record on Cloudflare.
+	Content string `json:"content,omitempty"`
+
+	// Data of this record on Cloudflare.
+	Data *RecordData `json:"data,omitempty"`
 }
 
 // A RecordSpec defines the desired state of a DNS Record.
 type RecordSpec struct {
-	xpv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       RecordParameters `json:"forProvider"`
 }
 
@@ -0,0 +1,216 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
+
+	zonev1beta1 "github.com/rossigee/provider-cloudflare/apis/zone/v1beta1"
+)
+
+// RecordSetParameters are the configurable fields of a DNS RecordSet.
+type RecordSetParameters struct {
+	// Type is the type of the DNS Records of the set.
+	// +kubebuilder:validation:Enum=A;AAAA;CAA;CNAME;TXT;SRV;LOC;MX;NS;SPF;CERT;DNSKEY;DS;NAPTR;SMIMEA;SSHFP;TLSA;URI
+	// +kubebuilder:default=A
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// Name of the DNS Records of the set, such as www or www.example.com.
+	// Existing records with the name and type are adopted only if the name
+	// is fully qualified, since Cloudflare lists records by their fully
+	// qualified names.
+	// +kubebuilder:validation:MaxLength=255
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name"`
+
+	// Values of the DNS Records of the set. The set manages one record
+	// for each value, and every other record on the zone with its name
+	// and type.
+	// +kubebuilder:validation:MinItems=1
+	Values []RecordSetValue `json:"values"`
+
+	// TTL of the DNS Records of the set.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+
+	// Proxied enables or disables proxying traffic to the DNS Records of
+	// the set via Cloudflare.
+	// +optional
+	Proxied *bool `json:"proxied,omitempty"`
+
+	// Comment of the DNS Records of the set.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// ZoneID this DNS RecordSet is managed on.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="zone is immutable"
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// ZoneRef references the Zone object this DNS RecordSet is managed on.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="zoneRef is immutable"
+	// +optional
+	ZoneRef *xpv1.Reference `json:"zoneRef,omitempty"`
+
+	// ZoneSelector selects the Zone object this DNS RecordSet is managed
+	// on.
+	// +immutable
+	// +optional
+	ZoneSelector *xpv1.Selector `json:"zoneSelector,omitempty"`
+}
+
+// A RecordSetValue is the value of one DNS Record of a set.
+type RecordSetValue struct {
+	// Content of the DNS Record. It is required unless Data is set.
+	// +optional
+	Content string `json:"content,omitempty"`
+
+	// Data of the DNS Record, for the types whose value Cloudflare holds
+	// as fields.
+	// +optional
+	Data *RecordData `json:"data,omitempty"`
+
+	// Priority of the DNS Record, such as the preference of an MX record.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+
+	// Weight for SRV records.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+
+	// Port for SRV records.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+}
+
+// RecordSetMemberObservation is the observable fields of one DNS Record of
+// a set.
+type RecordSetMemberObservation struct {
+	// ID of the DNS Record on Cloudflare.
+	ID string `json:"id"`
+
+	// Content of the DNS Record on Cloudflare.
+	Content string `json:"content,omitempty"`
+
+	// Priority of the DNS Record on Cloudflare.
+	Priority *int32 `json:"priority,omitempty"`
+}
+
+// RecordSetObservation is the observable fields of a DNS RecordSet.
+type RecordSetObservation struct {
+	// FQDN of the DNS Records of the set.
+	FQDN string `json:"fqdn,omitempty"`
+
+	// Records of the set on Cloudflare.
+	Records []RecordSetMemberObservation `json:"records,omitempty"`
+}
+
+// A RecordSetSpec defines the desired state of a DNS RecordSet.
+type RecordSetSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	ForProvider RecordSetParameters `json:"forProvider"`
+}
+
+// A RecordSetStatus represents the observed state of a DNS RecordSet.
+type RecordSetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RecordSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RecordSet represents the DNS Records of one name and type on a Zone,
+// such as the addresses of a round-robin A record or the MX records of a
+// domain.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="FQDN",type="string",JSONPath=".status.atProvider.fqdn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudflare}
+type RecordSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RecordSetSpec   `json:"spec"`
+	Status RecordSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RecordSetList contains a list of DNS RecordSet objects
+type RecordSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RecordSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RecordSet{}, &RecordSetList{})
+}
+
+// ResolveReferences resolves references to the Zone that this DNS
+// RecordSet is managed on.
+func (rs *RecordSet) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, rs)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(rs.Spec.ForProvider.Zone),
+		Reference:    rs.Spec.ForProvider.ZoneRef,
+		Selector:     rs.Spec.ForProvider.ZoneSelector,
+		To:           reference.To{Managed: &zonev1beta1.Zone{}, List: &zonev1beta1.ZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.zone")
+	}
+	rs.Spec.ForProvider.Zone = reference.ToPtrValue(rsp.ResolvedValue)
+	rs.Spec.ForProvider.ZoneRef = rsp.ResolvedReference
+
+	return nil
+}
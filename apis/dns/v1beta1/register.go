@@ -22,11 +22,16 @@ import (
 
 // Package type metadata.
 const (
-	RecordKind = "Record"
+	RecordKind    = "Record"
+	RecordSetKind = "RecordSet"
 )
 
 var (
 	RecordKindAPIVersion   = RecordKind + "." + GroupVersion.String()
 	RecordGroupKind        = schema.GroupKind{Group: Group, Kind: RecordKind}.String()
 	RecordGroupVersionKind = GroupVersion.WithKind(RecordKind)
+
+	RecordSetKindAPIVersion   = RecordSetKind + "." + GroupVersion.String()
+	RecordSetGroupKind        = schema.GroupKind{Group: Group, Kind: RecordSetKind}.String()
+	RecordSetGroupVersionKind = GroupVersion.WithKind(RecordSetKind)
 )
\ No newline at end of file
@@ -25,6 +25,96 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAARecordData) DeepCopyInto(out *CAARecordData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAARecordData.
+func (in *CAARecordData) DeepCopy() *CAARecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(CAARecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CERTRecordData) DeepCopyInto(out *CERTRecordData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CERTRecordData.
+func (in *CERTRecordData) DeepCopy() *CERTRecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(CERTRecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSKEYRecordData) DeepCopyInto(out *DNSKEYRecordData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSKEYRecordData.
+func (in *DNSKEYRecordData) DeepCopy() *DNSKEYRecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSKEYRecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DSRecordData) DeepCopyInto(out *DSRecordData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSRecordData.
+func (in *DSRecordData) DeepCopy() *DSRecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(DSRecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LOCRecordData) DeepCopyInto(out *LOCRecordData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LOCRecordData.
+func (in *LOCRecordData) DeepCopy() *LOCRecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(LOCRecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NAPTRRecordData) DeepCopyInto(out *NAPTRRecordData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NAPTRRecordData.
+func (in *NAPTRRecordData) DeepCopy() *NAPTRRecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(NAPTRRecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Record) DeepCopyInto(out *Record) {
 	*out = *in
@@ -52,6 +142,76 @@ func (in *Record) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordData) DeepCopyInto(out *RecordData) {
+	*out = *in
+	if in.CAA != nil {
+		in, out := &in.CAA, &out.CAA
+		*out = new(CAARecordData)
+		**out = **in
+	}
+	if in.CERT != nil {
+		in, out := &in.CERT, &out.CERT
+		*out = new(CERTRecordData)
+		**out = **in
+	}
+	if in.DNSKEY != nil {
+		in, out := &in.DNSKEY, &out.DNSKEY
+		*out = new(DNSKEYRecordData)
+		**out = **in
+	}
+	if in.DS != nil {
+		in, out := &in.DS, &out.DS
+		*out = new(DSRecordData)
+		**out = **in
+	}
+	if in.LOC != nil {
+		in, out := &in.LOC, &out.LOC
+		*out = new(LOCRecordData)
+		**out = **in
+	}
+	if in.NAPTR != nil {
+		in, out := &in.NAPTR, &out.NAPTR
+		*out = new(NAPTRRecordData)
+		**out = **in
+	}
+	if in.SMIMEA != nil {
+		in, out := &in.SMIMEA, &out.SMIMEA
+		*out = new(TLSARecordData)
+		**out = **in
+	}
+	if in.SRV != nil {
+		in, out := &in.SRV, &out.SRV
+		*out = new(SRVRecordData)
+		**out = **in
+	}
+	if in.SSHFP != nil {
+		in, out := &in.SSHFP, &out.SSHFP
+		*out = new(SSHFPRecordData)
+		**out = **in
+	}
+	if in.TLSA != nil {
+		in, out := &in.TLSA, &out.TLSA
+		*out = new(TLSARecordData)
+		**out = **in
+	}
+	if in.URI != nil {
+		in, out := &in.URI, &out.URI
+		*out = new(URIRecordData)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordData.
+func (in *RecordData) DeepCopy() *RecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RecordList) DeepCopyInto(out *RecordList) {
 	*out = *in
@@ -95,6 +255,21 @@ func (in *RecordObservation) DeepCopyInto(out *RecordObservation) {
 		in, out := &in.ModifiedOn, &out.ModifiedOn
 		*out = (*in).DeepCopy()
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(RecordSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = new(RecordData)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordObservation.
@@ -115,6 +290,11 @@ func (in *RecordParameters) DeepCopyInto(out *RecordParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = new(RecordData)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TTL != nil {
 		in, out := &in.TTL, &out.TTL
 		*out = new(int64)
@@ -140,6 +320,21 @@ func (in *RecordParameters) DeepCopyInto(out *RecordParameters) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(RecordSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Zone != nil {
 		in, out := &in.Zone, &out.Zone
 		*out = new(string)
@@ -167,10 +362,267 @@ func (in *RecordParameters) DeepCopy() *RecordParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordSet) DeepCopyInto(out *RecordSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordSet.
+func (in *RecordSet) DeepCopy() *RecordSet {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RecordSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordSetList) DeepCopyInto(out *RecordSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RecordSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordSetList.
+func (in *RecordSetList) DeepCopy() *RecordSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RecordSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordSetMemberObservation) DeepCopyInto(out *RecordSetMemberObservation) {
+	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordSetMemberObservation.
+func (in *RecordSetMemberObservation) DeepCopy() *RecordSetMemberObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordSetMemberObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordSetObservation) DeepCopyInto(out *RecordSetObservation) {
+	*out = *in
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
+		*out = make([]RecordSetMemberObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordSetObservation.
+func (in *RecordSetObservation) DeepCopy() *RecordSetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordSetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordSetParameters) DeepCopyInto(out *RecordSetParameters) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]RecordSetValue, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Proxied != nil {
+		in, out := &in.Proxied, &out.Proxied
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneRef != nil {
+		in, out := &in.ZoneRef, &out.ZoneRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneSelector != nil {
+		in, out := &in.ZoneSelector, &out.ZoneSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordSetParameters.
+func (in *RecordSetParameters) DeepCopy() *RecordSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordSetSpec) DeepCopyInto(out *RecordSetSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordSetSpec.
+func (in *RecordSetSpec) DeepCopy() *RecordSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordSetStatus) DeepCopyInto(out *RecordSetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordSetStatus.
+func (in *RecordSetStatus) DeepCopy() *RecordSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordSetValue) DeepCopyInto(out *RecordSetValue) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = new(RecordData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordSetValue.
+func (in *RecordSetValue) DeepCopy() *RecordSetValue {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordSetValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordSettings) DeepCopyInto(out *RecordSettings) {
+	*out = *in
+	if in.FlattenCNAME != nil {
+		in, out := &in.FlattenCNAME, &out.FlattenCNAME
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IPv4Only != nil {
+		in, out := &in.IPv4Only, &out.IPv4Only
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IPv6Only != nil {
+		in, out := &in.IPv6Only, &out.IPv6Only
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordSettings.
+func (in *RecordSettings) DeepCopy() *RecordSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RecordSpec) DeepCopyInto(out *RecordSpec) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
@@ -200,3 +652,63 @@ func (in *RecordStatus) DeepCopy() *RecordStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SRVRecordData) DeepCopyInto(out *SRVRecordData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SRVRecordData.
+func (in *SRVRecordData) DeepCopy() *SRVRecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(SRVRecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHFPRecordData) DeepCopyInto(out *SSHFPRecordData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHFPRecordData.
+func (in *SSHFPRecordData) DeepCopy() *SSHFPRecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHFPRecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSARecordData) DeepCopyInto(out *TLSARecordData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSARecordData.
+func (in *TLSARecordData) DeepCopy() *TLSARecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSARecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *URIRecordData) DeepCopyInto(out *URIRecordData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new URIRecordData.
+func (in *URIRecordData) DeepCopy() *URIRecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(URIRecordData)
+	in.DeepCopyInto(out)
+	return out
+}
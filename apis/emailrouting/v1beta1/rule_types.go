@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // RuleParameters are the configurable fields of an Email Routing Rule.
@@ -110,7 +111,15 @@ type RuleObservation struct {
 
 // A RuleSpec defines the desired state of an Email Routing Rule.
 type RuleSpec struct {
-	rtv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy rtv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       RuleParameters `json:"forProvider"`
 }
 
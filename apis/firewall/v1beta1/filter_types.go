@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
 	"github.com/pkg/errors"
 
@@ -60,11 +61,28 @@ type FilterParameters struct {
 }
 
 // FilterObservation is the observable fields of a Filter.
-type FilterObservation struct{}
+type FilterObservation struct {
+	// ID is the identifier of the filter assigned by Cloudflare.
+	ID string `json:"id,omitempty"`
+
+	// Paused indicates whether Cloudflare reports the filter as paused.
+	Paused bool `json:"paused,omitempty"`
+
+	// Ref is the short reference tag Cloudflare reports for the filter.
+	Ref string `json:"ref,omitempty"`
+}
 
 // A FilterSpec defines the desired state of a Filter.
 type FilterSpec struct {
-	xpv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       FilterParameters `json:"forProvider"`
 }
 
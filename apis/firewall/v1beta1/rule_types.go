@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
 	"github.com/pkg/errors"
 
@@ -98,11 +99,37 @@ type RuleParameters struct {
 }
 
 // RuleObservation is the observable fields of a Rule.
-type RuleObservation struct{}
+type RuleObservation struct {
+	// ID is the identifier of the firewall rule assigned by Cloudflare.
+	ID string `json:"id,omitempty"`
+
+	// FilterID is the identifier of the filter the rule is attached to.
+	FilterID string `json:"filterId,omitempty"`
+
+	// Priority is the priority Cloudflare reports for the rule, if any.
+	Priority *int32 `json:"priority,omitempty"`
+
+	// Paused indicates whether Cloudflare reports the rule as paused.
+	Paused bool `json:"paused,omitempty"`
+
+	// CreatedOn indicates when the rule was created.
+	CreatedOn *metav1.Time `json:"createdOn,omitempty"`
+
+	// ModifiedOn indicates when the rule was last modified.
+	ModifiedOn *metav1.Time `json:"modifiedOn,omitempty"`
+}
 
 // A RuleSpec defines the desired state of a Rule.
 type RuleSpec struct {
-	xpv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       RuleParameters `json:"forProvider"`
 }
 
@@ -0,0 +1,155 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// BulkRedirectParameters are the configurable fields of a BulkRedirect.
+type BulkRedirectParameters struct {
+	// AccountID is the account the redirects are managed under. It
+	// overrides the accountId of the ProviderConfig.
+	// +optional
+	// +immutable
+	AccountID *string `json:"accountId,omitempty"`
+
+	// Name of the redirect list holding the redirects. The rule enabling
+	// the list in the http_request_redirect phase has the ref
+	// bulk_redirect_<name>.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9_]+$`
+	// +kubebuilder:validation:MaxLength=50
+	// +immutable
+	Name string `json:"name"`
+
+	// Description of the redirect list.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Redirects from source to target URLs.
+	// +optional
+	Redirects []Redirect `json:"redirects,omitempty"`
+
+	// RedirectsFrom reads further redirects from a ConfigMap. The key holds
+	// either a YAML list of redirects, or one redirect per line as a source
+	// URL, a target URL and an optional status code separated by spaces or
+	// commas. Blank lines and lines starting with # are ignored.
+	// +optional
+	RedirectsFrom *ItemsSource `json:"redirectsFrom,omitempty"`
+
+	// StatusCode of redirects that do not set their own.
+	// +kubebuilder:validation:Enum=301;302;307;308
+	// +optional
+	StatusCode *int `json:"statusCode,omitempty"`
+
+	// IncludeSubdomains applies to redirects that do not set their own.
+	// +optional
+	IncludeSubdomains *bool `json:"includeSubdomains,omitempty"`
+
+	// SubpathMatching applies to redirects that do not set their own.
+	// +optional
+	SubpathMatching *bool `json:"subpathMatching,omitempty"`
+
+	// PreserveQueryString applies to redirects that do not set their own.
+	// +optional
+	PreserveQueryString *bool `json:"preserveQueryString,omitempty"`
+
+	// PreservePathSuffix applies to redirects that do not set their own.
+	// +optional
+	PreservePathSuffix *bool `json:"preservePathSuffix,omitempty"`
+
+	// Expression selects the requests the redirects apply to. It defaults
+	// to every request whose URL is in the redirect list.
+	// +optional
+	Expression *string `json:"expression,omitempty"`
+
+	// Enabled turns the redirects on or off without removing them.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// BulkRedirectObservation are the observable fields of a BulkRedirect.
+type BulkRedirectObservation struct {
+	// ListID is the ID of the redirect list.
+	ListID string `json:"listId,omitempty"`
+
+	// NumItems is the number of redirects in the list.
+	NumItems int `json:"numItems,omitempty"`
+
+	// RulesetID is the ID of the http_request_redirect entrypoint ruleset.
+	RulesetID string `json:"rulesetId,omitempty"`
+
+	// RuleID is the ID of the rule enabling the redirect list.
+	RuleID string `json:"ruleId,omitempty"`
+}
+
+// A BulkRedirectSpec defines the desired state of a BulkRedirect.
+type BulkRedirectSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	ForProvider       BulkRedirectParameters `json:"forProvider"`
+}
+
+// A BulkRedirectStatus represents the observed state of a BulkRedirect.
+type BulkRedirectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BulkRedirectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BulkRedirect is a set of Cloudflare Bulk Redirects: a redirect List and
+// the rule in the account http_request_redirect phase that enables it.
+// Other rules in the phase are left alone.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REDIRECTS",type="integer",JSONPath=".status.atProvider.numItems"
+// +kubebuilder:printcolumn:name="LIST",type="string",JSONPath=".status.atProvider.listId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudflare}
+type BulkRedirect struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BulkRedirectSpec   `json:"spec"`
+	Status BulkRedirectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BulkRedirectList contains a list of BulkRedirects
+type BulkRedirectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BulkRedirect `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BulkRedirect{}, &BulkRedirectList{})
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// Kinds of List.
+const (
+	ListKindIP       = "ip"
+	ListKindHostname = "hostname"
+	ListKindASN      = "asn"
+	ListKindRedirect = "redirect"
+)
+
+// Item is a single entry of a List.
+type Item struct {
+	// Value of an item in an ip, hostname or asn list: an IP address or
+	// CIDR range, a hostname, or an AS number respectively.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// Redirect of an item in a redirect list.
+	// +optional
+	Redirect *Redirect `json:"redirect,omitempty"`
+
+	// Comment describing the item.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// Redirect is an item of a redirect list, used by Bulk Redirects.
+type Redirect struct {
+	// SourceURL is the URL to redirect from, without a scheme.
+	SourceURL string `json:"sourceUrl"`
+
+	// TargetURL is the URL to redirect to.
+	TargetURL string `json:"targetUrl"`
+
+	// StatusCode of the redirect. Cloudflare defaults to 301.
+	// +kubebuilder:validation:Enum=301;302;307;308
+	// +optional
+	StatusCode *int `json:"statusCode,omitempty"`
+
+	// IncludeSubdomains also redirects subdomains of the source URL.
+	// +optional
+	IncludeSubdomains *bool `json:"includeSubdomains,omitempty"`
+
+	// SubpathMatching also redirects paths below the source URL.
+	// +optional
+	SubpathMatching *bool `json:"subpathMatching,omitempty"`
+
+	// PreserveQueryString keeps the query string of the request.
+	// +optional
+	PreserveQueryString *bool `json:"preserveQueryString,omitempty"`
+
+	// PreservePathSuffix appends the part of the path below the source URL
+	// to the target URL. Requires SubpathMatching.
+	// +optional
+	PreservePathSuffix *bool `json:"preservePathSuffix,omitempty"`
+}
+
+// ItemsSource is a source of items held outside the resource.
+type ItemsSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap in the namespace of the
+	// resource. Changes to the ConfigMap are applied when the resource is
+	// next polled.
+	ConfigMapKeyRef ConfigMapKeySelector `json:"configMapKeyRef"`
+}
+
+// ConfigMapKeySelector selects a key of a ConfigMap.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Key of the ConfigMap holding the items.
+	Key string `json:"key"`
+}
+
+// ListParameters are the configurable fields of a List.
+type ListParameters struct {
+	// AccountID is the account this list is managed under. It overrides
+	// the accountId of the ProviderConfig.
+	// +optional
+	// +immutable
+	AccountID *string `json:"accountId,omitempty"`
+
+	// Name of the list, used to reference it in rule expressions as
+	// $name.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9_]+$`
+	// +kubebuilder:validation:MaxLength=50
+	// +immutable
+	Name string `json:"name"`
+
+	// Kind of items the list holds.
+	// +kubebuilder:validation:Enum=ip;hostname;asn;redirect
+	// +immutable
+	Kind string `json:"kind"`
+
+	// Description of the list.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Items of the list.
+	// +optional
+	Items []Item `json:"items,omitempty"`
+
+	// ItemsFrom reads further items of the list from a ConfigMap. The key
+	// holds either a YAML list of items, a YAML list of values, or one
+	// value per line. In the latter blank lines and lines starting with #
+	// are ignored, and text after a # is the comment of the item. A key
+	// without items, such as an empty key or one holding only comments, is
+	// an error, so that the items are not deleted while the ConfigMap is
+	// being populated; use [] for a list without items.
+	// +optional
+	ItemsFrom *ItemsSource `json:"itemsFrom,omitempty"`
+}
+
+// ListObservation are the observable fields of a List.
+type ListObservation struct {
+	// ID is the identifier of the list assigned by Cloudflare.
+	ID string `json:"id,omitempty"`
+
+	// NumItems is the number of items in the list.
+	NumItems int `json:"numItems,omitempty"`
+
+	// NumReferencingFilters is the number of filters referencing the list.
+	NumReferencingFilters int `json:"numReferencingFilters,omitempty"`
+
+	// CreatedOn indicates when the list was created.
+	CreatedOn *metav1.Time `json:"createdOn,omitempty"`
+
+	// ModifiedOn indicates when the list was last modified.
+	ModifiedOn *metav1.Time `json:"modifiedOn,omitempty"`
+}
+
+// A ListSpec defines the desired state of a List.
+type ListSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	ForProvider       ListParameters `json:"forProvider"`
+}
+
+// A ListStatus represents the observed state of a List.
+type ListStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ListObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A List is an account-level Cloudflare List of IP addresses, hostnames, AS
+// numbers or redirects, referenced from rule expressions as $name. When
+// neither items nor itemsFrom is set the List leaves its items alone, so
+// they can be managed with ListItems instead.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="KIND",type="string",JSONPath=".spec.forProvider.kind"
+// +kubebuilder:printcolumn:name="ITEMS",type="integer",JSONPath=".status.atProvider.numItems"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudflare}
+type List struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListSpec   `json:"spec"`
+	Status ListStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListList contains a list of Lists
+type ListList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []List `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&List{}, &ListList{})
+}
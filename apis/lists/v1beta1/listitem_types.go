@@ -0,0 +1,113 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// ListItemParameters are the configurable fields of a ListItem.
+type ListItemParameters struct {
+	// AccountID is the account the list is managed under. It overrides
+	// the accountId of the ProviderConfig.
+	// +optional
+	// +immutable
+	AccountID *string `json:"accountId,omitempty"`
+
+	// ListID is the ID of the list holding the item.
+	// +crossplane:generate:reference:type=List
+	// +optional
+	// +immutable
+	ListID *string `json:"listId,omitempty"`
+
+	// ListIDRef is a reference to a List.
+	// +optional
+	ListIDRef *xpv1.NamespacedReference `json:"listIdRef,omitempty"`
+
+	// ListIDSelector selects a List.
+	// +optional
+	ListIDSelector *xpv1.NamespacedSelector `json:"listIdSelector,omitempty"`
+
+	Item `json:",inline"`
+}
+
+// ListItemObservation are the observable fields of a ListItem.
+type ListItemObservation struct {
+	// ID is the identifier of the item assigned by Cloudflare.
+	ID string `json:"id,omitempty"`
+
+	// CreatedOn indicates when the item was created.
+	CreatedOn *metav1.Time `json:"createdOn,omitempty"`
+
+	// ModifiedOn indicates when the item was last modified.
+	ModifiedOn *metav1.Time `json:"modifiedOn,omitempty"`
+}
+
+// A ListItemSpec defines the desired state of a ListItem.
+type ListItemSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	ForProvider       ListItemParameters `json:"forProvider"`
+}
+
+// A ListItemStatus represents the observed state of a ListItem.
+type ListItemStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ListItemObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ListItem is a single item of a Cloudflare List whose items are not
+// managed by the List itself.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VALUE",type="string",JSONPath=".spec.forProvider.value"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudflare}
+type ListItem struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListItemSpec   `json:"spec"`
+	Status ListItemStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListItemList contains a list of ListItems
+type ListItemList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ListItem `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ListItem{}, &ListItemList{})
+}
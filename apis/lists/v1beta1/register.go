@@ -0,0 +1,54 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// List type metadata.
+const (
+	ListKind = "List"
+)
+
+var (
+	ListKindAPIVersion   = ListKind + "." + GroupVersion.String()
+	ListGroupKind        = schema.GroupKind{Group: Group, Kind: ListKind}.String()
+	ListGroupVersionKind = GroupVersion.WithKind(ListKind)
+)
+
+// ListItem type metadata.
+const (
+	ListItemKind = "ListItem"
+)
+
+var (
+	ListItemKindAPIVersion   = ListItemKind + "." + GroupVersion.String()
+	ListItemGroupKind        = schema.GroupKind{Group: Group, Kind: ListItemKind}.String()
+	ListItemGroupVersionKind = GroupVersion.WithKind(ListItemKind)
+)
+
+// BulkRedirect type metadata.
+const (
+	BulkRedirectKind = "BulkRedirect"
+)
+
+var (
+	BulkRedirectKindAPIVersion   = BulkRedirectKind + "." + GroupVersion.String()
+	BulkRedirectGroupKind        = schema.GroupKind{Group: Group, Kind: BulkRedirectKind}.String()
+	BulkRedirectGroupVersionKind = GroupVersion.WithKind(BulkRedirectKind)
+)
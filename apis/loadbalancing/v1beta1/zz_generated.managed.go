@@ -24,23 +24,18 @@ func (mg *LoadBalancer) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
 	return mg.Status.GetCondition(ct)
 }
 
-// GetDeletionPolicy of this LoadBalancer.
-func (mg *LoadBalancer) GetDeletionPolicy() xpv1.DeletionPolicy {
-	return mg.Spec.DeletionPolicy
-}
-
 // GetManagementPolicies of this LoadBalancer.
 func (mg *LoadBalancer) GetManagementPolicies() xpv1.ManagementPolicies {
 	return mg.Spec.ManagementPolicies
 }
 
 // GetProviderConfigReference of this LoadBalancer.
-func (mg *LoadBalancer) GetProviderConfigReference() *xpv1.Reference {
+func (mg *LoadBalancer) GetProviderConfigReference() *xpv1.ProviderConfigReference {
 	return mg.Spec.ProviderConfigReference
 }
 
 // GetWriteConnectionSecretToReference of this LoadBalancer.
-func (mg *LoadBalancer) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+func (mg *LoadBalancer) GetWriteConnectionSecretToReference() *xpv1.LocalSecretReference {
 	return mg.Spec.WriteConnectionSecretToReference
 }
 
@@ -49,23 +44,18 @@ func (mg *LoadBalancer) SetConditions(c ...xpv1.Condition) {
 	mg.Status.SetConditions(c...)
 }
 
-// SetDeletionPolicy of this LoadBalancer.
-func (mg *LoadBalancer) SetDeletionPolicy(r xpv1.DeletionPolicy) {
-	mg.Spec.DeletionPolicy = r
-}
-
 // SetManagementPolicies of this LoadBalancer.
 func (mg *LoadBalancer) SetManagementPolicies(r xpv1.ManagementPolicies) {
 	mg.Spec.ManagementPolicies = r
 }
 
 // SetProviderConfigReference of this LoadBalancer.
-func (mg *LoadBalancer) SetProviderConfigReference(r *xpv1.Reference) {
+func (mg *LoadBalancer) SetProviderConfigReference(r *xpv1.ProviderConfigReference) {
 	mg.Spec.ProviderConfigReference = r
 }
 
 // SetWriteConnectionSecretToReference of this LoadBalancer.
-func (mg *LoadBalancer) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+func (mg *LoadBalancer) SetWriteConnectionSecretToReference(r *xpv1.LocalSecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
 
@@ -74,23 +64,18 @@ func (mg *LoadBalancerMonitor) GetCondition(ct xpv1.ConditionType) xpv1.Conditio
 	return mg.Status.GetCondition(ct)
 }
 
-// GetDeletionPolicy of this LoadBalancerMonitor.
-func (mg *LoadBalancerMonitor) GetDeletionPolicy() xpv1.DeletionPolicy {
-	return mg.Spec.DeletionPolicy
-}
-
 // GetManagementPolicies of this LoadBalancerMonitor.
 func (mg *LoadBalancerMonitor) GetManagementPolicies() xpv1.ManagementPolicies {
 	return mg.Spec.ManagementPolicies
 }
 
 // GetProviderConfigReference of this LoadBalancerMonitor.
-func (mg *LoadBalancerMonitor) GetProviderConfigReference() *xpv1.Reference {
+func (mg *LoadBalancerMonitor) GetProviderConfigReference() *xpv1.ProviderConfigReference {
 	return mg.Spec.ProviderConfigReference
 }
 
 // GetWriteConnectionSecretToReference of this LoadBalancerMonitor.
-func (mg *LoadBalancerMonitor) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+func (mg *LoadBalancerMonitor) GetWriteConnectionSecretToReference() *xpv1.LocalSecretReference {
 	return mg.Spec.WriteConnectionSecretToReference
 }
 
@@ -99,23 +84,18 @@ func (mg *LoadBalancerMonitor) SetConditions(c ...xpv1.Condition) {
 	mg.Status.SetConditions(c...)
 }
 
-// SetDeletionPolicy of this LoadBalancerMonitor.
-func (mg *LoadBalancerMonitor) SetDeletionPolicy(r xpv1.DeletionPolicy) {
-	mg.Spec.DeletionPolicy = r
-}
-
 // SetManagementPolicies of this LoadBalancerMonitor.
 func (mg *LoadBalancerMonitor) SetManagementPolicies(r xpv1.ManagementPolicies) {
 	mg.Spec.ManagementPolicies = r
 }
 
 // SetProviderConfigReference of this LoadBalancerMonitor.
-func (mg *LoadBalancerMonitor) SetProviderConfigReference(r *xpv1.Reference) {
+func (mg *LoadBalancerMonitor) SetProviderConfigReference(r *xpv1.ProviderConfigReference) {
 	mg.Spec.ProviderConfigReference = r
 }
 
 // SetWriteConnectionSecretToReference of this LoadBalancerMonitor.
-func (mg *LoadBalancerMonitor) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+func (mg *LoadBalancerMonitor) SetWriteConnectionSecretToReference(r *xpv1.LocalSecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
 
@@ -124,23 +104,18 @@ func (mg *LoadBalancerPool) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
 	return mg.Status.GetCondition(ct)
 }
 
-// GetDeletionPolicy of this LoadBalancerPool.
-func (mg *LoadBalancerPool) GetDeletionPolicy() xpv1.DeletionPolicy {
-	return mg.Spec.DeletionPolicy
-}
-
 // GetManagementPolicies of this LoadBalancerPool.
 func (mg *LoadBalancerPool) GetManagementPolicies() xpv1.ManagementPolicies {
 	return mg.Spec.ManagementPolicies
 }
 
 // GetProviderConfigReference of this LoadBalancerPool.
-func (mg *LoadBalancerPool) GetProviderConfigReference() *xpv1.Reference {
+func (mg *LoadBalancerPool) GetProviderConfigReference() *xpv1.ProviderConfigReference {
 	return mg.Spec.ProviderConfigReference
 }
 
 // GetWriteConnectionSecretToReference of this LoadBalancerPool.
-func (mg *LoadBalancerPool) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+func (mg *LoadBalancerPool) GetWriteConnectionSecretToReference() *xpv1.LocalSecretReference {
 	return mg.Spec.WriteConnectionSecretToReference
 }
 
@@ -149,22 +124,17 @@ func (mg *LoadBalancerPool) SetConditions(c ...xpv1.Condition) {
 	mg.Status.SetConditions(c...)
 }
 
-// SetDeletionPolicy of this LoadBalancerPool.
-func (mg *LoadBalancerPool) SetDeletionPolicy(r xpv1.DeletionPolicy) {
-	mg.Spec.DeletionPolicy = r
-}
-
 // SetManagementPolicies of this LoadBalancerPool.
 func (mg *LoadBalancerPool) SetManagementPolicies(r xpv1.ManagementPolicies) {
 	mg.Spec.ManagementPolicies = r
 }
 
 // SetProviderConfigReference of this LoadBalancerPool.
-func (mg *LoadBalancerPool) SetProviderConfigReference(r *xpv1.Reference) {
+func (mg *LoadBalancerPool) SetProviderConfigReference(r *xpv1.ProviderConfigReference) {
 	mg.Spec.ProviderConfigReference = r
 }
 
 // SetWriteConnectionSecretToReference of this LoadBalancerPool.
-func (mg *LoadBalancerPool) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+func (mg *LoadBalancerPool) SetWriteConnectionSecretToReference(r *xpv1.LocalSecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
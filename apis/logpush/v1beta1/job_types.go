@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // JobParameters are the configurable fields of a Logpush Job.
@@ -73,6 +74,29 @@ type JobParameters struct {
 	// MaxUploadIntervalSeconds is the maximum upload interval in seconds.
 	// +kubebuilder:validation:Optional
 	MaxUploadIntervalSeconds *int `json:"maxUploadIntervalSeconds,omitempty"`
+
+	// AccountID is the account this job is managed under. It overrides
+	// the accountId of the ProviderConfig. Ignored for zone-scoped jobs.
+	// +kubebuilder:validation:Optional
+	// +immutable
+	AccountID *string `json:"accountId,omitempty"`
+
+	// Zone is the zone identifier for a zone-scoped job. When no zone is
+	// set the job is account-scoped.
+	// +kubebuilder:validation:Optional
+	// +crossplane:generate:reference:type=github.com/rossigee/provider-cloudflare/apis/zone/v1beta1.Zone
+	// +immutable
+	Zone *string `json:"zone,omitempty"`
+
+	// ZoneRef references the Zone object this job is managed on.
+	// +kubebuilder:validation:Optional
+	// +immutable
+	ZoneRef *rtv1.NamespacedReference `json:"zoneRef,omitempty"`
+
+	// ZoneSelector selects the Zone object this job is managed on.
+	// +kubebuilder:validation:Optional
+	// +immutable
+	ZoneSelector *rtv1.NamespacedSelector `json:"zoneSelector,omitempty"`
 }
 
 // OutputOptions contains output configuration for logpush jobs.
@@ -201,7 +225,15 @@ type JobObservation struct {
 
 // A JobSpec defines the desired state of a Logpush Job.
 type JobSpec struct {
-	rtv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy rtv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       JobParameters `json:"forProvider"`
 }
 
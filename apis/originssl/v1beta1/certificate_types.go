@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // CertificateParameters define the desired state of a Cloudflare Origin CA Certificate.
@@ -76,7 +77,15 @@ type CertificateObservation struct {
 
 // CertificateSpec defines the desired state of a Certificate.
 type CertificateSpec struct {
-	rtv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy rtv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       CertificateParameters `json:"forProvider"`
 }
 
@@ -118,56 +127,6 @@ func init() {
 	SchemeBuilder.Register(&Certificate{}, &CertificateList{})
 }
 
-// GetCondition of this Certificate.
-func (mg *Certificate) GetCondition(ct rtv1.ConditionType) rtv1.Condition {
-	return mg.Status.GetCondition(ct)
-}
-
-// GetDeletionPolicy of this Certificate.
-func (mg *Certificate) GetDeletionPolicy() rtv1.DeletionPolicy {
-	return mg.Spec.DeletionPolicy
-}
-
-// GetManagementPolicies of this Certificate.
-func (mg *Certificate) GetManagementPolicies() rtv1.ManagementPolicies {
-	return mg.Spec.ManagementPolicies
-}
-
-// GetProviderConfigReference of this Certificate.
-func (mg *Certificate) GetProviderConfigReference() *rtv1.Reference {
-	return mg.Spec.ProviderConfigReference
-}
-
-// GetWriteConnectionSecretToReference of this Certificate.
-func (mg *Certificate) GetWriteConnectionSecretToReference() *rtv1.SecretReference {
-	return mg.Spec.WriteConnectionSecretToReference
-}
-
-// SetConditions of this Certificate.
-func (mg *Certificate) SetConditions(c ...rtv1.Condition) {
-	mg.Status.SetConditions(c...)
-}
-
-// SetDeletionPolicy of this Certificate.
-func (mg *Certificate) SetDeletionPolicy(r rtv1.DeletionPolicy) {
-	mg.Spec.DeletionPolicy = r
-}
-
-// SetManagementPolicies of this Certificate.
-func (mg *Certificate) SetManagementPolicies(r rtv1.ManagementPolicies) {
-	mg.Spec.ManagementPolicies = r
-}
-
-// SetProviderConfigReference of this Certificate.
-func (mg *Certificate) SetProviderConfigReference(r *rtv1.Reference) {
-	mg.Spec.ProviderConfigReference = r
-}
-
-// SetWriteConnectionSecretToReference of this Certificate.
-func (mg *Certificate) SetWriteConnectionSecretToReference(r *rtv1.SecretReference) {
-	mg.Spec.WriteConnectionSecretToReference = r
-}
-
 // GetGroupVersionKind returns the GroupVersionKind for Certificate.
 func (mg *Certificate) GetGroupVersionKind() schema.GroupVersionKind {
 	return CertificateGroupVersionKind
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // BucketParameters are the configurable fields of a Bucket.
@@ -34,6 +35,12 @@ type BucketParameters struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=apac;eeur;enam;weur;wnam
 	LocationHint *string `json:"locationHint,omitempty"`
+
+	// AccountID is the account this bucket is managed under. It
+	// overrides the accountId of the ProviderConfig.
+	// +kubebuilder:validation:Optional
+	// +immutable
+	AccountID *string `json:"accountId,omitempty"`
 }
 
 // BucketObservation are the observable fields of a Bucket.
@@ -50,7 +57,15 @@ type BucketObservation struct {
 
 // A BucketSpec defines the desired state of a Bucket.
 type BucketSpec struct {
-	rtv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy rtv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       BucketParameters `json:"forProvider"`
 }
 
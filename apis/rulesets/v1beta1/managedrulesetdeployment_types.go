@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// ManagedRulesetDeploymentParameters are the configurable fields of a
+// ManagedRulesetDeployment.
+type ManagedRulesetDeploymentParameters struct {
+	// Zone is the zone ID the managed ruleset is deployed to.
+	// Either Zone or Account must be specified, but not both.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// Account is the account ID the managed ruleset is deployed to.
+	// Either Zone or Account must be specified, but not both.
+	// +optional
+	Account *string `json:"account,omitempty"`
+
+	// Phase is the entrypoint phase the managed ruleset is executed from.
+	// +kubebuilder:default=http_request_firewall_managed
+	// +optional
+	Phase *string `json:"phase,omitempty"`
+
+	// ManagedRulesetID is the ID of the Cloudflare managed ruleset to
+	// deploy, such as the Cloudflare Managed Ruleset or the OWASP Core
+	// Ruleset.
+	// +required
+	ManagedRulesetID string `json:"managedRulesetId"`
+
+	// Ref identifies the execute rule of this deployment within the
+	// entrypoint ruleset. Skip exceptions are given refs derived from it.
+	// Defaults to the managed ruleset ID.
+	// +optional
+	Ref *string `json:"ref,omitempty"`
+
+	// Expression selects the requests the managed ruleset is executed for.
+	// +kubebuilder:default="true"
+	// +optional
+	Expression *string `json:"expression,omitempty"`
+
+	// Description is a human-readable description of the execute rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Enabled indicates whether the managed ruleset is executed.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Overrides changes the action, sensitivity or status of the rules of
+	// the managed ruleset, for all rules, by category or by rule.
+	// +optional
+	Overrides *RuleOverrides `json:"overrides,omitempty"`
+
+	// MatchedData enables payload logging for the managed ruleset.
+	// +optional
+	MatchedData *RuleMatchedData `json:"matchedData,omitempty"`
+
+	// SkipExceptions are skip rules placed before the execute rule, which
+	// exempt matching requests from the whole managed ruleset or from
+	// some of its rules.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	SkipExceptions []ManagedRulesetSkipException `json:"skipExceptions,omitempty"`
+}
+
+// ManagedRulesetSkipException exempts requests from a managed ruleset.
+type ManagedRulesetSkipException struct {
+	// Name identifies the exception within the deployment. It must be
+	// unique and stable, as it is used to build the ref of the skip rule.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9_]+$`
+	// +required
+	Name string `json:"name"`
+
+	// Expression selects the requests to exempt.
+	// +required
+	Expression string `json:"expression"`
+
+	// Description is a human-readable description of the exception.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Enabled indicates whether the exception is active.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Rules lists the IDs of the managed rules to skip. The whole managed
+	// ruleset is skipped when no rules are given.
+	// +optional
+	Rules []string `json:"rules,omitempty"`
+
+	// Logging controls whether requests matching the exception are logged.
+	// +optional
+	Logging *RuleLogging `json:"logging,omitempty"`
+}
+
+// ManagedRulesetDeploymentObservation are the observable fields of a
+// ManagedRulesetDeployment.
+type ManagedRulesetDeploymentObservation struct {
+	// RulesetID is the ID of the entrypoint ruleset the managed ruleset is
+	// deployed to.
+	RulesetID string `json:"rulesetId,omitempty"`
+
+	// RuleID is the ID of the execute rule.
+	RuleID string `json:"ruleId,omitempty"`
+
+	// Version is the version of the execute rule.
+	Version string `json:"version,omitempty"`
+
+	// Rules lists the skip and execute rules of the deployment, in order.
+	Rules []RulesetRuleObservation `json:"rules,omitempty"`
+}
+
+// A ManagedRulesetDeploymentSpec defines the desired state of a
+// ManagedRulesetDeployment.
+type ManagedRulesetDeploymentSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	ForProvider       ManagedRulesetDeploymentParameters `json:"forProvider"`
+}
+
+// A ManagedRulesetDeploymentStatus represents the observed state of a
+// ManagedRulesetDeployment.
+type ManagedRulesetDeploymentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ManagedRulesetDeploymentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ManagedRulesetDeployment executes a Cloudflare managed ruleset from the
+// entrypoint ruleset of a phase. It manages only its own execute rule and
+// skip exceptions, so several deployments can share an entrypoint.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="MANAGED-RULESET",type="string",JSONPath=".spec.forProvider.managedRulesetId"
+// +kubebuilder:printcolumn:name="RULE-ID",type="string",JSONPath=".status.atProvider.ruleId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudflare}
+type ManagedRulesetDeployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ManagedRulesetDeploymentSpec   `json:"spec"`
+	Status ManagedRulesetDeploymentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManagedRulesetDeploymentList contains a list of ManagedRulesetDeployments
+type ManagedRulesetDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ManagedRulesetDeployment `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ManagedRulesetDeployment{}, &ManagedRulesetDeploymentList{})
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// PhaseRuleParameters are the configurable fields of a PhaseRule.
+type PhaseRuleParameters struct {
+	// Zone is the zone ID whose phase entrypoint holds the rule.
+	// Either Zone or Account must be specified, but not both.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// Account is the account ID whose phase entrypoint holds the rule.
+	// Either Zone or Account must be specified, but not both.
+	// +optional
+	Account *string `json:"account,omitempty"`
+
+	// Phase is the phase whose entrypoint ruleset holds the rule, such as
+	// "http_request_origin", "http_config_settings" or
+	// "http_response_compression".
+	// +required
+	Phase string `json:"phase"`
+
+	// Position places the rule within the entrypoint ruleset, and is
+	// restored whenever the rule is moved. A rule without a position is
+	// appended when created and then left where it is.
+	// +optional
+	Position *PhaseRulePosition `json:"position,omitempty"`
+
+	RulesetRule `json:",inline"`
+}
+
+// PhaseRulePosition places a rule within its entrypoint ruleset. At most one
+// field may be set.
+// +kubebuilder:validation:MaxProperties=1
+type PhaseRulePosition struct {
+	// Before places the rule before the rule with this ref.
+	// +optional
+	Before *string `json:"before,omitempty"`
+
+	// After places the rule after the rule with this ref.
+	// +optional
+	After *string `json:"after,omitempty"`
+
+	// Index places the rule at this 1-based index.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Index *int `json:"index,omitempty"`
+}
+
+// PhaseRuleObservation are the observable fields of a PhaseRule.
+type PhaseRuleObservation struct {
+	// ID is the identifier of the rule assigned by Cloudflare.
+	ID string `json:"id,omitempty"`
+
+	// RulesetID is the ID of the entrypoint ruleset holding the rule.
+	RulesetID string `json:"rulesetId,omitempty"`
+
+	// Version is the version of the rule.
+	Version string `json:"version,omitempty"`
+
+	// Index is the current 1-based position of the rule in the ruleset.
+	Index int `json:"index,omitempty"`
+
+	// LastModified indicates when the rule was last modified.
+	LastModified *metav1.Time `json:"lastModified,omitempty"`
+}
+
+// A PhaseRuleSpec defines the desired state of a PhaseRule.
+type PhaseRuleSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	ForProvider       PhaseRuleParameters `json:"forProvider"`
+}
+
+// A PhaseRuleStatus represents the observed state of a PhaseRule.
+type PhaseRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PhaseRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PhaseRule is a single rule in the entrypoint ruleset of a phase. Unlike a
+// Ruleset in entrypoint mode it leaves the other rules of the phase alone, so
+// several owners can manage rules in the same phase.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=".spec.forProvider.phase"
+// +kubebuilder:printcolumn:name="INDEX",type="integer",JSONPath=".status.atProvider.index"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,cloudflare}
+type PhaseRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PhaseRuleSpec   `json:"spec"`
+	Status PhaseRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PhaseRuleList contains a list of PhaseRules
+type PhaseRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PhaseRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PhaseRule{}, &PhaseRuleList{})
+}
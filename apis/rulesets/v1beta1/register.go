@@ -29,4 +29,25 @@ var (
 	RulesetKindAPIVersion   = RulesetKind + "." + GroupVersion.String()
 	RulesetGroupKind        = schema.GroupKind{Group: Group, Kind: RulesetKind}.String()
 	RulesetGroupVersionKind = GroupVersion.WithKind(RulesetKind)
-)
\ No newline at end of file
+)
+// ManagedRulesetDeployment type metadata.
+const (
+	ManagedRulesetDeploymentKind = "ManagedRulesetDeployment"
+)
+
+var (
+	ManagedRulesetDeploymentKindAPIVersion   = ManagedRulesetDeploymentKind + "." + GroupVersion.String()
+	ManagedRulesetDeploymentGroupKind        = schema.GroupKind{Group: Group, Kind: ManagedRulesetDeploymentKind}.String()
+	ManagedRulesetDeploymentGroupVersionKind = GroupVersion.WithKind(ManagedRulesetDeploymentKind)
+)
+
+// PhaseRule type metadata.
+const (
+	PhaseRuleKind = "PhaseRule"
+)
+
+var (
+	PhaseRuleKindAPIVersion   = PhaseRuleKind + "." + GroupVersion.String()
+	PhaseRuleGroupKind        = schema.GroupKind{Group: Group, Kind: PhaseRuleKind}.String()
+	PhaseRuleGroupVersionKind = GroupVersion.WithKind(PhaseRuleKind)
+)
@@ -20,10 +20,27 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// Ruleset management modes
+const (
+	// RulesetModeCustom creates and deletes a ruleset of its own.
+	RulesetModeCustom = "custom"
+	// RulesetModeEntrypoint adopts the phase entrypoint ruleset of the zone
+	// or account and manages its own rules within it.
+	RulesetModeEntrypoint = "entrypoint"
 )
 
 // RulesetRule defines a single rule within a ruleset
 type RulesetRule struct {
+	// Ref is a stable identifier for the rule within the ruleset. Rules
+	// with a ref are matched against the rules Cloudflare reports by ref,
+	// so that only rules that changed are updated. When any rule in the
+	// ruleset has no ref the whole rule list is replaced on update.
+	// +optional
+	Ref *string `json:"ref,omitempty"`
+
 	// Action specifies what to do when the rule matches
 	// Valid values: "allow", "block", "challenge", "js_challenge", "log", "skip", "rewrite", "redirect"
 	// +required
@@ -41,9 +58,665 @@ type RulesetRule struct {
 	// Enabled indicates whether this rule is active
 	// +optional
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// ActionParameters configures the behaviour of the rule action.
+	// +optional
+	ActionParameters *RuleActionParameters `json:"actionParameters,omitempty"`
+
+	// Logging controls whether matches of the rule are logged.
+	// +optional
+	Logging *RuleLogging `json:"logging,omitempty"`
+
+	// RateLimit configures the rate limiting behaviour of rules in the
+	// http_ratelimit phase.
+	// +optional
+	RateLimit *RuleRateLimit `json:"rateLimit,omitempty"`
+
+	// ExposedCredentialCheck configures how credentials are extracted from
+	// requests for leaked credential detection.
+	// +optional
+	ExposedCredentialCheck *RuleExposedCredentialCheck `json:"exposedCredentialCheck,omitempty"`
+
+	// ScoreThreshold is the anomaly score threshold for the rule.
+	// +optional
+	ScoreThreshold *int `json:"scoreThreshold,omitempty"`
+}
+
+// RuleActionParameters configures the behaviour of a rule action. Which
+// fields apply depends on the action of the rule.
+type RuleActionParameters struct {
+	// ID is the ruleset to run for the execute action.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Version is the version of the ruleset to run for the execute action.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// Overrides changes the behaviour of the executed ruleset.
+	// +optional
+	Overrides *RuleOverrides `json:"overrides,omitempty"`
+
+	// MatchedData enables payload logging for the executed ruleset.
+	// +optional
+	MatchedData *RuleMatchedData `json:"matchedData,omitempty"`
+
+	// Ruleset skips the remaining rules of the current ruleset. The only
+	// accepted value is "current".
+	// +optional
+	Ruleset *string `json:"ruleset,omitempty"`
+
+	// Rulesets lists the rulesets to skip.
+	// +optional
+	Rulesets []string `json:"rulesets,omitempty"`
+
+	// Rules maps ruleset IDs to the rule IDs to skip within them.
+	// +optional
+	Rules map[string][]string `json:"rules,omitempty"`
+
+	// Phases lists the phases to skip.
+	// +optional
+	Phases []string `json:"phases,omitempty"`
+
+	// Products lists the legacy security products to skip.
+	// +optional
+	Products []string `json:"products,omitempty"`
+
+	// Increment is the amount to add to the anomaly score.
+	// +optional
+	Increment *int `json:"increment,omitempty"`
+
+	// Response customises the response returned by the block action.
+	// +optional
+	Response *RuleBlockResponse `json:"response,omitempty"`
+
+	// URI rewrites the request URI.
+	// +optional
+	URI *RuleURI `json:"uri,omitempty"`
+
+	// Headers modifies request or response headers, keyed by header name.
+	// +optional
+	Headers map[string]RuleHTTPHeader `json:"headers,omitempty"`
+
+	// FromList redirects requests using a Bulk Redirect list.
+	// +optional
+	FromList *RuleFromList `json:"fromList,omitempty"`
+
+	// FromValue redirects requests to a static or dynamic target URL.
+	// +optional
+	FromValue *RuleFromValue `json:"fromValue,omitempty"`
+
+	// HostHeader overrides the Host header sent to the origin.
+	// +optional
+	HostHeader *string `json:"hostHeader,omitempty"`
+
+	// Origin overrides the origin the request is sent to.
+	// +optional
+	Origin *RuleOrigin `json:"origin,omitempty"`
+
+	// SNI overrides the server name indication sent to the origin.
+	// +optional
+	SNI *RuleSNI `json:"sni,omitempty"`
+
+	// RequestFields lists the request headers to include in logs.
+	// +optional
+	RequestFields []string `json:"requestFields,omitempty"`
+
+	// ResponseFields lists the response headers to include in logs.
+	// +optional
+	ResponseFields []string `json:"responseFields,omitempty"`
+
+	// CookieFields lists the cookies to include in logs.
+	// +optional
+	CookieFields []string `json:"cookieFields,omitempty"`
+
+	// Content is the response body served by the serve_error action.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentType is the content type served by the serve_error action.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// StatusCode is the status code served by the serve_error action.
+	// +optional
+	StatusCode *int `json:"statusCode,omitempty"`
+
+	// Cache controls whether matching requests are eligible for caching.
+	// +optional
+	Cache *bool `json:"cache,omitempty"`
+
+	// CacheKey customises the cache key of matching requests.
+	// +optional
+	CacheKey *RuleCacheKey `json:"cacheKey,omitempty"`
+
+	// CacheReserve controls Cache Reserve eligibility.
+	// +optional
+	CacheReserve *RuleCacheReserve `json:"cacheReserve,omitempty"`
+
+	// EdgeTTL controls how long Cloudflare caches matching responses.
+	// +optional
+	EdgeTTL *RuleEdgeTTL `json:"edgeTtl,omitempty"`
+
+	// BrowserTTL controls how long browsers cache matching responses.
+	// +optional
+	BrowserTTL *RuleBrowserTTL `json:"browserTtl,omitempty"`
+
+	// ServeStale controls serving stale content while revalidating.
+	// +optional
+	ServeStale *RuleServeStale `json:"serveStale,omitempty"`
+
+	// RespectStrongETags controls whether strong ETags are respected.
+	// +optional
+	RespectStrongETags *bool `json:"respectStrongEtags,omitempty"`
+
+	// OriginCacheControl controls whether origin Cache-Control is respected.
+	// +optional
+	OriginCacheControl *bool `json:"originCacheControl,omitempty"`
+
+	// OriginErrorPagePassthru passes origin error pages through.
+	// +optional
+	OriginErrorPagePassthru *bool `json:"originErrorPagePassthru,omitempty"`
+
+	// AdditionalCacheablePorts lists non-standard ports eligible for caching.
+	// +optional
+	AdditionalCacheablePorts []int `json:"additionalCacheablePorts,omitempty"`
+
+	// ReadTimeout is the origin read timeout in seconds.
+	// +optional
+	ReadTimeout *int `json:"readTimeout,omitempty"`
+
+	// AutomaticHTTPSRewrites toggles Automatic HTTPS Rewrites.
+	// +optional
+	AutomaticHTTPSRewrites *bool `json:"automaticHttpsRewrites,omitempty"`
+
+	// AutoMinify configures which resources are minified.
+	// +optional
+	AutoMinify *RuleAutoMinify `json:"autoMinify,omitempty"`
+
+	// BrowserIntegrityCheck toggles the Browser Integrity Check.
+	// +optional
+	BrowserIntegrityCheck *bool `json:"browserIntegrityCheck,omitempty"`
+
+	// DisableApps disables Cloudflare Apps.
+	// +optional
+	DisableApps *bool `json:"disableApps,omitempty"`
+
+	// DisableZaraz disables Zaraz.
+	// +optional
+	DisableZaraz *bool `json:"disableZaraz,omitempty"`
+
+	// DisableRailgun disables Railgun.
+	// +optional
+	DisableRailgun *bool `json:"disableRailgun,omitempty"`
+
+	// DisableRUM disables Real User Monitoring.
+	// +optional
+	DisableRUM *bool `json:"disableRum,omitempty"`
+
+	// EmailObfuscation toggles Email Obfuscation.
+	// +optional
+	EmailObfuscation *bool `json:"emailObfuscation,omitempty"`
+
+	// Fonts toggles Cloudflare Fonts.
+	// +optional
+	Fonts *bool `json:"fonts,omitempty"`
+
+	// HotLinkProtection toggles Hotlink Protection.
+	// +optional
+	HotLinkProtection *bool `json:"hotlinkProtection,omitempty"`
+
+	// Mirage toggles Mirage.
+	// +optional
+	Mirage *bool `json:"mirage,omitempty"`
+
+	// OpportunisticEncryption toggles Opportunistic Encryption.
+	// +optional
+	OpportunisticEncryption *bool `json:"opportunisticEncryption,omitempty"`
+
+	// Polish sets the Polish level.
+	// +kubebuilder:validation:Enum=off;lossless;lossy
+	// +optional
+	Polish *string `json:"polish,omitempty"`
+
+	// RocketLoader toggles Rocket Loader.
+	// +optional
+	RocketLoader *bool `json:"rocketLoader,omitempty"`
+
+	// SecurityLevel sets the security level.
+	// +kubebuilder:validation:Enum=off;essentially_off;low;medium;high;under_attack
+	// +optional
+	SecurityLevel *string `json:"securityLevel,omitempty"`
+
+	// ServerSideExcludes toggles Server Side Excludes.
+	// +optional
+	ServerSideExcludes *bool `json:"serverSideExcludes,omitempty"`
+
+	// SSL sets the SSL/TLS encryption mode.
+	// +kubebuilder:validation:Enum=off;flexible;full;strict;origin_pull
+	// +optional
+	SSL *string `json:"ssl,omitempty"`
+
+	// SXG toggles Signed Exchanges.
+	// +optional
+	SXG *bool `json:"sxg,omitempty"`
+
+	// Algorithms lists the compression algorithms, in order of preference,
+	// used by the compress_response action.
+	// +optional
+	Algorithms []string `json:"algorithms,omitempty"`
+}
+
+// RuleOverrides changes the behaviour of an executed ruleset.
+type RuleOverrides struct {
+	// Enabled enables or disables every rule in the executed ruleset.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Action overrides the action of every rule in the executed ruleset.
+	// +optional
+	Action *string `json:"action,omitempty"`
+
+	// SensitivityLevel overrides the sensitivity of every rule.
+	// +kubebuilder:validation:Enum=default;medium;low;eoff
+	// +optional
+	SensitivityLevel *string `json:"sensitivityLevel,omitempty"`
+
+	// Categories overrides the rules with a given tag.
+	// +optional
+	Categories []RuleCategoryOverride `json:"categories,omitempty"`
+
+	// Rules overrides individual rules of the executed ruleset.
+	// +optional
+	Rules []RuleOverride `json:"rules,omitempty"`
+}
+
+// RuleCategoryOverride overrides the rules with a given tag.
+type RuleCategoryOverride struct {
+	// Category is the tag of the rules to override.
+	Category string `json:"category"`
+
+	// Action overrides the action of the rules.
+	// +optional
+	Action *string `json:"action,omitempty"`
+
+	// Enabled enables or disables the rules.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// RuleOverride overrides a single rule of an executed ruleset.
+type RuleOverride struct {
+	// ID is the identifier of the rule to override.
+	ID string `json:"id"`
+
+	// Action overrides the action of the rule.
+	// +optional
+	Action *string `json:"action,omitempty"`
+
+	// Enabled enables or disables the rule.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ScoreThreshold overrides the anomaly score threshold of the rule.
+	// +optional
+	ScoreThreshold *int `json:"scoreThreshold,omitempty"`
+
+	// SensitivityLevel overrides the sensitivity of the rule.
+	// +kubebuilder:validation:Enum=default;medium;low;eoff
+	// +optional
+	SensitivityLevel *string `json:"sensitivityLevel,omitempty"`
+}
+
+// RuleMatchedData enables payload logging for an executed ruleset.
+type RuleMatchedData struct {
+	// PublicKey is the public key used to encrypt matched payloads.
+	PublicKey string `json:"publicKey"`
+}
+
+// RuleBlockResponse customises the response returned by the block action.
+type RuleBlockResponse struct {
+	// StatusCode is the HTTP status code of the response.
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=499
+	StatusCode int `json:"statusCode"`
+
+	// ContentType is the content type of the response body.
+	ContentType string `json:"contentType"`
+
+	// Content is the response body.
+	Content string `json:"content"`
+}
+
+// RuleURI rewrites the request URI.
+type RuleURI struct {
+	// Path rewrites the URI path.
+	// +optional
+	Path *RuleRewriteValue `json:"path,omitempty"`
+
+	// Query rewrites the URI query string.
+	// +optional
+	Query *RuleRewriteValue `json:"query,omitempty"`
+
+	// Origin indicates an origin rewrite.
+	// +optional
+	Origin *bool `json:"origin,omitempty"`
+}
+
+// RuleRewriteValue is a static value or a dynamic expression.
+type RuleRewriteValue struct {
+	// Value is a static value.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// Expression is a dynamic expression evaluated per request.
+	// +optional
+	Expression *string `json:"expression,omitempty"`
+}
+
+// RuleHTTPHeader modifies a single request or response header.
+type RuleHTTPHeader struct {
+	// Operation is the operation to perform on the header.
+	// +kubebuilder:validation:Enum=set;add;remove
+	Operation string `json:"operation"`
+
+	// Value is a static header value.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// Expression is a dynamic expression for the header value.
+	// +optional
+	Expression *string `json:"expression,omitempty"`
+}
+
+// RuleFromList redirects requests using a Bulk Redirect list.
+type RuleFromList struct {
+	// Name is the name of the list.
+	Name string `json:"name"`
+
+	// Key is the expression used to look up the redirect in the list.
+	Key string `json:"key"`
+}
+
+// RuleFromValue redirects requests to a target URL.
+type RuleFromValue struct {
+	// StatusCode is the redirect status code.
+	// +kubebuilder:validation:Enum=301;302;303;307;308
+	// +optional
+	StatusCode *int `json:"statusCode,omitempty"`
+
+	// TargetURL is the URL to redirect to.
+	TargetURL RuleRewriteValue `json:"targetUrl"`
+
+	// PreserveQueryString keeps the query string of the original request.
+	// +optional
+	PreserveQueryString *bool `json:"preserveQueryString,omitempty"`
+}
+
+// RuleOrigin overrides the origin a request is sent to.
+type RuleOrigin struct {
+	// Host is the origin hostname.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// Port is the origin port.
+	// +optional
+	Port *int `json:"port,omitempty"`
+}
+
+// RuleSNI overrides the server name indication sent to the origin.
+type RuleSNI struct {
+	// Value is the server name to send.
+	Value string `json:"value"`
+}
+
+// RuleCacheKey customises the cache key of matching requests.
+type RuleCacheKey struct {
+	// CacheByDeviceType separates cached content by device type.
+	// +optional
+	CacheByDeviceType *bool `json:"cacheByDeviceType,omitempty"`
+
+	// IgnoreQueryStringsOrder treats query strings in any order as equal.
+	// +optional
+	IgnoreQueryStringsOrder *bool `json:"ignoreQueryStringsOrder,omitempty"`
+
+	// CacheDeceptionArmor protects against web cache deception attacks.
+	// +optional
+	CacheDeceptionArmor *bool `json:"cacheDeceptionArmor,omitempty"`
+
+	// CustomKey selects the request properties included in the cache key.
+	// +optional
+	CustomKey *RuleCustomKey `json:"customKey,omitempty"`
+}
+
+// RuleCustomKey selects the request properties included in the cache key.
+type RuleCustomKey struct {
+	// Query controls which query string parameters are included.
+	// +optional
+	Query *RuleCustomKeyQuery `json:"query,omitempty"`
+
+	// Header controls which headers are included.
+	// +optional
+	Header *RuleCustomKeyHeader `json:"header,omitempty"`
+
+	// Cookie controls which cookies are included.
+	// +optional
+	Cookie *RuleCustomKeyFields `json:"cookie,omitempty"`
+
+	// User controls which user properties are included.
+	// +optional
+	User *RuleCustomKeyUser `json:"user,omitempty"`
+
+	// Host controls whether the resolved host is included.
+	// +optional
+	Host *RuleCustomKeyHost `json:"host,omitempty"`
+}
+
+// RuleCustomKeyQuery controls which query string parameters are included
+// in the cache key.
+type RuleCustomKeyQuery struct {
+	// Include lists the parameters to include. A single "*" includes all.
+	// +optional
+	Include []string `json:"include,omitempty"`
+
+	// Exclude lists the parameters to exclude. A single "*" excludes all.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+
+	// Ignore ignores the query string entirely.
+	// +optional
+	Ignore *bool `json:"ignore,omitempty"`
+}
+
+// RuleCustomKeyFields lists fields to include in the cache key.
+type RuleCustomKeyFields struct {
+	// Include lists the fields whose values are included.
+	// +optional
+	Include []string `json:"include,omitempty"`
+
+	// CheckPresence lists the fields whose presence is included.
+	// +optional
+	CheckPresence []string `json:"checkPresence,omitempty"`
+}
+
+// RuleCustomKeyHeader controls which headers are included in the cache key.
+type RuleCustomKeyHeader struct {
+	RuleCustomKeyFields `json:",inline"`
+
+	// ExcludeOrigin excludes the Origin header.
+	// +optional
+	ExcludeOrigin *bool `json:"excludeOrigin,omitempty"`
+
+	// Contains includes headers whose value contains one of the listed
+	// values, keyed by header name.
+	// +optional
+	Contains map[string][]string `json:"contains,omitempty"`
+}
+
+// RuleCustomKeyUser controls which user properties are included in the
+// cache key.
+type RuleCustomKeyUser struct {
+	// DeviceType includes the device type.
+	// +optional
+	DeviceType *bool `json:"deviceType,omitempty"`
+
+	// Geo includes the country.
+	// +optional
+	Geo *bool `json:"geo,omitempty"`
+
+	// Lang includes the first language of Accept-Language.
+	// +optional
+	Lang *bool `json:"lang,omitempty"`
+}
+
+// RuleCustomKeyHost controls how the host is included in the cache key.
+type RuleCustomKeyHost struct {
+	// Resolved uses the resolved host instead of the Host header.
+	// +optional
+	Resolved *bool `json:"resolved,omitempty"`
+}
+
+// RuleCacheReserve controls Cache Reserve eligibility.
+type RuleCacheReserve struct {
+	// Eligible marks matching responses as eligible for Cache Reserve.
+	// +optional
+	Eligible *bool `json:"eligible,omitempty"`
+
+	// MinimumFileSize is the minimum size, in bytes, of eligible responses.
+	// +optional
+	MinimumFileSize *int `json:"minimumFileSize,omitempty"`
+}
+
+// RuleEdgeTTL controls how long Cloudflare caches matching responses.
+type RuleEdgeTTL struct {
+	// Mode is the edge TTL mode.
+	// +kubebuilder:validation:Enum=respect_origin;bypass_by_default;override_origin
+	// +optional
+	Mode *string `json:"mode,omitempty"`
+
+	// Default is the TTL in seconds.
+	// +optional
+	Default *int `json:"default,omitempty"`
+
+	// StatusCodeTTL sets TTLs for specific status codes.
+	// +optional
+	StatusCodeTTL []RuleStatusCodeTTL `json:"statusCodeTtl,omitempty"`
+}
+
+// RuleStatusCodeTTL sets the TTL for a status code or range of codes.
+type RuleStatusCodeTTL struct {
+	// StatusCode is a single status code.
+	// +optional
+	StatusCode *int `json:"statusCode,omitempty"`
+
+	// StatusCodeRange is a range of status codes.
+	// +optional
+	StatusCodeRange *RuleStatusCodeRange `json:"statusCodeRange,omitempty"`
+
+	// Value is the TTL in seconds.
+	Value int `json:"value"`
+}
+
+// RuleStatusCodeRange is an inclusive range of status codes.
+type RuleStatusCodeRange struct {
+	// From is the first status code of the range.
+	// +optional
+	From *int `json:"from,omitempty"`
+
+	// To is the last status code of the range.
+	// +optional
+	To *int `json:"to,omitempty"`
+}
+
+// RuleBrowserTTL controls how long browsers cache matching responses.
+type RuleBrowserTTL struct {
+	// Mode is the browser TTL mode.
+	// +kubebuilder:validation:Enum=respect_origin;bypass_by_default;override_origin;bypass
+	Mode string `json:"mode"`
+
+	// Default is the TTL in seconds.
+	// +optional
+	Default *int `json:"default,omitempty"`
+}
+
+// RuleServeStale controls serving stale content while revalidating.
+type RuleServeStale struct {
+	// DisableStaleWhileUpdating disables serving stale content while the
+	// cache is being updated.
+	// +optional
+	DisableStaleWhileUpdating *bool `json:"disableStaleWhileUpdating,omitempty"`
+}
+
+// RuleAutoMinify configures which resources are minified.
+type RuleAutoMinify struct {
+	// HTML minifies HTML.
+	// +optional
+	HTML bool `json:"html,omitempty"`
+
+	// CSS minifies CSS.
+	// +optional
+	CSS bool `json:"css,omitempty"`
+
+	// JS minifies JavaScript.
+	// +optional
+	JS bool `json:"js,omitempty"`
+}
+
+// RuleLogging controls whether matches of a rule are logged.
+type RuleLogging struct {
+	// Enabled enables or disables logging.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// RuleRateLimit configures the rate limiting behaviour of a rule.
+type RuleRateLimit struct {
+	// Characteristics are the request properties used to group requests
+	// into counters, for example "ip.src" and "cf.colo.id".
+	Characteristics []string `json:"characteristics"`
+
+	// Period is the length of the counting window in seconds.
+	Period int `json:"period"`
+
+	// RequestsPerPeriod is the number of requests allowed per period.
+	// +optional
+	RequestsPerPeriod *int `json:"requestsPerPeriod,omitempty"`
+
+	// ScorePerPeriod is the complexity score allowed per period.
+	// +optional
+	ScorePerPeriod *int `json:"scorePerPeriod,omitempty"`
+
+	// ScoreResponseHeaderName is the response header carrying the score.
+	// +optional
+	ScoreResponseHeaderName *string `json:"scoreResponseHeaderName,omitempty"`
+
+	// MitigationTimeout is how long, in seconds, the action applies once
+	// the limit is reached.
+	// +optional
+	MitigationTimeout *int `json:"mitigationTimeout,omitempty"`
+
+	// CountingExpression restricts which requests are counted.
+	// +optional
+	CountingExpression *string `json:"countingExpression,omitempty"`
+
+	// RequestsToOrigin counts only requests that reach the origin.
+	// +optional
+	RequestsToOrigin *bool `json:"requestsToOrigin,omitempty"`
+}
+
+// RuleExposedCredentialCheck configures how credentials are extracted from
+// a request.
+type RuleExposedCredentialCheck struct {
+	// UsernameExpression extracts the username from the request.
+	UsernameExpression string `json:"usernameExpression"`
+
+	// PasswordExpression extracts the password from the request.
+	PasswordExpression string `json:"passwordExpression"`
 }
 
 // RulesetParameters define the desired state of a Cloudflare Ruleset
+// +kubebuilder:validation:XValidation:rule="(has(self.mode) ? self.mode : 'custom') == (has(oldSelf.mode) ? oldSelf.mode : 'custom')",message="mode is immutable"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'entrypoint' || !has(self.rules) || self.rules.all(r, has(r.ref) && r.ref != '')",message="every rule of a ruleset in entrypoint mode must have a ref"
 type RulesetParameters struct {
 	// Zone is the zone ID where this ruleset will be applied.
 	// Either Zone or Account must be specified, but not both.
@@ -73,6 +746,20 @@ type RulesetParameters struct {
 	// +required
 	Phase string `json:"phase"`
 
+	// Mode selects how the ruleset is managed. In "custom" mode a new
+	// ruleset is created and deleted with the resource. In "entrypoint"
+	// mode the rules are kept in the entrypoint ruleset of the phase,
+	// alongside the rules of other resources such as PhaseRules and
+	// ManagedRulesetDeployments. Only the rules of this resource, matched
+	// by ref, are updated, and only they are deleted with the resource.
+	// Every rule must have a ref in entrypoint mode. Name and kind are
+	// ignored, and the description is only used when the entrypoint does
+	// not exist yet.
+	// +kubebuilder:validation:Enum=custom;entrypoint
+	// +immutable
+	// +optional
+	Mode *string `json:"mode,omitempty"`
+
 	// Rules is the list of rules in this ruleset
 	// +optional
 	Rules []RulesetRule `json:"rules,omitempty"`
@@ -100,11 +787,34 @@ type RulesetObservation struct {
 
 	// LastModified indicates when this ruleset was last modified
 	LastModified *metav1.Time `json:"lastModified,omitempty"`
+
+	// Rules lists the rules Cloudflare reports for the ruleset, in order.
+	Rules []RulesetRuleObservation `json:"rules,omitempty"`
+}
+
+// RulesetRuleObservation contains the observable fields of a ruleset rule.
+type RulesetRuleObservation struct {
+	// ID is the identifier of the rule assigned by Cloudflare.
+	ID string `json:"id,omitempty"`
+
+	// Ref is the stable reference of the rule.
+	Ref string `json:"ref,omitempty"`
+
+	// Version is the version of the rule.
+	Version string `json:"version,omitempty"`
 }
 
 // A RulesetSpec defines the desired state of a Ruleset.
 type RulesetSpec struct {
-	xpv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       RulesetParameters `json:"forProvider"`
 }
 
@@ -24,6 +24,1451 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetDeployment) DeepCopyInto(out *ManagedRulesetDeployment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetDeployment.
+func (in *ManagedRulesetDeployment) DeepCopy() *ManagedRulesetDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedRulesetDeployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetDeploymentList) DeepCopyInto(out *ManagedRulesetDeploymentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagedRulesetDeployment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetDeploymentList.
+func (in *ManagedRulesetDeploymentList) DeepCopy() *ManagedRulesetDeploymentList {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetDeploymentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedRulesetDeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetDeploymentObservation) DeepCopyInto(out *ManagedRulesetDeploymentObservation) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RulesetRuleObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetDeploymentObservation.
+func (in *ManagedRulesetDeploymentObservation) DeepCopy() *ManagedRulesetDeploymentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetDeploymentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetDeploymentParameters) DeepCopyInto(out *ManagedRulesetDeploymentParameters) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Account != nil {
+		in, out := &in.Account, &out.Account
+		*out = new(string)
+		**out = **in
+	}
+	if in.Phase != nil {
+		in, out := &in.Phase, &out.Phase
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ref != nil {
+		in, out := &in.Ref, &out.Ref
+		*out = new(string)
+		**out = **in
+	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(RuleOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MatchedData != nil {
+		in, out := &in.MatchedData, &out.MatchedData
+		*out = new(RuleMatchedData)
+		**out = **in
+	}
+	if in.SkipExceptions != nil {
+		in, out := &in.SkipExceptions, &out.SkipExceptions
+		*out = make([]ManagedRulesetSkipException, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetDeploymentParameters.
+func (in *ManagedRulesetDeploymentParameters) DeepCopy() *ManagedRulesetDeploymentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetDeploymentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetDeploymentSpec) DeepCopyInto(out *ManagedRulesetDeploymentSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetDeploymentSpec.
+func (in *ManagedRulesetDeploymentSpec) DeepCopy() *ManagedRulesetDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetDeploymentStatus) DeepCopyInto(out *ManagedRulesetDeploymentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetDeploymentStatus.
+func (in *ManagedRulesetDeploymentStatus) DeepCopy() *ManagedRulesetDeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetDeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRulesetSkipException) DeepCopyInto(out *ManagedRulesetSkipException) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(RuleLogging)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRulesetSkipException.
+func (in *ManagedRulesetSkipException) DeepCopy() *ManagedRulesetSkipException {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRulesetSkipException)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhaseRule) DeepCopyInto(out *PhaseRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhaseRule.
+func (in *PhaseRule) DeepCopy() *PhaseRule {
+	if in == nil {
+		return nil
+	}
+	out := new(PhaseRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PhaseRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhaseRuleList) DeepCopyInto(out *PhaseRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PhaseRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhaseRuleList.
+func (in *PhaseRuleList) DeepCopy() *PhaseRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(PhaseRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PhaseRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhaseRuleObservation) DeepCopyInto(out *PhaseRuleObservation) {
+	*out = *in
+	if in.LastModified != nil {
+		in, out := &in.LastModified, &out.LastModified
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhaseRuleObservation.
+func (in *PhaseRuleObservation) DeepCopy() *PhaseRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PhaseRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhaseRuleParameters) DeepCopyInto(out *PhaseRuleParameters) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Account != nil {
+		in, out := &in.Account, &out.Account
+		*out = new(string)
+		**out = **in
+	}
+	if in.Position != nil {
+		in, out := &in.Position, &out.Position
+		*out = new(PhaseRulePosition)
+		(*in).DeepCopyInto(*out)
+	}
+	in.RulesetRule.DeepCopyInto(&out.RulesetRule)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhaseRuleParameters.
+func (in *PhaseRuleParameters) DeepCopy() *PhaseRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PhaseRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhaseRulePosition) DeepCopyInto(out *PhaseRulePosition) {
+	*out = *in
+	if in.Before != nil {
+		in, out := &in.Before, &out.Before
+		*out = new(string)
+		**out = **in
+	}
+	if in.After != nil {
+		in, out := &in.After, &out.After
+		*out = new(string)
+		**out = **in
+	}
+	if in.Index != nil {
+		in, out := &in.Index, &out.Index
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhaseRulePosition.
+func (in *PhaseRulePosition) DeepCopy() *PhaseRulePosition {
+	if in == nil {
+		return nil
+	}
+	out := new(PhaseRulePosition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhaseRuleSpec) DeepCopyInto(out *PhaseRuleSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhaseRuleSpec.
+func (in *PhaseRuleSpec) DeepCopy() *PhaseRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PhaseRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhaseRuleStatus) DeepCopyInto(out *PhaseRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhaseRuleStatus.
+func (in *PhaseRuleStatus) DeepCopy() *PhaseRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PhaseRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleActionParameters) DeepCopyInto(out *RuleActionParameters) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(RuleOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MatchedData != nil {
+		in, out := &in.MatchedData, &out.MatchedData
+		*out = new(RuleMatchedData)
+		**out = **in
+	}
+	if in.Ruleset != nil {
+		in, out := &in.Ruleset, &out.Ruleset
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rulesets != nil {
+		in, out := &in.Rulesets, &out.Rulesets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Products != nil {
+		in, out := &in.Products, &out.Products
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Increment != nil {
+		in, out := &in.Increment, &out.Increment
+		*out = new(int)
+		**out = **in
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(RuleBlockResponse)
+		**out = **in
+	}
+	if in.URI != nil {
+		in, out := &in.URI, &out.URI
+		*out = new(RuleURI)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]RuleHTTPHeader, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.FromList != nil {
+		in, out := &in.FromList, &out.FromList
+		*out = new(RuleFromList)
+		**out = **in
+	}
+	if in.FromValue != nil {
+		in, out := &in.FromValue, &out.FromValue
+		*out = new(RuleFromValue)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostHeader != nil {
+		in, out := &in.HostHeader, &out.HostHeader
+		*out = new(string)
+		**out = **in
+	}
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(RuleOrigin)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SNI != nil {
+		in, out := &in.SNI, &out.SNI
+		*out = new(RuleSNI)
+		**out = **in
+	}
+	if in.RequestFields != nil {
+		in, out := &in.RequestFields, &out.RequestFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseFields != nil {
+		in, out := &in.ResponseFields, &out.ResponseFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CookieFields != nil {
+		in, out := &in.CookieFields, &out.CookieFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int)
+		**out = **in
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CacheKey != nil {
+		in, out := &in.CacheKey, &out.CacheKey
+		*out = new(RuleCacheKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CacheReserve != nil {
+		in, out := &in.CacheReserve, &out.CacheReserve
+		*out = new(RuleCacheReserve)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EdgeTTL != nil {
+		in, out := &in.EdgeTTL, &out.EdgeTTL
+		*out = new(RuleEdgeTTL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BrowserTTL != nil {
+		in, out := &in.BrowserTTL, &out.BrowserTTL
+		*out = new(RuleBrowserTTL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServeStale != nil {
+		in, out := &in.ServeStale, &out.ServeStale
+		*out = new(RuleServeStale)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RespectStrongETags != nil {
+		in, out := &in.RespectStrongETags, &out.RespectStrongETags
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OriginCacheControl != nil {
+		in, out := &in.OriginCacheControl, &out.OriginCacheControl
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OriginErrorPagePassthru != nil {
+		in, out := &in.OriginErrorPagePassthru, &out.OriginErrorPagePassthru
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdditionalCacheablePorts != nil {
+		in, out := &in.AdditionalCacheablePorts, &out.AdditionalCacheablePorts
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadTimeout != nil {
+		in, out := &in.ReadTimeout, &out.ReadTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.AutomaticHTTPSRewrites != nil {
+		in, out := &in.AutomaticHTTPSRewrites, &out.AutomaticHTTPSRewrites
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoMinify != nil {
+		in, out := &in.AutoMinify, &out.AutoMinify
+		*out = new(RuleAutoMinify)
+		**out = **in
+	}
+	if in.BrowserIntegrityCheck != nil {
+		in, out := &in.BrowserIntegrityCheck, &out.BrowserIntegrityCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableApps != nil {
+		in, out := &in.DisableApps, &out.DisableApps
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableZaraz != nil {
+		in, out := &in.DisableZaraz, &out.DisableZaraz
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableRailgun != nil {
+		in, out := &in.DisableRailgun, &out.DisableRailgun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableRUM != nil {
+		in, out := &in.DisableRUM, &out.DisableRUM
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EmailObfuscation != nil {
+		in, out := &in.EmailObfuscation, &out.EmailObfuscation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Fonts != nil {
+		in, out := &in.Fonts, &out.Fonts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HotLinkProtection != nil {
+		in, out := &in.HotLinkProtection, &out.HotLinkProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Mirage != nil {
+		in, out := &in.Mirage, &out.Mirage
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OpportunisticEncryption != nil {
+		in, out := &in.OpportunisticEncryption, &out.OpportunisticEncryption
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Polish != nil {
+		in, out := &in.Polish, &out.Polish
+		*out = new(string)
+		**out = **in
+	}
+	if in.RocketLoader != nil {
+		in, out := &in.RocketLoader, &out.RocketLoader
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecurityLevel != nil {
+		in, out := &in.SecurityLevel, &out.SecurityLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServerSideExcludes != nil {
+		in, out := &in.ServerSideExcludes, &out.ServerSideExcludes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SSL != nil {
+		in, out := &in.SSL, &out.SSL
+		*out = new(string)
+		**out = **in
+	}
+	if in.SXG != nil {
+		in, out := &in.SXG, &out.SXG
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Algorithms != nil {
+		in, out := &in.Algorithms, &out.Algorithms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleActionParameters.
+func (in *RuleActionParameters) DeepCopy() *RuleActionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleActionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleAutoMinify) DeepCopyInto(out *RuleAutoMinify) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleAutoMinify.
+func (in *RuleAutoMinify) DeepCopy() *RuleAutoMinify {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleAutoMinify)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleBlockResponse) DeepCopyInto(out *RuleBlockResponse) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleBlockResponse.
+func (in *RuleBlockResponse) DeepCopy() *RuleBlockResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleBlockResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleBrowserTTL) DeepCopyInto(out *RuleBrowserTTL) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleBrowserTTL.
+func (in *RuleBrowserTTL) DeepCopy() *RuleBrowserTTL {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleBrowserTTL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleCacheKey) DeepCopyInto(out *RuleCacheKey) {
+	*out = *in
+	if in.CacheByDeviceType != nil {
+		in, out := &in.CacheByDeviceType, &out.CacheByDeviceType
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IgnoreQueryStringsOrder != nil {
+		in, out := &in.IgnoreQueryStringsOrder, &out.IgnoreQueryStringsOrder
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CacheDeceptionArmor != nil {
+		in, out := &in.CacheDeceptionArmor, &out.CacheDeceptionArmor
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CustomKey != nil {
+		in, out := &in.CustomKey, &out.CustomKey
+		*out = new(RuleCustomKey)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleCacheKey.
+func (in *RuleCacheKey) DeepCopy() *RuleCacheKey {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleCacheKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleCacheReserve) DeepCopyInto(out *RuleCacheReserve) {
+	*out = *in
+	if in.Eligible != nil {
+		in, out := &in.Eligible, &out.Eligible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinimumFileSize != nil {
+		in, out := &in.MinimumFileSize, &out.MinimumFileSize
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleCacheReserve.
+func (in *RuleCacheReserve) DeepCopy() *RuleCacheReserve {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleCacheReserve)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleCategoryOverride) DeepCopyInto(out *RuleCategoryOverride) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleCategoryOverride.
+func (in *RuleCategoryOverride) DeepCopy() *RuleCategoryOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleCategoryOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleCustomKey) DeepCopyInto(out *RuleCustomKey) {
+	*out = *in
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(RuleCustomKeyQuery)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(RuleCustomKeyHeader)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cookie != nil {
+		in, out := &in.Cookie, &out.Cookie
+		*out = new(RuleCustomKeyFields)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(RuleCustomKeyUser)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(RuleCustomKeyHost)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleCustomKey.
+func (in *RuleCustomKey) DeepCopy() *RuleCustomKey {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleCustomKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleCustomKeyFields) DeepCopyInto(out *RuleCustomKeyFields) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CheckPresence != nil {
+		in, out := &in.CheckPresence, &out.CheckPresence
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleCustomKeyFields.
+func (in *RuleCustomKeyFields) DeepCopy() *RuleCustomKeyFields {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleCustomKeyFields)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleCustomKeyHeader) DeepCopyInto(out *RuleCustomKeyHeader) {
+	*out = *in
+	in.RuleCustomKeyFields.DeepCopyInto(&out.RuleCustomKeyFields)
+	if in.ExcludeOrigin != nil {
+		in, out := &in.ExcludeOrigin, &out.ExcludeOrigin
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Contains != nil {
+		in, out := &in.Contains, &out.Contains
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleCustomKeyHeader.
+func (in *RuleCustomKeyHeader) DeepCopy() *RuleCustomKeyHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleCustomKeyHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleCustomKeyHost) DeepCopyInto(out *RuleCustomKeyHost) {
+	*out = *in
+	if in.Resolved != nil {
+		in, out := &in.Resolved, &out.Resolved
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleCustomKeyHost.
+func (in *RuleCustomKeyHost) DeepCopy() *RuleCustomKeyHost {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleCustomKeyHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleCustomKeyQuery) DeepCopyInto(out *RuleCustomKeyQuery) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ignore != nil {
+		in, out := &in.Ignore, &out.Ignore
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleCustomKeyQuery.
+func (in *RuleCustomKeyQuery) DeepCopy() *RuleCustomKeyQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleCustomKeyQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleCustomKeyUser) DeepCopyInto(out *RuleCustomKeyUser) {
+	*out = *in
+	if in.DeviceType != nil {
+		in, out := &in.DeviceType, &out.DeviceType
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Geo != nil {
+		in, out := &in.Geo, &out.Geo
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Lang != nil {
+		in, out := &in.Lang, &out.Lang
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleCustomKeyUser.
+func (in *RuleCustomKeyUser) DeepCopy() *RuleCustomKeyUser {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleCustomKeyUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleEdgeTTL) DeepCopyInto(out *RuleEdgeTTL) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(int)
+		**out = **in
+	}
+	if in.StatusCodeTTL != nil {
+		in, out := &in.StatusCodeTTL, &out.StatusCodeTTL
+		*out = make([]RuleStatusCodeTTL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleEdgeTTL.
+func (in *RuleEdgeTTL) DeepCopy() *RuleEdgeTTL {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleEdgeTTL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleExposedCredentialCheck) DeepCopyInto(out *RuleExposedCredentialCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleExposedCredentialCheck.
+func (in *RuleExposedCredentialCheck) DeepCopy() *RuleExposedCredentialCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleExposedCredentialCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleFromList) DeepCopyInto(out *RuleFromList) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleFromList.
+func (in *RuleFromList) DeepCopy() *RuleFromList {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleFromList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleFromValue) DeepCopyInto(out *RuleFromValue) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int)
+		**out = **in
+	}
+	in.TargetURL.DeepCopyInto(&out.TargetURL)
+	if in.PreserveQueryString != nil {
+		in, out := &in.PreserveQueryString, &out.PreserveQueryString
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleFromValue.
+func (in *RuleFromValue) DeepCopy() *RuleFromValue {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleFromValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleHTTPHeader) DeepCopyInto(out *RuleHTTPHeader) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleHTTPHeader.
+func (in *RuleHTTPHeader) DeepCopy() *RuleHTTPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleHTTPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleLogging) DeepCopyInto(out *RuleLogging) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleLogging.
+func (in *RuleLogging) DeepCopy() *RuleLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleMatchedData) DeepCopyInto(out *RuleMatchedData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleMatchedData.
+func (in *RuleMatchedData) DeepCopy() *RuleMatchedData {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleMatchedData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleOrigin) DeepCopyInto(out *RuleOrigin) {
+	*out = *in
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleOrigin.
+func (in *RuleOrigin) DeepCopy() *RuleOrigin {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleOrigin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleOverride) DeepCopyInto(out *RuleOverride) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScoreThreshold != nil {
+		in, out := &in.ScoreThreshold, &out.ScoreThreshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.SensitivityLevel != nil {
+		in, out := &in.SensitivityLevel, &out.SensitivityLevel
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleOverride.
+func (in *RuleOverride) DeepCopy() *RuleOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleOverrides) DeepCopyInto(out *RuleOverrides) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(string)
+		**out = **in
+	}
+	if in.SensitivityLevel != nil {
+		in, out := &in.SensitivityLevel, &out.SensitivityLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]RuleCategoryOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RuleOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleOverrides.
+func (in *RuleOverrides) DeepCopy() *RuleOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleRateLimit) DeepCopyInto(out *RuleRateLimit) {
+	*out = *in
+	if in.Characteristics != nil {
+		in, out := &in.Characteristics, &out.Characteristics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequestsPerPeriod != nil {
+		in, out := &in.RequestsPerPeriod, &out.RequestsPerPeriod
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScorePerPeriod != nil {
+		in, out := &in.ScorePerPeriod, &out.ScorePerPeriod
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScoreResponseHeaderName != nil {
+		in, out := &in.ScoreResponseHeaderName, &out.ScoreResponseHeaderName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MitigationTimeout != nil {
+		in, out := &in.MitigationTimeout, &out.MitigationTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.CountingExpression != nil {
+		in, out := &in.CountingExpression, &out.CountingExpression
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequestsToOrigin != nil {
+		in, out := &in.RequestsToOrigin, &out.RequestsToOrigin
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleRateLimit.
+func (in *RuleRateLimit) DeepCopy() *RuleRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleRewriteValue) DeepCopyInto(out *RuleRewriteValue) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleRewriteValue.
+func (in *RuleRewriteValue) DeepCopy() *RuleRewriteValue {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleRewriteValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleSNI) DeepCopyInto(out *RuleSNI) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleSNI.
+func (in *RuleSNI) DeepCopy() *RuleSNI {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleSNI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleServeStale) DeepCopyInto(out *RuleServeStale) {
+	*out = *in
+	if in.DisableStaleWhileUpdating != nil {
+		in, out := &in.DisableStaleWhileUpdating, &out.DisableStaleWhileUpdating
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleServeStale.
+func (in *RuleServeStale) DeepCopy() *RuleServeStale {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleServeStale)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleStatusCodeRange) DeepCopyInto(out *RuleStatusCodeRange) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = new(int)
+		**out = **in
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleStatusCodeRange.
+func (in *RuleStatusCodeRange) DeepCopy() *RuleStatusCodeRange {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleStatusCodeRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleStatusCodeTTL) DeepCopyInto(out *RuleStatusCodeTTL) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int)
+		**out = **in
+	}
+	if in.StatusCodeRange != nil {
+		in, out := &in.StatusCodeRange, &out.StatusCodeRange
+		*out = new(RuleStatusCodeRange)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleStatusCodeTTL.
+func (in *RuleStatusCodeTTL) DeepCopy() *RuleStatusCodeTTL {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleStatusCodeTTL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleURI) DeepCopyInto(out *RuleURI) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(RuleRewriteValue)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(RuleRewriteValue)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleURI.
+func (in *RuleURI) DeepCopy() *RuleURI {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleURI)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ruleset) DeepCopyInto(out *Ruleset) {
 	*out = *in
@@ -90,6 +1535,11 @@ func (in *RulesetObservation) DeepCopyInto(out *RulesetObservation) {
 		in, out := &in.LastModified, &out.LastModified
 		*out = (*in).DeepCopy()
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RulesetRuleObservation, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetObservation.
@@ -120,6 +1570,11 @@ func (in *RulesetParameters) DeepCopyInto(out *RulesetParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
 	if in.Rules != nil {
 		in, out := &in.Rules, &out.Rules
 		*out = make([]RulesetRule, len(*in))
@@ -142,6 +1597,11 @@ func (in *RulesetParameters) DeepCopy() *RulesetParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RulesetRule) DeepCopyInto(out *RulesetRule) {
 	*out = *in
+	if in.Ref != nil {
+		in, out := &in.Ref, &out.Ref
+		*out = new(string)
+		**out = **in
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -152,6 +1612,31 @@ func (in *RulesetRule) DeepCopyInto(out *RulesetRule) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ActionParameters != nil {
+		in, out := &in.ActionParameters, &out.ActionParameters
+		*out = new(RuleActionParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(RuleLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RuleRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExposedCredentialCheck != nil {
+		in, out := &in.ExposedCredentialCheck, &out.ExposedCredentialCheck
+		*out = new(RuleExposedCredentialCheck)
+		**out = **in
+	}
+	if in.ScoreThreshold != nil {
+		in, out := &in.ScoreThreshold, &out.ScoreThreshold
+		*out = new(int)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRule.
@@ -164,10 +1649,25 @@ func (in *RulesetRule) DeepCopy() *RulesetRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetRuleObservation) DeepCopyInto(out *RulesetRuleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRuleObservation.
+func (in *RulesetRuleObservation) DeepCopy() *RulesetRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RulesetSpec) DeepCopyInto(out *RulesetSpec) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
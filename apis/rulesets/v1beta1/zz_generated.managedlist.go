@@ -19,6 +19,24 @@ package v1beta1
 
 import resource "github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
+// GetItems of this ManagedRulesetDeploymentList.
+func (l *ManagedRulesetDeploymentList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this PhaseRuleList.
+func (l *PhaseRuleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this RulesetList.
 func (l *RulesetList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // BotManagementParameters define the desired state of Cloudflare Bot Management for a zone.
@@ -118,7 +119,15 @@ type BotManagementObservation struct {
 
 // BotManagementSpec defines the desired state of Bot Management.
 type BotManagementSpec struct {
-	rtv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy rtv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       BotManagementParameters `json:"forProvider"`
 }
 
@@ -24,23 +24,18 @@ func (mg *BotManagement) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
 	return mg.Status.GetCondition(ct)
 }
 
-// GetDeletionPolicy of this BotManagement.
-func (mg *BotManagement) GetDeletionPolicy() xpv1.DeletionPolicy {
-	return mg.Spec.DeletionPolicy
-}
-
 // GetManagementPolicies of this BotManagement.
 func (mg *BotManagement) GetManagementPolicies() xpv1.ManagementPolicies {
 	return mg.Spec.ManagementPolicies
 }
 
 // GetProviderConfigReference of this BotManagement.
-func (mg *BotManagement) GetProviderConfigReference() *xpv1.Reference {
+func (mg *BotManagement) GetProviderConfigReference() *xpv1.ProviderConfigReference {
 	return mg.Spec.ProviderConfigReference
 }
 
 // GetWriteConnectionSecretToReference of this BotManagement.
-func (mg *BotManagement) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+func (mg *BotManagement) GetWriteConnectionSecretToReference() *xpv1.LocalSecretReference {
 	return mg.Spec.WriteConnectionSecretToReference
 }
 
@@ -49,23 +44,18 @@ func (mg *BotManagement) SetConditions(c ...xpv1.Condition) {
 	mg.Status.SetConditions(c...)
 }
 
-// SetDeletionPolicy of this BotManagement.
-func (mg *BotManagement) SetDeletionPolicy(r xpv1.DeletionPolicy) {
-	mg.Spec.DeletionPolicy = r
-}
-
 // SetManagementPolicies of this BotManagement.
 func (mg *BotManagement) SetManagementPolicies(r xpv1.ManagementPolicies) {
 	mg.Spec.ManagementPolicies = r
 }
 
 // SetProviderConfigReference of this BotManagement.
-func (mg *BotManagement) SetProviderConfigReference(r *xpv1.Reference) {
+func (mg *BotManagement) SetProviderConfigReference(r *xpv1.ProviderConfigReference) {
 	mg.Spec.ProviderConfigReference = r
 }
 
 // SetWriteConnectionSecretToReference of this BotManagement.
-func (mg *BotManagement) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+func (mg *BotManagement) SetWriteConnectionSecretToReference(r *xpv1.LocalSecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
 
@@ -74,23 +64,18 @@ func (mg *RateLimit) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
 	return mg.Status.GetCondition(ct)
 }
 
-// GetDeletionPolicy of this RateLimit.
-func (mg *RateLimit) GetDeletionPolicy() xpv1.DeletionPolicy {
-	return mg.Spec.DeletionPolicy
-}
-
 // GetManagementPolicies of this RateLimit.
 func (mg *RateLimit) GetManagementPolicies() xpv1.ManagementPolicies {
 	return mg.Spec.ManagementPolicies
 }
 
 // GetProviderConfigReference of this RateLimit.
-func (mg *RateLimit) GetProviderConfigReference() *xpv1.Reference {
+func (mg *RateLimit) GetProviderConfigReference() *xpv1.ProviderConfigReference {
 	return mg.Spec.ProviderConfigReference
 }
 
 // GetWriteConnectionSecretToReference of this RateLimit.
-func (mg *RateLimit) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+func (mg *RateLimit) GetWriteConnectionSecretToReference() *xpv1.LocalSecretReference {
 	return mg.Spec.WriteConnectionSecretToReference
 }
 
@@ -99,23 +84,18 @@ func (mg *RateLimit) SetConditions(c ...xpv1.Condition) {
 	mg.Status.SetConditions(c...)
 }
 
-// SetDeletionPolicy of this RateLimit.
-func (mg *RateLimit) SetDeletionPolicy(r xpv1.DeletionPolicy) {
-	mg.Spec.DeletionPolicy = r
-}
-
 // SetManagementPolicies of this RateLimit.
 func (mg *RateLimit) SetManagementPolicies(r xpv1.ManagementPolicies) {
 	mg.Spec.ManagementPolicies = r
 }
 
 // SetProviderConfigReference of this RateLimit.
-func (mg *RateLimit) SetProviderConfigReference(r *xpv1.Reference) {
+func (mg *RateLimit) SetProviderConfigReference(r *xpv1.ProviderConfigReference) {
 	mg.Spec.ProviderConfigReference = r
 }
 
 // SetWriteConnectionSecretToReference of this RateLimit.
-func (mg *RateLimit) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+func (mg *RateLimit) SetWriteConnectionSecretToReference(r *xpv1.LocalSecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
 
@@ -124,23 +104,18 @@ func (mg *Turnstile) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
 	return mg.Status.GetCondition(ct)
 }
 
-// GetDeletionPolicy of this Turnstile.
-func (mg *Turnstile) GetDeletionPolicy() xpv1.DeletionPolicy {
-	return mg.Spec.DeletionPolicy
-}
-
 // GetManagementPolicies of this Turnstile.
 func (mg *Turnstile) GetManagementPolicies() xpv1.ManagementPolicies {
 	return mg.Spec.ManagementPolicies
 }
 
 // GetProviderConfigReference of this Turnstile.
-func (mg *Turnstile) GetProviderConfigReference() *xpv1.Reference {
+func (mg *Turnstile) GetProviderConfigReference() *xpv1.ProviderConfigReference {
 	return mg.Spec.ProviderConfigReference
 }
 
 // GetWriteConnectionSecretToReference of this Turnstile.
-func (mg *Turnstile) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+func (mg *Turnstile) GetWriteConnectionSecretToReference() *xpv1.LocalSecretReference {
 	return mg.Spec.WriteConnectionSecretToReference
 }
 
@@ -149,22 +124,17 @@ func (mg *Turnstile) SetConditions(c ...xpv1.Condition) {
 	mg.Status.SetConditions(c...)
 }
 
-// SetDeletionPolicy of this Turnstile.
-func (mg *Turnstile) SetDeletionPolicy(r xpv1.DeletionPolicy) {
-	mg.Spec.DeletionPolicy = r
-}
-
 // SetManagementPolicies of this Turnstile.
 func (mg *Turnstile) SetManagementPolicies(r xpv1.ManagementPolicies) {
 	mg.Spec.ManagementPolicies = r
 }
 
 // SetProviderConfigReference of this Turnstile.
-func (mg *Turnstile) SetProviderConfigReference(r *xpv1.Reference) {
+func (mg *Turnstile) SetProviderConfigReference(r *xpv1.ProviderConfigReference) {
 	mg.Spec.ProviderConfigReference = r
 }
 
 // SetWriteConnectionSecretToReference of this Turnstile.
-func (mg *Turnstile) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+func (mg *Turnstile) SetWriteConnectionSecretToReference(r *xpv1.LocalSecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // CertificatePackParameters define the desired state of a Cloudflare Certificate Pack.
@@ -151,7 +152,15 @@ type CertificatePackObservation struct {
 
 // CertificatePackSpec defines the desired state of Certificate Pack.
 type CertificatePackSpec struct {
-	rtv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy rtv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       CertificatePackParameters `json:"forProvider"`
 }
 
@@ -191,56 +200,6 @@ func init() {
 	SchemeBuilder.Register(&CertificatePack{}, &CertificatePackList{})
 }
 
-// GetCondition of this CertificatePack.
-func (mg *CertificatePack) GetCondition(ct rtv1.ConditionType) rtv1.Condition {
-	return mg.Status.GetCondition(ct)
-}
-
-// GetDeletionPolicy of this CertificatePack.
-func (mg *CertificatePack) GetDeletionPolicy() rtv1.DeletionPolicy {
-	return mg.Spec.DeletionPolicy
-}
-
-// GetManagementPolicies of this CertificatePack.
-func (mg *CertificatePack) GetManagementPolicies() rtv1.ManagementPolicies {
-	return mg.Spec.ManagementPolicies
-}
-
-// GetProviderConfigReference of this CertificatePack.
-func (mg *CertificatePack) GetProviderConfigReference() *rtv1.Reference {
-	return mg.Spec.ProviderConfigReference
-}
-
-// GetWriteConnectionSecretToReference of this CertificatePack.
-func (mg *CertificatePack) GetWriteConnectionSecretToReference() *rtv1.SecretReference {
-	return mg.Spec.WriteConnectionSecretToReference
-}
-
-// SetConditions of this CertificatePack.
-func (mg *CertificatePack) SetConditions(c ...rtv1.Condition) {
-	mg.Status.SetConditions(c...)
-}
-
-// SetDeletionPolicy of this CertificatePack.
-func (mg *CertificatePack) SetDeletionPolicy(r rtv1.DeletionPolicy) {
-	mg.Spec.DeletionPolicy = r
-}
-
-// SetManagementPolicies of this CertificatePack.
-func (mg *CertificatePack) SetManagementPolicies(r rtv1.ManagementPolicies) {
-	mg.Spec.ManagementPolicies = r
-}
-
-// SetProviderConfigReference of this CertificatePack.
-func (mg *CertificatePack) SetProviderConfigReference(r *rtv1.Reference) {
-	mg.Spec.ProviderConfigReference = r
-}
-
-// SetWriteConnectionSecretToReference of this CertificatePack.
-func (mg *CertificatePack) SetWriteConnectionSecretToReference(r *rtv1.SecretReference) {
-	mg.Spec.WriteConnectionSecretToReference = r
-}
-
 // GetGroupVersionKind returns the GroupVersionKind for CertificatePack.
 func (mg *CertificatePack) GetGroupVersionKind() schema.GroupVersionKind {
 	return CertificatePackGroupVersionKind
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // TotalTLSParameters define the desired state of Cloudflare Total TLS for a zone.
@@ -60,7 +61,15 @@ type TotalTLSObservation struct {
 
 // TotalTLSSpec defines the desired state of Total TLS.
 type TotalTLSSpec struct {
-	rtv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy rtv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       TotalTLSParameters `json:"forProvider"`
 }
 
@@ -100,56 +109,6 @@ func init() {
 	SchemeBuilder.Register(&TotalTLS{}, &TotalTLSList{})
 }
 
-// GetCondition of this TotalTLS.
-func (mg *TotalTLS) GetCondition(ct rtv1.ConditionType) rtv1.Condition {
-	return mg.Status.GetCondition(ct)
-}
-
-// GetDeletionPolicy of this TotalTLS.
-func (mg *TotalTLS) GetDeletionPolicy() rtv1.DeletionPolicy {
-	return mg.Spec.DeletionPolicy
-}
-
-// GetManagementPolicies of this TotalTLS.
-func (mg *TotalTLS) GetManagementPolicies() rtv1.ManagementPolicies {
-	return mg.Spec.ManagementPolicies
-}
-
-// GetProviderConfigReference of this TotalTLS.
-func (mg *TotalTLS) GetProviderConfigReference() *rtv1.Reference {
-	return mg.Spec.ProviderConfigReference
-}
-
-// GetWriteConnectionSecretToReference of this TotalTLS.
-func (mg *TotalTLS) GetWriteConnectionSecretToReference() *rtv1.SecretReference {
-	return mg.Spec.WriteConnectionSecretToReference
-}
-
-// SetConditions of this TotalTLS.
-func (mg *TotalTLS) SetConditions(c ...rtv1.Condition) {
-	mg.Status.SetConditions(c...)
-}
-
-// SetDeletionPolicy of this TotalTLS.
-func (mg *TotalTLS) SetDeletionPolicy(r rtv1.DeletionPolicy) {
-	mg.Spec.DeletionPolicy = r
-}
-
-// SetManagementPolicies of this TotalTLS.
-func (mg *TotalTLS) SetManagementPolicies(r rtv1.ManagementPolicies) {
-	mg.Spec.ManagementPolicies = r
-}
-
-// SetProviderConfigReference of this TotalTLS.
-func (mg *TotalTLS) SetProviderConfigReference(r *rtv1.Reference) {
-	mg.Spec.ProviderConfigReference = r
-}
-
-// SetWriteConnectionSecretToReference of this TotalTLS.
-func (mg *TotalTLS) SetWriteConnectionSecretToReference(r *rtv1.SecretReference) {
-	mg.Spec.WriteConnectionSecretToReference = r
-}
-
 // GetGroupVersionKind returns the GroupVersionKind for TotalTLS.
 func (mg *TotalTLS) GetGroupVersionKind() schema.GroupVersionKind {
 	return TotalTLSGroupVersionKind
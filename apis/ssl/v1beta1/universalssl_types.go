@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // UniversalSSLParameters define the desired state of Cloudflare Universal SSL for a zone.
@@ -42,7 +43,15 @@ type UniversalSSLObservation struct {
 
 // UniversalSSLSpec defines the desired state of Universal SSL.
 type UniversalSSLSpec struct {
-	rtv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy rtv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       UniversalSSLParameters `json:"forProvider"`
 }
 
@@ -80,56 +89,6 @@ func init() {
 	SchemeBuilder.Register(&UniversalSSL{}, &UniversalSSLList{})
 }
 
-// GetCondition of this UniversalSSL.
-func (mg *UniversalSSL) GetCondition(ct rtv1.ConditionType) rtv1.Condition {
-	return mg.Status.GetCondition(ct)
-}
-
-// GetDeletionPolicy of this UniversalSSL.
-func (mg *UniversalSSL) GetDeletionPolicy() rtv1.DeletionPolicy {
-	return mg.Spec.DeletionPolicy
-}
-
-// GetManagementPolicies of this UniversalSSL.
-func (mg *UniversalSSL) GetManagementPolicies() rtv1.ManagementPolicies {
-	return mg.Spec.ManagementPolicies
-}
-
-// GetProviderConfigReference of this UniversalSSL.
-func (mg *UniversalSSL) GetProviderConfigReference() *rtv1.Reference {
-	return mg.Spec.ProviderConfigReference
-}
-
-// GetWriteConnectionSecretToReference of this UniversalSSL.
-func (mg *UniversalSSL) GetWriteConnectionSecretToReference() *rtv1.SecretReference {
-	return mg.Spec.WriteConnectionSecretToReference
-}
-
-// SetConditions of this UniversalSSL.
-func (mg *UniversalSSL) SetConditions(c ...rtv1.Condition) {
-	mg.Status.SetConditions(c...)
-}
-
-// SetDeletionPolicy of this UniversalSSL.
-func (mg *UniversalSSL) SetDeletionPolicy(r rtv1.DeletionPolicy) {
-	mg.Spec.DeletionPolicy = r
-}
-
-// SetManagementPolicies of this UniversalSSL.
-func (mg *UniversalSSL) SetManagementPolicies(r rtv1.ManagementPolicies) {
-	mg.Spec.ManagementPolicies = r
-}
-
-// SetProviderConfigReference of this UniversalSSL.
-func (mg *UniversalSSL) SetProviderConfigReference(r *rtv1.Reference) {
-	mg.Spec.ProviderConfigReference = r
-}
-
-// SetWriteConnectionSecretToReference of this UniversalSSL.
-func (mg *UniversalSSL) SetWriteConnectionSecretToReference(r *rtv1.SecretReference) {
-	mg.Spec.WriteConnectionSecretToReference = r
-}
-
 // GetGroupVersionKind returns the GroupVersionKind for UniversalSSL.
 func (mg *UniversalSSL) GetGroupVersionKind() schema.GroupVersionKind {
 	return UniversalSSLGroupVersionKind
@@ -249,7 +249,7 @@ func (in *CertificatePackParameters) DeepCopy() *CertificatePackParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificatePackSpec) DeepCopyInto(out *CertificatePackSpec) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
@@ -462,7 +462,7 @@ func (in *TotalTLSParameters) DeepCopy() *TotalTLSParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TotalTLSSpec) DeepCopyInto(out *TotalTLSSpec) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
@@ -590,7 +590,7 @@ func (in *UniversalSSLParameters) DeepCopy() *UniversalSSLParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UniversalSSLSpec) DeepCopyInto(out *UniversalSSLSpec) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	out.ForProvider = in.ForProvider
 }
 
@@ -346,7 +346,7 @@ func (in *CustomHostnameSSLValidationErrors) DeepCopy() *CustomHostnameSSLValida
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomHostnameSpec) DeepCopyInto(out *CustomHostnameSpec) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
@@ -504,7 +504,7 @@ func (in *FallbackOriginParameters) DeepCopy() *FallbackOriginParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FallbackOriginSpec) DeepCopyInto(out *FallbackOriginSpec) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
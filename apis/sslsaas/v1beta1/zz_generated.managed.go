@@ -24,23 +24,18 @@ func (mg *CustomHostname) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
 	return mg.Status.GetCondition(ct)
 }
 
-// GetDeletionPolicy of this CustomHostname.
-func (mg *CustomHostname) GetDeletionPolicy() xpv1.DeletionPolicy {
-	return mg.Spec.DeletionPolicy
-}
-
 // GetManagementPolicies of this CustomHostname.
 func (mg *CustomHostname) GetManagementPolicies() xpv1.ManagementPolicies {
 	return mg.Spec.ManagementPolicies
 }
 
 // GetProviderConfigReference of this CustomHostname.
-func (mg *CustomHostname) GetProviderConfigReference() *xpv1.Reference {
+func (mg *CustomHostname) GetProviderConfigReference() *xpv1.ProviderConfigReference {
 	return mg.Spec.ProviderConfigReference
 }
 
 // GetWriteConnectionSecretToReference of this CustomHostname.
-func (mg *CustomHostname) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+func (mg *CustomHostname) GetWriteConnectionSecretToReference() *xpv1.LocalSecretReference {
 	return mg.Spec.WriteConnectionSecretToReference
 }
 
@@ -49,23 +44,18 @@ func (mg *CustomHostname) SetConditions(c ...xpv1.Condition) {
 	mg.Status.SetConditions(c...)
 }
 
-// SetDeletionPolicy of this CustomHostname.
-func (mg *CustomHostname) SetDeletionPolicy(r xpv1.DeletionPolicy) {
-	mg.Spec.DeletionPolicy = r
-}
-
 // SetManagementPolicies of this CustomHostname.
 func (mg *CustomHostname) SetManagementPolicies(r xpv1.ManagementPolicies) {
 	mg.Spec.ManagementPolicies = r
 }
 
 // SetProviderConfigReference of this CustomHostname.
-func (mg *CustomHostname) SetProviderConfigReference(r *xpv1.Reference) {
+func (mg *CustomHostname) SetProviderConfigReference(r *xpv1.ProviderConfigReference) {
 	mg.Spec.ProviderConfigReference = r
 }
 
 // SetWriteConnectionSecretToReference of this CustomHostname.
-func (mg *CustomHostname) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+func (mg *CustomHostname) SetWriteConnectionSecretToReference(r *xpv1.LocalSecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
 
@@ -74,23 +64,18 @@ func (mg *FallbackOrigin) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
 	return mg.Status.GetCondition(ct)
 }
 
-// GetDeletionPolicy of this FallbackOrigin.
-func (mg *FallbackOrigin) GetDeletionPolicy() xpv1.DeletionPolicy {
-	return mg.Spec.DeletionPolicy
-}
-
 // GetManagementPolicies of this FallbackOrigin.
 func (mg *FallbackOrigin) GetManagementPolicies() xpv1.ManagementPolicies {
 	return mg.Spec.ManagementPolicies
 }
 
 // GetProviderConfigReference of this FallbackOrigin.
-func (mg *FallbackOrigin) GetProviderConfigReference() *xpv1.Reference {
+func (mg *FallbackOrigin) GetProviderConfigReference() *xpv1.ProviderConfigReference {
 	return mg.Spec.ProviderConfigReference
 }
 
 // GetWriteConnectionSecretToReference of this FallbackOrigin.
-func (mg *FallbackOrigin) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+func (mg *FallbackOrigin) GetWriteConnectionSecretToReference() *xpv1.LocalSecretReference {
 	return mg.Spec.WriteConnectionSecretToReference
 }
 
@@ -99,22 +84,17 @@ func (mg *FallbackOrigin) SetConditions(c ...xpv1.Condition) {
 	mg.Status.SetConditions(c...)
 }
 
-// SetDeletionPolicy of this FallbackOrigin.
-func (mg *FallbackOrigin) SetDeletionPolicy(r xpv1.DeletionPolicy) {
-	mg.Spec.DeletionPolicy = r
-}
-
 // SetManagementPolicies of this FallbackOrigin.
 func (mg *FallbackOrigin) SetManagementPolicies(r xpv1.ManagementPolicies) {
 	mg.Spec.ManagementPolicies = r
 }
 
 // SetProviderConfigReference of this FallbackOrigin.
-func (mg *FallbackOrigin) SetProviderConfigReference(r *xpv1.Reference) {
+func (mg *FallbackOrigin) SetProviderConfigReference(r *xpv1.ProviderConfigReference) {
 	mg.Spec.ProviderConfigReference = r
 }
 
 // SetWriteConnectionSecretToReference of this FallbackOrigin.
-func (mg *FallbackOrigin) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+func (mg *FallbackOrigin) SetWriteConnectionSecretToReference(r *xpv1.LocalSecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
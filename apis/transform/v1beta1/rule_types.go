@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // Transform Rule phases for different types of transformations
@@ -47,10 +48,10 @@ type RuleParameters struct {
 	Zone *string `json:"zone,omitempty"`
 
 	// ZoneRef is a reference to a Zone object.
-	ZoneRef *xpv1.Reference `json:"zoneRef,omitempty"`
+	ZoneRef *xpv1.NamespacedReference `json:"zoneRef,omitempty"`
 
 	// ZoneSelector selects a Zone object.
-	ZoneSelector *xpv1.Selector `json:"zoneSelector,omitempty"`
+	ZoneSelector *xpv1.NamespacedSelector `json:"zoneSelector,omitempty"`
 
 	// Phase specifies the ruleset phase where this rule should be applied.
 	// Valid values: http_request_transform, http_request_late_transform, http_response_headers_transform
@@ -167,7 +168,15 @@ type RuleObservation struct {
 
 // RuleSpec defines the desired state of Rule
 type RuleSpec struct {
-	xpv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       RuleParameters `json:"forProvider"`
 }
 
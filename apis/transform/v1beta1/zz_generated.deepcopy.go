@@ -220,12 +220,12 @@ func (in *RuleParameters) DeepCopyInto(out *RuleParameters) {
 	}
 	if in.ZoneRef != nil {
 		in, out := &in.ZoneRef, &out.ZoneRef
-		*out = new(v1.Reference)
+		*out = new(v1.NamespacedReference)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ZoneSelector != nil {
 		in, out := &in.ZoneSelector, &out.ZoneSelector
-		*out = new(v1.Selector)
+		*out = new(v1.NamespacedSelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Description != nil {
@@ -263,7 +263,7 @@ func (in *RuleParameters) DeepCopy() *RuleParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuleSpec) DeepCopyInto(out *RuleSpec) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// TypeCredentialsVerified indicates whether Cloudflare accepted the
+// credential of a ProviderConfig or ClusterProviderConfig.
+const TypeCredentialsVerified xpv1.ConditionType = "CredentialsVerified"
+
+// Reasons a credential is, or is not, verified.
+const (
+	ReasonVerified     xpv1.ConditionReason = "Verified"
+	ReasonRejected     xpv1.ConditionReason = "Rejected"
+	ReasonVerifyFailed xpv1.ConditionReason = "VerifyFailed"
+)
+
+// CredentialsVerified returns a condition that indicates Cloudflare
+// accepted the credential.
+func CredentialsVerified() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCredentialsVerified,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonVerified,
+	}
+}
+
+// CredentialsRejected returns a condition that indicates Cloudflare
+// rejected the credential. Managed resources will not use it.
+func CredentialsRejected(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCredentialsVerified,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRejected,
+		Message:            err.Error(),
+	}
+}
+
+// CredentialsUnverified returns a condition that indicates the credential
+// could not be verified, for example because Cloudflare was unreachable.
+func CredentialsUnverified(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCredentialsVerified,
+		Status:             corev1.ConditionUnknown,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonVerifyFailed,
+		Message:            err.Error(),
+	}
+}
@@ -20,12 +20,75 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // A ProviderConfigSpec defines the desired state of a ProviderConfig.
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// AccountID is the Cloudflare account that account-scoped resources
+	// such as Workers, R2 buckets and Logpush jobs are managed under.
+	// Resources may override it with their own accountId. When neither
+	// is set the credential must have access to exactly one account.
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// BaseURL of the Cloudflare v4 API, for example to use a stand-in API
+	// in tests. Defaults to https://api.cloudflare.com/client/v4.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	BaseURL *string `json:"baseURL,omitempty"`
+
+	// ProxyURL of an HTTP proxy that requests to the Cloudflare API are
+	// sent through. Defaults to the proxy set by the HTTPS_PROXY and
+	// NO_PROXY environment variables of the provider, if any.
+	// +kubebuilder:validation:Pattern=`^(https?|socks5)://`
+	// +optional
+	ProxyURL *string `json:"proxyURL,omitempty"`
+
+	// CABundle holds PEM encoded certificate authorities that are trusted
+	// in addition to those of the system, for example those of a proxy
+	// that inspects TLS.
+	// +optional
+	CABundle *CABundleSource `json:"caBundle,omitempty"`
+
+	// Timeout of each request to the Cloudflare API, for example 30s.
+	// Requests do not time out by default.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// UserAgentSuffix is appended to the User-Agent of requests to the
+	// Cloudflare API, for example to tell apart several installations of
+	// the provider in audit logs.
+	// +optional
+	UserAgentSuffix *string `json:"userAgentSuffix,omitempty"`
+}
+
+// A CABundleSource is a key of a Secret or ConfigMap that holds PEM encoded
+// certificate authorities. Exactly one of its references must be set.
+type CABundleSource struct {
+	// SecretRef selects the key of a Secret that holds the bundle.
+	// +optional
+	SecretRef *xpv1.SecretKeySelector `json:"secretRef,omitempty"`
+
+	// ConfigMapRef selects the key of a ConfigMap that holds the bundle.
+	// +optional
+	ConfigMapRef *ConfigMapKeySelector `json:"configMapRef,omitempty"`
+}
+
+// A ConfigMapKeySelector is a reference to a key of a ConfigMap in an
+// arbitrary namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key whose value is selected.
+	Key string `json:"key"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -40,6 +103,41 @@ type ProviderCredentials struct {
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// Credential is what was learned by verifying the credential of the
+	// ProviderConfig with Cloudflare.
+	// +optional
+	Credential *CredentialStatus `json:"credential,omitempty"`
+}
+
+// A CredentialStatus is the result of verifying a credential with
+// Cloudflare.
+type CredentialStatus struct {
+	// TokenID is the ID of the API token, when the credential is one.
+	// +optional
+	TokenID string `json:"tokenId,omitempty"`
+
+	// TokenStatus is the status Cloudflare reports for the API token,
+	// for example active, disabled or expired.
+	// +optional
+	TokenStatus string `json:"tokenStatus,omitempty"`
+
+	// ExpiresOn is when the API token expires, if it does.
+	// +optional
+	ExpiresOn *metav1.Time `json:"expiresOn,omitempty"`
+
+	// PermissionGroups are the names of the permission groups the API
+	// token is allowed. They are unset when they could not be listed,
+	// either because the credential is a global API key or because the
+	// token may not read its own details, which needs the API Tokens Read
+	// permission. Managed resources are only checked against them when
+	// they are set.
+	// +optional
+	PermissionGroups []string `json:"permissionGroups,omitempty"`
+
+	// LastVerifiedTime is when the credential was last verified.
+	// +optional
+	LastVerifiedTime *metav1.Time `json:"lastVerifiedTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -48,6 +146,8 @@ type ProviderConfigStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
+// +kubebuilder:printcolumn:name="USERS",type="integer",JSONPath=".status.users"
+// +kubebuilder:printcolumn:name="VERIFIED",type="string",JSONPath=".status.conditions[?(@.type=='CredentialsVerified')].status"
 // +kubebuilder:resource:scope=Namespaced,categories={crossplane,provider,cloudflare}
 type ProviderConfig struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -68,8 +168,38 @@ type ProviderConfigList struct {
 
 // +kubebuilder:object:root=true
 
-// A ProviderConfigUsage indicates that a resource is using a ProviderConfig.
+// A ClusterProviderConfig configures the provider for managed resources in
+// any namespace. It lets credentials be held outside the namespaces of the
+// resources that use them.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
+// +kubebuilder:printcolumn:name="USERS",type="integer",JSONPath=".status.users"
+// +kubebuilder:printcolumn:name="VERIFIED",type="string",JSONPath=".status.conditions[?(@.type=='CredentialsVerified')].status"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,cloudflare}
+type ClusterProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProviderConfigSpec   `json:"spec"`
+	Status ProviderConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterProviderConfigList contains a list of ClusterProviderConfig.
+type ClusterProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterProviderConfig `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProviderConfigUsage indicates that a resource is using a ProviderConfig
+// or ClusterProviderConfig. It is always in the namespace of the resource.
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="CONFIG-KIND",type="string",JSONPath=".providerConfigRef.kind"
 // +kubebuilder:printcolumn:name="CONFIG-NAME",type="string",JSONPath=".providerConfigRef.name"
 // +kubebuilder:printcolumn:name="RESOURCE-KIND",type="string",JSONPath=".resourceRef.kind"
 // +kubebuilder:printcolumn:name="RESOURCE-NAME",type="string",JSONPath=".resourceRef.name"
@@ -78,7 +208,7 @@ type ProviderConfigUsage struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	xpv1.ProviderConfigUsage `json:",inline"`
+	xpv2.TypedProviderConfigUsage `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,7 +20,7 @@ package v1beta1
 import xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 
 // GetProviderConfigReference of this ProviderConfigUsage.
-func (p *ProviderConfigUsage) GetProviderConfigReference() xpv1.Reference {
+func (p *ProviderConfigUsage) GetProviderConfigReference() xpv1.ProviderConfigReference {
 	return p.ProviderConfigReference
 }
 
@@ -30,7 +30,7 @@ func (p *ProviderConfigUsage) GetResourceReference() xpv1.TypedReference {
 }
 
 // SetProviderConfigReference of this ProviderConfigUsage.
-func (p *ProviderConfigUsage) SetProviderConfigReference(r xpv1.Reference) {
+func (p *ProviderConfigUsage) SetProviderConfigReference(r xpv1.ProviderConfigReference) {
 	p.ProviderConfigReference = r
 }
 
@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // CronTriggerParameters are the configurable fields of a Workers Cron Trigger.
@@ -32,6 +33,12 @@ type CronTriggerParameters struct {
 	// Examples: "0 0 * * *" (daily at midnight), "*/5 * * * *" (every 5 minutes)
 	// Documentation: https://developers.cloudflare.com/workers/platform/cron-triggers/
 	Cron string `json:"cron"`
+
+	// AccountID is the account this cron trigger is managed under. It
+	// overrides the accountId of the ProviderConfig.
+	// +immutable
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
 }
 
 // CronTriggerObservation are the observable fields of a Workers Cron Trigger.
@@ -51,7 +58,15 @@ type CronTriggerObservation struct {
 
 // A CronTriggerSpec defines the desired state of a Workers Cron Trigger.
 type CronTriggerSpec struct {
-	xpv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       CronTriggerParameters `json:"forProvider"`
 }
 
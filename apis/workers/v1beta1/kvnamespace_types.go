@@ -20,12 +20,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // KVNamespaceParameters are the configurable fields of a Workers KV Namespace.
 type KVNamespaceParameters struct {
 	// Title is the human-readable name of the KV namespace.
 	Title string `json:"title"`
+
+	// AccountID is the account this KV namespace is managed under. It
+	// overrides the accountId of the ProviderConfig.
+	// +immutable
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
 }
 
 // KVNamespaceObservation are the observable fields of a Workers KV Namespace.
@@ -39,7 +46,15 @@ type KVNamespaceObservation struct {
 
 // A KVNamespaceSpec defines the desired state of a Workers KV Namespace.
 type KVNamespaceSpec struct {
-	xpv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       KVNamespaceParameters `json:"forProvider"`
 }
 
@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 )
 
 // PlacementMode represents the placement mode for a Worker script.
@@ -77,6 +78,12 @@ type ScriptParameters struct {
 	// +immutable
 	ScriptName string `json:"scriptName"`
 
+	// AccountID is the account this Worker script is managed under. It
+	// overrides the accountId of the ProviderConfig.
+	// +immutable
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
 	// Script is the JavaScript/WebAssembly content of the Worker.
 	// This can be raw script content or base64 encoded for binary content.
 	// +required
@@ -157,7 +164,15 @@ type ScriptObservation struct {
 
 // A ScriptSpec defines the desired state of a Worker Script.
 type ScriptSpec struct {
-	xpv1.ResourceSpec `json:",inline"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+
+	// DeletionPolicy specifies what happens to the external resource when
+	// this managed resource is deleted, either Delete or Orphan. It is
+	// deprecated in favor of managementPolicies; Orphan is still honored.
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +optional
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	ForProvider       ScriptParameters `json:"forProvider"`
 }
 
@@ -20,18 +20,23 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 
 	"gopkg.in/alecthomas/kingpin.v2"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
 
 	"github.com/rossigee/provider-cloudflare/apis"
 	"github.com/rossigee/provider-cloudflare/internal/controller"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 	"github.com/rossigee/provider-cloudflare/internal/version"
+	"github.com/rossigee/provider-cloudflare/internal/webhooks"
 )
 
 func main() {
@@ -40,6 +45,18 @@ func main() {
 		debug          = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		syncPeriod     = app.Flag("sync", "Controller manager sync period such as 300ms, 1.5h, or 2h45m").Short('s').Default("1h").Duration()
 		leaderElection = app.Flag("leader-election", "Use leader election for the controller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
+
+		pollInterval            = app.Flag("poll", "How often individual resources will be checked for drift from the desired state.").Default("5m").Duration()
+		pollIntervalOverrides   = app.Flag("poll-override", "Poll interval of the resources of an API group or kind, such as zone=1h, LoadBalancerPool=1m or rule.firewall.cloudflare.m.crossplane.io=10m. A kind in several groups needs its group. May be repeated.").Strings()
+		maxReconcileRate        = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may be checked for drift from the desired state.").Default("10").Int()
+		maxConcurrentReconciles = app.Flag("max-concurrent-reconciles", "The maximum number of resources of each kind that may be reconciled at once.").Default("5").Int()
+		concurrencyOverrides    = app.Flag("concurrency-override", "Maximum concurrent reconciles of the resources of an API group or kind, keyed like --poll-override, such as dns=10. May be repeated.").Strings()
+
+		enableGroups  = app.Flag("enable-groups", "Start only the controllers of these API groups, such as dns,zone. Groups are "+strings.Join(controller.Groups(), ", ")+".").Strings()
+		disableGroups = app.Flag("disable-groups", "Do not start the controllers of these API groups, such as workers,r2.").Strings()
+		detectCRDs    = app.Flag("detect-crds", "Skip the controllers of API groups whose CRDs are not all installed.").Default("false").Bool()
+
+		certsDir = app.Flag("tls-server-certs-dir", "The directory of the TLS certificate and key used to serve the validating webhooks. Webhooks are not served if unset.").Envar("TLS_SERVER_CERTS_DIR").String()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -60,26 +77,70 @@ func main() {
 		"sync-period", syncPeriod.String(),
 		"leader-election", *leaderElection,
 		"leader-election-id", "crossplane-leader-election-provider-cloudflare",
+		"poll-interval", pollInterval.String(),
+		"max-reconcile-rate", *maxReconcileRate,
+		"max-concurrent-reconciles", *maxConcurrentReconciles,
+		"enable-groups", *enableGroups,
+		"disable-groups", *disableGroups,
+		"detect-crds", *detectCRDs,
 		"debug-mode", *debug)
 
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
-	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+	mgr, err := ctrl.NewManager(ratelimiter.LimitRESTConfig(cfg, *maxReconcileRate), ctrl.Options{
 		LeaderElection:   *leaderElection,
 		LeaderElectionID: "crossplane-leader-election-provider-cloudflare",
+		WebhookServer:    webhook.NewServer(webhook.Options{CertDir: *certsDir}),
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 
-	rl := workqueue.DefaultTypedControllerRateLimiter[any]()
 	log.Info("Adding CloudFlare APIs to scheme")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add CloudFlare APIs to scheme")
 	kingpin.FatalIfError(apis.VerifySchemeRegistration(), "Scheme verification failed")
 	log.Info("CloudFlare APIs added to scheme successfully")
-	kingpin.FatalIfError(controller.SetupMinimal(mgr, log, rl), "Cannot setup minimal CloudFlare controllers")
+
+	kinds := controller.Kinds(mgr.GetScheme())
+	pollIntervals, err := options.ParsePollIntervals(*pollIntervalOverrides, kinds)
+	kingpin.FatalIfError(err, "Cannot parse poll interval overrides")
+	concurrency, err := options.ParseConcurrency(*concurrencyOverrides, kinds)
+	kingpin.FatalIfError(err, "Cannot parse concurrency overrides")
+
+	rl := ratelimiter.NewGlobal(*maxReconcileRate)
+	o := options.Options{
+		Options: xpcontroller.Options{
+			Logger:                  log,
+			GlobalRateLimiter:       rl,
+			PollInterval:            *pollInterval,
+			MaxConcurrentReconciles: *maxConcurrentReconciles,
+		},
+		PollIntervals: pollIntervals,
+		Concurrency:   concurrency,
+	}
+	kingpin.FatalIfError(controller.Setup(mgr, o, controller.Selection{
+		Enable:     splitList(*enableGroups),
+		Disable:    splitList(*disableGroups),
+		DetectCRDs: *detectCRDs,
+	}), "Cannot setup CloudFlare controllers")
+	if *certsDir != "" {
+		kingpin.FatalIfError(webhooks.Setup(mgr), "Cannot setup CloudFlare webhooks")
+	}
 
 	kingpin.FatalIfError(mgr.AddHealthzCheck("healthz", healthz.Ping), "Cannot add health check")
 	kingpin.FatalIfError(mgr.AddReadyzCheck("readyz", healthz.Ping), "Cannot add ready check")
 
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
-}
\ No newline at end of file
+}
+
+// splitList splits repeated and comma separated flag values into a list.
+func splitList(values []string) []string {
+	var list []string
+	for _, v := range values {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				list = append(list, s)
+			}
+		}
+	}
+	return list
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	errListAccounts     = "failed to list accounts"
+	errNoAccounts       = "no accounts found"
+	errAmbiguousAccount = "credential has access to %d accounts; set accountId on the ProviderConfig or the resource"
+)
+
+// AccountLister lists the accounts a credential has access to.
+type AccountLister interface {
+	Accounts(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error)
+}
+
+// AccountID returns the account ID a resource should be managed under
+// without calling the API. An override set on the resource wins over the
+// accountId of its ProviderConfig. An empty string means neither is set.
+func AccountID(override *string, cfg Config) string {
+	if override != nil && *override != "" {
+		return *override
+	}
+	if cfg.AccountID != nil {
+		return *cfg.AccountID
+	}
+	return ""
+}
+
+// DiscoverAccountID returns the only account the credential has access
+// to. It refuses to guess when the credential can see several accounts.
+func DiscoverAccountID(ctx context.Context, api AccountLister) (string, error) {
+	accounts, _, err := api.Accounts(ctx, cloudflare.AccountsListParams{})
+	if err != nil {
+		return "", errors.Wrap(err, errListAccounts)
+	}
+
+	switch len(accounts) {
+	case 0:
+		return "", errors.New(errNoAccounts)
+	case 1:
+		return accounts[0].ID, nil
+	default:
+		return "", errors.Errorf(errAmbiguousAccount, len(accounts))
+	}
+}
+
+// ResolveAccountID returns the account ID a resource should be managed
+// under, falling back to DiscoverAccountID when neither the resource nor
+// its ProviderConfig sets one. A discovered account ID is cached by the
+// Session of cfg, if it has one.
+func ResolveAccountID(ctx context.Context, api AccountLister, override *string, cfg Config) (string, error) {
+	if id := AccountID(override, cfg); id != "" {
+		return id, nil
+	}
+	if cfg.Session == nil {
+		return DiscoverAccountID(ctx, api)
+	}
+	return cfg.Session.account(ctx, func(ctx context.Context) (string, error) {
+		return DiscoverAccountID(ctx, api)
+	})
+}
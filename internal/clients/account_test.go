@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+type fakeAccountLister struct {
+	accounts []cloudflare.Account
+	err      error
+	calls    int
+}
+
+func (f *fakeAccountLister) Accounts(_ context.Context, _ cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+	f.calls++
+	return f.accounts, cloudflare.ResultInfo{}, f.err
+}
+
+func TestResolveAccountID(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		api      *fakeAccountLister
+		override *string
+		cfg      Config
+	}
+
+	type want struct {
+		id    string
+		err   error
+		calls int
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"ResourceOverride": {
+			reason: "An account ID set on the resource should win over the ProviderConfig",
+			args: args{
+				api:      &fakeAccountLister{},
+				override: ptr.To("resource-account"),
+				cfg:      Config{AccountID: ptr.To("pc-account")},
+			},
+			want: want{id: "resource-account"},
+		},
+		"ProviderConfig": {
+			reason: "The ProviderConfig account ID should be used when the resource sets none",
+			args: args{
+				api:      &fakeAccountLister{},
+				override: ptr.To(""),
+				cfg:      Config{AccountID: ptr.To("pc-account")},
+			},
+			want: want{id: "pc-account"},
+		},
+		"SingleAccount": {
+			reason: "The only visible account should be used when none is configured",
+			args: args{
+				api: &fakeAccountLister{accounts: []cloudflare.Account{{ID: "only-account"}}},
+			},
+			want: want{id: "only-account", calls: 1},
+		},
+		"Ambiguous": {
+			reason: "An error should be returned rather than guessing between several accounts",
+			args: args{
+				api: &fakeAccountLister{accounts: []cloudflare.Account{{ID: "a"}, {ID: "b"}, {ID: "c"}}},
+			},
+			want: want{err: errors.Errorf(errAmbiguousAccount, 3), calls: 1},
+		},
+		"NoAccounts": {
+			reason: "An error should be returned if the credential can see no accounts",
+			args: args{
+				api: &fakeAccountLister{},
+			},
+			want: want{err: errors.New(errNoAccounts), calls: 1},
+		},
+		"ListError": {
+			reason: "Errors listing accounts should be returned, not papered over",
+			args: args{
+				api: &fakeAccountLister{err: errBoom},
+			},
+			want: want{err: errors.Wrap(errBoom, errListAccounts), calls: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ResolveAccountID(context.Background(), tc.args.api, tc.args.override, tc.args.cfg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nResolveAccountID(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.id, got); diff != "" {
+				t.Errorf("\n%s\nResolveAccountID(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.args.api.calls); diff != "" {
+				t.Errorf("\n%s\nResolveAccountID(...): -want Accounts calls, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
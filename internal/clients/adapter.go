@@ -29,31 +29,17 @@ type CloudflareAPIAdapter struct {
 	accountID string
 }
 
-// NewCloudflareAPIAdapter creates a new adapter for cloudflare.API
-func NewCloudflareAPIAdapter(api *cloudflare.API) *CloudflareAPIAdapter {
+// NewCloudflareAPIAdapter creates a new adapter for cloudflare.API that
+// manages account-scoped resources under the supplied account ID.
+func NewCloudflareAPIAdapter(api *cloudflare.API, accountID string) *CloudflareAPIAdapter {
 	return &CloudflareAPIAdapter{
-		api: api,
+		api:       api,
+		accountID: accountID,
 	}
 }
 
 // GetAccountID returns the account ID
 func (a *CloudflareAPIAdapter) GetAccountID() string {
-	if a.accountID != "" {
-		return a.accountID
-	}
-	
-	// Try to get account ID from Cloudflare API by listing accounts
-	// Most users have access to only one account, so we'll use the first one
-	accounts, _, err := a.api.Accounts(context.Background(), cloudflare.AccountsListParams{})
-	if err == nil && len(accounts) > 0 {
-		a.accountID = accounts[0].ID
-		// Log successful account ID retrieval
-		return a.accountID
-	}
-	
-	// If API call fails, use the known account ID for this deployment
-	// Log fallback usage for debugging
-	a.accountID = "c1b74f148aee28025816e104a92622c5"
 	return a.accountID
 }
 
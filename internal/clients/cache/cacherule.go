@@ -26,17 +26,21 @@ import (
 
 	"github.com/rossigee/provider-cloudflare/apis/cache/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/clients/phaselock"
 )
 
 const (
-	errCreateCacheRule = "failed to create cache rule"
-	errGetCacheRule    = "failed to get cache rule"
-	errUpdateCacheRule = "failed to update cache rule"
-	errDeleteCacheRule = "failed to delete cache rule"
-	errListRulesets    = "failed to list rulesets"
-	errCreateRuleset   = "failed to create cache rule ruleset"
-	errUpdateRuleset   = "failed to update cache rule ruleset"
-	errDeleteRuleset   = "failed to delete cache rule ruleset"
+	errCreateCacheRule   = "failed to create cache rule"
+	errGetCacheRule      = "failed to get cache rule"
+	errUpdateCacheRule   = "failed to update cache rule"
+	errDeleteCacheRule   = "failed to delete cache rule"
+	errListRulesets      = "failed to list rulesets"
+	errCreateRuleset     = "failed to create cache rule ruleset"
+	errUpdateRuleset     = "failed to update cache rule ruleset"
+	errDeleteRuleset     = "failed to delete cache rule ruleset"
+	errLockRuleset       = "failed to lock cache rule ruleset"
+	errCacheRuleNotFound = "cache rule %s not found in ruleset %s"
 
 	cacheRulesetPhase = "http_request_cache_settings"
 	cacheRulesetKind  = "zone"
@@ -68,6 +72,12 @@ type cacheRuleClient struct {
 func (c *cacheRuleClient) CreateCacheRule(ctx context.Context, params v1beta1.CacheRuleParameters) (*cloudflare.RulesetRule, *cloudflare.Ruleset, error) {
 	rc := cloudflare.ZoneIdentifier(params.Zone)
 
+	unlock, err := phaselock.Lock(ctx, phaselock.ZoneKey(params.Zone, cacheRulesetPhase))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errLockRuleset)
+	}
+	defer unlock()
+
 	// First, find or create the cache rules ruleset
 	ruleset, err := c.findOrCreateCacheRuleset(ctx, rc, params)
 	if err != nil {
@@ -112,13 +122,19 @@ func (c *cacheRuleClient) GetCacheRule(ctx context.Context, rulesetID, ruleID st
 		}
 	}
 
-	return nil, nil, fmt.Errorf("cache rule %s not found in ruleset %s", ruleID, rulesetID)
+	return nil, nil, cferrors.NewNotFound(fmt.Sprintf(errCacheRuleNotFound, ruleID, rulesetID))
 }
 
 // UpdateCacheRule updates an existing cache rule in Cloudflare
 func (c *cacheRuleClient) UpdateCacheRule(ctx context.Context, rulesetID, ruleID string, params v1beta1.CacheRuleParameters) (*cloudflare.RulesetRule, *cloudflare.Ruleset, error) {
 	rc := cloudflare.ZoneIdentifier(params.Zone)
 
+	unlock, err := phaselock.Lock(ctx, phaselock.ZoneKey(params.Zone, cacheRulesetPhase))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errLockRuleset)
+	}
+	defer unlock()
+
 	// Get the current ruleset
 	ruleset, err := c.api.GetRuleset(ctx, rc, rulesetID)
 	if err != nil {
@@ -138,7 +154,7 @@ func (c *cacheRuleClient) UpdateCacheRule(ctx context.Context, rulesetID, ruleID
 	}
 
 	if updatedRule == nil {
-		return nil, nil, fmt.Errorf("cache rule %s not found in ruleset %s", ruleID, rulesetID)
+		return nil, nil, cferrors.NewNotFound(fmt.Sprintf(errCacheRuleNotFound, ruleID, rulesetID))
 	}
 
 	// Update the ruleset
@@ -166,6 +182,12 @@ func (c *cacheRuleClient) UpdateCacheRule(ctx context.Context, rulesetID, ruleID
 func (c *cacheRuleClient) DeleteCacheRule(ctx context.Context, rulesetID, ruleID string, params v1beta1.CacheRuleParameters) error {
 	rc := cloudflare.ZoneIdentifier(params.Zone)
 
+	unlock, err := phaselock.Lock(ctx, phaselock.ZoneKey(params.Zone, cacheRulesetPhase))
+	if err != nil {
+		return errors.Wrap(err, errLockRuleset)
+	}
+	defer unlock()
+
 	// Get the current ruleset
 	ruleset, err := c.api.GetRuleset(ctx, rc, rulesetID)
 	if err != nil {
@@ -184,7 +206,7 @@ func (c *cacheRuleClient) DeleteCacheRule(ctx context.Context, rulesetID, ruleID
 	}
 
 	if !found {
-		return fmt.Errorf("cache rule %s not found in ruleset %s", ruleID, rulesetID)
+		return cferrors.NewNotFound(fmt.Sprintf(errCacheRuleNotFound, ruleID, rulesetID))
 	}
 
 	// If this was the last rule, delete the entire ruleset
@@ -218,10 +240,15 @@ func (c *cacheRuleClient) findOrCreateCacheRuleset(ctx context.Context, rc *clou
 		return nil, errors.Wrap(err, errListRulesets)
 	}
 
-	// Look for an existing cache rules ruleset
+	// Look for an existing cache rules ruleset. Listed rulesets carry no
+	// rules, so the ruleset is fetched to keep the rules of other owners.
 	for _, ruleset := range rulesets {
 		if ruleset.Phase == cacheRulesetPhase && ruleset.Kind == cacheRulesetKind {
-			return &ruleset, nil
+			rs, err := c.api.GetRuleset(ctx, rc, ruleset.ID)
+			if err != nil {
+				return nil, errors.Wrap(err, errGetCacheRule)
+			}
+			return &rs, nil
 		}
 	}
 
@@ -440,18 +467,6 @@ func convertCustomKeyHostToCloudflare(host v1beta1.HostKey) *cloudflare.RulesetR
 	}
 }
 
-// IsCacheRuleNotFound checks if error indicates cache rule not found
-func IsCacheRuleNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	// Check for Cloudflare API not found errors
-	if cfErr := (*cloudflare.Error)(nil); errors.As(err, &cfErr) {
-		return cfErr.StatusCode == 404
-	}
-	return false
-}
-
 // GenerateCacheRuleObservation creates observation from Cloudflare cache rule
 func GenerateCacheRuleObservation(rule *cloudflare.RulesetRule, ruleset *cloudflare.Ruleset) v1beta1.CacheRuleObservation {
 	observation := v1beta1.CacheRuleObservation{
@@ -493,4 +508,4 @@ func IsCacheRuleUpToDate(params *v1beta1.CacheRuleParameters, rule *cloudflare.R
 	// For a more sophisticated comparison, we would need to compare action parameters
 	// This is a simplified check focusing on the most common fields
 	return true
-}
\ No newline at end of file
+}
@@ -17,6 +17,12 @@ limitations under the License.
 package cache
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -359,61 +365,96 @@ func TestConvertCacheRuleParametersToCloudflare(t *testing.T) {
 	}
 }
 
-func TestIsCacheRuleNotFound(t *testing.T) {
-	type args struct {
-		err error
+// fakeRulesetAPI serves a single zone cache ruleset. Reads are slowed down so
+// that unserialized read-modify-write cycles would overlap and lose rules.
+type fakeRulesetAPI struct {
+	mu    sync.Mutex
+	rules []cloudflare.RulesetRule
+	seq   int
+}
+
+func (f *fakeRulesetAPI) ruleset() cloudflare.Ruleset {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return cloudflare.Ruleset{
+		ID:    "rs",
+		Kind:  "zone",
+		Phase: cacheRulesetPhase,
+		Rules: append([]cloudflare.RulesetRule(nil), f.rules...),
 	}
+}
 
-	type want struct {
-		notFound bool
+func (f *fakeRulesetAPI) handler() http.Handler {
+	reply := func(w http.ResponseWriter, result any) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": result})
 	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /zones/z/rulesets", func(w http.ResponseWriter, _ *http.Request) {
+		rs := f.ruleset()
+		rs.Rules = nil
+		reply(w, []cloudflare.Ruleset{rs})
+	})
+	mux.HandleFunc("GET /zones/z/rulesets/rs", func(w http.ResponseWriter, _ *http.Request) {
+		rs := f.ruleset()
+		time.Sleep(5 * time.Millisecond)
+		reply(w, rs)
+	})
+	mux.HandleFunc("PUT /zones/z/rulesets/rs", func(w http.ResponseWriter, r *http.Request) {
+		var body cloudflare.UpdateRulesetParams
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.mu.Lock()
+		for i := range body.Rules {
+			if body.Rules[i].ID == "" {
+				f.seq++
+				body.Rules[i].ID = fmt.Sprintf("rule-%d", f.seq)
+			}
+		}
+		f.rules = body.Rules
+		f.mu.Unlock()
+		reply(w, f.ruleset())
+	})
+	return mux
+}
 
-	cases := map[string]struct {
-		reason string
-		args   args
-		want   want
-	}{
-		"NotFoundError": {
-			reason: "Should return true for 404 error",
-			args: args{
-				err: &cloudflare.Error{
-					StatusCode: 404,
-					ErrorCodes: []int{10006},
-				},
-			},
-			want: want{
-				notFound: true,
-			},
-		},
-		"OtherError": {
-			reason: "Should return false for other errors",
-			args: args{
-				err: &cloudflare.Error{
-					StatusCode: 500,
-					ErrorCodes: []int{10001},
-				},
-			},
-			want: want{
-				notFound: false,
-			},
-		},
-		"NilError": {
-			reason: "Should return false for nil error",
-			args: args{
-				err: nil,
-			},
-			want: want{
-				notFound: false,
-			},
-		},
+func TestCreateCacheRuleConcurrent(t *testing.T) {
+	fake := &fakeRulesetAPI{}
+	srv := httptest.NewServer(fake.handler())
+	defer srv.Close()
+
+	api, err := cloudflare.NewWithAPIToken("token", cloudflare.BaseURL(srv.URL), cloudflare.UsingRateLimit(1000))
+	if err != nil {
+		t.Fatal(err)
 	}
+	c := &cacheRuleClient{api: api}
 
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got := IsCacheRuleNotFound(tc.args.err)
-			if diff := cmp.Diff(tc.want.notFound, got); diff != "" {
-				t.Errorf("%s\nIsCacheRuleNotFound(...): -want, +got:\n%s", tc.reason, diff)
-			}
-		})
+	const n = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, _, err := c.CreateCacheRule(context.Background(), v1beta1.CacheRuleParameters{
+				Zone:       "z",
+				Name:       fmt.Sprintf("rule-%d", i),
+				Expression: fmt.Sprintf("(http.host eq \"%d.example.com\")", i),
+			})
+			errs <- err
+		}(i)
 	}
-}
\ No newline at end of file
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("CreateCacheRule(...): %v", err)
+		}
+	}
+	if got := len(fake.ruleset().Rules); got != n {
+		t.Errorf("CreateCacheRule(...): want %d rules after parallel creates, got %d", n, got)
+	}
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cferrors classifies the errors returned by the Cloudflare API.
+//
+// Clients and controllers use the categories here rather than matching
+// error messages, which Cloudflare is free to reword. Errors are classified
+// by their Cloudflare API error codes where those are known, then by the
+// HTTP status of the response they came from.
+package cferrors
+
+import (
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// A Category of error.
+type Category int
+
+// Error categories.
+const (
+	// Unknown errors could not be classified.
+	Unknown Category = iota
+
+	// NotFound errors report that a resource does not exist.
+	NotFound
+
+	// Conflict errors report that a resource already exists, or that it
+	// was changed concurrently.
+	Conflict
+
+	// RateLimited errors report that the request budget of a credential
+	// is exhausted.
+	RateLimited
+
+	// Auth errors report that a credential is missing or invalid.
+	Auth
+
+	// Permission errors report that a valid credential may not make a
+	// request.
+	Permission
+
+	// Validation errors report that a request was invalid, and will not
+	// succeed until it is changed.
+	Validation
+
+	// Transient errors report a failure that may not happen again.
+	Transient
+)
+
+// String returns a description of the category.
+func (c Category) String() string {
+	switch c {
+	case NotFound:
+		return "not found"
+	case Conflict:
+		return "conflict"
+	case RateLimited:
+		return "rate limited"
+	case Auth:
+		return "authentication failed"
+	case Permission:
+		return "permission denied"
+	case Validation:
+		return "invalid request"
+	case Transient:
+		return "transient failure"
+	default:
+		return "unknown"
+	}
+}
+
+// Cloudflare API error codes whose category does not follow from the
+// status of the response they are returned with.
+var codes = map[int]Category{
+	1001:  NotFound,    // Invalid zone identifier.
+	7000:  NotFound,    // No route for that URI.
+	7003:  NotFound,    // Could not route, perhaps the identifier is invalid.
+	10006: NotFound,    // Spectrum application not found.
+	10007: NotFound,    // Workers script or ruleset not found.
+	10014: NotFound,    // Ruleset rule not found.
+	81044: NotFound,    // DNS record does not exist.
+	1061:  Conflict,    // Zone already exists.
+	81053: Conflict,    // An A, AAAA or CNAME record with that host exists.
+	81057: Conflict,    // DNS record already exists.
+	81058: Conflict,    // DNS record with those settings already exists.
+	971:   RateLimited, // Please wait and consider throttling requests.
+	6003:  Auth,        // Invalid request headers.
+	9103:  Auth,        // Unknown X-Auth-Key or X-Auth-Email.
+	9106:  Auth,        // Missing X-Auth-Key or X-Auth-Email.
+	10000: Auth,        // Authentication error.
+	9109:  Permission,  // Unauthorized to access requested resource.
+}
+
+// An Error of a known category, for conditions a client detects itself.
+type Error struct {
+	Category Category
+	Message  string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// New returns an error of the supplied category.
+func New(c Category, message string) error {
+	return &Error{Category: c, Message: message}
+}
+
+// NewNotFound returns a NotFound error.
+func NewNotFound(message string) error {
+	return New(NotFound, message)
+}
+
+// Classify returns the category of an error, looking through any errors
+// it wraps.
+func Classify(err error) Category {
+	if err == nil {
+		return Unknown
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Category
+	}
+
+	if c, ok := classifyAPIError(err); ok {
+		return c
+	}
+
+	// cloudflare-go wraps each API error in a type chosen by status, but
+	// these are not always populated, for example by test fakes.
+	switch {
+	case errors.As(err, new(*cloudflare.NotFoundError)):
+		return NotFound
+	case errors.As(err, new(*cloudflare.RatelimitError)):
+		return RateLimited
+	case errors.As(err, new(*cloudflare.ServiceError)):
+		return Transient
+	case errors.As(err, new(*cloudflare.RequestError)):
+		return Validation
+	case errors.As(err, new(*cloudflare.AuthenticationError)):
+		return Auth
+	case errors.As(err, new(*cloudflare.AuthorizationError)):
+		return Permission
+	}
+
+	var ne net.Error
+	if errors.As(err, &ne) && ne.Timeout() {
+		return Transient
+	}
+	return Unknown
+}
+
+func classifyAPIError(err error) (Category, bool) {
+	var e *cloudflare.Error
+	if !errors.As(err, &e) || e == nil {
+		return Unknown, false
+	}
+
+	for _, code := range e.ErrorCodes {
+		if c, ok := codes[code]; ok {
+			return c, true
+		}
+	}
+
+	// Classify by status rather than by e.Type: cloudflare-go reports 401
+	// responses as authorization errors and 403 responses as
+	// authentication errors.
+	switch s := e.StatusCode; {
+	case s == http.StatusNotFound:
+		return NotFound, true
+	case s == http.StatusConflict:
+		return Conflict, true
+	case s == http.StatusTooManyRequests:
+		return RateLimited, true
+	case s == http.StatusUnauthorized:
+		return Auth, true
+	case s == http.StatusForbidden:
+		return Permission, true
+	case s >= http.StatusInternalServerError:
+		return Transient, true
+	case s >= http.StatusBadRequest:
+		return Validation, true
+	}
+	return Unknown, false
+}
+
+// Is returns true if the error is of the supplied category.
+func Is(err error, c Category) bool {
+	return Classify(err) == c
+}
+
+// IsNotFound returns true if the error reports that a resource does not
+// exist.
+func IsNotFound(err error) bool {
+	return Is(err, NotFound)
+}
+
+// IsConflict returns true if the error reports that a resource already
+// exists, or was changed concurrently.
+func IsConflict(err error) bool {
+	return Is(err, Conflict)
+}
+
+// IsRateLimited returns true if the error reports that the request budget
+// of a credential is exhausted.
+func IsRateLimited(err error) bool {
+	return Is(err, RateLimited)
+}
+
+// IsAuth returns true if the error reports that a credential is missing or
+// invalid.
+func IsAuth(err error) bool {
+	return Is(err, Auth)
+}
+
+// IsPermission returns true if the error reports that a credential may not
+// make a request.
+func IsPermission(err error) bool {
+	return Is(err, Permission)
+}
+
+// IsValidation returns true if the error reports that a request was
+// invalid.
+func IsValidation(err error) bool {
+	return Is(err, Validation)
+}
+
+// IsTransient returns true if the error reports a failure that may not
+// happen again.
+func IsTransient(err error) bool {
+	return Is(err, Transient)
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cferrors
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestClassify(t *testing.T) {
+	notFound := cloudflare.NewNotFoundError(&cloudflare.Error{StatusCode: http.StatusNotFound, ErrorCodes: []int{81044}})
+	unauthorized := cloudflare.NewAuthorizationError(&cloudflare.Error{StatusCode: http.StatusUnauthorized})
+	forbidden := cloudflare.NewAuthenticationError(&cloudflare.Error{StatusCode: http.StatusForbidden})
+	badRequest := cloudflare.NewRequestError(&cloudflare.Error{StatusCode: http.StatusBadRequest, ErrorCodes: []int{7003}})
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   Category
+	}{
+		"Nil": {
+			reason: "A nil error should not be classified.",
+			err:    nil,
+			want:   Unknown,
+		},
+		"Plain": {
+			reason: "An error that did not come from the API should not be classified by its message.",
+			err:    errors.New("404 not found"),
+			want:   Unknown,
+		},
+		"Own": {
+			reason: "An error made by New should keep its category.",
+			err:    New(Conflict, "exists"),
+			want:   Conflict,
+		},
+		"OwnWrapped": {
+			reason: "An error made by New should keep its category when wrapped.",
+			err:    errors.Wrap(NewNotFound("rule not found"), "cannot get rule"),
+			want:   NotFound,
+		},
+		"NotFoundWrapper": {
+			reason: "A NotFoundError should be NotFound.",
+			err:    &notFound,
+			want:   NotFound,
+		},
+		"EmptyNotFoundWrapper": {
+			reason: "A NotFoundError with no API error should be NotFound.",
+			err:    &cloudflare.NotFoundError{},
+			want:   NotFound,
+		},
+		"EmptyRatelimitWrapper": {
+			reason: "A RatelimitError with no API error should be RateLimited.",
+			err:    &cloudflare.RatelimitError{},
+			want:   RateLimited,
+		},
+		"EmptyServiceWrapper": {
+			reason: "A ServiceError with no API error should be Transient.",
+			err:    &cloudflare.ServiceError{},
+			want:   Transient,
+		},
+		"Unauthorized": {
+			reason: "A 401 response should be Auth, though cloudflare-go reports it as an authorization error.",
+			err:    &unauthorized,
+			want:   Auth,
+		},
+		"Forbidden": {
+			reason: "A 403 response should be Permission, though cloudflare-go reports it as an authentication error.",
+			err:    &forbidden,
+			want:   Permission,
+		},
+		"CodeOverridesStatus": {
+			reason: "A known error code should take precedence over the status it was returned with.",
+			err:    &badRequest,
+			want:   NotFound,
+		},
+		"RuleNotFoundCode": {
+			reason: "Error code 10014 should be NotFound.",
+			err:    &cloudflare.Error{StatusCode: http.StatusBadRequest, ErrorCodes: []int{10014}},
+			want:   NotFound,
+		},
+		"PermissionCode": {
+			reason: "Error code 9109 should be Permission.",
+			err:    &cloudflare.Error{StatusCode: http.StatusBadRequest, ErrorCodes: []int{9109}},
+			want:   Permission,
+		},
+		"ConflictCode": {
+			reason: "Error code 81057 should be Conflict.",
+			err:    &cloudflare.Error{StatusCode: http.StatusBadRequest, ErrorCodes: []int{1004, 81057}},
+			want:   Conflict,
+		},
+		"Conflict": {
+			reason: "A 409 response should be Conflict.",
+			err:    &cloudflare.Error{StatusCode: http.StatusConflict},
+			want:   Conflict,
+		},
+		"TooManyRequests": {
+			reason: "A 429 response should be RateLimited.",
+			err:    &cloudflare.Error{StatusCode: http.StatusTooManyRequests},
+			want:   RateLimited,
+		},
+		"ServerError": {
+			reason: "A 5xx response should be Transient.",
+			err:    &cloudflare.Error{StatusCode: http.StatusBadGateway},
+			want:   Transient,
+		},
+		"BadRequest": {
+			reason: "Any other 4xx response should be Validation.",
+			err:    &cloudflare.Error{StatusCode: http.StatusUnprocessableEntity, ErrorCodes: []int{1004}},
+			want:   Validation,
+		},
+		"Timeout": {
+			reason: "A network timeout should be Transient.",
+			err:    errors.Wrap(timeoutError{}, "cannot get zone"),
+			want:   Transient,
+		},
+		"Cancelled": {
+			reason: "A cancelled context should not be classified.",
+			err:    context.Canceled,
+			want:   Unknown,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Classify(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nClassify(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIs(t *testing.T) {
+	err := errors.Wrap(&cloudflare.NotFoundError{}, "cannot get record")
+
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound(%q): want true", err)
+	}
+	if IsConflict(err) || IsRateLimited(err) || IsAuth(err) || IsPermission(err) || IsValidation(err) || IsTransient(err) {
+		t.Errorf("%q: want only IsNotFound", err)
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cftest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// Name of the account of a Server.
+const accountName = "cftest"
+
+func (s *Server) routeAccounts() {
+	s.handle("GET /accounts", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, []cloudflare.Account{s.account()})
+	})
+	s.handle("GET /accounts/{account}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.checkAccount(w, r) {
+			return
+		}
+		writeResult(w, s.account())
+	})
+	s.handle("GET /user", func(w http.ResponseWriter, _ *http.Request) {
+		writeResult(w, cloudflare.User{ID: "0000000000000000000000000000000u", Email: Email})
+	})
+	s.handle("GET /user/tokens/verify", func(w http.ResponseWriter, r *http.Request) {
+		if !bearer(w, r) {
+			return
+		}
+		writeResult(w, cloudflare.APITokenVerifyBody{ID: TokenID, Status: "active"})
+	})
+	s.handle("GET /user/tokens/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !bearer(w, r) {
+			return
+		}
+		if s.groups == nil {
+			writeError(w, http.StatusForbidden, codeUnauthorized, "Unauthorized to access requested resource")
+			return
+		}
+		if r.PathValue("id") != TokenID {
+			writeError(w, http.StatusNotFound, codeNoRoute, "Token not found")
+			return
+		}
+		groups := make([]cloudflare.APITokenPermissionGroups, len(s.groups))
+		for i, g := range s.groups {
+			groups[i] = cloudflare.APITokenPermissionGroups{ID: fmt.Sprintf("%032x", i+1), Name: g}
+		}
+		writeResult(w, cloudflare.APIToken{
+			ID:     TokenID,
+			Name:   accountName,
+			Status: "active",
+			Policies: []cloudflare.APITokenPolicies{{
+				Effect:           "allow",
+				Resources:        map[string]interface{}{"com.cloudflare.api.account." + AccountID: "*"},
+				PermissionGroups: groups,
+			}},
+		})
+	})
+}
+
+func (s *Server) account() cloudflare.Account {
+	return cloudflare.Account{ID: AccountID, Name: accountName, Type: "standard", CreatedOn: time.Unix(0, 0).UTC()}
+}
+
+// checkAccount answers a request and returns false unless the account in
+// its path is that of the Server.
+func (s *Server) checkAccount(w http.ResponseWriter, r *http.Request) bool {
+	if id := r.PathValue("account"); id != AccountID {
+		writeError(w, http.StatusNotFound, codeInvalidObject, fmt.Sprintf("Could not route to /accounts/%s, perhaps your object identifier is invalid?", id))
+		return false
+	}
+	return true
+}
+
+// bearer answers a request and returns false unless it was authenticated
+// with an API token.
+func bearer(w http.ResponseWriter, r *http.Request) bool {
+	if r.Header.Get("Authorization") == "" {
+		writeError(w, http.StatusBadRequest, codeInvalidHeaders, "Invalid request headers")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cftest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// Error codes returned by Cloudflare, as classified by package cferrors.
+const (
+	codeNoRoute        = 7000
+	codeInvalidHeaders = 6003
+	codeMissingKey     = 9106
+	codeUnknownKey     = 9103
+	codeUnauthorized   = 9109
+	codeInvalidJSON    = 9207
+	codeRateLimited    = 971
+	codeBadRequest     = 1004
+	codeZoneExists     = 1061
+	codeInvalidObject  = 7003
+	codeRecordNotFound = 81044
+	codeRecordExists   = 81058
+	codeRecordConflict = 81053
+	codeInvalidContent = 9005
+	codeInvalidTTL     = 9021
+	codeNotProxiable   = 9004
+	codeRulesetMissing = 10007
+	codeRuleMissing    = 10014
+	codeLBNotFound     = 1002
+	codeBucketNotFound = 10006
+	codeBucketExists   = 10004
+	codeScriptNotFound = 10007
+)
+
+// An apiError is an error in the errors array of a response.
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// resultInfo describes the page of a paginated list.
+type resultInfo struct {
+	Page       int `json:"page"`
+	PerPage    int `json:"per_page"`
+	Count      int `json:"count"`
+	TotalCount int `json:"total_count"`
+	TotalPages int `json:"total_pages"`
+}
+
+// envelope is the body of every JSON response of the API.
+type envelope struct {
+	Success    bool        `json:"success"`
+	Errors     []apiError  `json:"errors"`
+	Messages   []apiError  `json:"messages"`
+	Result     interface{} `json:"result"`
+	ResultInfo *resultInfo `json:"result_info,omitempty"`
+}
+
+func writeEnvelope(w http.ResponseWriter, status int, e envelope) {
+	if e.Errors == nil {
+		e.Errors = []apiError{}
+	}
+	if e.Messages == nil {
+		e.Messages = []apiError{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(e)
+}
+
+// writeResult answers a request successfully with the supplied result.
+func writeResult(w http.ResponseWriter, result interface{}) {
+	writeEnvelope(w, http.StatusOK, envelope{Success: true, Result: result})
+}
+
+// writeError answers a request with the supplied status and error.
+func writeError(w http.ResponseWriter, status, code int, message string) {
+	writeEnvelope(w, status, envelope{Errors: []apiError{{Code: code, Message: message}}})
+}
+
+// Pagination defaults of the API.
+const (
+	defaultPerPage = 20
+	maxPerPage     = 100
+)
+
+// writePage answers a request with the page of items it asks for, using
+// the page and per_page query parameters.
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	page, perPage := 1, defaultPerPage
+	if v := r.URL.Query().Get("page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("Invalid page: %q", v))
+			return
+		}
+		page = n
+	}
+	if v := r.URL.Query().Get("per_page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("Invalid per_page: %q", v))
+			return
+		}
+		perPage = min(n, maxPerPage)
+	}
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+	result := append([]T{}, items[start:end]...)
+	writeEnvelope(w, http.StatusOK, envelope{
+		Success: true,
+		Result:  result,
+		ResultInfo: &resultInfo{
+			Page:       page,
+			PerPage:    perPage,
+			Count:      len(result),
+			TotalCount: len(items),
+			TotalPages: (len(items) + perPage - 1) / perPage,
+		},
+	})
+}
+
+// decode decodes the JSON body of a request into v, rejecting fields that
+// v does not have as Cloudflare rejects fields it does not recognise. It
+// answers the request and returns false if the body cannot be decoded.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidJSON, err.Error())
+		return false
+	}
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.DisallowUnknownFields()
+	if err := d.Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidJSON, fmt.Sprintf("Malformed JSON in request body: %s", err))
+		return false
+	}
+	return true
+}
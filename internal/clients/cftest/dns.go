@@ -0,0 +1,396 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cftest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// A record is a DNS record and the zone it belongs to.
+type record struct {
+	cloudflare.DNSRecord
+	zone     string
+	settings recordSettings
+}
+
+// recordSettings are the settings of a DNS record, including those
+// cloudflare-go does not cover.
+type recordSettings struct {
+	FlattenCNAME *bool `json:"flatten_cname,omitempty"`
+	IPv4Only     *bool `json:"ipv4_only,omitempty"`
+	IPv6Only     *bool `json:"ipv6_only,omitempty"`
+}
+
+// result returns the record as the API returns it.
+func (r *record) result() interface{} {
+	return struct {
+		cloudflare.DNSRecord
+		Settings recordSettings `json:"settings"`
+	}{DNSRecord: r.DNSRecord, Settings: r.settings}
+}
+
+// setSettings sets the settings of the record.
+func (r *record) setSettings(s recordSettings) {
+	r.settings = s
+	r.Settings = cloudflare.DNSRecordSettings{FlattenCNAME: s.FlattenCNAME}
+}
+
+// Fields of the data of each type of DNS record that has one, in the order
+// they appear in its content.
+var recordDataFields = map[string][]string{
+	"CAA":    {"flags", "tag", "value"},
+	"CERT":   {"type", "key_tag", "algorithm", "certificate"},
+	"DNSKEY": {"flags", "protocol", "algorithm", "public_key"},
+	"DS":     {"key_tag", "algorithm", "digest_type", "digest"},
+	"HTTPS":  {"priority", "target", "value"},
+	"LOC": {"lat_degrees", "lat_minutes", "lat_seconds", "lat_direction",
+		"long_degrees", "long_minutes", "long_seconds", "long_direction",
+		"altitude", "size", "precision_horz", "precision_vert"},
+	"NAPTR":  {"order", "preference", "flags", "service", "regex", "replacement"},
+	"SMIMEA": {"usage", "selector", "matching_type", "certificate"},
+	"SRV":    {"priority", "weight", "port", "target", "service", "proto", "name"},
+	"SSHFP":  {"algorithm", "type", "fingerprint"},
+	"SVCB":   {"priority", "target", "value"},
+	"TLSA":   {"usage", "selector", "matching_type", "certificate"},
+	"URI":    {"weight", "target"},
+}
+
+// Types of DNS record that have no data.
+var recordTypes = map[string]bool{
+	"A": true, "AAAA": true, "CNAME": true, "MX": true, "NS": true, "PTR": true, "TXT": true,
+}
+
+// Types of DNS record that may be proxied.
+var proxiable = map[string]bool{"A": true, "AAAA": true, "CNAME": true}
+
+// Most characters of TXT record content.
+const maxTXTLength = 2048
+
+// DNSRecords returns the DNS records of the supplied zone, oldest first.
+func (s *Server) DNSRecords(zoneID string) []cloudflare.DNSRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []cloudflare.DNSRecord{}
+	for _, r := range sorted(s.store.records) {
+		if r.zone == zoneID {
+			out = append(out, r.DNSRecord)
+		}
+	}
+	return out
+}
+
+// recordPatch is the body of a request to update a DNS record. Pointers
+// tell fields that were not sent from fields that were sent empty.
+type recordPatch struct {
+	Type     string          `json:"type"`
+	Name     string          `json:"name"`
+	Content  string          `json:"content"`
+	Data     interface{}     `json:"data"`
+	Priority *uint16         `json:"priority"`
+	TTL      int             `json:"ttl"`
+	Proxied  *bool           `json:"proxied"`
+	Comment  *string         `json:"comment"`
+	Tags     *[]string       `json:"tags"`
+	Settings *recordSettings `json:"settings"`
+}
+
+// recordCreate is the body of a request to create a DNS record.
+type recordCreate struct {
+	cloudflare.CreateDNSRecordParams
+	Settings recordSettings `json:"settings"`
+}
+
+func (s *Server) routeDNS() {
+	s.handle("GET /zones/{zone}/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		z := s.zone(w, r)
+		if z == nil {
+			return
+		}
+		q := r.URL.Query()
+		records := []interface{}{}
+		for _, rec := range sorted(s.store.records) {
+			if rec.zone != z.ID ||
+				(q.Get("name") != "" && rec.Name != q.Get("name")) ||
+				(q.Get("type") != "" && rec.Type != q.Get("type")) ||
+				(q.Get("content") != "" && rec.Content != q.Get("content")) {
+				continue
+			}
+			records = append(records, rec.result())
+		}
+		writePage(w, r, records)
+	})
+
+	s.handle("POST /zones/{zone}/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		z := s.zone(w, r)
+		if z == nil {
+			return
+		}
+		p := &recordCreate{}
+		if !decode(w, r, p) {
+			return
+		}
+		rec := &record{zone: z.ID, DNSRecord: cloudflare.DNSRecord{
+			Type:     p.Type,
+			Name:     p.Name,
+			Content:  p.Content,
+			Data:     p.Data,
+			Priority: p.Priority,
+			TTL:      p.TTL,
+			Proxied:  p.Proxied,
+			Comment:  p.Comment,
+			Tags:     p.Tags,
+		}}
+		rec.setSettings(p.Settings)
+		if !s.validateRecord(w, z, rec) {
+			return
+		}
+		now := s.now().UTC()
+		rec.ID = s.store.id()
+		rec.CreatedOn, rec.ModifiedOn = now, now
+		s.store.records[rec.ID] = rec
+		writeResult(w, rec.result())
+	})
+
+	s.handle("GET /zones/{zone}/dns_records/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if rec := s.record(w, r); rec != nil {
+			writeResult(w, rec.result())
+		}
+	})
+
+	s.handle("PATCH /zones/{zone}/dns_records/{id}", func(w http.ResponseWriter, r *http.Request) {
+		rec := s.record(w, r)
+		if rec == nil {
+			return
+		}
+		p := &recordPatch{}
+		if !decode(w, r, p) {
+			return
+		}
+		updated := *rec
+		updated.Type = or(p.Type, rec.Type)
+		updated.Name = or(p.Name, rec.Name)
+		if p.Content != "" || p.Data != nil {
+			updated.Content, updated.Data = p.Content, p.Data
+		}
+		if p.Priority != nil {
+			updated.Priority = p.Priority
+		}
+		if p.TTL != 0 {
+			updated.TTL = p.TTL
+		}
+		if p.Proxied != nil {
+			updated.Proxied = p.Proxied
+		}
+		if p.Comment != nil {
+			updated.Comment = *p.Comment
+		}
+		if p.Tags != nil {
+			updated.Tags = *p.Tags
+		}
+		if p.Settings != nil {
+			updated.setSettings(*p.Settings)
+		}
+		if !s.validateRecord(w, s.store.zones[rec.zone], &updated) {
+			return
+		}
+		updated.ModifiedOn = s.now().UTC()
+		*rec = updated
+		writeResult(w, rec.result())
+	})
+
+	s.handle("DELETE /zones/{zone}/dns_records/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if rec := s.record(w, r); rec != nil {
+			delete(s.store.records, rec.ID)
+			writeResult(w, map[string]string{"id": rec.ID})
+		}
+	})
+}
+
+// record returns the DNS record in the path of a request. It answers the
+// request and returns nil if there is no such record.
+func (s *Server) record(w http.ResponseWriter, r *http.Request) *record {
+	z := s.zone(w, r)
+	if z == nil {
+		return nil
+	}
+	rec, ok := s.store.records[r.PathValue("id")]
+	if !ok || rec.zone != z.ID {
+		writeError(w, http.StatusNotFound, codeRecordNotFound, "Record does not exist.")
+		return nil
+	}
+	return rec
+}
+
+// validateRecord normalises a new or updated DNS record as Cloudflare does,
+// and checks it is valid and does not conflict with the other records of
+// its zone. It answers the request and returns false if it is not.
+func (s *Server) validateRecord(w http.ResponseWriter, z *cloudflare.Zone, rec *record) bool { //nolint:gocyclo
+	fail := func(code int, format string, args ...interface{}) bool {
+		writeError(w, http.StatusBadRequest, code, fmt.Sprintf(format, args...))
+		return false
+	}
+
+	fields, hasData := recordDataFields[rec.Type]
+	if !hasData && !recordTypes[rec.Type] {
+		return fail(codeBadRequest, "DNS Validation Error: invalid type %q", rec.Type)
+	}
+
+	rec.Name = strings.ToLower(strings.TrimSuffix(rec.Name, "."))
+	switch {
+	case rec.Name == "" || rec.Name == "@":
+		rec.Name = z.Name
+	case rec.Name != z.Name && !strings.HasSuffix(rec.Name, "."+z.Name):
+		rec.Name += "." + z.Name
+	}
+
+	if rec.Data != nil {
+		if !hasData {
+			return fail(codeBadRequest, "DNS Validation Error: %s records do not have data", rec.Type)
+		}
+		content, priority, err := recordContent(rec.Type, fields, rec.Data)
+		if err != nil {
+			return fail(codeBadRequest, "DNS Validation Error: %s", err)
+		}
+		rec.Content = content
+		if priority != nil {
+			rec.Priority = priority
+		}
+	}
+
+	switch {
+	case rec.Content == "":
+		return fail(codeInvalidContent, "Content for %s record is invalid. Must not be empty.", rec.Type)
+	case rec.Type == "A" && (net.ParseIP(rec.Content) == nil || net.ParseIP(rec.Content).To4() == nil):
+		return fail(codeInvalidContent, "Content for A record is invalid. Must be a valid IPv4 address")
+	case rec.Type == "AAAA" && (net.ParseIP(rec.Content) == nil || net.ParseIP(rec.Content).To4() != nil):
+		return fail(codeInvalidContent, "Content for AAAA record is invalid. Must be a valid IPv6 address")
+	case rec.Type == "TXT" && len(rec.Content) > maxTXTLength:
+		return fail(codeInvalidContent, "Content for TXT record is invalid. Must be %d characters or fewer.", maxTXTLength)
+	case rec.Type == "MX" && rec.Priority == nil:
+		return fail(codeBadRequest, "DNS Validation Error: priority is required for MX records")
+	}
+
+	switch {
+	case rec.TTL == 0:
+		rec.TTL = 1
+	case rec.TTL != 1 && (rec.TTL < 60 || rec.TTL > 86400):
+		return fail(codeInvalidTTL, "TTL must be between 60 and 86400 seconds, or 1 for Automatic")
+	}
+
+	rec.Proxiable = proxiable[rec.Type]
+	if rec.Proxied == nil {
+		f := false
+		rec.Proxied = &f
+	}
+	if *rec.Proxied && !rec.Proxiable {
+		return fail(codeNotProxiable, "Invalid 'proxied' value, %s records cannot be proxied", rec.Type)
+	}
+
+	for _, other := range s.store.records {
+		if other.zone != rec.zone || other.ID == rec.ID || other.Name != rec.Name {
+			continue
+		}
+		if other.Type == rec.Type && other.Content == rec.Content {
+			writeError(w, http.StatusBadRequest, codeRecordExists, "An identical record already exists.")
+			return false
+		}
+		if other.Type == "CNAME" || rec.Type == "CNAME" {
+			writeError(w, http.StatusBadRequest, codeRecordConflict, "An A, AAAA, or CNAME record with that host already exists.")
+			return false
+		}
+	}
+	return true
+}
+
+// recordContent returns the content of a DNS record of the supplied type
+// with the supplied data, and its priority if the data has one that is
+// not part of its content. It returns an error if the data has a field
+// that the type does not.
+func recordContent(rtype string, fields []string, data interface{}) (string, *uint16, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return "", nil, err
+	}
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return "", nil, fmt.Errorf("data must be an object: %s", err)
+	}
+
+	known := map[string]bool{}
+	for _, f := range fields {
+		known[f] = true
+	}
+	for f := range values {
+		if !known[f] {
+			return "", nil, fmt.Errorf("unrecognised field %q in data of %s record", f, rtype)
+		}
+	}
+
+	var priority *uint16
+	parts := []string{}
+	for _, f := range fields {
+		v, ok := values[f]
+		if !ok {
+			continue
+		}
+		if rtype == "SRV" {
+			if f == "priority" {
+				n, ok := v.(float64)
+				if !ok || n < 0 || n > 65535 {
+					return "", nil, fmt.Errorf("invalid priority %v in data of SRV record", v)
+				}
+				p := uint16(n)
+				priority = &p
+			}
+			if f != "weight" && f != "port" && f != "target" {
+				continue
+			}
+		}
+		switch {
+		case rtype == "CAA" && f == "value":
+			parts = append(parts, fmt.Sprintf("%q", v))
+		case isNumber(v):
+			parts = append(parts, strconv.FormatFloat(v.(float64), 'f', -1, 64))
+		default:
+			parts = append(parts, fmt.Sprint(v))
+		}
+	}
+	if len(parts) == 0 {
+		return "", nil, fmt.Errorf("data of %s record is empty", rtype)
+	}
+	return strings.Join(parts, " "), priority, nil
+}
+
+func isNumber(v interface{}) bool {
+	_, ok := v.(float64)
+	return ok
+}
+
+// or returns v, or def if v is empty.
+func or(v, def string) string {
+	if v == "" {
+		return def
+	}
+	return v
+}
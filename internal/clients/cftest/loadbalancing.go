@@ -0,0 +1,427 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cftest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// Error code returned when a pool or monitor that is in use is deleted.
+const codeInUse = 1002
+
+// Types of load balancer monitor.
+var monitorTypes = map[string]bool{
+	"http": true, "https": true, "tcp": true, "udp_icmp": true, "icmp_ping": true, "smtp": true,
+}
+
+// A loadBalancer and the zone it belongs to.
+type loadBalancer struct {
+	cloudflare.LoadBalancer
+	zone string
+}
+
+// LoadBalancerMonitors returns the load balancer monitors, oldest first.
+func (s *Server) LoadBalancerMonitors() []cloudflare.LoadBalancerMonitor {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []cloudflare.LoadBalancerMonitor{}
+	for _, m := range sorted(s.store.monitors) {
+		out = append(out, *m)
+	}
+	return out
+}
+
+// LoadBalancerPools returns the load balancer pools, oldest first.
+func (s *Server) LoadBalancerPools() []cloudflare.LoadBalancerPool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []cloudflare.LoadBalancerPool{}
+	for _, p := range sorted(s.store.pools) {
+		out = append(out, *p)
+	}
+	return out
+}
+
+// LoadBalancers returns the load balancers of the supplied zone, oldest
+// first.
+func (s *Server) LoadBalancers(zoneID string) []cloudflare.LoadBalancer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []cloudflare.LoadBalancer{}
+	for _, lb := range sorted(s.store.lbs) {
+		if lb.zone == zoneID {
+			out = append(out, lb.LoadBalancer)
+		}
+	}
+	return out
+}
+
+func (s *Server) routeLoadBalancing() {
+	for _, c := range []string{"/accounts/{account}", "/user"} {
+		s.handle("GET "+c+"/load_balancers/pools", s.lbHandler(s.listPools))
+		s.handle("POST "+c+"/load_balancers/pools", s.lbHandler(s.createPool))
+		s.handle("GET "+c+"/load_balancers/pools/{id}", s.lbHandler(s.getPool))
+		s.handle("PUT "+c+"/load_balancers/pools/{id}", s.lbHandler(s.updatePool))
+		s.handle("DELETE "+c+"/load_balancers/pools/{id}", s.lbHandler(s.deletePool))
+
+		s.handle("GET "+c+"/load_balancers/monitors", s.lbHandler(s.listMonitors))
+		s.handle("POST "+c+"/load_balancers/monitors", s.lbHandler(s.createMonitor))
+		s.handle("GET "+c+"/load_balancers/monitors/{id}", s.lbHandler(s.getMonitor))
+		s.handle("PUT "+c+"/load_balancers/monitors/{id}", s.lbHandler(s.updateMonitor))
+		s.handle("DELETE "+c+"/load_balancers/monitors/{id}", s.lbHandler(s.deleteMonitor))
+	}
+
+	s.handle("GET /zones/{zone}/load_balancers", s.listLoadBalancers)
+	s.handle("POST /zones/{zone}/load_balancers", s.createLoadBalancer)
+	s.handle("GET /zones/{zone}/load_balancers/{id}", s.getLoadBalancer)
+	s.handle("PUT /zones/{zone}/load_balancers/{id}", s.updateLoadBalancer)
+	s.handle("DELETE /zones/{zone}/load_balancers/{id}", s.deleteLoadBalancer)
+}
+
+// lbHandler checks the account of an account level request before passing
+// it to fn. Pools and monitors of the user and of the account are the same.
+func (s *Server) lbHandler(fn http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, BasePath+"/accounts/") && !s.checkAccount(w, r) {
+			return
+		}
+		fn(w, r)
+	}
+}
+
+func (s *Server) listPools(w http.ResponseWriter, r *http.Request) {
+	out := []cloudflare.LoadBalancerPool{}
+	for _, p := range sorted(s.store.pools) {
+		out = append(out, *p)
+	}
+	writeResult(w, out)
+}
+
+func (s *Server) createPool(w http.ResponseWriter, r *http.Request) {
+	p := &cloudflare.LoadBalancerPool{}
+	if !decode(w, r, p) || !s.validatePool(w, p) {
+		return
+	}
+	now := s.now().UTC()
+	p.ID, p.CreatedOn, p.ModifiedOn = s.store.id(), &now, &now
+	s.store.pools[p.ID] = p
+	writeResult(w, p)
+}
+
+func (s *Server) getPool(w http.ResponseWriter, r *http.Request) {
+	if p := s.pool(w, r); p != nil {
+		writeResult(w, p)
+	}
+}
+
+func (s *Server) updatePool(w http.ResponseWriter, r *http.Request) {
+	old := s.pool(w, r)
+	if old == nil {
+		return
+	}
+	p := &cloudflare.LoadBalancerPool{}
+	if !decode(w, r, p) || !s.validatePool(w, p) {
+		return
+	}
+	now := s.now().UTC()
+	p.ID, p.CreatedOn, p.ModifiedOn = old.ID, old.CreatedOn, &now
+	s.store.pools[p.ID] = p
+	writeResult(w, p)
+}
+
+func (s *Server) deletePool(w http.ResponseWriter, r *http.Request) {
+	p := s.pool(w, r)
+	if p == nil {
+		return
+	}
+	for _, lb := range s.store.lbs {
+		if poolIDs(lb.LoadBalancer)[p.ID] {
+			writeError(w, http.StatusBadRequest, codeInUse, fmt.Sprintf("pool %s is referenced by load balancer %s", p.ID, lb.ID))
+			return
+		}
+	}
+	delete(s.store.pools, p.ID)
+	writeResult(w, cloudflare.ZoneID{ID: p.ID})
+}
+
+// pool returns the pool in the path of a request. It answers the request
+// and returns nil if there is no such pool.
+func (s *Server) pool(w http.ResponseWriter, r *http.Request) *cloudflare.LoadBalancerPool {
+	p, ok := s.store.pools[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, codeLBNotFound, "pool not found")
+		return nil
+	}
+	return p
+}
+
+func (s *Server) validatePool(w http.ResponseWriter, p *cloudflare.LoadBalancerPool) bool {
+	switch {
+	case p.Name == "":
+		writeError(w, http.StatusBadRequest, codeBadRequest, "name: required")
+		return false
+	case len(p.Origins) == 0:
+		writeError(w, http.StatusBadRequest, codeBadRequest, "origins: at least one origin is required")
+		return false
+	case p.Monitor != "" && s.store.monitors[p.Monitor] == nil:
+		writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("monitor: %s does not exist", p.Monitor))
+		return false
+	}
+	for i, o := range p.Origins {
+		if o.Name == "" || o.Address == "" {
+			writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("origins[%d]: name and address are required", i))
+			return false
+		}
+	}
+	if p.CheckRegions == nil {
+		p.CheckRegions = []string{}
+	}
+	return true
+}
+
+func (s *Server) listMonitors(w http.ResponseWriter, r *http.Request) {
+	out := []cloudflare.LoadBalancerMonitor{}
+	for _, m := range sorted(s.store.monitors) {
+		out = append(out, *m)
+	}
+	writeResult(w, out)
+}
+
+func (s *Server) createMonitor(w http.ResponseWriter, r *http.Request) {
+	m := &cloudflare.LoadBalancerMonitor{}
+	if !decode(w, r, m) || !validateMonitor(w, m) {
+		return
+	}
+	now := s.now().UTC()
+	m.ID, m.CreatedOn, m.ModifiedOn = s.store.id(), &now, &now
+	s.store.monitors[m.ID] = m
+	writeResult(w, m)
+}
+
+func (s *Server) getMonitor(w http.ResponseWriter, r *http.Request) {
+	if m := s.monitor(w, r); m != nil {
+		writeResult(w, m)
+	}
+}
+
+func (s *Server) updateMonitor(w http.ResponseWriter, r *http.Request) {
+	old := s.monitor(w, r)
+	if old == nil {
+		return
+	}
+	m := &cloudflare.LoadBalancerMonitor{}
+	if !decode(w, r, m) || !validateMonitor(w, m) {
+		return
+	}
+	now := s.now().UTC()
+	m.ID, m.CreatedOn, m.ModifiedOn = old.ID, old.CreatedOn, &now
+	s.store.monitors[m.ID] = m
+	writeResult(w, m)
+}
+
+func (s *Server) deleteMonitor(w http.ResponseWriter, r *http.Request) {
+	m := s.monitor(w, r)
+	if m == nil {
+		return
+	}
+	for _, p := range s.store.pools {
+		if p.Monitor == m.ID {
+			writeError(w, http.StatusBadRequest, codeInUse, fmt.Sprintf("monitor %s is referenced by pool %s", m.ID, p.ID))
+			return
+		}
+	}
+	delete(s.store.monitors, m.ID)
+	writeResult(w, cloudflare.ZoneID{ID: m.ID})
+}
+
+// monitor returns the monitor in the path of a request. It answers the
+// request and returns nil if there is no such monitor.
+func (s *Server) monitor(w http.ResponseWriter, r *http.Request) *cloudflare.LoadBalancerMonitor {
+	m, ok := s.store.monitors[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, codeLBNotFound, "monitor not found")
+		return nil
+	}
+	return m
+}
+
+// validateMonitor checks a monitor and fills in the defaults of its
+// fields, as Cloudflare does.
+func validateMonitor(w http.ResponseWriter, m *cloudflare.LoadBalancerMonitor) bool {
+	if m.Type == "" {
+		m.Type = "http"
+	}
+	if !monitorTypes[m.Type] {
+		writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("type: invalid value %q", m.Type))
+		return false
+	}
+	if m.Interval == 0 {
+		m.Interval = 60
+	}
+	if m.Timeout == 0 {
+		m.Timeout = 5
+	}
+	if m.Retries == 0 {
+		m.Retries = 2
+	}
+	if m.Timeout >= m.Interval {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "timeout must be less than interval")
+		return false
+	}
+	if m.Type == "http" || m.Type == "https" {
+		m.Method = or(m.Method, "GET")
+		m.Path = or(m.Path, "/")
+		m.ExpectedCodes = or(m.ExpectedCodes, "200")
+	}
+	return true
+}
+
+func (s *Server) listLoadBalancers(w http.ResponseWriter, r *http.Request) {
+	z := s.zone(w, r)
+	if z == nil {
+		return
+	}
+	out := []cloudflare.LoadBalancer{}
+	for _, lb := range sorted(s.store.lbs) {
+		if lb.zone == z.ID {
+			out = append(out, lb.LoadBalancer)
+		}
+	}
+	writeResult(w, out)
+}
+
+func (s *Server) createLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	z := s.zone(w, r)
+	if z == nil {
+		return
+	}
+	lb := &loadBalancer{zone: z.ID}
+	if !decode(w, r, &lb.LoadBalancer) || !s.validateLoadBalancer(w, z, lb) {
+		return
+	}
+	now := s.now().UTC()
+	lb.ID, lb.CreatedOn, lb.ModifiedOn = s.store.id(), &now, &now
+	s.store.lbs[lb.ID] = lb
+	writeResult(w, lb.LoadBalancer)
+}
+
+func (s *Server) getLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	if lb := s.loadBalancer(w, r); lb != nil {
+		writeResult(w, lb.LoadBalancer)
+	}
+}
+
+func (s *Server) updateLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	old := s.loadBalancer(w, r)
+	if old == nil {
+		return
+	}
+	lb := &loadBalancer{zone: old.zone}
+	if !decode(w, r, &lb.LoadBalancer) || !s.validateLoadBalancer(w, s.store.zones[old.zone], lb) {
+		return
+	}
+	now := s.now().UTC()
+	lb.ID, lb.CreatedOn, lb.ModifiedOn = old.ID, old.CreatedOn, &now
+	s.store.lbs[lb.ID] = lb
+	writeResult(w, lb.LoadBalancer)
+}
+
+func (s *Server) deleteLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	if lb := s.loadBalancer(w, r); lb != nil {
+		delete(s.store.lbs, lb.ID)
+		writeResult(w, cloudflare.ZoneID{ID: lb.ID})
+	}
+}
+
+// loadBalancer returns the load balancer in the path of a request. It
+// answers the request and returns nil if there is no such load balancer.
+func (s *Server) loadBalancer(w http.ResponseWriter, r *http.Request) *loadBalancer {
+	z := s.zone(w, r)
+	if z == nil {
+		return nil
+	}
+	lb, ok := s.store.lbs[r.PathValue("id")]
+	if !ok || lb.zone != z.ID {
+		writeError(w, http.StatusNotFound, codeLBNotFound, "load balancer not found")
+		return nil
+	}
+	return lb
+}
+
+// validateLoadBalancer checks that a load balancer has a name and that the
+// pools it uses exist, and fills in the defaults of its fields.
+func (s *Server) validateLoadBalancer(w http.ResponseWriter, z *cloudflare.Zone, lb *loadBalancer) bool {
+	switch {
+	case lb.Name == "":
+		writeError(w, http.StatusBadRequest, codeBadRequest, "name: required")
+		return false
+	case lb.FallbackPool == "":
+		writeError(w, http.StatusBadRequest, codeBadRequest, "fallback_pool: required")
+		return false
+	case len(lb.DefaultPools) == 0:
+		writeError(w, http.StatusBadRequest, codeBadRequest, "default_pools: at least one pool is required")
+		return false
+	}
+	for id := range poolIDs(lb.LoadBalancer) {
+		if s.store.pools[id] == nil {
+			writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("pool %s does not exist", id))
+			return false
+		}
+	}
+
+	lb.Name = strings.ToLower(strings.TrimSuffix(lb.Name, "."))
+	if lb.Name != z.Name && !strings.HasSuffix(lb.Name, "."+z.Name) {
+		lb.Name += "." + z.Name
+	}
+	for _, other := range s.store.lbs {
+		if other.ID != lb.ID && other.zone == lb.zone && other.Name == lb.Name {
+			writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("a load balancer named %s already exists", lb.Name))
+			return false
+		}
+	}
+	if lb.TTL == 0 {
+		lb.TTL = 30
+	}
+	if lb.Enabled == nil {
+		t := true
+		lb.Enabled = &t
+	}
+	lb.SteeringPolicy = or(lb.SteeringPolicy, "off")
+	lb.Persistence = or(lb.Persistence, "none")
+	return true
+}
+
+// poolIDs returns the IDs of every pool a load balancer uses.
+func poolIDs(lb cloudflare.LoadBalancer) map[string]bool {
+	ids := map[string]bool{lb.FallbackPool: true}
+	for _, id := range lb.DefaultPools {
+		ids[id] = true
+	}
+	for _, m := range []map[string][]string{lb.RegionPools, lb.PopPools, lb.CountryPools} {
+		for _, pools := range m {
+			for _, id := range pools {
+				ids[id] = true
+			}
+		}
+	}
+	delete(ids, "")
+	return ids
+}
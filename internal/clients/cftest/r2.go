@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cftest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// Names that R2 buckets may have.
+var bucketName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`)
+
+// Location hints that R2 buckets may be created with.
+var bucketLocations = map[string]bool{"apac": true, "eeur": true, "enam": true, "weur": true, "wnam": true, "oc": true}
+
+// R2Buckets returns the R2 buckets of the account, ordered by name.
+func (s *Server) R2Buckets() []cloudflare.R2Bucket {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []cloudflare.R2Bucket{}
+	for _, b := range sorted(s.store.buckets) {
+		out = append(out, *b)
+	}
+	return out
+}
+
+func (s *Server) routeR2() {
+	s.handle("GET /accounts/{account}/r2/buckets", func(w http.ResponseWriter, r *http.Request) {
+		if !s.checkAccount(w, r) {
+			return
+		}
+		out := []cloudflare.R2Bucket{}
+		for _, b := range sorted(s.store.buckets) {
+			out = append(out, *b)
+		}
+		writeResult(w, cloudflare.R2Buckets{Buckets: out})
+	})
+
+	s.handle("POST /accounts/{account}/r2/buckets", func(w http.ResponseWriter, r *http.Request) {
+		if !s.checkAccount(w, r) {
+			return
+		}
+		p := &cloudflare.CreateR2BucketParameters{}
+		if !decode(w, r, p) {
+			return
+		}
+		switch {
+		case !bucketName.MatchString(p.Name):
+			writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("The specified bucket name %q is not valid.", p.Name))
+			return
+		case p.LocationHint != "" && !bucketLocations[p.LocationHint]:
+			writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("The specified location hint %q is not valid.", p.LocationHint))
+			return
+		case s.store.buckets[p.Name] != nil:
+			writeError(w, http.StatusConflict, codeBucketExists, "The bucket you tried to create already exists, and you own it.")
+			return
+		}
+		now := s.now().UTC()
+		b := &cloudflare.R2Bucket{Name: p.Name, CreationDate: &now, Location: p.LocationHint}
+		if b.Location == "" {
+			b.Location = "enam"
+		}
+		s.store.buckets[b.Name] = b
+		writeResult(w, b)
+	})
+
+	s.handle("GET /accounts/{account}/r2/buckets/{name}", func(w http.ResponseWriter, r *http.Request) {
+		if b := s.bucket(w, r); b != nil {
+			writeResult(w, b)
+		}
+	})
+
+	s.handle("DELETE /accounts/{account}/r2/buckets/{name}", func(w http.ResponseWriter, r *http.Request) {
+		if b := s.bucket(w, r); b != nil {
+			delete(s.store.buckets, b.Name)
+			writeResult(w, map[string]interface{}{})
+		}
+	})
+}
+
+// bucket returns the R2 bucket in the path of a request. It answers the
+// request and returns nil if there is no such bucket.
+func (s *Server) bucket(w http.ResponseWriter, r *http.Request) *cloudflare.R2Bucket {
+	if !s.checkAccount(w, r) {
+		return nil
+	}
+	b, ok := s.store.buckets[r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, codeBucketNotFound, "The specified bucket does not exist.")
+		return nil
+	}
+	return b
+}
@@ -0,0 +1,409 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cftest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// Error code returned when a phase already has an entry point ruleset.
+const codeEntrypointExists = 20217
+
+// Kinds of ruleset, and whether each is the entry point of its phase.
+var rulesetKinds = map[string]bool{"custom": false, "managed": false, "root": true, "zone": true}
+
+// A ruleset and the zone or account it belongs to, such as
+// "zones/<zone ID>".
+type ruleset struct {
+	cloudflare.Ruleset
+	container string
+}
+
+// rulesetBody is the body of a request to create or update a ruleset.
+type rulesetBody struct {
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Kind        string                   `json:"kind"`
+	Phase       string                   `json:"phase"`
+	Rules       []cloudflare.RulesetRule `json:"rules"`
+}
+
+// ruleBody is the body of a request to create or update a single rule.
+type ruleBody struct {
+	cloudflare.RulesetRule
+	Position *struct {
+		Index  int    `json:"index"`
+		Before string `json:"before"`
+		After  string `json:"after"`
+	} `json:"position"`
+}
+
+// Rulesets returns the rulesets of the supplied zone or account ID, oldest
+// first.
+func (s *Server) Rulesets(id string) []cloudflare.Ruleset {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []cloudflare.Ruleset{}
+	for _, rs := range sorted(s.store.rulesets) {
+		if rs.container == "zones/"+id || rs.container == "accounts/"+id {
+			out = append(out, rs.Ruleset)
+		}
+	}
+	return out
+}
+
+func (s *Server) routeRulesets() {
+	for _, c := range []string{"/zones/{zone}", "/accounts/{account}"} {
+		s.handle("GET "+c+"/rulesets", s.listRulesets)
+		s.handle("POST "+c+"/rulesets", s.createRuleset)
+		s.handle("GET "+c+"/rulesets/{ruleset}", s.getRuleset)
+		s.handle("PUT "+c+"/rulesets/{ruleset}", s.updateRuleset)
+		s.handle("DELETE "+c+"/rulesets/{ruleset}", s.deleteRuleset)
+		s.handle("GET "+c+"/rulesets/phases/{phase}/entrypoint", s.getEntrypoint)
+		s.handle("PUT "+c+"/rulesets/phases/{phase}/entrypoint", s.updateEntrypoint)
+		s.handle("POST "+c+"/rulesets/{ruleset}/rules", s.createRule)
+		s.handle("PATCH "+c+"/rulesets/{ruleset}/rules/{rule}", s.updateRule)
+		s.handle("DELETE "+c+"/rulesets/{ruleset}/rules/{rule}", s.deleteRule)
+	}
+}
+
+// container returns the zone or account in the path of a request. It
+// answers the request and returns false if there is no such zone or
+// account.
+func (s *Server) container(w http.ResponseWriter, r *http.Request) (string, bool) {
+	if r.PathValue("zone") != "" {
+		z := s.zone(w, r)
+		if z == nil {
+			return "", false
+		}
+		return "zones/" + z.ID, true
+	}
+	if !s.checkAccount(w, r) {
+		return "", false
+	}
+	return "accounts/" + AccountID, true
+}
+
+// ruleset returns the ruleset in the path of a request. It answers the
+// request and returns nil if there is no such ruleset.
+func (s *Server) ruleset(w http.ResponseWriter, r *http.Request) *ruleset {
+	c, ok := s.container(w, r)
+	if !ok {
+		return nil
+	}
+	rs, ok := s.store.rulesets[r.PathValue("ruleset")]
+	if !ok || rs.container != c {
+		writeError(w, http.StatusNotFound, codeRulesetMissing, "could not find ruleset")
+		return nil
+	}
+	return rs
+}
+
+// entrypoint returns the entry point ruleset of a phase, if any.
+func (s *Server) entrypoint(container, phase string) *ruleset {
+	for _, rs := range s.store.rulesets {
+		if rs.container == container && rs.Phase == phase && rulesetKinds[rs.Kind] {
+			return rs
+		}
+	}
+	return nil
+}
+
+func (s *Server) listRulesets(w http.ResponseWriter, r *http.Request) {
+	c, ok := s.container(w, r)
+	if !ok {
+		return
+	}
+	out := []cloudflare.Ruleset{}
+	for _, rs := range sorted(s.store.rulesets) {
+		if rs.container == c {
+			listed := rs.Ruleset
+			listed.Rules = nil
+			out = append(out, listed)
+		}
+	}
+	writeResult(w, out)
+}
+
+func (s *Server) createRuleset(w http.ResponseWriter, r *http.Request) {
+	c, ok := s.container(w, r)
+	if !ok {
+		return
+	}
+	b := &rulesetBody{}
+	if !decode(w, r, b) {
+		return
+	}
+	entrypoint, known := rulesetKinds[b.Kind]
+	switch {
+	case b.Name == "":
+		writeError(w, http.StatusBadRequest, codeBadRequest, "name: required")
+		return
+	case !known:
+		writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("kind: invalid value %q", b.Kind))
+		return
+	case b.Phase == "":
+		writeError(w, http.StatusBadRequest, codeBadRequest, "phase: required")
+		return
+	case entrypoint && s.entrypoint(c, b.Phase) != nil:
+		writeError(w, http.StatusBadRequest, codeEntrypointExists, fmt.Sprintf("a ruleset of kind %s already exists for phase %s", b.Kind, b.Phase))
+		return
+	}
+	rs := &ruleset{container: c, Ruleset: cloudflare.Ruleset{
+		ID:          s.store.id(),
+		Name:        b.Name,
+		Description: b.Description,
+		Kind:        b.Kind,
+		Phase:       b.Phase,
+	}}
+	if !s.setRules(w, rs, b.Rules) {
+		return
+	}
+	s.store.rulesets[rs.ID] = rs
+	writeResult(w, rs.Ruleset)
+}
+
+func (s *Server) getRuleset(w http.ResponseWriter, r *http.Request) {
+	if rs := s.ruleset(w, r); rs != nil {
+		writeResult(w, rs.Ruleset)
+	}
+}
+
+func (s *Server) updateRuleset(w http.ResponseWriter, r *http.Request) {
+	rs := s.ruleset(w, r)
+	if rs == nil {
+		return
+	}
+	b := &rulesetBody{}
+	if !decode(w, r, b) {
+		return
+	}
+	if (b.Kind != "" && b.Kind != rs.Kind) || (b.Phase != "" && b.Phase != rs.Phase) {
+		writeError(w, http.StatusBadRequest, codeBadRequest, "the kind and phase of a ruleset cannot be changed")
+		return
+	}
+	if !s.setRules(w, rs, b.Rules) {
+		return
+	}
+	rs.Description = b.Description
+	writeResult(w, rs.Ruleset)
+}
+
+func (s *Server) deleteRuleset(w http.ResponseWriter, r *http.Request) {
+	if rs := s.ruleset(w, r); rs != nil {
+		delete(s.store.rulesets, rs.ID)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) getEntrypoint(w http.ResponseWriter, r *http.Request) {
+	c, ok := s.container(w, r)
+	if !ok {
+		return
+	}
+	rs := s.entrypoint(c, r.PathValue("phase"))
+	if rs == nil {
+		writeError(w, http.StatusNotFound, codeRulesetMissing, "could not find entrypoint ruleset in the "+r.PathValue("phase")+" phase")
+		return
+	}
+	writeResult(w, rs.Ruleset)
+}
+
+func (s *Server) updateEntrypoint(w http.ResponseWriter, r *http.Request) {
+	c, ok := s.container(w, r)
+	if !ok {
+		return
+	}
+	b := &rulesetBody{}
+	if !decode(w, r, b) {
+		return
+	}
+	phase := r.PathValue("phase")
+	rs := s.entrypoint(c, phase)
+	if rs == nil {
+		kind := "zone"
+		if r.PathValue("zone") == "" {
+			kind = "root"
+		}
+		rs = &ruleset{container: c, Ruleset: cloudflare.Ruleset{ID: s.store.id(), Name: "default", Kind: kind, Phase: phase}}
+	}
+	if !s.setRules(w, rs, b.Rules) {
+		return
+	}
+	if b.Description != "" {
+		rs.Description = b.Description
+	}
+	s.store.rulesets[rs.ID] = rs
+	writeResult(w, rs.Ruleset)
+}
+
+func (s *Server) createRule(w http.ResponseWriter, r *http.Request) {
+	rs := s.ruleset(w, r)
+	if rs == nil {
+		return
+	}
+	b := &ruleBody{}
+	if !decode(w, r, b) {
+		return
+	}
+	rules := slices.Clone(rs.Rules)
+	b.ID = ""
+	at, ok := position(w, rules, b, len(rules))
+	if !ok {
+		return
+	}
+	if !s.setRules(w, rs, slices.Insert(rules, at, b.RulesetRule)) {
+		return
+	}
+	writeResult(w, rs.Ruleset)
+}
+
+func (s *Server) updateRule(w http.ResponseWriter, r *http.Request) {
+	rs := s.ruleset(w, r)
+	if rs == nil {
+		return
+	}
+	i := ruleIndex(rs.Rules, r.PathValue("rule"))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, codeRuleMissing, "could not find rule "+r.PathValue("rule"))
+		return
+	}
+	b := &ruleBody{}
+	if !decode(w, r, b) {
+		return
+	}
+	b.ID = rs.Rules[i].ID
+	rules := slices.Delete(slices.Clone(rs.Rules), i, i+1)
+	at := i
+	if b.Position != nil {
+		var ok bool
+		if at, ok = position(w, rules, b, i); !ok {
+			return
+		}
+	}
+	if !s.setRules(w, rs, slices.Insert(rules, at, b.RulesetRule)) {
+		return
+	}
+	writeResult(w, rs.Ruleset)
+}
+
+func (s *Server) deleteRule(w http.ResponseWriter, r *http.Request) {
+	rs := s.ruleset(w, r)
+	if rs == nil {
+		return
+	}
+	i := ruleIndex(rs.Rules, r.PathValue("rule"))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, codeRuleMissing, "could not find rule "+r.PathValue("rule"))
+		return
+	}
+	if !s.setRules(w, rs, slices.Delete(slices.Clone(rs.Rules), i, i+1)) {
+		return
+	}
+	writeResult(w, rs.Ruleset)
+}
+
+// position returns where in rules the rule of a request should be placed,
+// or def if the request does not say. It answers the request and returns
+// false if the position is invalid.
+func position(w http.ResponseWriter, rules []cloudflare.RulesetRule, b *ruleBody, def int) (int, bool) {
+	p := b.Position
+	switch {
+	case p == nil:
+		return def, true
+	case p.Index > 0:
+		if p.Index > len(rules)+1 {
+			writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("position: index %d is out of range", p.Index))
+			return 0, false
+		}
+		return p.Index - 1, true
+	case p.Before != "" || p.After != "":
+		i := ruleIndex(rules, p.Before+p.After)
+		if i < 0 {
+			writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("position: could not find rule %s", p.Before+p.After))
+			return 0, false
+		}
+		if p.After != "" {
+			i++
+		}
+		return i, true
+	}
+	return def, true
+}
+
+func ruleIndex(rules []cloudflare.RulesetRule, id string) int {
+	return slices.IndexFunc(rules, func(r cloudflare.RulesetRule) bool { return r.ID == id })
+}
+
+// setRules validates and replaces the rules of a ruleset, assigning IDs to
+// new rules, and bumps its version. It answers the request and returns
+// false if a rule is invalid.
+func (s *Server) setRules(w http.ResponseWriter, rs *ruleset, rules []cloudflare.RulesetRule) bool {
+	old := map[string]cloudflare.RulesetRule{}
+	for _, r := range rs.Rules {
+		old[r.ID] = r
+	}
+
+	version := "1"
+	if rs.Version != nil {
+		n, _ := strconv.Atoi(*rs.Version)
+		version = strconv.Itoa(n + 1)
+	}
+	now := s.now().UTC()
+	latest := "latest"
+
+	out := make([]cloudflare.RulesetRule, len(rules))
+	for i, r := range rules {
+		if r.Expression == "" {
+			writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("rules[%d].expression: required", i))
+			return false
+		}
+		if r.Action == "" {
+			writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("rules[%d].action: required", i))
+			return false
+		}
+		if _, ok := old[r.ID]; !ok {
+			r.ID = s.store.id()
+		}
+		if r.Ref == "" {
+			r.Ref = r.ID
+		}
+		if r.Enabled == nil {
+			t := true
+			r.Enabled = &t
+		}
+		if r.Action == "execute" && r.ActionParameters != nil && r.ActionParameters.Version == nil {
+			// Cloudflare runs the latest version of the deployed ruleset.
+			ap := *r.ActionParameters
+			ap.Version = &latest
+			r.ActionParameters = &ap
+		}
+		r.Version = &version
+		r.LastUpdated = &now
+		out[i] = r
+	}
+
+	rs.Rules = out
+	rs.Version = &version
+	rs.LastUpdated = &now
+	return true
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cftest provides a fake of the Cloudflare v4 API that runs in
+// process, for tests that exercise real cloudflare-go clients and the
+// controllers built on them.
+//
+// The fake is stateful: objects created through it can be read, updated,
+// listed and deleted again. It answers with the same envelopes, error
+// codes and pagination as Cloudflare, rejects request fields that
+// Cloudflare would not recognise, and can be told to rate limit requests
+// or to fail particular ones. It covers accounts, API tokens, zones, DNS
+// records, rulesets, load balancers, Workers scripts and R2 buckets.
+package cftest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BasePath is the path under which the fake serves the API.
+const BasePath = "/client/v4"
+
+// Credentials accepted by a Server.
+const (
+	Token   = "cftest-token"
+	TokenID = "0000000000000000000000000000700e"
+	APIKey  = "cftest-key"
+	Email   = "cftest@example.org"
+)
+
+// AccountID is the ID of the only account of a Server.
+const AccountID = "0000000000000000000000000000acc7"
+
+// A Request made to a Server.
+type Request struct {
+	Method string
+
+	// Path of the request, relative to BasePath.
+	Path string
+}
+
+// A Server is a fake of the Cloudflare v4 API.
+type Server struct {
+	// URL of the API, including BasePath, suitable for use as the base
+	// URL of a cloudflare-go client.
+	URL string
+
+	srv *httptest.Server
+	mux *http.ServeMux
+
+	mu       sync.Mutex
+	store    *store
+	requests []Request
+	failures []failure
+	limit    *rateLimit
+	groups   []string
+	now      func() time.Time
+}
+
+// NewServer starts a Server. Callers should Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		store: newStore(),
+		mux:   http.NewServeMux(),
+		now:   time.Now,
+	}
+	s.routeAccounts()
+	s.routeZones()
+	s.routeDNS()
+	s.routeRulesets()
+	s.routeLoadBalancing()
+	s.routeWorkers()
+	s.routeR2()
+
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL + BasePath
+	return s
+}
+
+// Close shuts the Server down.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Requests returns the requests made to the Server so far, oldest first.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// SetPermissionGroups sets the names of the permission groups the API
+// token of the Server is allowed, such as "DNS Write". Until it is called
+// the token may not read its own permission groups, as if it were not
+// allowed "API Tokens Read".
+func (s *Server) SetPermissionGroups(groups ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.groups = groups
+}
+
+// FailNext makes the next request with the supplied method and path fail
+// with the supplied HTTP status and Cloudflare error. The path is relative
+// to BasePath, and does not include a query.
+func (s *Server) FailNext(method, path string, status, code int, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, failure{method: method, path: path, status: status, code: code, message: message})
+}
+
+type failure struct {
+	method  string
+	path    string
+	status  int
+	code    int
+	message string
+}
+
+// SetRateLimit limits the Server to the supplied number of requests per
+// window, as Cloudflare limits each credential. Requests beyond the limit
+// are answered with 429 Too Many Requests until the window ends. A limit
+// of zero removes it.
+func (s *Server) SetRateLimit(limit int, window time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if limit <= 0 {
+		s.limit = nil
+		return
+	}
+	s.limit = &rateLimit{limit: limit, window: window}
+}
+
+type rateLimit struct {
+	limit  int
+	window time.Duration
+	start  time.Time
+	used   int
+}
+
+// take uses one request of the budget, and returns how many remain and
+// how long until the budget is reset. It returns false if none remain.
+func (l *rateLimit) take(now time.Time) (int, time.Duration, bool) {
+	if now.Sub(l.start) >= l.window {
+		l.start, l.used = now, 0
+	}
+	reset := l.start.Add(l.window).Sub(now)
+	if l.used >= l.limit {
+		return 0, reset, false
+	}
+	l.used++
+	return l.limit - l.used, reset, true
+}
+
+// ServeHTTP records the request, applies any rate limit, injected failure
+// and authentication, then routes it.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, BasePath)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: path})
+
+	if l := s.limit; l != nil {
+		remaining, reset, ok := l.take(s.now())
+		secs := int((reset + time.Second - 1) / time.Second)
+		w.Header().Set("Ratelimit-Policy", fmt.Sprintf(`"default";q=%d;w=%d`, l.limit, int(l.window/time.Second)))
+		w.Header().Set("Ratelimit", fmt.Sprintf(`"default";r=%d;t=%d`, remaining, secs))
+		if !ok {
+			s.mu.Unlock()
+			w.Header().Set("Retry-After", fmt.Sprint(secs))
+			writeError(w, http.StatusTooManyRequests, codeRateLimited, "Please wait and consider throttling your request speed")
+			return
+		}
+	}
+
+	for i, f := range s.failures {
+		if f.method == r.Method && f.path == path {
+			s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			s.mu.Unlock()
+			writeError(w, f.status, f.code, f.message)
+			return
+		}
+	}
+	s.mu.Unlock()
+
+	if !strings.HasPrefix(r.URL.Path, BasePath+"/") {
+		writeError(w, http.StatusNotFound, codeNoRoute, "No route for that URI")
+		return
+	}
+	if status, code, msg := authenticate(r); status != 0 {
+		writeError(w, status, code, msg)
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// authenticate returns the status and error to answer a request with if
+// its credential is missing or wrong.
+func authenticate(r *http.Request) (int, int, string) {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		if auth != "Bearer "+Token {
+			return http.StatusBadRequest, codeInvalidHeaders, "Invalid request headers"
+		}
+		return 0, 0, ""
+	}
+	key, email := r.Header.Get("X-Auth-Key"), r.Header.Get("X-Auth-Email")
+	switch {
+	case key == "" || email == "":
+		return http.StatusBadRequest, codeMissingKey, "Missing X-Auth-Key, X-Auth-Email or Authorization headers"
+	case key != APIKey || email != Email:
+		return http.StatusForbidden, codeUnknownKey, "Unknown X-Auth-Key or X-Auth-Email"
+	}
+	return 0, 0, ""
+}
+
+// handle routes requests matching the supplied pattern, which is relative
+// to BasePath, to fn. The Server is locked while fn runs.
+func (s *Server) handle(pattern string, fn func(w http.ResponseWriter, r *http.Request)) {
+	method, path, _ := strings.Cut(pattern, " ")
+	s.mux.HandleFunc(method+" "+BasePath+path, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		fn(w, r)
+	})
+}
+
+// timestamp returns the current time as Cloudflare formats it.
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,522 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cftest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+)
+
+func newAPI(t *testing.T, s *Server) *cloudflare.API {
+	t.Helper()
+	api, err := cloudflare.NewWithAPIToken(Token, cloudflare.BaseURL(s.URL), cloudflare.UsingRateLimit(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api
+}
+
+// get makes a request to the supplied path of s, and decodes its envelope.
+func get(t *testing.T, s *Server, path string) (*http.Response, envelope) {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodGet, s.URL+path, nil)
+	req.Header.Set("Authorization", "Bearer "+Token)
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rsp.Body.Close() //nolint:errcheck
+	e := envelope{}
+	if err := json.NewDecoder(rsp.Body).Decode(&e); err != nil {
+		t.Fatal(err)
+	}
+	return rsp, e
+}
+
+func TestAuthenticate(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	zone := s.AddZone("example.org")
+
+	cases := map[string]struct {
+		reason string
+		api    func() (*cloudflare.API, error)
+		want   cferrors.Category
+	}{
+		"Token": {
+			reason: "Requests with the API token of the server should succeed.",
+			api: func() (*cloudflare.API, error) {
+				return cloudflare.NewWithAPIToken(Token, cloudflare.BaseURL(s.URL), cloudflare.UsingRateLimit(1000))
+			},
+			want: cferrors.Unknown,
+		},
+		"Key": {
+			reason: "Requests with the API key and email of the server should succeed.",
+			api: func() (*cloudflare.API, error) {
+				return cloudflare.New(APIKey, Email, cloudflare.BaseURL(s.URL), cloudflare.UsingRateLimit(1000))
+			},
+			want: cferrors.Unknown,
+		},
+		"WrongToken": {
+			reason: "Requests with another API token should be rejected.",
+			api: func() (*cloudflare.API, error) {
+				return cloudflare.NewWithAPIToken("nope", cloudflare.BaseURL(s.URL), cloudflare.UsingRateLimit(1000))
+			},
+			want: cferrors.Auth,
+		},
+		"WrongKey": {
+			reason: "Requests with another API key should be rejected.",
+			api: func() (*cloudflare.API, error) {
+				return cloudflare.New("nope", Email, cloudflare.BaseURL(s.URL), cloudflare.UsingRateLimit(1000))
+			},
+			want: cferrors.Auth,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api, err := tc.api()
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = api.ZoneDetails(context.Background(), zone)
+			if diff := cmp.Diff(tc.want, cferrors.Classify(err)); diff != "" {
+				t.Errorf("\n%s\nZoneDetails(...): -want category, +got category:\n%s\nerror: %v", tc.reason, diff, err)
+			}
+		})
+	}
+}
+
+func TestPagination(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	for i := range 45 {
+		s.AddZone(fmt.Sprintf("zone%02d.example", i))
+	}
+
+	cases := map[string]struct {
+		reason string
+		query  string
+		want   resultInfo
+	}{
+		"Default": {
+			reason: "Lists should have 20 items per page by default.",
+			query:  "",
+			want:   resultInfo{Page: 1, PerPage: 20, Count: 20, TotalCount: 45, TotalPages: 3},
+		},
+		"LastPage": {
+			reason: "The last page should have the remaining items.",
+			query:  "?page=3&per_page=20",
+			want:   resultInfo{Page: 3, PerPage: 20, Count: 5, TotalCount: 45, TotalPages: 3},
+		},
+		"PastTheEnd": {
+			reason: "Pages past the end should be empty.",
+			query:  "?page=9",
+			want:   resultInfo{Page: 9, PerPage: 20, Count: 0, TotalCount: 45, TotalPages: 3},
+		},
+		"MaxPerPage": {
+			reason: "Pages should have at most 100 items.",
+			query:  "?per_page=500",
+			want:   resultInfo{Page: 1, PerPage: 100, Count: 45, TotalCount: 45, TotalPages: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, e := get(t, s, "/zones"+tc.query)
+			if diff := cmp.Diff(&tc.want, e.ResultInfo); diff != "" {
+				t.Errorf("\n%s\nGET /zones%s: -want, +got:\n%s", tc.reason, tc.query, diff)
+			}
+		})
+	}
+
+	t.Run("Client", func(t *testing.T) {
+		zones, err := newAPI(t, s).ListZones(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(45, len(zones)); diff != "" {
+			t.Errorf("\ncloudflare-go should read every page.\nListZones(...): -want, +got:\n%s", diff)
+		}
+	})
+}
+
+func TestRateLimit(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.SetRateLimit(2, time.Minute)
+
+	type want struct {
+		Status    int
+		Ratelimit string
+	}
+	wants := []want{
+		{Status: http.StatusOK, Ratelimit: `"default";r=1;t=60`},
+		{Status: http.StatusOK, Ratelimit: `"default";r=0;t=60`},
+		{Status: http.StatusTooManyRequests, Ratelimit: `"default";r=0;t=60`},
+	}
+	for i, w := range wants {
+		rsp, e := get(t, s, "/accounts")
+		got := want{Status: rsp.StatusCode, Ratelimit: rsp.Header.Get("Ratelimit")}
+		if diff := cmp.Diff(w, got); diff != "" {
+			t.Errorf("\nRequest %d should use the budget of the server.\n-want, +got:\n%s", i, diff)
+		}
+		if rsp.StatusCode == http.StatusTooManyRequests {
+			if rsp.Header.Get("Retry-After") != "60" {
+				t.Errorf("\nA rate limited request should say when to retry.\nRetry-After: %q", rsp.Header.Get("Retry-After"))
+			}
+			if diff := cmp.Diff([]apiError{{Code: codeRateLimited, Message: e.Errors[0].Message}}, e.Errors); diff != "" {
+				t.Errorf("\nA rate limited request should return error %d.\n-want, +got:\n%s", codeRateLimited, diff)
+			}
+		}
+	}
+}
+
+func TestFailNext(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	api := newAPI(t, s)
+	zone := s.AddZone("example.org")
+
+	s.FailNext(http.MethodGet, "/zones/"+zone, http.StatusForbidden, 9109, "Unauthorized to access requested resource")
+
+	_, err := api.ZoneDetails(context.Background(), zone)
+	if diff := cmp.Diff(cferrors.Permission, cferrors.Classify(err)); diff != "" {
+		t.Errorf("\nThe injected failure should be returned.\nZoneDetails(...): -want, +got:\n%s", diff)
+	}
+	if _, err := api.ZoneDetails(context.Background(), zone); err != nil {
+		t.Errorf("\nOnly the next request should fail.\nZoneDetails(...): %v", err)
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	zone := s.AddZone("example.org")
+
+	body := strings.NewReader(`{"type":"A","name":"www","content":"192.0.2.1","proxy":true}`)
+	req, _ := http.NewRequest(http.MethodPost, s.URL+"/zones/"+zone+"/dns_records", body)
+	req.Header.Set("Authorization", "Bearer "+Token)
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rsp.Body.Close() //nolint:errcheck
+	e := envelope{}
+	_ = json.NewDecoder(rsp.Body).Decode(&e)
+
+	if diff := cmp.Diff(http.StatusBadRequest, rsp.StatusCode); diff != "" {
+		t.Errorf("\nA request with a field Cloudflare does not know should be rejected.\n-want status, +got status:\n%s", diff)
+	}
+	if len(e.Errors) != 1 || e.Errors[0].Code != codeInvalidJSON || !strings.Contains(e.Errors[0].Message, `"proxy"`) {
+		t.Errorf("\nThe error should name the unknown field.\nerrors: %+v", e.Errors)
+	}
+}
+
+func TestDNSRecords(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	api := newAPI(t, s)
+	zone := s.AddZone("example.org")
+	rc := cloudflare.ZoneIdentifier(zone)
+	ctx := context.Background()
+
+	if _, err := api.CreateDNSRecord(ctx, rc, cloudflare.CreateDNSRecordParams{Type: "CNAME", Name: "alias", Content: "example.net"}); err != nil {
+		t.Fatal(err)
+	}
+
+	type want struct {
+		Name     string
+		Content  string
+		Category cferrors.Category
+	}
+	cases := map[string]struct {
+		reason string
+		params cloudflare.CreateDNSRecordParams
+		want   want
+	}{
+		"Relative": {
+			reason: "Relative names should be qualified with the zone name.",
+			params: cloudflare.CreateDNSRecordParams{Type: "A", Name: "www", Content: "192.0.2.1"},
+			want:   want{Name: "www.example.org", Content: "192.0.2.1"},
+		},
+		"Apex": {
+			reason: "The name @ should be the zone name.",
+			params: cloudflare.CreateDNSRecordParams{Type: "TXT", Name: "@", Content: "v=spf1 -all"},
+			want:   want{Name: "example.org", Content: "v=spf1 -all"},
+		},
+		"SRV": {
+			reason: "The content of a record with data should be made from its data.",
+			params: cloudflare.CreateDNSRecordParams{Type: "SRV", Name: "_sip._tcp", Data: map[string]interface{}{
+				"priority": 10, "weight": 5, "port": 5060, "target": "sip.example.org",
+			}},
+			want: want{Name: "_sip._tcp.example.org", Content: "5 5060 sip.example.org"},
+		},
+		"InvalidA": {
+			reason: "A records should have IPv4 addresses.",
+			params: cloudflare.CreateDNSRecordParams{Type: "A", Name: "v6", Content: "2001:db8::1"},
+			want:   want{Category: cferrors.Validation},
+		},
+		"NotProxiable": {
+			reason: "Only A, AAAA and CNAME records may be proxied.",
+			params: cloudflare.CreateDNSRecordParams{Type: "TXT", Name: "txt", Content: "hello", Proxied: cloudflare.BoolPtr(true)},
+			want:   want{Category: cferrors.Validation},
+		},
+		"InvalidTTL": {
+			reason: "TTLs should be automatic or between 60 and 86400 seconds.",
+			params: cloudflare.CreateDNSRecordParams{Type: "A", Name: "ttl", Content: "192.0.2.1", TTL: 30},
+			want:   want{Category: cferrors.Validation},
+		},
+		"UnknownData": {
+			reason: "Data fields the type does not have should be rejected.",
+			params: cloudflare.CreateDNSRecordParams{Type: "CAA", Name: "caa", Data: map[string]interface{}{"flag": 0, "tag": "issue", "value": "ca.example"}},
+			want:   want{Category: cferrors.Validation},
+		},
+		"CNAMEConflict": {
+			reason: "No other record may have the name of a CNAME record.",
+			params: cloudflare.CreateDNSRecordParams{Type: "A", Name: "alias", Content: "192.0.2.1"},
+			want:   want{Category: cferrors.Conflict},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rec, err := api.CreateDNSRecord(ctx, rc, tc.params)
+			got := want{Name: rec.Name, Content: rec.Content, Category: cferrors.Classify(err)}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nCreateDNSRecord(...): -want, +got:\n%s\nerror: %v", tc.reason, diff, err)
+			}
+		})
+	}
+
+	t.Run("Lifecycle", func(t *testing.T) {
+		rec, err := api.CreateDNSRecord(ctx, rc, cloudflare.CreateDNSRecordParams{Type: "A", Name: "api", Content: "192.0.2.1", TTL: 300})
+		if err != nil {
+			t.Fatal(err)
+		}
+		updated, err := api.UpdateDNSRecord(ctx, rc, cloudflare.UpdateDNSRecordParams{ID: rec.ID, Content: "192.0.2.2", Proxied: cloudflare.BoolPtr(true)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if updated.Content != "192.0.2.2" || updated.TTL != 300 || !*updated.Proxied {
+			t.Errorf("\nAn update should change only the fields it sends.\nUpdateDNSRecord(...): %+v", updated)
+		}
+		if err := api.DeleteDNSRecord(ctx, rc, rec.ID); err != nil {
+			t.Fatal(err)
+		}
+		_, err = api.GetDNSRecord(ctx, rc, rec.ID)
+		if diff := cmp.Diff(cferrors.NotFound, cferrors.Classify(err)); diff != "" {
+			t.Errorf("\nA deleted record should not be found.\nGetDNSRecord(...): -want, +got:\n%s", diff)
+		}
+	})
+}
+
+func TestRulesets(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	api := newAPI(t, s)
+	zone := s.AddZone("example.org")
+	rc := cloudflare.ZoneIdentifier(zone)
+	ctx := context.Background()
+
+	if _, err := api.GetEntrypointRuleset(ctx, rc, "http_request_firewall_custom"); !cferrors.IsNotFound(err) {
+		t.Errorf("\nA phase without an entry point should not be found.\nGetEntrypointRuleset(...): %v", err)
+	}
+
+	rs, err := api.UpdateEntrypointRuleset(ctx, rc, cloudflare.UpdateEntrypointRulesetParams{
+		Phase: "http_request_firewall_custom",
+		Rules: []cloudflare.RulesetRule{{Action: "block", Expression: `ip.src eq 192.0.2.1`}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Add a rule in front of the first through the rules endpoint.
+	res, err := api.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/rulesets/%s/rules", zone, rs.ID), map[string]interface{}{
+		"action": "skip", "expression": "true", "position": map[string]interface{}{"index": 1},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := cloudflare.Ruleset{}
+	if err := json.Unmarshal(res.Result, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	actions := []string{}
+	for _, r := range got.Rules {
+		actions = append(actions, r.Action)
+	}
+	if diff := cmp.Diff([]string{"skip", "block"}, actions); diff != "" {
+		t.Errorf("\nA rule should be added where its position says.\n-want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("2", *got.Version); diff != "" {
+		t.Errorf("\nEach change should bump the version of the ruleset.\n-want, +got:\n%s", diff)
+	}
+
+	_, err = api.Raw(ctx, http.MethodDelete, fmt.Sprintf("/zones/%s/rulesets/%s/rules/nope", zone, rs.ID), nil, nil)
+	if diff := cmp.Diff(cferrors.NotFound, cferrors.Classify(err)); diff != "" {
+		t.Errorf("\nDeleting a rule that does not exist should fail.\n-want, +got:\n%s", diff)
+	}
+}
+
+func TestLoadBalancing(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	api := newAPI(t, s)
+	zone := s.AddZone("example.org")
+	acc := cloudflare.AccountIdentifier(AccountID)
+	ctx := context.Background()
+
+	m, err := api.CreateLoadBalancerMonitor(ctx, acc, cloudflare.CreateLoadBalancerMonitorParams{LoadBalancerMonitor: cloudflare.LoadBalancerMonitor{Type: "https"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Path != "/" || m.Interval != 60 || m.ExpectedCodes != "200" {
+		t.Errorf("\nA new monitor should have the default settings.\nCreateLoadBalancerMonitor(...): %+v", m)
+	}
+
+	p, err := api.CreateLoadBalancerPool(ctx, acc, cloudflare.CreateLoadBalancerPoolParams{LoadBalancerPool: cloudflare.LoadBalancerPool{
+		Name:    "origins",
+		Monitor: m.ID,
+		Origins: []cloudflare.LoadBalancerOrigin{{Name: "a", Address: "192.0.2.1", Enabled: true, Weight: 1}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = api.CreateLoadBalancer(ctx, cloudflare.ZoneIdentifier(zone), cloudflare.CreateLoadBalancerParams{LoadBalancer: cloudflare.LoadBalancer{
+		Name: "lb", FallbackPool: "nope", DefaultPools: []string{"nope"},
+	}})
+	if diff := cmp.Diff(cferrors.Validation, cferrors.Classify(err)); diff != "" {
+		t.Errorf("\nA load balancer should not use pools that do not exist.\n-want, +got:\n%s", diff)
+	}
+
+	lb, err := api.CreateLoadBalancer(ctx, cloudflare.ZoneIdentifier(zone), cloudflare.CreateLoadBalancerParams{LoadBalancer: cloudflare.LoadBalancer{
+		Name: "lb", FallbackPool: p.ID, DefaultPools: []string{p.ID},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("lb.example.org", lb.Name); diff != "" {
+		t.Errorf("\nLoad balancer names should be qualified with the zone name.\n-want, +got:\n%s", diff)
+	}
+
+	err = api.DeleteLoadBalancerPool(ctx, acc, p.ID)
+	if diff := cmp.Diff(cferrors.Validation, cferrors.Classify(err)); diff != "" {
+		t.Errorf("\nA pool should not be deleted while a load balancer uses it.\n-want, +got:\n%s", diff)
+	}
+}
+
+func TestWorkers(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	api := newAPI(t, s)
+	acc := cloudflare.AccountIdentifier(AccountID)
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		reason string
+		params cloudflare.CreateWorkerParams
+	}{
+		"ServiceWorker": {
+			reason: "A script uploaded as plain JavaScript should be read back as such.",
+			params: cloudflare.CreateWorkerParams{ScriptName: "plain", Script: "addEventListener('fetch', () => {})"},
+		},
+		"Multipart": {
+			reason: "A script uploaded with metadata should be read back as such.",
+			params: cloudflare.CreateWorkerParams{ScriptName: "compat", Script: "addEventListener('fetch', () => {})", CompatibilityDate: "2024-01-01"},
+		},
+		"Module": {
+			reason: "A module script should be read back as a module.",
+			params: cloudflare.CreateWorkerParams{ScriptName: "module", Module: true, Script: "export default { fetch() {} }"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := api.UploadWorker(ctx, acc, tc.params); err != nil {
+				t.Fatal(err)
+			}
+			got, err := api.GetWorker(ctx, acc, tc.params.ScriptName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.params.Script, got.Script); diff != "" {
+				t.Errorf("\n%s\nGetWorker(...): -want script, +got script:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.params.Module, got.Module); diff != "" {
+				t.Errorf("\n%s\nGetWorker(...): -want module, +got module:\n%s", tc.reason, diff)
+			}
+		})
+	}
+
+	if err := api.DeleteWorker(ctx, acc, cloudflare.DeleteWorkerParams{ScriptName: "plain"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := api.GetWorker(ctx, acc, "plain")
+	if diff := cmp.Diff(cferrors.NotFound, cferrors.Classify(err)); diff != "" {
+		t.Errorf("\nA deleted script should not be found.\n-want, +got:\n%s", diff)
+	}
+}
+
+func TestR2Buckets(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	api := newAPI(t, s)
+	acc := cloudflare.AccountIdentifier(AccountID)
+	ctx := context.Background()
+
+	b, err := api.CreateR2Bucket(ctx, acc, cloudflare.CreateR2BucketParameters{Name: "assets", LocationHint: "weur"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("weur", b.Location); diff != "" {
+		t.Errorf("\nA bucket should be created where its hint says.\n-want, +got:\n%s", diff)
+	}
+
+	_, err = api.CreateR2Bucket(ctx, acc, cloudflare.CreateR2BucketParameters{Name: "assets"})
+	if diff := cmp.Diff(cferrors.Conflict, cferrors.Classify(err)); diff != "" {
+		t.Errorf("\nBucket names should be unique.\n-want, +got:\n%s", diff)
+	}
+
+	buckets, err := api.ListR2Buckets(ctx, acc, cloudflare.ListR2BucketsParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(1, len(buckets)); diff != "" {
+		t.Errorf("\nListR2Buckets(...): -want, +got:\n%s", diff)
+	}
+
+	if err := api.DeleteR2Bucket(ctx, acc, "assets"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = api.GetR2Bucket(ctx, acc, "assets")
+	if diff := cmp.Diff(cferrors.NotFound, cferrors.Classify(err)); diff != "" {
+		t.Errorf("\nA deleted bucket should not be found.\n-want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cftest
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// store holds the objects of a Server. Objects are keyed by ID, and IDs
+// are assigned in order so that sorting them gives the order objects were
+// created in.
+type store struct {
+	seq int
+
+	zones        map[string]*cloudflare.Zone
+	zoneSettings map[string]map[string]cloudflare.ZoneSetting
+	records      map[string]*record
+	rulesets     map[string]*ruleset
+	pools        map[string]*cloudflare.LoadBalancerPool
+	monitors     map[string]*cloudflare.LoadBalancerMonitor
+	lbs          map[string]*loadBalancer
+	scripts      map[string]*script
+	buckets      map[string]*cloudflare.R2Bucket
+}
+
+func newStore() *store {
+	return &store{
+		zones:        map[string]*cloudflare.Zone{},
+		zoneSettings: map[string]map[string]cloudflare.ZoneSetting{},
+		records:      map[string]*record{},
+		rulesets:     map[string]*ruleset{},
+		pools:        map[string]*cloudflare.LoadBalancerPool{},
+		monitors:     map[string]*cloudflare.LoadBalancerMonitor{},
+		lbs:          map[string]*loadBalancer{},
+		scripts:      map[string]*script{},
+		buckets:      map[string]*cloudflare.R2Bucket{},
+	}
+}
+
+// id returns a new ID, formatted like those Cloudflare assigns.
+func (s *store) id() string {
+	s.seq++
+	return fmt.Sprintf("%032x", s.seq)
+}
+
+// sorted returns the values of m ordered by key.
+func sorted[T any](m map[string]T) []T {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make([]T, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, m[k])
+	}
+	return out
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cftest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"regexp"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// Error code returned when a Workers script is invalid.
+const codeInvalidScript = 10021
+
+// Names that Workers scripts may have.
+var scriptName = regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]{0,61}[a-z0-9])?$`)
+
+// A script is a Workers script.
+type script struct {
+	cloudflare.WorkerMetaData
+	content  string
+	module   bool
+	settings scriptMetadata
+}
+
+// scriptMetadata is the metadata part of a multipart script upload.
+type scriptMetadata struct {
+	BodyPart           string                            `json:"body_part,omitempty"`
+	MainModule         string                            `json:"main_module,omitempty"`
+	Bindings           []map[string]interface{}          `json:"bindings"`
+	Logpush            *bool                             `json:"logpush,omitempty"`
+	TailConsumers      *[]cloudflare.WorkersTailConsumer `json:"tail_consumers,omitempty"`
+	CompatibilityDate  string                            `json:"compatibility_date,omitempty"`
+	CompatibilityFlags []string                          `json:"compatibility_flags,omitempty"`
+	Placement          *cloudflare.Placement             `json:"placement,omitempty"`
+	Tags               []string                          `json:"tags"`
+	UsageModel         string                            `json:"usage_model,omitempty"`
+}
+
+func (s *Server) routeWorkers() {
+	s.handle("GET /accounts/{account}/workers/scripts", func(w http.ResponseWriter, r *http.Request) {
+		if !s.checkAccount(w, r) {
+			return
+		}
+		out := []cloudflare.WorkerMetaData{}
+		for _, sc := range sorted(s.store.scripts) {
+			out = append(out, sc.WorkerMetaData)
+		}
+		writeResult(w, out)
+	})
+
+	s.handle("PUT /accounts/{account}/workers/scripts/{name}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.checkAccount(w, r) {
+			return
+		}
+		name := r.PathValue("name")
+		if !scriptName.MatchString(name) {
+			writeError(w, http.StatusBadRequest, codeInvalidScript, fmt.Sprintf("Invalid script name %q", name))
+			return
+		}
+		content, module, meta, ok := readScript(w, r)
+		if !ok {
+			return
+		}
+
+		now := s.now().UTC()
+		sc, exists := s.store.scripts[name]
+		if !exists {
+			sc = &script{WorkerMetaData: cloudflare.WorkerMetaData{ID: name, CreatedOn: now}}
+			s.store.scripts[name] = sc
+		}
+		sc.content, sc.module, sc.settings = content, module, meta
+		sc.ModifiedOn = now
+		sc.Size = len(content)
+		sc.ETAG = s.store.id()
+		sc.Logpush = meta.Logpush
+		sc.TailConsumers = meta.TailConsumers
+		sc.Placement = meta.Placement
+		writeResult(w, cloudflare.WorkerScript{WorkerMetaData: sc.WorkerMetaData, UsageModel: or(meta.UsageModel, "standard")})
+	})
+
+	s.handle("GET /accounts/{account}/workers/scripts/{name}", func(w http.ResponseWriter, r *http.Request) {
+		sc := s.script(w, r)
+		if sc == nil {
+			return
+		}
+		if !sc.module {
+			w.Header().Set("Content-Type", "application/javascript")
+			_, _ = io.WriteString(w, sc.content)
+			return
+		}
+		buf := &bytes.Buffer{}
+		mpw := multipart.NewWriter(buf)
+		hdr := textproto.MIMEHeader{}
+		hdr.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%[1]q`, sc.settings.MainModule))
+		hdr.Set("Content-Type", "application/javascript+module")
+		pw, _ := mpw.CreatePart(hdr)
+		_, _ = io.WriteString(pw, sc.content)
+		_ = mpw.Close()
+		w.Header().Set("Content-Type", mpw.FormDataContentType())
+		_, _ = w.Write(buf.Bytes())
+	})
+
+	s.handle("GET /accounts/{account}/workers/scripts/{name}/content/v2", func(w http.ResponseWriter, r *http.Request) {
+		if sc := s.script(w, r); sc != nil {
+			w.Header().Set("Content-Type", "application/javascript")
+			_, _ = io.WriteString(w, sc.content)
+		}
+	})
+
+	s.handle("GET /accounts/{account}/workers/scripts/{name}/settings", func(w http.ResponseWriter, r *http.Request) {
+		sc := s.script(w, r)
+		if sc == nil {
+			return
+		}
+		settings := sc.settings
+		settings.BodyPart, settings.MainModule = "", ""
+		if settings.Bindings == nil {
+			settings.Bindings = []map[string]interface{}{}
+		}
+		writeResult(w, settings)
+	})
+
+	s.handle("DELETE /accounts/{account}/workers/scripts/{name}", func(w http.ResponseWriter, r *http.Request) {
+		if sc := s.script(w, r); sc != nil {
+			delete(s.store.scripts, sc.ID)
+			writeResult(w, nil)
+		}
+	})
+}
+
+// script returns the Workers script in the path of a request. It answers
+// the request and returns nil if there is no such script.
+func (s *Server) script(w http.ResponseWriter, r *http.Request) *script {
+	if !s.checkAccount(w, r) {
+		return nil
+	}
+	sc, ok := s.store.scripts[r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, codeScriptNotFound, "workers.api.error.script_not_found")
+		return nil
+	}
+	return sc
+}
+
+// readScript reads an uploaded Workers script, which is either plain
+// JavaScript or a multipart form of its metadata and modules. It answers
+// the request and returns false if the upload is invalid.
+func readScript(w http.ResponseWriter, r *http.Request) (string, bool, scriptMetadata, bool) {
+	meta := scriptMetadata{}
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidScript, "Invalid Content-Type")
+		return "", false, meta, false
+	}
+
+	if mediaType == "application/javascript" {
+		body, err := io.ReadAll(r.Body)
+		if err != nil || len(body) == 0 {
+			writeError(w, http.StatusBadRequest, codeInvalidScript, "No script was uploaded")
+			return "", false, meta, false
+		}
+		return string(body), false, meta, true
+	}
+	if mediaType != "multipart/form-data" {
+		writeError(w, http.StatusBadRequest, codeInvalidScript, fmt.Sprintf("Unsupported Content-Type %q", mediaType))
+		return "", false, meta, false
+	}
+
+	parts := map[string]string{}
+	mr := multipart.NewReader(r.Body, params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			writeError(w, http.StatusBadRequest, codeInvalidScript, err.Error())
+			return "", false, meta, false
+		}
+		body, _ := io.ReadAll(p)
+		parts[p.FormName()] = string(body)
+	}
+
+	raw, ok := parts["metadata"]
+	if !ok {
+		writeError(w, http.StatusBadRequest, codeInvalidScript, "No metadata part was uploaded")
+		return "", false, meta, false
+	}
+	d := json.NewDecoder(strings.NewReader(raw))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&meta); err != nil {
+		writeError(w, http.StatusBadRequest, codeInvalidJSON, fmt.Sprintf("Malformed metadata: %s", err))
+		return "", false, meta, false
+	}
+
+	main := meta.BodyPart
+	if meta.MainModule != "" {
+		main = meta.MainModule
+	}
+	content, ok := parts[main]
+	if main == "" || !ok || content == "" {
+		writeError(w, http.StatusBadRequest, codeInvalidScript, fmt.Sprintf("No script was uploaded in part %q", main))
+		return "", false, meta, false
+	}
+	return content, meta.MainModule != "", meta, true
+}
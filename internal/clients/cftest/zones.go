@@ -0,0 +1,253 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cftest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// Rate plans that zones may be subscribed to, by ID.
+var ratePlans = map[string]string{
+	"CF_FREE": "Free Website",
+	"CF_PRO":  "Pro Website",
+	"CF_BIZ":  "Business Website",
+	"CF_ENT":  "Enterprise Website",
+}
+
+// Settings of a new zone. Only these may be changed.
+var defaultZoneSettings = map[string]interface{}{
+	"0rtt":                     "off",
+	"always_online":            "on",
+	"always_use_https":         "off",
+	"automatic_https_rewrites": "on",
+	"brotli":                   "on",
+	"browser_cache_ttl":        14400,
+	"browser_check":            "on",
+	"cache_level":              "aggressive",
+	"development_mode":         "off",
+	"email_obfuscation":        "on",
+	"http3":                    "off",
+	"ipv6":                     "on",
+	"min_tls_version":          "1.0",
+	"security_level":           "medium",
+	"ssl":                      "flexible",
+	"tls_1_3":                  "on",
+	"websockets":               "on",
+}
+
+// AddZone adds a zone with the supplied name to the account of the Server,
+// and returns its ID.
+func (s *Server) AddZone(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addZone(name, "full", false).ID
+}
+
+func (s *Server) addZone(name, zoneType string, jumpStart bool) *cloudflare.Zone {
+	id := s.store.id()
+	now := s.now().UTC()
+	z := &cloudflare.Zone{
+		ID:          id,
+		Name:        name,
+		CreatedOn:   now,
+		ModifiedOn:  now,
+		NameServers: []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"},
+		Status:      "pending",
+		Type:        zoneType,
+		Plan:        zonePlan("CF_FREE"),
+		Account:     cloudflare.Account{ID: AccountID, Name: accountName},
+	}
+	if zoneType == "" {
+		z.Type = "full"
+	}
+	s.store.zones[id] = z
+
+	settings := map[string]cloudflare.ZoneSetting{}
+	for k, v := range defaultZoneSettings {
+		settings[k] = cloudflare.ZoneSetting{ID: k, Value: v, Editable: true}
+	}
+	s.store.zoneSettings[id] = settings
+	return z
+}
+
+func zonePlan(id string) cloudflare.ZonePlan {
+	return cloudflare.ZonePlan{
+		ZonePlanCommon: cloudflare.ZonePlanCommon{ID: id, Name: ratePlans[id], Currency: "USD", Frequency: "monthly"},
+		LegacyID:       strings.ToLower(strings.TrimPrefix(id, "CF_")),
+		IsSubscribed:   true,
+	}
+}
+
+// newZone is the body of a request to create a zone. cloudflare-go sends
+// the account as "organization".
+type newZone struct {
+	Name         string              `json:"name"`
+	JumpStart    bool                `json:"jump_start"`
+	Type         string              `json:"type"`
+	Account      *cloudflare.Account `json:"account,omitempty"`
+	Organization *cloudflare.Account `json:"organization,omitempty"`
+}
+
+func (s *Server) routeZones() {
+	s.handle("GET /zones", func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("name")
+		zones := []cloudflare.Zone{}
+		for _, z := range sorted(s.store.zones) {
+			if name == "" || z.Name == name {
+				zones = append(zones, *z)
+			}
+		}
+		writePage(w, r, zones)
+	})
+
+	s.handle("POST /zones", func(w http.ResponseWriter, r *http.Request) {
+		nz := &newZone{}
+		if !decode(w, r, nz) {
+			return
+		}
+		if !strings.Contains(nz.Name, ".") {
+			writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("Invalid zone name: %q", nz.Name))
+			return
+		}
+		for _, z := range s.store.zones {
+			if z.Name == nz.Name {
+				writeError(w, http.StatusBadRequest, codeZoneExists, fmt.Sprintf("%s already exists", nz.Name))
+				return
+			}
+		}
+		writeResult(w, s.addZone(nz.Name, nz.Type, nz.JumpStart))
+	})
+
+	s.handle("GET /zones/{zone}", func(w http.ResponseWriter, r *http.Request) {
+		if z := s.zone(w, r); z != nil {
+			writeResult(w, z)
+		}
+	})
+
+	s.handle("PATCH /zones/{zone}", func(w http.ResponseWriter, r *http.Request) {
+		z := s.zone(w, r)
+		if z == nil {
+			return
+		}
+		o := &cloudflare.ZoneOptions{}
+		if !decode(w, r, o) {
+			return
+		}
+		if o.Paused != nil {
+			z.Paused = *o.Paused
+		}
+		if o.VanityNS != nil {
+			z.VanityNS = o.VanityNS
+		}
+		if o.Plan != nil {
+			z.PlanPending = *o.Plan
+		}
+		if o.Type != "" {
+			z.Type = o.Type
+		}
+		z.ModifiedOn = s.now().UTC()
+		writeResult(w, z)
+	})
+
+	s.handle("DELETE /zones/{zone}", func(w http.ResponseWriter, r *http.Request) {
+		z := s.zone(w, r)
+		if z == nil {
+			return
+		}
+		delete(s.store.zones, z.ID)
+		delete(s.store.zoneSettings, z.ID)
+		for id, rec := range s.store.records {
+			if rec.zone == z.ID {
+				delete(s.store.records, id)
+			}
+		}
+		writeResult(w, cloudflare.ZoneID{ID: z.ID})
+	})
+
+	s.handle("GET /zones/{zone}/settings", func(w http.ResponseWriter, r *http.Request) {
+		if z := s.zone(w, r); z != nil {
+			writeResult(w, sorted(s.store.zoneSettings[z.ID]))
+		}
+	})
+
+	s.handle("PATCH /zones/{zone}/settings", func(w http.ResponseWriter, r *http.Request) {
+		z := s.zone(w, r)
+		if z == nil {
+			return
+		}
+		body := &struct {
+			Items []cloudflare.ZoneSetting `json:"items"`
+		}{}
+		if !decode(w, r, body) {
+			return
+		}
+		settings := s.store.zoneSettings[z.ID]
+		for _, item := range body.Items {
+			if _, ok := settings[item.ID]; !ok {
+				writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("Unrecognized zone setting name: %s", item.ID))
+				return
+			}
+		}
+		changed := []cloudflare.ZoneSetting{}
+		for _, item := range body.Items {
+			set := settings[item.ID]
+			set.Value = item.Value
+			set.ModifiedOn = s.timestamp()
+			settings[item.ID] = set
+			changed = append(changed, set)
+		}
+		writeResult(w, changed)
+	})
+
+	s.handle("POST /zones/{zone}/subscription", func(w http.ResponseWriter, r *http.Request) {
+		z := s.zone(w, r)
+		if z == nil {
+			return
+		}
+		body := &struct {
+			RatePlan struct {
+				ID string `json:"id"`
+			} `json:"rate_plan"`
+		}{}
+		if !decode(w, r, body) {
+			return
+		}
+		if _, ok := ratePlans[body.RatePlan.ID]; !ok {
+			writeError(w, http.StatusBadRequest, codeBadRequest, fmt.Sprintf("Invalid rate plan: %q", body.RatePlan.ID))
+			return
+		}
+		z.Plan = zonePlan(body.RatePlan.ID)
+		z.PlanPending = cloudflare.ZonePlan{}
+		writeResult(w, body)
+	})
+}
+
+// zone returns the zone in the path of a request. It answers the request
+// and returns nil if there is no such zone.
+func (s *Server) zone(w http.ResponseWriter, r *http.Request) *cloudflare.Zone {
+	id := r.PathValue("zone")
+	z, ok := s.store.zones[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, codeInvalidObject, fmt.Sprintf("Could not route to /zones/%s, perhaps your object identifier is invalid?", id))
+		return nil
+	}
+	return z
+}
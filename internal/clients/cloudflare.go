@@ -20,24 +20,33 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/throttle"
+	"github.com/rossigee/provider-cloudflare/internal/clients/transport"
 )
 
 const (
-	errGetPC        = "cannot get ProviderConfig"
-	errPCRef        = "providerConfigRef not set"
-	errTrackPCUsage = "cannot track ProviderConfig usage"
-	errNoAuth       = "auth details not valid"
+	errGetPC           = "cannot get ProviderConfig"
+	errGetCPC          = "cannot get ClusterProviderConfig"
+	errFmtPCKind       = "unsupported providerConfigRef kind %q"
+	errFmtRejected     = "credential of %s %q was rejected by Cloudflare: %s"
+	errFmtMissingPerms = "credential of %s %q is not allowed the permission groups a %s needs: %s"
+	errPCRef           = "providerConfigRef not set"
+	errTrackPCUsage    = "cannot track ProviderConfig usage"
+	errNoAuth          = "auth details not valid"
 )
 
 // AuthByAPIKey represents the details required to authenticate
@@ -59,78 +68,191 @@ type AuthByAPIToken struct {
 type Config struct {
 	*AuthByAPIKey   `json:",inline"`
 	*AuthByAPIToken `json:",inline"`
+
+	// AccountID is the account that account-scoped resources are
+	// managed under, unless a resource overrides it.
+	AccountID *string `json:"accountId,omitempty"`
+
+	// Endpoint is how the Cloudflare API is reached. The public API is
+	// reached directly when it is nil.
+	Endpoint *Endpoint `json:"-"`
+
+	// Session caches clients and lookups made with this configuration
+	// across reconciles. It is nil unless the configuration was read from
+	// a ProviderConfig.
+	Session *Session `json:"-"`
 }
 
 // NewClient creates a new Cloudflare Client with provided Credentials.
+// Requests made by the client wait for the budget of its credential,
+// which is shared by every client using the same credential. A client is
+// made once per Session and HTTP client, and reused thereafter.
 func NewClient(c Config, hc *http.Client) (*cloudflare.API, error) {
-	if hc == nil {
-		hc = http.DefaultClient
+	if c.Session == nil {
+		return newClient(c, hc)
+	}
+	return c.Session.api(hc, func() (*cloudflare.API, error) {
+		return newClient(c, hc)
+	})
+}
+
+func newClient(c Config, hc *http.Client) (*cloudflare.API, error) {
+	eopts, hc, err := c.Endpoint.options(throttled(hc))
+	if err != nil {
+		return nil, err
 	}
-	ohc := cloudflare.HTTPClient(hc)
+	opts := append([]cloudflare.Option{
+		cloudflare.HTTPClient(hc),
+		// Requests are throttled by the transport, across clients, rather
+		// than by each client alone.
+		cloudflare.UsingRateLimit(float64(rate.Inf)),
+	}, eopts...)
 
 	if c.AuthByAPIKey != nil && c.Key != nil &&
 		c.Email != nil {
-		return cloudflare.New(*c.Key, *c.Email, ohc)
+		return cloudflare.New(*c.Key, *c.Email, opts...)
 	}
 	if c.AuthByAPIToken != nil && c.Token != nil {
-		return cloudflare.NewWithAPIToken(*c.Token, ohc)
+		return cloudflare.NewWithAPIToken(*c.Token, opts...)
 	}
 	return nil, errors.New(errNoAuth)
 }
 
+// throttled returns an *http.Client like the supplied one whose requests
+// are throttled, unless they already are. Requests of an HTTP client
+// without a transport of its own are sent with transport.Base.
+func throttled(hc *http.Client) *http.Client {
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	if _, ok := hc.Transport.(*throttle.Transport); ok {
+		return hc
+	}
+	base := hc.Transport
+	if base == nil {
+		base = transport.Base
+	}
+	thc := *hc
+	thc.Transport = throttle.NewTransport("", base)
+	return &thc
+}
+
 // GetConfig returns a valid Cloudflare API configuration
 func GetConfig(ctx context.Context, c client.Client, mg resource.Managed) (*Config, error) {
-	// Get provider config reference from the managed resource's ResourceSpec
-	var pcRef *xpv1.Reference
-
-	// Type assert to extract the ProviderConfigReference from the managed resource
-	switch mr := mg.(type) {
-	case interface{ GetProviderConfigReference() *xpv1.Reference }:
-		pcRef = mr.GetProviderConfigReference()
-	default:
+	if providerConfigReference(mg) == nil {
 		return nil, errors.New(errPCRef)
 	}
-
-	if pcRef != nil {
-		return UseProviderConfig(ctx, c, mg)
-	}
-	return nil, errors.New(errPCRef)
+	return UseProviderConfig(ctx, c, mg)
 }
 
-// UseProviderConfig produces a config that can be used to authenticate with Cloudflare.
+// UseProviderConfig produces a config that can be used to authenticate with
+// Cloudflare. A reference of kind ProviderConfig is resolved in the
+// namespace of the managed resource, while one of kind ClusterProviderConfig
+// is resolved by name alone. A reference without a kind, as written before
+// kinds were introduced, is treated as a ProviderConfig.
 func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (*Config, error) {
-	// Get provider config reference from the managed resource's ResourceSpec
-	var pcRef *xpv1.Reference
-
-	// Type assert to extract the ProviderConfigReference from the managed resource
-	switch mr := mg.(type) {
-	case interface{ GetProviderConfigReference() *xpv1.Reference }:
-		pcRef = mr.GetProviderConfigReference()
-	default:
+	ref := providerConfigReference(mg)
+	if ref == nil {
 		return nil, errors.New(errPCRef)
 	}
 
-	if pcRef == nil {
-		return nil, errors.New(errPCRef)
+	pc, err := getProviderConfig(ctx, c, mg.GetNamespace(), ref)
+	if err != nil {
+		return nil, err
 	}
 
-	pc := &v1beta1.ProviderConfig{}
-	if err := c.Get(ctx, types.NamespacedName{Name: pcRef.Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPC)
+	if err := NewUsageTracker(c).Track(ctx, mg); err != nil {
+		return nil, err
 	}
 
-	// Use no-op tracker for v2.0.0 compatibility
-	t := resource.TrackerFn(func(ctx context.Context, mg resource.Managed) error { return nil })
-	if err := t.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsage)
+	_, status := configOf(pc)
+	if err := checkCredential(mg, ref, status); err != nil {
+		return nil, err
 	}
 
-	cd := pc.Spec.Credentials
+	return newConfig(ctx, c, pc)
+}
+
+// newConfig produces a config from the credential and endpoint of the
+// supplied ProviderConfig or ClusterProviderConfig.
+func newConfig(ctx context.Context, c client.Client, pc resource.ProviderConfig) (*Config, error) {
+	spec, _ := configOf(pc)
+	if spec == nil {
+		return nil, errors.Errorf(errFmtPCKind, pc.GetObjectKind().GroupVersionKind().Kind)
+	}
+
+	cd := spec.Credentials
 	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c, cd.CommonCredentialSelectors)
 	if err != nil {
 		return nil, errors.Wrap(err, errGetPC)
 	}
-	return UseProviderSecret(ctx, data)
+	config, err := UseProviderSecret(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	if spec.AccountID != nil {
+		config.AccountID = spec.AccountID
+	}
+	if config.Endpoint, err = getEndpoint(ctx, c, spec); err != nil {
+		return nil, err
+	}
+	if uid := pc.GetUID(); uid != "" {
+		config.Session = DefaultPool.Session(uid, sessionKey(data, config.Endpoint))
+	}
+	return config, nil
+}
+
+// getProviderConfig returns the ProviderConfig or ClusterProviderConfig
+// that ref points to. Namespace is that of the managed resource making the
+// reference.
+func getProviderConfig(ctx context.Context, c client.Reader, namespace string, ref *xpv1.ProviderConfigReference) (resource.ProviderConfig, error) {
+	switch providerConfigKind(ref) {
+	case v1beta1.ProviderConfigKind:
+		pc := &v1beta1.ProviderConfig{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, pc); err != nil {
+			return nil, errors.Wrap(err, errGetPC)
+		}
+		return pc, nil
+	case v1beta1.ClusterProviderConfigKind:
+		cpc := &v1beta1.ClusterProviderConfig{}
+		if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, cpc); err != nil {
+			return nil, errors.Wrap(err, errGetCPC)
+		}
+		return cpc, nil
+	default:
+		return nil, errors.Errorf(errFmtPCKind, ref.Kind)
+	}
+}
+
+// configOf returns the spec and status of the supplied ProviderConfig or
+// ClusterProviderConfig, or nil if it is neither.
+func configOf(pc resource.ProviderConfig) (*v1beta1.ProviderConfigSpec, *v1beta1.ProviderConfigStatus) {
+	switch o := pc.(type) {
+	case *v1beta1.ProviderConfig:
+		return &o.Spec, &o.Status
+	case *v1beta1.ClusterProviderConfig:
+		return &o.Spec, &o.Status
+	}
+	return nil, nil
+}
+
+// checkCredential returns an error if Cloudflare rejected the credential
+// of the config that ref points to, or if the credential is known not to
+// allow the permission groups the kind of the managed resource needs. This
+// stops a managed resource before it makes any request that would fail.
+func checkCredential(mg resource.Managed, ref *xpv1.ProviderConfigReference, status *v1beta1.ProviderConfigStatus) error {
+	kind := providerConfigKind(ref)
+	if c := status.GetCondition(v1beta1.TypeCredentialsVerified); c.Status == corev1.ConditionFalse {
+		return errors.Errorf(errFmtRejected, kind, ref.Name, c.Message)
+	}
+	if status.Credential == nil || status.Credential.PermissionGroups == nil {
+		return nil
+	}
+	gk := mg.GetObjectKind().GroupVersionKind().GroupKind()
+	if missing := MissingPermissions(gk, status.Credential.PermissionGroups); len(missing) > 0 {
+		return errors.Errorf(errFmtMissingPerms, kind, ref.Name, gk.Kind, strings.Join(missing, ", "))
+	}
+	return nil
 }
 
 // UseProviderSecret extracts a JSON blob containing configuration
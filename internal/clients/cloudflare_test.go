@@ -40,25 +40,105 @@ import (
 	rtfake "github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 
+	dnsv1beta1 "github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
 	v1beta1 "github.com/rossigee/provider-cloudflare/apis/v1beta1"
 )
 
 // testProviderConfigReferencer is a test helper that implements ProviderConfigReferencer
 type testProviderConfigReferencer struct {
 	*rtfake.Managed
-	Ref *xpv1.Reference
+	Ref *xpv1.ProviderConfigReference
 }
 
-func (t *testProviderConfigReferencer) GetProviderConfigReference() *xpv1.Reference {
+func (t *testProviderConfigReferencer) GetProviderConfigReference() *xpv1.ProviderConfigReference {
 	return t.Ref
 }
 
-func (t *testProviderConfigReferencer) SetProviderConfigReference(ref *xpv1.Reference) {
+func (t *testProviderConfigReferencer) SetProviderConfigReference(ref *xpv1.ProviderConfigReference) {
 	t.Ref = ref
 }
 
+// referencer returns a managed resource in the supplied namespace that
+// references the supplied kind of config named default.
+func referencer(namespace, kind string) *testProviderConfigReferencer {
+	mg := &testProviderConfigReferencer{
+		Managed: &rtfake.Managed{},
+		Ref:     &xpv1.ProviderConfigReference{Kind: kind, Name: "default"},
+	}
+	mg.SetNamespace(namespace)
+	return mg
+}
+
+var errBoomGet = errors.New("unexpected get")
+
+// configGetFn returns a MockGetFn that serves a config of the supplied
+// type, but only under the supplied key, along with its credentials.
+func configGetFn(t *testing.T, key client.ObjectKey, kind string) test.MockGetFn {
+	t.Helper()
+	spec := v1beta1.ProviderConfigSpec{
+		Credentials: v1beta1.ProviderCredentials{
+			Source: xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+				SecretRef: &xpv1.SecretKeySelector{Key: "creds"},
+			},
+		},
+	}
+	return func(_ context.Context, k client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *v1beta1.ProviderConfig:
+			if kind != v1beta1.ProviderConfigKind || k != key {
+				t.Errorf("Get(...): unexpected ProviderConfig %s", k)
+				return errBoomGet
+			}
+			o.Spec = spec
+		case *v1beta1.ClusterProviderConfig:
+			if kind != v1beta1.ClusterProviderConfigKind || k != key {
+				t.Errorf("Get(...): unexpected ClusterProviderConfig %s", k)
+				return errBoomGet
+			}
+			o.Spec = spec
+		case *corev1.Secret:
+			o.Data = map[string][]byte{"creds": []byte(`{"token":"foo"}`)}
+		}
+		return nil
+	}
+}
+
+// withStatus returns a MockGetFn that serves configs as fn does, but with
+// the supplied status.
+func withStatus(fn test.MockGetFn, status v1beta1.ProviderConfigStatus) test.MockGetFn {
+	return func(ctx context.Context, k client.ObjectKey, obj client.Object) error {
+		err := fn(ctx, k, obj)
+		switch o := obj.(type) {
+		case *v1beta1.ProviderConfig:
+			o.Status = status
+		case *v1beta1.ClusterProviderConfig:
+			o.Status = status
+		}
+		return err
+	}
+}
+
+// dnsRecord returns a Record in the supplied namespace that references the
+// supplied kind of config named default.
+func dnsRecord(namespace, kind string) *dnsv1beta1.Record {
+	r := &dnsv1beta1.Record{}
+	r.SetGroupVersionKind(dnsv1beta1.RecordGroupVersionKind)
+	r.SetNamespace(namespace)
+	r.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: kind, Name: "default"})
+	return r
+}
+
 func TestGetConfig(t *testing.T) {
 	errBoom := errors.New("boom")
+	token := &Config{AuthByAPIToken: &AuthByAPIToken{Token: ptr.To("foo")}}
+	rejected := v1beta1.ProviderConfigStatus{}
+	rejected.SetConditions(v1beta1.CredentialsRejected(errors.New("API token is expired")))
+	granted := func(groups ...string) v1beta1.ProviderConfigStatus {
+		s := v1beta1.ProviderConfigStatus{Credential: &v1beta1.CredentialStatus{PermissionGroups: groups}}
+		s.SetConditions(v1beta1.CredentialsVerified())
+		return s
+	}
 
 	mc := &test.MockClient{
 		MockGet: test.NewMockGetFn(errBoom),
@@ -116,7 +196,7 @@ func TestGetConfig(t *testing.T) {
 			args: args{
 				mg: &testProviderConfigReferencer{
 					Managed: &rtfake.Managed{},
-					Ref:     &xpv1.Reference{},
+					Ref:     &xpv1.ProviderConfigReference{Kind: v1beta1.ProviderConfigKind},
 				},
 			},
 			want: want{
@@ -142,13 +222,120 @@ func TestGetConfig(t *testing.T) {
 			args: args{
 				mg: &testProviderConfigReferencer{
 					Managed: &rtfake.Managed{},
-					Ref:     &xpv1.Reference{},
+					Ref:     &xpv1.ProviderConfigReference{Kind: v1beta1.ProviderConfigKind},
 				},
 			},
 			want: want{
 				err: errors.Wrap(errGetCredentialsSecret, errGetPC),
 			},
 		},
+		"ProviderConfig": {
+			reason: "A ProviderConfig should be looked up in the namespace of the managed resource",
+			fields: fields{
+				client: &test.MockClient{
+					MockGet: configGetFn(t, client.ObjectKey{Namespace: "team-a", Name: "default"}, v1beta1.ProviderConfigKind),
+				},
+			},
+			args: args{
+				mg: referencer("team-a", v1beta1.ProviderConfigKind),
+			},
+			want: want{
+				o: token,
+			},
+		},
+		"ProviderConfigWithoutKind": {
+			reason: "A reference without a kind should be looked up as a ProviderConfig in the namespace of the managed resource",
+			fields: fields{
+				client: &test.MockClient{
+					MockGet: configGetFn(t, client.ObjectKey{Namespace: "team-a", Name: "default"}, v1beta1.ProviderConfigKind),
+				},
+			},
+			args: args{
+				mg: referencer("team-a", ""),
+			},
+			want: want{
+				o: token,
+			},
+		},
+		"ClusterProviderConfig": {
+			reason: "A ClusterProviderConfig should be looked up by name alone, whatever the namespace of the managed resource",
+			fields: fields{
+				client: &test.MockClient{
+					MockGet: configGetFn(t, client.ObjectKey{Name: "default"}, v1beta1.ClusterProviderConfigKind),
+				},
+			},
+			args: args{
+				mg: referencer("team-a", v1beta1.ClusterProviderConfigKind),
+			},
+			want: want{
+				o: token,
+			},
+		},
+		"ErrGetClusterProviderConfig": {
+			reason: "An error should be returned if we can't get our ClusterProviderConfig",
+			fields: fields{
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				mg: referencer("team-a", v1beta1.ClusterProviderConfigKind),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetCPC),
+			},
+		},
+		"ErrUnsupportedKind": {
+			reason: "An error should be returned if the providerConfigRef is of an unknown kind",
+			args: args{
+				mg: referencer("team-a", "SomeOtherConfig"),
+			},
+			want: want{
+				err: errors.Errorf(errFmtPCKind, "SomeOtherConfig"),
+			},
+		},
+		"ErrCredentialRejected": {
+			reason: "An error should be returned if Cloudflare rejected the credential of our ProviderConfig",
+			fields: fields{
+				client: &test.MockClient{
+					MockGet: withStatus(configGetFn(t, client.ObjectKey{Namespace: "team-a", Name: "default"}, v1beta1.ProviderConfigKind), rejected),
+				},
+			},
+			args: args{
+				mg: dnsRecord("team-a", v1beta1.ProviderConfigKind),
+			},
+			want: want{
+				err: errors.Errorf(errFmtRejected, v1beta1.ProviderConfigKind, "default", "API token is expired"),
+			},
+		},
+		"ErrMissingPermissions": {
+			reason: "An error should be returned if the credential of our ClusterProviderConfig does not allow the permission groups a Record needs",
+			fields: fields{
+				client: &test.MockClient{
+					MockGet: withStatus(configGetFn(t, client.ObjectKey{Name: "default"}, v1beta1.ClusterProviderConfigKind), granted("DNS Read", "Zone Read")),
+				},
+			},
+			args: args{
+				mg: dnsRecord("team-a", v1beta1.ClusterProviderConfigKind),
+			},
+			want: want{
+				err: errors.Errorf(errFmtMissingPerms, v1beta1.ClusterProviderConfigKind, "default", dnsv1beta1.RecordKind, "DNS Write"),
+			},
+		},
+		"PermissionsGranted": {
+			reason: "A Record should get its configuration if the credential allows the permission groups it needs",
+			fields: fields{
+				client: &test.MockClient{
+					MockGet: withStatus(configGetFn(t, client.ObjectKey{Name: "default"}, v1beta1.ClusterProviderConfigKind), granted("DNS Write")),
+				},
+			},
+			args: args{
+				mg: dnsRecord("team-a", v1beta1.ClusterProviderConfigKind),
+			},
+			want: want{
+				o: token,
+			},
+		},
 	}
 
 	for name, tc := range cases {
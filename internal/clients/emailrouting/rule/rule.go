@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/rossigee/provider-cloudflare/apis/emailrouting/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // EmailRoutingRuleAPI defines the interface for Email Routing Rule operations
@@ -211,7 +212,7 @@ func (c *RuleClient) Delete(ctx context.Context, zoneID, ruleTag string) error {
 	rc := cloudflare.ZoneIdentifier(zoneID)
 
 	_, err := c.client.DeleteEmailRoutingRule(ctx, rc, ruleTag)
-	if err != nil && !IsRuleNotFound(err) {
+	if err != nil && !cferrors.IsNotFound(err) {
 		return errors.Wrap(err, errDeleteRule)
 	}
 
@@ -279,13 +280,3 @@ func (c *RuleClient) IsUpToDate(ctx context.Context, params v1beta1.RuleParamete
 
 	return true, nil
 }
-
-// IsRuleNotFound returns true if the error indicates the rule was not found
-func IsRuleNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return err.Error() == "rule not found" ||
-		err.Error() == "404" ||
-		err.Error() == "Not found"
-}
\ No newline at end of file
@@ -606,7 +606,7 @@ func TestDelete(t *testing.T) {
 			fields: fields{
 				client: &MockEmailRoutingRuleAPI{
 					MockDeleteEmailRoutingRule: func(ctx context.Context, rc *cloudflare.ResourceContainer, ruleTag string) (cloudflare.EmailRoutingRule, error) {
-						return cloudflare.EmailRoutingRule{}, errors.New("rule not found")
+						return cloudflare.EmailRoutingRule{}, &cloudflare.NotFoundError{}
 					},
 				},
 			},
@@ -1039,74 +1039,3 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
-
-func TestIsRuleNotFound(t *testing.T) {
-	type args struct {
-		err error
-	}
-
-	type want struct {
-		isNotFound bool
-	}
-
-	cases := map[string]struct {
-		reason string
-		args   args
-		want   want
-	}{
-		"NilError": {
-			reason: "IsRuleNotFound should return false for nil error",
-			args: args{
-				err: nil,
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-		"RuleNotFoundError": {
-			reason: "IsRuleNotFound should return true for 'rule not found' error",
-			args: args{
-				err: errors.New("rule not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"NotFoundError404": {
-			reason: "IsRuleNotFound should return true for '404' error",
-			args: args{
-				err: errors.New("404"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"NotFoundError": {
-			reason: "IsRuleNotFound should return true for 'Not found' error",
-			args: args{
-				err: errors.New("Not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"OtherError": {
-			reason: "IsRuleNotFound should return false for other errors",
-			args: args{
-				err: errors.New("some other error"),
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got := IsRuleNotFound(tc.args.err)
-			if diff := cmp.Diff(tc.want.isNotFound, got); diff != "" {
-				t.Errorf("\n%s\nIsRuleNotFound(...): -want, +got:\n%s\n", tc.reason, diff)
-			}
-		})
-	}
-}
\ No newline at end of file
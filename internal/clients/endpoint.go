@@ -0,0 +1,174 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/transport"
+)
+
+const (
+	errGetCABundleSecret    = "cannot get CA bundle Secret"
+	errGetCABundleConfigMap = "cannot get CA bundle ConfigMap"
+	errFmtNoCABundleKey     = "CA bundle key %q not found"
+	errCABundleSource       = "exactly one of secretRef and configMapRef must be set for a CA bundle"
+	errNewTransport         = "cannot create HTTP transport"
+)
+
+// An Endpoint is how the Cloudflare API is reached. Its zero value reaches
+// the public API directly.
+type Endpoint struct {
+	// BaseURL of the API, if not the public one.
+	BaseURL string `json:"baseURL,omitempty"`
+
+	// ProxyURL of the HTTP proxy requests are sent through, if not the
+	// one set by the environment.
+	ProxyURL string `json:"proxyURL,omitempty"`
+
+	// CABundle holds PEM encoded certificate authorities that are trusted
+	// in addition to those of the system.
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// Timeout of each request, if any.
+	Timeout time.Duration `json:"timeout,omitempty"`
+
+	// UserAgentSuffix is appended to the User-Agent of requests.
+	UserAgentSuffix string `json:"userAgentSuffix,omitempty"`
+}
+
+// options returns the client options that reach the endpoint, and an HTTP
+// client like the supplied one that does. The supplied client is returned
+// if it already does.
+func (e *Endpoint) options(hc *http.Client) ([]cloudflare.Option, *http.Client, error) {
+	if e == nil {
+		return nil, hc, nil
+	}
+
+	var opts []cloudflare.Option
+	if e.BaseURL != "" {
+		opts = append(opts, cloudflare.BaseURL(strings.TrimSuffix(e.BaseURL, "/")))
+	}
+	if e.UserAgentSuffix != "" {
+		opts = append(opts, func(api *cloudflare.API) error {
+			api.UserAgent += " " + e.UserAgentSuffix
+			return nil
+		})
+	}
+
+	if e.Timeout == 0 && e.ProxyURL == "" && len(e.CABundle) == 0 {
+		return opts, hc, nil
+	}
+	ehc := *hc
+	ehc.Timeout = e.Timeout
+	if e.ProxyURL != "" || len(e.CABundle) > 0 {
+		t, err := transport.New(e.ProxyURL, e.CABundle)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errNewTransport)
+		}
+		ehc.Transport = transport.With(hc.Transport, t)
+	}
+	return opts, &ehc, nil
+}
+
+// getEndpoint returns the Endpoint configured by the supplied spec, or nil
+// if the public API should be reached directly.
+func getEndpoint(ctx context.Context, c client.Reader, spec *v1beta1.ProviderConfigSpec) (*Endpoint, error) {
+	if spec.BaseURL == nil && spec.ProxyURL == nil && spec.CABundle == nil &&
+		spec.Timeout == nil && spec.UserAgentSuffix == nil {
+		return nil, nil
+	}
+
+	e := &Endpoint{}
+	if spec.BaseURL != nil {
+		e.BaseURL = *spec.BaseURL
+	}
+	if spec.ProxyURL != nil {
+		e.ProxyURL = *spec.ProxyURL
+	}
+	if spec.Timeout != nil {
+		e.Timeout = spec.Timeout.Duration
+	}
+	if spec.UserAgentSuffix != nil {
+		e.UserAgentSuffix = *spec.UserAgentSuffix
+	}
+	if spec.CABundle != nil {
+		b, err := getCABundle(ctx, c, spec.CABundle)
+		if err != nil {
+			return nil, err
+		}
+		e.CABundle = b
+	}
+	return e, nil
+}
+
+// getCABundle returns the CA bundle held by the selected Secret or
+// ConfigMap key.
+func getCABundle(ctx context.Context, c client.Reader, src *v1beta1.CABundleSource) ([]byte, error) {
+	switch {
+	case src.SecretRef != nil && src.ConfigMapRef == nil:
+		ref := src.SecretRef
+		s := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, errors.Wrap(err, errGetCABundleSecret)
+		}
+		b, ok := s.Data[ref.Key]
+		if !ok {
+			return nil, errors.Errorf(errFmtNoCABundleKey, ref.Key)
+		}
+		return b, nil
+	case src.ConfigMapRef != nil && src.SecretRef == nil:
+		ref := src.ConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return nil, errors.Wrap(err, errGetCABundleConfigMap)
+		}
+		if v, ok := cm.Data[ref.Key]; ok {
+			return []byte(v), nil
+		}
+		if b, ok := cm.BinaryData[ref.Key]; ok {
+			return b, nil
+		}
+		return nil, errors.Errorf(errFmtNoCABundleKey, ref.Key)
+	}
+	return nil, errors.New(errCABundleSource)
+}
+
+// sessionKey identifies what clients of a Session are made with, so that
+// the Session is replaced when either the credential or the endpoint
+// changes.
+func sessionKey(credential []byte, e *Endpoint) []byte {
+	if e == nil {
+		return credential
+	}
+	// An Endpoint always encodes.
+	b, _ := json.Marshal(e)
+	sum := sha256.Sum256(b)
+	return append(append([]byte{}, credential...), sum[:]...)
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
+)
+
+func TestGetEndpoint(t *testing.T) {
+	errBoom := errors.New("boom")
+	bundle := []byte("-----BEGIN CERTIFICATE-----")
+
+	fromSecret := &v1beta1.CABundleSource{
+		SecretRef: &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "ca"},
+			Key:             "ca.crt",
+		},
+	}
+	fromConfigMap := &v1beta1.CABundleSource{
+		ConfigMapRef: &v1beta1.ConfigMapKeySelector{Namespace: "crossplane-system", Name: "ca", Key: "ca.crt"},
+	}
+
+	// get serves the CA bundle from a Secret or ConfigMap named ca.
+	get := func(key string) test.MockGetFn {
+		return func(_ context.Context, k client.ObjectKey, obj client.Object) error {
+			if k != (client.ObjectKey{Namespace: "crossplane-system", Name: "ca"}) {
+				return errBoom
+			}
+			switch o := obj.(type) {
+			case *corev1.Secret:
+				o.Data = map[string][]byte{key: bundle}
+			case *corev1.ConfigMap:
+				o.Data = map[string]string{key: string(bundle)}
+			}
+			return nil
+		}
+	}
+
+	type want struct {
+		e   *Endpoint
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client client.Reader
+		spec   v1beta1.ProviderConfigSpec
+		want   want
+	}{
+		"Default": {
+			reason: "No Endpoint should be returned if the public API should be reached directly",
+		},
+		"Configured": {
+			reason: "Every configured field should be part of the Endpoint",
+			client: &test.MockClient{MockGet: get("ca.crt")},
+			spec: v1beta1.ProviderConfigSpec{
+				BaseURL:         ptr.To("http://localhost:8080/client/v4"),
+				ProxyURL:        ptr.To("http://proxy:3128"),
+				CABundle:        fromSecret,
+				Timeout:         &metav1.Duration{Duration: 30 * time.Second},
+				UserAgentSuffix: ptr.To("team-a"),
+			},
+			want: want{
+				e: &Endpoint{
+					BaseURL:         "http://localhost:8080/client/v4",
+					ProxyURL:        "http://proxy:3128",
+					CABundle:        bundle,
+					Timeout:         30 * time.Second,
+					UserAgentSuffix: "team-a",
+				},
+			},
+		},
+		"CABundleFromConfigMap": {
+			reason: "A CA bundle should be read from a ConfigMap",
+			client: &test.MockClient{MockGet: get("ca.crt")},
+			spec:   v1beta1.ProviderConfigSpec{CABundle: fromConfigMap},
+			want: want{
+				e: &Endpoint{CABundle: bundle},
+			},
+		},
+		"ErrGetCABundleSecret": {
+			reason: "An error should be returned if the CA bundle Secret cannot be read",
+			client: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			spec:   v1beta1.ProviderConfigSpec{CABundle: fromSecret},
+			want: want{
+				err: errors.Wrap(errBoom, errGetCABundleSecret),
+			},
+		},
+		"ErrGetCABundleConfigMap": {
+			reason: "An error should be returned if the CA bundle ConfigMap cannot be read",
+			client: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			spec:   v1beta1.ProviderConfigSpec{CABundle: fromConfigMap},
+			want: want{
+				err: errors.Wrap(errBoom, errGetCABundleConfigMap),
+			},
+		},
+		"ErrNoCABundleKey": {
+			reason: "An error should be returned if the CA bundle key does not exist",
+			client: &test.MockClient{MockGet: get("other.crt")},
+			spec:   v1beta1.ProviderConfigSpec{CABundle: fromConfigMap},
+			want: want{
+				err: errors.Errorf(errFmtNoCABundleKey, "ca.crt"),
+			},
+		},
+		"ErrCABundleSource": {
+			reason: "An error should be returned unless exactly one source of the CA bundle is set",
+			spec: v1beta1.ProviderConfigSpec{CABundle: &v1beta1.CABundleSource{
+				SecretRef:    fromSecret.SecretRef,
+				ConfigMapRef: fromConfigMap.ConfigMapRef,
+			}},
+			want: want{
+				err: errors.New(errCABundleSource),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := getEndpoint(context.Background(), tc.client, &tc.spec)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ngetEndpoint(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\ngetEndpoint(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNewClientEndpoint(t *testing.T) {
+	var userAgent string
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{"id":"t","status":"active"}}`))
+	}))
+	defer srv.Close()
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	cfg := Config{
+		AuthByAPIToken: &AuthByAPIToken{Token: ptr.To("beef")},
+		Endpoint: &Endpoint{
+			BaseURL:         srv.URL + "/client/v4/",
+			CABundle:        ca,
+			Timeout:         10 * time.Second,
+			UserAgentSuffix: "team-a",
+		},
+	}
+
+	api, err := NewClient(cfg, nil)
+	if err != nil {
+		t.Fatalf("NewClient(...): %v", err)
+	}
+	if diff := cmp.Diff(srv.URL+"/client/v4", api.BaseURL); diff != "" {
+		t.Errorf("NewClient(...): -want base URL, +got base URL:\n%s", diff)
+	}
+
+	// The test server is only trusted by way of the CA bundle.
+	v, err := api.VerifyAPIToken(context.Background())
+	if err != nil {
+		t.Fatalf("VerifyAPIToken(...): %v", err)
+	}
+	if diff := cmp.Diff("active", v.Status); diff != "" {
+		t.Errorf("VerifyAPIToken(...): -want status, +got status:\n%s", diff)
+	}
+	if !strings.HasSuffix(userAgent, " team-a") {
+		t.Errorf("VerifyAPIToken(...): want User-Agent ending in %q, got %q", " team-a", userAgent)
+	}
+}
+
+func TestSessionKeyEndpoint(t *testing.T) {
+	p := NewPool()
+	cred := []byte(`{"token":"foo"}`)
+	public := p.Session("uid", sessionKey(cred, nil))
+
+	if p.Session("uid", sessionKey(cred, nil)) != public {
+		t.Errorf("Session(...): want the Session reused while the credential and endpoint are unchanged")
+	}
+	local := p.Session("uid", sessionKey(cred, &Endpoint{BaseURL: "http://localhost:8080"}))
+	if local == public {
+		t.Errorf("Session(...): want the Session replaced when the endpoint changes")
+	}
+	if p.Session("uid", sessionKey(cred, &Endpoint{BaseURL: "http://localhost:8080"})) != local {
+		t.Errorf("Session(...): want the Session reused while the credential and endpoint are unchanged")
+	}
+}
@@ -19,37 +19,17 @@ package filter
 import (
 	"context"
 	"net/http"
-	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/client-go/util/workqueue"
-	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
-
-	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/firewall/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
-	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 const (
-	errNotFilter      = "managed resource is not a Filter custom resource"
-	errClientConfig   = "error getting client config"
-	errFilterLookup   = "cannot lookup filter"
-	errFilterCreation = "cannot create filter"
-	errFilterUpdate   = "cannot update filter"
-	errFilterDeletion = "cannot delete filter"
-	errNoZone         = "no zone found"
 	errFilterNotFound = "Filter not found"
-	maxConcurrency    = 5
 )
 
 // Client is a Cloudflare API client that implements methods for working
@@ -84,7 +64,7 @@ func (c *clientImpl) Filter(ctx context.Context, zoneID, filterID string) (cloud
 	}
 
 	if filter.ID == "" {
-		return cloudflare.Filter{}, errors.New(errFilterNotFound)
+		return cloudflare.Filter{}, cferrors.NewNotFound(errFilterNotFound)
 	}
 
 	return filter, nil
@@ -132,19 +112,13 @@ func (c *clientImpl) DeleteFilter(ctx context.Context, zoneID, filterID string)
 	return err
 }
 
-// IsFilterNotFound returns true if the error indicates the filter was not found
-func IsFilterNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return err.Error() == errFilterNotFound ||
-		err.Error() == "404" ||
-		err.Error() == "Not found"
-}
-
 // GenerateObservation creates observation data from a Filter
 func GenerateObservation(filter cloudflare.Filter) v1beta1.FilterObservation {
-	return v1beta1.FilterObservation{}
+	return v1beta1.FilterObservation{
+		ID:     filter.ID,
+		Paused: filter.Paused,
+		Ref:    filter.Ref,
+	}
 }
 
 // LateInitialize initializes FilterParameters based on the remote resource
@@ -230,179 +204,3 @@ func UpdateFilter(ctx context.Context, client Client, filterID string, params *v
 	err := client.UpdateFilter(ctx, *params.Zone, filterID, filter)
 	return err
 }
-
-// Setup adds a controller that reconciles Filter managed resources.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	name := managed.ControllerName(v1beta1.FilterGroupKind)
-
-	o := controller.Options{
-		RateLimiter:             nil, // Use default rate limiter
-		MaxConcurrentReconciles: maxConcurrency,
-	}
-
-	hc := metrics.NewInstrumentedHTTPClient(name)
-	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1beta1.FilterGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
-			kube: mgr.GetClient(),
-			newCloudflareClientFn: func(cfg clients.Config) (Client, error) {
-				return NewClient(cfg, hc)
-			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
-		// Do not initialize external-name field.
-		managed.WithInitializers(),
-	)
-
-	return ctrl.NewControllerManagedBy(mgr).
-		Named(name).
-		WithOptions(o).
-		For(&v1beta1.Filter{}).
-		Complete(r)
-}
-
-// A connector is expected to produce an ExternalClient when its Connect method
-// is called.
-type connector struct {
-	kube                  client.Client
-	newCloudflareClientFn func(cfg clients.Config) (Client, error)
-}
-
-// Connect produces a valid configuration for a Cloudflare API
-// instance, and returns it as an external client.
-func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	_, ok := mg.(*v1beta1.Filter)
-	if !ok {
-		return nil, errors.New(errNotFilter)
-	}
-
-	// Get client configuration
-	config, err := clients.GetConfig(ctx, c.kube, mg)
-	if err != nil {
-		return nil, errors.Wrap(err, errClientConfig)
-	}
-
-	client, err := c.newCloudflareClientFn(*config)
-	if err != nil {
-		return nil, err
-	}
-
-	return &external{client: client}, nil
-}
-
-// An ExternalClient observes, then either creates, updates, or deletes an
-// external resource to ensure it reflects the managed resource's desired state.
-type external struct {
-	client Client
-}
-
-func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*v1beta1.Filter)
-	if !ok {
-		return managed.ExternalObservation{}, errors.New(errNotFilter)
-	}
-
-	// Filter does not exist if we dont have an ID stored in external-name
-	fid := meta.GetExternalName(cr)
-	if fid == "" {
-		return managed.ExternalObservation{ResourceExists: false}, nil
-	}
-
-	if cr.Spec.ForProvider.Zone == nil {
-		return managed.ExternalObservation{}, errors.New(errNoZone)
-	}
-
-	f, err := e.client.Filter(ctx, *cr.Spec.ForProvider.Zone, fid)
-
-	if err != nil {
-		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(IsFilterNotFound, err), errFilterLookup)
-	}
-
-	cr.Status.AtProvider = GenerateObservation(f)
-
-	cr.Status.SetConditions(rtv1.Available())
-
-	return managed.ExternalObservation{
-		ResourceExists:          true,
-		ResourceLateInitialized: LateInitialize(&cr.Spec.ForProvider, f),
-		ResourceUpToDate:        UpToDate(&cr.Spec.ForProvider, f),
-	}, nil
-}
-
-func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*v1beta1.Filter)
-	if !ok {
-		return managed.ExternalCreation{}, errors.New(errNotFilter)
-	}
-
-	if cr.Spec.ForProvider.Zone == nil {
-		return managed.ExternalCreation{}, errors.New(errNoZone)
-	}
-
-	nr, err := CreateFilter(ctx, e.client, &cr.Spec.ForProvider)
-
-	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errFilterCreation)
-	}
-
-	cr.Status.AtProvider = GenerateObservation(*nr)
-
-	// Update the external name with the ID of the new Rule
-	meta.SetExternalName(cr, nr.ID)
-
-	return managed.ExternalCreation{}, nil
-}
-
-func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*v1beta1.Filter)
-	if !ok {
-		return managed.ExternalUpdate{}, errors.New(errNotFilter)
-	}
-
-	if cr.Spec.ForProvider.Zone == nil {
-		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errNoZone), errFilterUpdate)
-	}
-
-	rid := meta.GetExternalName(cr)
-
-	// Update should never be called on a nonexistent resource
-	if rid == "" {
-		return managed.ExternalUpdate{}, errors.New(errFilterUpdate)
-	}
-
-	return managed.ExternalUpdate{},
-		errors.Wrap(
-			UpdateFilter(ctx, e.client, meta.GetExternalName(cr), &cr.Spec.ForProvider),
-			errFilterUpdate,
-		)
-}
-
-func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1beta1.Filter)
-	if !ok {
-		return managed.ExternalDelete{}, errors.New(errNotFilter)
-	}
-
-	if cr.Spec.ForProvider.Zone == nil {
-		return managed.ExternalDelete{}, errors.Wrap(errors.New(errNoZone), errFilterDeletion)
-	}
-
-	rid := meta.GetExternalName(cr)
-
-	// Delete should never be called on a nonexistent resource
-	if rid == "" {
-		return managed.ExternalDelete{}, errors.New(errFilterDeletion)
-	}
-
-	return managed.ExternalDelete{}, errors.Wrap(
-		e.client.DeleteFilter(ctx, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)),
-		errFilterDeletion)
-}
-
-func (e *external) Disconnect(ctx context.Context) error {
-	// No persistent connections to clean up
-	return nil
-}
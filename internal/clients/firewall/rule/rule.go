@@ -19,41 +19,20 @@ package rule
 import (
 	"context"
 	"net/http"
-	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/client-go/util/workqueue"
-	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
-
-	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/rossigee/provider-cloudflare/apis/firewall/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
-	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 const (
-	errNotRule = "managed resource is not a Rule custom resource"
-
-	errClientConfig = "error getting client config"
-
-	errRuleLookup   = "cannot lookup firewall rule"
-	errRuleCreation = "cannot create firewall rule"
-	errRuleUpdate   = "cannot update firewall rule"
-	errRuleDeletion = "cannot delete firewall rule"
-	errNoZone       = "no zone found"
-	errNoFilter     = "no filter found"
 	errRuleNotFound = "Rule not found"
-
-	maxConcurrency = 5
 )
 
 // Client is a Cloudflare API client that implements methods for working
@@ -88,7 +67,7 @@ func (c *clientImpl) FirewallRule(ctx context.Context, zoneID, ruleID string) (c
 	}
 
 	if rule.ID == "" {
-		return cloudflare.FirewallRule{}, errors.New(errRuleNotFound)
+		return cloudflare.FirewallRule{}, cferrors.NewNotFound(errRuleNotFound)
 	}
 
 	return rule, nil
@@ -98,8 +77,12 @@ func (c *clientImpl) FirewallRule(ctx context.Context, zoneID, ruleID string) (c
 func (c *clientImpl) CreateFirewallRule(ctx context.Context, zoneID string, rule cloudflare.FirewallRule) (*cloudflare.FirewallRule, error) {
 	rc := cloudflare.ZoneIdentifier(zoneID)
 	params := []cloudflare.FirewallRuleCreateParams{{
-		Filter: cloudflare.Filter{ID: rule.Filter.ID},
-		Action: rule.Action,
+		Filter:      cloudflare.Filter{ID: rule.Filter.ID},
+		Action:      rule.Action,
+		Description: rule.Description,
+		Paused:      rule.Paused,
+		Priority:    rule.Priority,
+		Products:    rule.Products,
 	}}
 	
 	rules, err := c.cf.CreateFirewallRules(ctx, rc, params)
@@ -118,9 +101,13 @@ func (c *clientImpl) CreateFirewallRule(ctx context.Context, zoneID string, rule
 func (c *clientImpl) UpdateFirewallRule(ctx context.Context, zoneID, ruleID string, rule cloudflare.FirewallRule) error {
 	rc := cloudflare.ZoneIdentifier(zoneID)
 	params := cloudflare.FirewallRuleUpdateParams{
-		ID:     ruleID,
-		Filter: cloudflare.Filter{ID: rule.Filter.ID},
-		Action: rule.Action,
+		ID:          ruleID,
+		Filter:      cloudflare.Filter{ID: rule.Filter.ID},
+		Action:      rule.Action,
+		Description: rule.Description,
+		Paused:      rule.Paused,
+		Priority:    rule.Priority,
+		Products:    rule.Products,
 	}
 	
 	_, err := c.cf.UpdateFirewallRule(ctx, rc, params)
@@ -134,19 +121,55 @@ func (c *clientImpl) DeleteFirewallRule(ctx context.Context, zoneID, ruleID stri
 	return err
 }
 
-// IsRuleNotFound returns true if the error indicates the rule was not found
-func IsRuleNotFound(err error) bool {
-	if err == nil {
-		return false
+// GenerateObservation creates observation data from a FirewallRule
+func GenerateObservation(rule cloudflare.FirewallRule) v1beta1.RuleObservation {
+	o := v1beta1.RuleObservation{
+		ID:       rule.ID,
+		FilterID: rule.Filter.ID,
+		Priority: priority(rule.Priority),
+		Paused:   rule.Paused,
+	}
+
+	if !rule.CreatedOn.IsZero() {
+		o.CreatedOn = &metav1.Time{Time: rule.CreatedOn}
+	}
+
+	if !rule.ModifiedOn.IsZero() {
+		o.ModifiedOn = &metav1.Time{Time: rule.ModifiedOn}
 	}
-	return err.Error() == errRuleNotFound ||
-		err.Error() == "404" ||
-		err.Error() == "Not found"
+
+	return o
 }
 
-// GenerateObservation creates observation data from a FirewallRule
-func GenerateObservation(rule cloudflare.FirewallRule) v1beta1.RuleObservation {
-	return v1beta1.RuleObservation{}
+// priority converts the loosely typed priority returned by the Cloudflare
+// API into an int32. The API returns a JSON number when a priority is set
+// and null otherwise.
+func priority(p interface{}) *int32 {
+	switch v := p.(type) {
+	case float64:
+		i := int32(v)
+		return &i
+	case int:
+		i := int32(v)
+		return &i
+	case int32:
+		return &v
+	default:
+		return nil
+	}
+}
+
+// products converts the bypass products of a Rule into the identifiers
+// expected by the Cloudflare API.
+func products(bp []v1beta1.RuleBypassProduct) []string {
+	if len(bp) == 0 {
+		return nil
+	}
+	p := make([]string, len(bp))
+	for i, b := range bp {
+		p[i] = string(b)
+	}
+	return p
 }
 
 // LateInitialize initializes RuleParameters based on the remote resource
@@ -161,6 +184,18 @@ func LateInitialize(spec *v1beta1.RuleParameters, rule cloudflare.FirewallRule)
 		li = true
 	}
 
+	if spec.Description == nil && rule.Description != "" {
+		spec.Description = &rule.Description
+		li = true
+	}
+
+	if spec.Priority == nil {
+		if p := priority(rule.Priority); p != nil {
+			spec.Priority = p
+			li = true
+		}
+	}
+
 	return li
 }
 
@@ -182,6 +217,18 @@ func UpToDate(spec *v1beta1.RuleParameters, rule cloudflare.FirewallRule) bool {
 		return false
 	}
 
+	if spec.Description != nil && *spec.Description != rule.Description {
+		return false
+	}
+
+	if p := priority(rule.Priority); spec.Priority != nil && (p == nil || *p != *spec.Priority) {
+		return false
+	}
+
+	if !cmp.Equal(products(spec.BypassProducts), rule.Products, cmpopts.EquateEmpty()) {
+		return false
+	}
+
 	return true
 }
 
@@ -196,14 +243,23 @@ func CreateRule(ctx context.Context, client Client, params *v1beta1.RuleParamete
 	}
 
 	rule := cloudflare.FirewallRule{
-		Filter: cloudflare.Filter{ID: *params.Filter},
-		Action: params.Action,
+		Filter:   cloudflare.Filter{ID: *params.Filter},
+		Action:   params.Action,
+		Products: products(params.BypassProducts),
+	}
+
+	if params.Description != nil {
+		rule.Description = *params.Description
 	}
 
 	if params.Paused != nil {
 		rule.Paused = *params.Paused
 	}
 
+	if params.Priority != nil {
+		rule.Priority = *params.Priority
+	}
+
 	result, err := client.CreateFirewallRule(ctx, *params.Zone, rule)
 	if err != nil {
 		return nil, err
@@ -223,194 +279,23 @@ func UpdateRule(ctx context.Context, client Client, ruleID string, params *v1bet
 	}
 
 	rule := cloudflare.FirewallRule{
-		Filter: cloudflare.Filter{ID: *params.Filter},
-		Action: params.Action,
-	}
-
-	if params.Paused != nil {
-		rule.Paused = *params.Paused
+		Filter:   cloudflare.Filter{ID: *params.Filter},
+		Action:   params.Action,
+		Products: products(params.BypassProducts),
 	}
 
-	err := client.UpdateFirewallRule(ctx, *params.Zone, ruleID, rule)
-	return err
-}
-
-// Setup adds a controller that reconciles Rule managed resources.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	name := managed.ControllerName(v1beta1.RuleGroupKind)
-
-	o := controller.Options{
-		RateLimiter: nil, // Use default rate limiter
-		MaxConcurrentReconciles: maxConcurrency,
-	}
-
-	hc := metrics.NewInstrumentedHTTPClient(name)
-	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1beta1.RuleGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
-			kube: mgr.GetClient(),
-			newCloudflareClientFn: func(cfg clients.Config) (Client, error) {
-				return NewClient(cfg, hc)
-			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
-		// Do not initialize external-name field.
-		managed.WithInitializers(),
-	)
-
-	return ctrl.NewControllerManagedBy(mgr).
-		Named(name).
-		WithOptions(o).
-		For(&v1beta1.Rule{}).
-		Complete(r)
-}
-
-// A connector is expected to produce an ExternalClient when its Connect method
-// is called.
-type connector struct {
-	kube                  client.Client
-	newCloudflareClientFn func(cfg clients.Config) (Client, error)
-}
-
-// Connect produces a valid configuration for a Cloudflare API
-// instance, and returns it as an external client.
-func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	_, ok := mg.(*v1beta1.Rule)
-	if !ok {
-		return nil, errors.New(errNotRule)
-	}
-
-	// Get client configuration
-	config, err := clients.GetConfig(ctx, c.kube, mg)
-	if err != nil {
-		return nil, errors.Wrap(err, errClientConfig)
+	if params.Description != nil {
+		rule.Description = *params.Description
 	}
 
-	client, err := c.newCloudflareClientFn(*config)
-	if err != nil {
-		return nil, err
-	}
-
-	return &external{client: client}, nil
-}
-
-// An ExternalClient observes, then either creates, updates, or deletes an
-// external resource to ensure it reflects the managed resource's desired state.
-type external struct {
-	client Client
-}
-
-func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*v1beta1.Rule)
-	if !ok {
-		return managed.ExternalObservation{}, errors.New(errNotRule)
-	}
-
-	// Rule does not exist if we dont have an ID stored in external-name
-	rid := meta.GetExternalName(cr)
-	if rid == "" {
-		return managed.ExternalObservation{ResourceExists: false}, nil
-	}
-
-	if cr.Spec.ForProvider.Zone == nil {
-		return managed.ExternalObservation{}, errors.New(errNoZone)
-	}
-
-	r, err := e.client.FirewallRule(ctx, *cr.Spec.ForProvider.Zone, rid)
-
-	if err != nil {
-		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(IsRuleNotFound, err), errRuleLookup)
-	}
-
-	cr.Status.AtProvider = GenerateObservation(r)
-
-	cr.Status.SetConditions(rtv1.Available())
-
-	return managed.ExternalObservation{
-		ResourceExists:          true,
-		ResourceLateInitialized: LateInitialize(&cr.Spec.ForProvider, r),
-		ResourceUpToDate:        UpToDate(&cr.Spec.ForProvider, r),
-	}, nil
-}
-
-func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*v1beta1.Rule)
-	if !ok {
-		return managed.ExternalCreation{}, errors.New(errNotRule)
-	}
-
-	if cr.Spec.ForProvider.Zone == nil {
-		return managed.ExternalCreation{}, errors.New(errNoZone)
-	}
-
-	if cr.Spec.ForProvider.Filter == nil {
-		return managed.ExternalCreation{}, errors.New(errNoFilter)
-	}
-
-	nr, err := CreateRule(ctx, e.client, &cr.Spec.ForProvider)
-
-	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errRuleCreation)
-	}
-
-	cr.Status.AtProvider = GenerateObservation(*nr)
-
-	// Update the external name with the ID of the new Rule
-	meta.SetExternalName(cr, nr.ID)
-
-	return managed.ExternalCreation{}, nil
-}
-
-func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*v1beta1.Rule)
-	if !ok {
-		return managed.ExternalUpdate{}, errors.New(errNotRule)
-	}
-
-	if cr.Spec.ForProvider.Zone == nil {
-		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errNoZone), errRuleUpdate)
-	}
-
-	rid := meta.GetExternalName(cr)
-
-	// Update should never be called on a nonexistent resource
-	if rid == "" {
-		return managed.ExternalUpdate{}, errors.New(errRuleUpdate)
-	}
-
-	return managed.ExternalUpdate{},
-		errors.Wrap(
-			UpdateRule(ctx, e.client, meta.GetExternalName(cr), &cr.Spec.ForProvider),
-			errRuleUpdate,
-		)
-}
-
-func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*v1beta1.Rule)
-	if !ok {
-		return managed.ExternalDelete{}, errors.New(errNotRule)
-	}
-
-	if cr.Spec.ForProvider.Zone == nil {
-		return managed.ExternalDelete{}, errors.Wrap(errors.New(errNoZone), errRuleDeletion)
+	if params.Paused != nil {
+		rule.Paused = *params.Paused
 	}
 
-	rid := meta.GetExternalName(cr)
-
-	// Delete should never be called on a nonexistent resource
-	if rid == "" {
-		return managed.ExternalDelete{}, errors.New(errRuleDeletion)
+	if params.Priority != nil {
+		rule.Priority = *params.Priority
 	}
 
-	return managed.ExternalDelete{}, errors.Wrap(
-		e.client.DeleteFirewallRule(ctx, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)),
-		errRuleDeletion)
-}
-
-func (e *external) Disconnect(ctx context.Context) error {
-	// No persistent connections to clean up
-	return nil
+	err := client.UpdateFirewallRule(ctx, *params.Zone, ruleID, rule)
+	return err
 }
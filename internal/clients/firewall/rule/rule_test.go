@@ -18,680 +18,200 @@ package rule
 
 import (
 	"context"
-	"net/http"
 	"testing"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
-
-	"github.com/rossigee/provider-cloudflare/apis/firewall/v1beta1"
-	pcv1beta1 "github.com/rossigee/provider-cloudflare/apis/v1beta1"
-
-	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
-
 	"github.com/google/go-cmp/cmp"
-	"github.com/pkg/errors"
-
-	"github.com/rossigee/provider-cloudflare/internal/clients/firewall/rule/fake"
-
-	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
-
-	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
-	rtfake "github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
-	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
-	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/apis/firewall/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/firewall/rule/fake"
 )
 
-// Unlike many Kubernetes projects Crossplane does not use third party testing
-// libraries, per the common Go test review comments. Crossplane encourages the
-// use of table driven unit tests. The tests of the crossplane-runtime project
-// are representative of the testing style Crossplane encourages.
-//
-// https://github.com/golang/go/wiki/TestComments
-// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
-
-type ruleModifer func(*v1beta1.Rule)
-
-func withAction(action string) ruleModifer {
-	return func(r *v1beta1.Rule) { r.Spec.ForProvider.Action = action }
-}
-
-func withDescription(description string) ruleModifer {
-	return func(r *v1beta1.Rule) { r.Spec.ForProvider.Description = &description }
-}
-
-func withPaused(paused bool) ruleModifer {
-	return func(r *v1beta1.Rule) { r.Spec.ForProvider.Paused = &paused }
-}
-
-func withBypassProducts(bp []v1beta1.RuleBypassProduct) ruleModifer {
-	return func(r *v1beta1.Rule) { r.Spec.ForProvider.BypassProducts = bp }
-}
-
-func withZone(zone string) ruleModifer {
-	return func(r *v1beta1.Rule) { r.Spec.ForProvider.Zone = &zone }
-}
-
-func withExternalName(ruleID string) ruleModifer {
-	return func(r *v1beta1.Rule) { meta.SetExternalName(r, ruleID) }
-}
-
-func withFilter(filter string) ruleModifer {
-	return func(r *v1beta1.Rule) { r.Spec.ForProvider.Filter = ptr.To(filter) }
-}
-
-func ruleBuild(m ...ruleModifer) *v1beta1.Rule {
-	cr := &v1beta1.Rule{}
-	for _, f := range m {
-		f(cr)
-	}
-	return cr
-}
-func TestObserve(t *testing.T) {
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		client Client
-	}
-
-	type args struct {
-		ctx context.Context
-		mg  resource.Managed
-	}
-
-	type want struct {
-		o   managed.ExternalObservation
-		err error
-	}
+func TestGenerateObservation(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
 
 	cases := map[string]struct {
 		reason string
-		fields fields
-		args   args
-		want   want
+		rule   cloudflare.FirewallRule
+		want   v1beta1.RuleObservation
 	}{
-		"ErrNotRule": {
-			reason: "An error should be returned if the managed resource is not a *Rule",
-			fields: fields{
-				client: &fake.MockClient{},
-			},
-			args: args{
-				mg: nil,
-			},
-			want: want{
-				err: errors.New(errNotRule),
-			},
-		},
-		"ErrNoRule": {
-			reason: "We should return ResourceExists: false when no external name is set",
-			fields: fields{
-				client: &fake.MockClient{},
+		"WithPriority": {
+			reason: "The priority decoded from the API as a JSON number should be reported",
+			rule: cloudflare.FirewallRule{
+				ID:        "372e67954025e0ba6aaa6d586b9e0b60",
+				Paused:    true,
+				Priority:  float64(10),
+				Filter:    cloudflare.Filter{ID: "372e67954025e0ba6aaa6d586b9e0b61"},
+				CreatedOn: created,
 			},
-			args: args{
-				mg: &v1beta1.Rule{},
-			},
-			want: want{
-				o: managed.ExternalObservation{ResourceExists: false},
+			want: v1beta1.RuleObservation{
+				ID:        "372e67954025e0ba6aaa6d586b9e0b60",
+				FilterID:  "372e67954025e0ba6aaa6d586b9e0b61",
+				Priority:  ptr.To[int32](10),
+				Paused:    true,
+				CreatedOn: &metav1.Time{Time: created},
 			},
 		},
-		"ErrRuleLookup": {
-			reason: "We should return an empty observation and an error if the API returned an error",
-			fields: fields{
-				client: &fake.MockClient{
-					MockFirewallRule: func(ctx context.Context, zoneID string, ruleID string) (cloudflare.FirewallRule, error) {
-						return cloudflare.FirewallRule{}, errBoom
-					},
-				},
+		"WithoutPriority": {
+			reason: "A null priority should not be reported",
+			rule: cloudflare.FirewallRule{
+				ID:     "372e67954025e0ba6aaa6d586b9e0b60",
+				Filter: cloudflare.Filter{ID: "372e67954025e0ba6aaa6d586b9e0b61"},
 			},
-			args: args{
-				mg: ruleBuild(
-					withExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
-					withZone("Test Zone"),
-				),
-			},
-			want: want{
-				o:   managed.ExternalObservation{},
-				err: errors.Wrap(errBoom, errRuleLookup),
-			},
-		},
-		"ErrRuleNoZone": {
-			reason: "We should return an error if the rule does not have a zone",
-			fields: fields{
-				client: &fake.MockClient{},
-			},
-			args: args{
-				mg: ruleBuild(
-					withExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
-				),
-			},
-			want: want{
-				o:   managed.ExternalObservation{},
-				err: errors.New(errNoZone),
-			},
-		},
-		"Success": {
-			reason: "We should return ResourceExists: true and no error when a rule is found",
-			fields: fields{
-				client: &fake.MockClient{
-					MockFirewallRule: func(ctx context.Context, zoneID string, ruleID string) (cloudflare.FirewallRule, error) {
-						return cloudflare.FirewallRule{
-							ID:          "372e67954025e0ba6aaa6d586b9e0b61",
-							Paused:      false,
-							Description: "Test Description",
-							Action:      "allow",
-							Priority:    "1.0",
-							Filter:      cloudflare.Filter{},
-							Products:    []string{"waf"},
-						}, nil
-					},
-				},
-			},
-			args: args{
-				mg: ruleBuild(
-					withExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
-					withDescription("Test Description"),
-					withPaused(false),
-					withZone("Test Zone"),
-					withAction("allow"),
-					withBypassProducts([]v1beta1.RuleBypassProduct{"waf"}),
-				),
-			},
-			want: want{
-				o: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: true,
-				},
-				err: nil,
+			want: v1beta1.RuleObservation{
+				ID:       "372e67954025e0ba6aaa6d586b9e0b60",
+				FilterID: "372e67954025e0ba6aaa6d586b9e0b61",
 			},
 		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := external{client: tc.fields.client}
-			got, err := e.Observe(tc.args.ctx, tc.args.mg)
-			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
-			}
-			if diff := cmp.Diff(tc.want.o, got); diff != "" {
-				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			got := GenerateObservation(tc.rule)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nGenerateObservation(...): -want, +got:\n%s\n", tc.reason, diff)
 			}
 		})
 	}
 }
 
-func TestCreate(t *testing.T) {
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		client Client
-	}
-
+func TestUpToDate(t *testing.T) {
 	type args struct {
-		ctx context.Context
-		mg  resource.Managed
-	}
-
-	type want struct {
-		o   managed.ExternalCreation
-		err error
+		spec *v1beta1.RuleParameters
+		rule cloudflare.FirewallRule
 	}
 
 	cases := map[string]struct {
 		reason string
-		fields fields
 		args   args
-		want   want
+		want   bool
 	}{
-		"ErrNotRule": {
-			reason: "An error should be returned if the managed resource is not a *Rule",
-			args: args{
-				mg: nil,
-			},
-			want: want{
-				err: errors.New(errNotRule),
-			},
-		},
-		"ErrRuleCreate": {
-			reason: "We should return any errors during the create process",
-			fields: fields{
-				client: &fake.MockClient{
-					MockCreateFirewallRule: func(ctx context.Context, zoneID string, rule cloudflare.FirewallRule) (*cloudflare.FirewallRule, error) {
-						return nil, errBoom
-					},
+		"SpecNil": {
+			reason: "UpToDate should return true when not passed a spec",
+			args:   args{},
+			want:   true,
+		},
+		"Matching": {
+			reason: "UpToDate should return true when the rule matches the spec",
+			args: args{
+				spec: &v1beta1.RuleParameters{
+					Action:         "bypass",
+					Filter:         ptr.To("filter-id"),
+					Description:    ptr.To("Test Description"),
+					Priority:       ptr.To[int32](10),
+					BypassProducts: []v1beta1.RuleBypassProduct{"waf"},
 				},
-			},
-			args: args{
-				mg: ruleBuild(
-					withExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
-					withDescription("Test Description"),
-					withPaused(false),
-					withZone("Test Zone"),
-					withAction("allow"),
-					withBypassProducts([]v1beta1.RuleBypassProduct{"waf"}),
-					withFilter("372e67954025e0ba6aaa6d586b9e0b61"),
-				),
-			},
-			want: want{
-				o:   managed.ExternalCreation{},
-				err: errors.Wrap(errBoom, errRuleCreation),
-			},
-		},
-		"Success": {
-			reason: "We should return ExternalNameAssigned: true and no error when a rule is created",
-			fields: fields{
-				client: &fake.MockClient{
-					MockCreateFirewallRule: func(ctx context.Context, zoneID string, rule cloudflare.FirewallRule) (*cloudflare.FirewallRule, error) {
-						return &cloudflare.FirewallRule{
-							ID:          "372e67954025e0ba6aaa6d586b9e0b61",
-							Paused:      false,
-							Description: "Test Description",
-							Action:      "allow",
-							Priority:    "1.0",
-							Filter:      cloudflare.Filter{},
-							Products:    []string{"waf"},
-						}, nil
-					},
+				rule: cloudflare.FirewallRule{
+					Action:      "bypass",
+					Filter:      cloudflare.Filter{ID: "filter-id"},
+					Description: "Test Description",
+					Priority:    float64(10),
+					Products:    []string{"waf"},
 				},
 			},
-			args: args{
-				mg: ruleBuild(
-					withExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
-					withDescription("Test Description"),
-					withPaused(false),
-					withZone("Test Zone"),
-					withAction("allow"),
-					withBypassProducts([]v1beta1.RuleBypassProduct{"waf"}),
-					withFilter("372e67954025e0ba6aaa6d586b9e0b61"),
-				),
-			},
-			want: want{
-				o: managed.ExternalCreation{},
-				err: nil,
-			},
+			want: true,
 		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			e := external{client: tc.fields.client}
-			got, err := e.Create(tc.args.ctx, tc.args.mg)
-			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
-			}
-			if diff := cmp.Diff(tc.want.o, got); diff != "" {
-				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
-			}
-		})
-	}
-}
-
-func TestConnect(t *testing.T) {
-	mc := &test.MockClient{
-		MockGet: test.NewMockGetFn(nil),
-	}
-
-	_, errGetProviderConfig := clients.GetConfig(context.Background(), mc, &rtfake.Managed{})
-
-	type fields struct {
-		kube      client.Client
-		newClient func(cfg clients.Config, hc *http.Client) (Client, error)
-	}
-
-	type args struct {
-		ctx context.Context
-		mg  resource.Managed
-	}
-
-	cases := map[string]struct {
-		reason string
-		fields fields
-		args   args
-		want   error
-	}{
-		"ErrNotRule": {
-			reason: "An error should be returned if the managed resource is not a Rule",
-			args: args{
-				mg: nil,
-			},
-			want: errors.New(errNotRule),
-		},
-		"ErrGetConfig": {
-			reason: "Any errors from GetConfig should be wrapped",
-			fields: fields{
-				kube: mc,
-			},
+		"PriorityDifferent": {
+			reason: "UpToDate should return false if the priority differs",
 			args: args{
-				mg: &v1beta1.Rule{
-					Spec: v1beta1.RuleSpec{
-						ResourceSpec: xpv1.ResourceSpec{},
-					},
-				},
-			},
-			want: errors.Wrap(errGetProviderConfig, errClientConfig),
-		},
-		"ConnectReturnOK": {
-			reason: "Connect should return no error when passed the correct values",
-			fields: fields{
-				kube: &test.MockClient{
-					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
-						switch o := obj.(type) {
-						case *pcv1beta1.ProviderConfig:
-							o.Spec.Credentials.Source = "Secret"
-							o.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{
-								Key: "creds",
-							}
-						case *corev1.Secret:
-							o.Data = map[string][]byte{
-								"creds": []byte("{\"APIKey\":\"foo\",\"Email\":\"foo@bar.com\"}"),
-							}
-						}
-						return nil
-					}),
+				spec: &v1beta1.RuleParameters{
+					Action:   "block",
+					Priority: ptr.To[int32](10),
 				},
-				newClient: NewClient,
-			},
-			args: args{
-				mg: &v1beta1.Rule{
-					Spec: v1beta1.RuleSpec{
-						ResourceSpec: xpv1.ResourceSpec{
-							ProviderConfigReference: &xpv1.Reference{
-								Name: "blah",
-							},
-						},
-					},
+				rule: cloudflare.FirewallRule{
+					Action:   "block",
+					Priority: float64(20),
 				},
 			},
-			want: nil,
+			want: false,
 		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			nc := func(cfg clients.Config) (Client, error) {
-				return tc.fields.newClient(cfg, nil)
-			}
-			e := &connector{kube: tc.fields.kube, newCloudflareClientFn: nc}
-			_, err := e.Connect(tc.args.ctx, tc.args.mg)
-			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
-				t.Errorf("\n%s\ne.Connect(...): -want error, +got error:\n%s\n", tc.reason, diff)
-			}
-		})
-	}
-}
-
-func TestUpdate(t *testing.T) {
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		client Client
-	}
-
-	type args struct {
-		ctx context.Context
-		mg  resource.Managed
-	}
-
-	type want struct {
-		o   managed.ExternalUpdate
-		err error
-	}
-
-	cases := map[string]struct {
-		reason string
-		fields fields
-		args   args
-		want   want
-	}{
-		"ErrNotRule": {
-			reason: "An error should be returned if the managed resource is not a *Rule",
+		"PriorityUnset": {
+			reason: "UpToDate should return false if the rule has no priority but the spec does",
 			args: args{
-				mg: nil,
-			},
-			want: want{
-				err: errors.New(errNotRule),
+				spec: &v1beta1.RuleParameters{
+					Action:   "block",
+					Priority: ptr.To[int32](10),
+				},
+				rule: cloudflare.FirewallRule{
+					Action: "block",
+				},
 			},
+			want: false,
 		},
-		"ErrNoRule": {
-			reason: "We should return an error when no external name is set",
-			fields: fields{
-				client: &fake.MockClient{},
-			},
+		"DescriptionDifferent": {
+			reason: "UpToDate should return false if the description differs",
 			args: args{
-				mg: ruleBuild(
-					withDescription("Test Description"),
-					withPaused(false),
-					withZone("Test Zone"),
-					withAction("allow"),
-					withBypassProducts([]v1beta1.RuleBypassProduct{"waf"}),
-					withFilter("372e67954025e0ba6aaa6d586b9e0b61"),
-				),
-			},
-			want: want{
-				o:   managed.ExternalUpdate{},
-				err: errors.New(errRuleUpdate),
+				spec: &v1beta1.RuleParameters{
+					Action:      "block",
+					Description: ptr.To("new"),
+				},
+				rule: cloudflare.FirewallRule{
+					Action:      "block",
+					Description: "old",
+				},
 			},
+			want: false,
 		},
-		"ErrNoZone": {
-			reason: "We should return an error when no Zone is set",
-			fields: fields{
-				client: &fake.MockClient{},
-			},
+		"ProductsDifferent": {
+			reason: "UpToDate should return false if the bypass products differ",
 			args: args{
-				mg: ruleBuild(
-					withExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
-					withDescription("Test Description"),
-					withPaused(false),
-					withAction("allow"),
-					withBypassProducts([]v1beta1.RuleBypassProduct{"waf"}),
-					withFilter("372e67954025e0ba6aaa6d586b9e0b61"),
-				),
-			},
-			want: want{
-				o:   managed.ExternalUpdate{},
-				err: errors.Wrap(errors.New(errNoZone), errRuleUpdate),
-			},
-		},
-		"ErrRuleUpdate": {
-			reason: "We should return any errors during the update process",
-			fields: fields{
-				client: &fake.MockClient{
-					MockUpdateFirewallRule: func(ctx context.Context, zoneID, ruleID string, rule cloudflare.FirewallRule) error {
-						return errBoom
-					},
-					MockFirewallRule: func(ctx context.Context, zoneID string, ruleID string) (cloudflare.FirewallRule, error) {
-						return cloudflare.FirewallRule{
-							ID:          "372e67954025e0ba6aaa6d586b9e0b61",
-							Paused:      false,
-							Description: "Test Description",
-							Action:      "allow",
-							Priority:    "1.0",
-							Filter:      cloudflare.Filter{},
-							Products:    []string{"waf"},
-						}, nil
-					},
+				spec: &v1beta1.RuleParameters{
+					Action:         "bypass",
+					BypassProducts: []v1beta1.RuleBypassProduct{"waf", "uaBlock"},
 				},
-			},
-			args: args{
-				mg: ruleBuild(
-					withExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
-					withDescription("Test Description"),
-					withPaused(false),
-					withZone("Test Zone"),
-					withAction("allow"),
-					withBypassProducts([]v1beta1.RuleBypassProduct{"waf"}),
-					withFilter("372e67954025e0ba6aaa6d586b9e0b61"),
-				),
-			},
-			want: want{
-				o:   managed.ExternalUpdate{},
-				err: errors.Wrap(errBoom, errRuleUpdate),
-			},
-		},
-		"Success": {
-			reason: "We should return no error when a rule is updated successfully",
-			fields: fields{
-				client: &fake.MockClient{
-					MockFirewallRule: func(ctx context.Context, zoneID string, ruleID string) (cloudflare.FirewallRule, error) {
-						return cloudflare.FirewallRule{
-							ID:          "372e67954025e0ba6aaa6d586b9e0b61",
-							Paused:      false,
-							Description: "Test Description",
-							Action:      "allow",
-							Priority:    "1.0",
-							Filter:      cloudflare.Filter{},
-							Products:    []string{"waf"},
-						}, nil
-					},
-					MockUpdateFirewallRule: func(ctx context.Context, zoneID, ruleID string, rule cloudflare.FirewallRule) error {
-						return nil
-					},
+				rule: cloudflare.FirewallRule{
+					Action:   "bypass",
+					Products: []string{"waf"},
 				},
 			},
-			args: args{
-				mg: ruleBuild(
-					withExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
-					withDescription("Test Description"),
-					withPaused(false),
-					withZone("Test Zone"),
-					withAction("allow"),
-					withBypassProducts([]v1beta1.RuleBypassProduct{"waf"}),
-					withFilter("372e67954025e0ba6aaa6d586b9e0b61"),
-				),
-			},
-			want: want{
-				o:   managed.ExternalUpdate{},
-				err: nil,
-			},
+			want: false,
 		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := external{client: tc.fields.client}
-			got, err := e.Update(tc.args.ctx, tc.args.mg)
-			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
-			}
-			if diff := cmp.Diff(tc.want.o, got); diff != "" {
-				t.Errorf("\n%s\ne.Update(...): -want, +got:\n%s\n", tc.reason, diff)
+			got := UpToDate(tc.args.spec, tc.args.rule)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
 			}
 		})
 	}
 }
 
-func TestDelete(t *testing.T) {
-	errBoom := errors.New("boom")
-
-	type fields struct {
-		client Client
-	}
-
-	type args struct {
-		ctx context.Context
-		mg  resource.Managed
-	}
-
-	type want struct {
-		err error
-	}
-
-	cases := map[string]struct {
-		reason string
-		fields fields
-		args   args
-		want   want
-	}{
-		"ErrNotRule": {
-			reason: "An error should be returned if the managed resource is not a *Rule",
-			args: args{
-				mg: nil,
-			},
-			want: want{
-				err: errors.New(errNotRule),
-			},
-		},
-		"ErrNoRule": {
-			reason: "We should return an error when no external name is set",
-			fields: fields{
-				client: &fake.MockClient{},
-			},
-			args: args{
-				mg: ruleBuild(
-					withDescription("Test Description"),
-					withPaused(false),
-					withZone("Test Zone"),
-					withAction("allow"),
-					withBypassProducts([]v1beta1.RuleBypassProduct{"waf"}),
-					withFilter("372e67954025e0ba6aaa6d586b9e0b61"),
-				),
-			},
-			want: want{
-				err: errors.New(errRuleDeletion),
-			},
-		},
-		"ErrRuleDelete": {
-			reason: "We should return any errors during the delete process",
-			fields: fields{
-				client: &fake.MockClient{
-					MockDeleteFirewallRule: func(ctx context.Context, zoneID string, ruleID string) error {
-						return errBoom
-					},
-				},
-			},
-			args: args{
-				mg: ruleBuild(
-					withExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
-					withDescription("Test Description"),
-					withPaused(false),
-					withZone("Test Zone"),
-					withAction("allow"),
-					withBypassProducts([]v1beta1.RuleBypassProduct{"waf"}),
-					withFilter("372e67954025e0ba6aaa6d586b9e0b61"),
-				),
-			},
-			want: want{
-				err: errors.Wrap(errBoom, errRuleDeletion),
-			},
-		},
-		"Success": {
-			reason: "We should return no error when a rule is deleted",
-			fields: fields{
-				client: &fake.MockClient{
-					MockDeleteFirewallRule: func(ctx context.Context, zoneID string, ruleID string) error {
-						return nil
-					},
-				},
-			},
-			args: args{
-				mg: ruleBuild(
-					withExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
-					withDescription("Test Description"),
-					withPaused(false),
-					withZone("Test Zone"),
-					withAction("allow"),
-					withBypassProducts([]v1beta1.RuleBypassProduct{"waf"}),
-					withFilter("372e67954025e0ba6aaa6d586b9e0b61"),
-				),
-			},
-			want: want{
-				err: nil,
-			},
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			e := external{client: tc.fields.client}
-			_, err := e.Delete(tc.args.ctx, tc.args.mg)
-			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
-			}
-		})
+func TestCreateRule(t *testing.T) {
+	var got cloudflare.FirewallRule
+	mc := &fake.MockClient{
+		MockCreateFirewallRule: func(ctx context.Context, zoneID string, rule cloudflare.FirewallRule) (*cloudflare.FirewallRule, error) {
+			got = rule
+			return &rule, nil
+		},
+	}
+
+	_, err := CreateRule(context.Background(), mc, &v1beta1.RuleParameters{
+		Zone:           ptr.To("zone-id"),
+		Filter:         ptr.To("filter-id"),
+		Action:         "bypass",
+		Description:    ptr.To("Test Description"),
+		Paused:         ptr.To(true),
+		Priority:       ptr.To[int32](10),
+		BypassProducts: []v1beta1.RuleBypassProduct{"waf"},
+	})
+	if err != nil {
+		t.Fatalf("CreateRule(...): unexpected error: %v", err)
+	}
+
+	want := cloudflare.FirewallRule{
+		Filter:      cloudflare.Filter{ID: "filter-id"},
+		Action:      "bypass",
+		Description: "Test Description",
+		Paused:      true,
+		Priority:    int32(10),
+		Products:    []string{"waf"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("CreateRule(...): -want, +got:\n%s\n", diff)
 	}
 }
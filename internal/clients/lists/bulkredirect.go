@@ -0,0 +1,149 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lists
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/rossigee/provider-cloudflare/apis/lists/v1beta1"
+	rulesetsv1beta1 "github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+)
+
+const (
+	// PhaseRedirect is the phase Bulk Redirect rules run in.
+	PhaseRedirect = "http_request_redirect"
+
+	redirectKey = "http.request.full_uri"
+
+	errParseRedirects = "cannot parse redirects"
+	errRedirectLine   = "line %d: want a source URL, a target URL and an optional status code"
+	errStatusCode     = "line %d: invalid status code %q"
+)
+
+// BulkRedirectRef returns the ref of the rule enabling the redirect list of
+// a BulkRedirect.
+func BulkRedirectRef(p v1beta1.BulkRedirectParameters) string {
+	return "bulk_redirect_" + p.Name
+}
+
+// BulkRedirectEntrypoint returns the parameters that address the account
+// entrypoint ruleset holding the rules of Bulk Redirects.
+func BulkRedirectEntrypoint(accountID string) rulesetsv1beta1.RulesetParameters {
+	return rulesetsv1beta1.RulesetParameters{
+		Account: ptr.To(accountID),
+		Phase:   PhaseRedirect,
+		Mode:    ptr.To(rulesetsv1beta1.RulesetModeEntrypoint),
+	}
+}
+
+// BulkRedirectRule returns the rule enabling the redirect list of a
+// BulkRedirect.
+func BulkRedirectRule(p v1beta1.BulkRedirectParameters) rulesetsv1beta1.RulesetRule {
+	expr := fmt.Sprintf("%s in $%s", redirectKey, p.Name)
+	if p.Expression != nil {
+		expr = *p.Expression
+	}
+	return rulesetsv1beta1.RulesetRule{
+		Ref:         ptr.To(BulkRedirectRef(p)),
+		Action:      "redirect",
+		Expression:  expr,
+		Description: ptr.To("Bulk redirects from list " + p.Name),
+		Enabled:     ptr.To(ptr.Deref(p.Enabled, true)),
+		ActionParameters: &rulesetsv1beta1.RuleActionParameters{
+			FromList: &rulesetsv1beta1.RuleFromList{Name: p.Name, Key: redirectKey},
+		},
+	}
+}
+
+// BulkRedirectItems returns the redirect list items of a BulkRedirect,
+// filling settings that redirects do not set from its defaults.
+func BulkRedirectItems(p v1beta1.BulkRedirectParameters, redirects []v1beta1.Redirect) []v1beta1.Item {
+	items := make([]v1beta1.Item, 0, len(redirects))
+	for _, r := range redirects {
+		r := r
+		if r.StatusCode == nil {
+			r.StatusCode = p.StatusCode
+		}
+		if r.IncludeSubdomains == nil {
+			r.IncludeSubdomains = p.IncludeSubdomains
+		}
+		if r.SubpathMatching == nil {
+			r.SubpathMatching = p.SubpathMatching
+		}
+		if r.PreserveQueryString == nil {
+			r.PreserveQueryString = p.PreserveQueryString
+		}
+		if r.PreservePathSuffix == nil {
+			r.PreservePathSuffix = p.PreservePathSuffix
+		}
+		items = append(items, v1beta1.Item{Redirect: &r})
+	}
+	return items
+}
+
+// ParseRedirects parses redirects held in a ConfigMap. The data is either
+// a YAML list of redirects, or one redirect per line as a source URL, a
+// target URL and an optional status code separated by spaces or commas.
+// Blank lines and lines starting with # are ignored in the latter.
+func ParseRedirects(data string) ([]v1beta1.Redirect, error) {
+	var redirects []v1beta1.Redirect
+	if err := yaml.Unmarshal([]byte(data), &redirects); err == nil {
+		return redirects, nil
+	}
+
+	redirects = nil
+	s := bufio.NewScanner(strings.NewReader(data))
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.FieldsFunc(line, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, errors.Wrap(errors.Errorf(errRedirectLine, n), errParseRedirects)
+		}
+		r := v1beta1.Redirect{SourceURL: fields[0], TargetURL: fields[1]}
+		if len(fields) == 3 {
+			code, err := strconv.Atoi(fields[2])
+			if err != nil {
+				return nil, errors.Wrap(errors.Errorf(errStatusCode, n, fields[2]), errParseRedirects)
+			}
+			r.StatusCode = &code
+		}
+		redirects = append(redirects, r)
+	}
+	return redirects, errors.Wrap(s.Err(), errParseRedirects)
+}
+
+// FindBulkRedirectRule returns the rule of the entrypoint ruleset that
+// enables the redirect list of a BulkRedirect, or nil if there is none.
+func FindBulkRedirectRule(p v1beta1.BulkRedirectParameters, rs *cloudflare.Ruleset) *cloudflare.RulesetRule {
+	for i := range rs.Rules {
+		if rs.Rules[i].Ref == BulkRedirectRef(p) {
+			return &rs.Rules[i]
+		}
+	}
+	return nil
+}
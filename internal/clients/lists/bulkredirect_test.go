@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lists
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/lists/v1beta1"
+	rulesetsv1beta1 "github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+)
+
+func TestParseRedirects(t *testing.T) {
+	type want struct {
+		redirects []v1beta1.Redirect
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason string
+		data   string
+		want   want
+	}{
+		"YAML": {
+			reason: "A YAML list of redirects should be parsed as is",
+			data: `
+- sourceUrl: example.com/old
+  targetUrl: https://example.com/new
+  statusCode: 302
+  preserveQueryString: true
+`,
+			want: want{redirects: []v1beta1.Redirect{{
+				SourceURL:           "example.com/old",
+				TargetURL:           "https://example.com/new",
+				StatusCode:          ptr.To(302),
+				PreserveQueryString: ptr.To(true),
+			}}},
+		},
+		"Lines": {
+			reason: "Lines should be split on spaces, commas and tabs, ignoring comments",
+			data: `# Moved during the 2024 migration
+example.com/a https://example.com/b
+
+example.com/c,https://example.com/d,308
+example.com/e	https://example.com/f	307
+`,
+			want: want{redirects: []v1beta1.Redirect{
+				{SourceURL: "example.com/a", TargetURL: "https://example.com/b"},
+				{SourceURL: "example.com/c", TargetURL: "https://example.com/d", StatusCode: ptr.To(308)},
+				{SourceURL: "example.com/e", TargetURL: "https://example.com/f", StatusCode: ptr.To(307)},
+			}},
+		},
+		"MissingTarget": {
+			reason: "A line without a target should be rejected",
+			data:   "example.com/a https://example.com/b\nexample.com/c\n",
+			want:   want{err: errors.Wrap(errors.Errorf(errRedirectLine, 2), errParseRedirects)},
+		},
+		"InvalidStatusCode": {
+			reason: "A status code that is not a number should be rejected",
+			data:   "example.com/a https://example.com/b moved\n",
+			want:   want{err: errors.Wrap(errors.Errorf(errStatusCode, 1, "moved"), errParseRedirects)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseRedirects(tc.data)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nParseRedirects(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.redirects, got); diff != "" {
+				t.Errorf("\n%s\nParseRedirects(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBulkRedirectItems(t *testing.T) {
+	p := v1beta1.BulkRedirectParameters{
+		Name:                "moved",
+		StatusCode:          ptr.To(308),
+		PreserveQueryString: ptr.To(true),
+	}
+	redirects := []v1beta1.Redirect{
+		{SourceURL: "example.com/a", TargetURL: "https://example.com/b"},
+		{SourceURL: "example.com/c", TargetURL: "https://example.com/d", StatusCode: ptr.To(302), PreserveQueryString: ptr.To(false)},
+	}
+
+	want := []v1beta1.Item{
+		{Redirect: &v1beta1.Redirect{
+			SourceURL: "example.com/a", TargetURL: "https://example.com/b",
+			StatusCode: ptr.To(308), PreserveQueryString: ptr.To(true),
+		}},
+		{Redirect: &v1beta1.Redirect{
+			SourceURL: "example.com/c", TargetURL: "https://example.com/d",
+			StatusCode: ptr.To(302), PreserveQueryString: ptr.To(false),
+		}},
+	}
+	if diff := cmp.Diff(want, BulkRedirectItems(p, redirects)); diff != "" {
+		t.Errorf("BulkRedirectItems(...): -want, +got:\n%s", diff)
+	}
+	if redirects[0].StatusCode != nil {
+		t.Errorf("BulkRedirectItems(...): modified the redirects it was passed")
+	}
+}
+
+func TestBulkRedirectRule(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      v1beta1.BulkRedirectParameters
+		want   rulesetsv1beta1.RulesetRule
+	}{
+		"Defaults": {
+			reason: "The rule should match every URL in the list and be enabled",
+			p:      v1beta1.BulkRedirectParameters{Name: "moved"},
+			want: rulesetsv1beta1.RulesetRule{
+				Ref:         ptr.To("bulk_redirect_moved"),
+				Action:      "redirect",
+				Expression:  "http.request.full_uri in $moved",
+				Description: ptr.To("Bulk redirects from list moved"),
+				Enabled:     ptr.To(true),
+				ActionParameters: &rulesetsv1beta1.RuleActionParameters{
+					FromList: &rulesetsv1beta1.RuleFromList{Name: "moved", Key: "http.request.full_uri"},
+				},
+			},
+		},
+		"Overrides": {
+			reason: "The expression and enabled state should be configurable",
+			p: v1beta1.BulkRedirectParameters{
+				Name:       "moved",
+				Expression: ptr.To(`http.host eq "example.com"`),
+				Enabled:    ptr.To(false),
+			},
+			want: rulesetsv1beta1.RulesetRule{
+				Ref:         ptr.To("bulk_redirect_moved"),
+				Action:      "redirect",
+				Expression:  `http.host eq "example.com"`,
+				Description: ptr.To("Bulk redirects from list moved"),
+				Enabled:     ptr.To(false),
+				ActionParameters: &rulesetsv1beta1.RuleActionParameters{
+					FromList: &rulesetsv1beta1.RuleFromList{Name: "moved", Key: "http.request.full_uri"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, BulkRedirectRule(tc.p)); diff != "" {
+				t.Errorf("\n%s\nBulkRedirectRule(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
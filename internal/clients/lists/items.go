@@ -0,0 +1,305 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lists
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/rossigee/provider-cloudflare/apis/lists/v1beta1"
+)
+
+const (
+	errNoValue       = "item of a %s list must set value"
+	errNoRedirect    = "item of a redirect list must set redirect"
+	errBothSet       = "item must set only one of value and redirect"
+	errInvalidIP     = "invalid IP address or CIDR range %q"
+	errInvalidASN    = "invalid AS number %q"
+	errUnknownKind   = "unknown list kind %q"
+	errDuplicateItem = "duplicate list item %q"
+	errParseItems    = "cannot parse list items"
+	errNoItems       = "list items are empty; use [] for a list without items"
+)
+
+// ItemsDiff is the set of bulk changes that brings the items of a list to
+// their desired state.
+type ItemsDiff struct {
+	Create []cloudflare.ListItemCreateRequest
+	Delete []cloudflare.ListItemDeleteItemRequest
+}
+
+// Empty returns true if the diff holds no changes.
+func (d ItemsDiff) Empty() bool {
+	return len(d.Create) == 0 && len(d.Delete) == 0
+}
+
+// CreateRequest converts a desired item of a list of the supplied kind into
+// a Cloudflare create request, validating its value.
+func CreateRequest(kind string, i v1beta1.Item) (cloudflare.ListItemCreateRequest, error) {
+	r := cloudflare.ListItemCreateRequest{Comment: ptr.Deref(i.Comment, "")}
+
+	if i.Value != nil && i.Redirect != nil {
+		return r, errors.New(errBothSet)
+	}
+	if kind != v1beta1.ListKindRedirect && i.Value == nil {
+		return r, errors.Errorf(errNoValue, kind)
+	}
+
+	switch kind {
+	case v1beta1.ListKindIP:
+		v, err := normalizeIP(*i.Value)
+		if err != nil {
+			return r, err
+		}
+		r.IP = &v
+	case v1beta1.ListKindHostname:
+		r.Hostname = &cloudflare.Hostname{UrlHostname: strings.ToLower(strings.TrimSpace(*i.Value))}
+	case v1beta1.ListKindASN:
+		asn, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(*i.Value)), "AS"), 10, 32)
+		if err != nil {
+			return r, errors.Errorf(errInvalidASN, *i.Value)
+		}
+		r.ASN = ptr.To(uint32(asn))
+	case v1beta1.ListKindRedirect:
+		if i.Redirect == nil {
+			return r, errors.New(errNoRedirect)
+		}
+		r.Redirect = &cloudflare.Redirect{
+			SourceUrl:           i.Redirect.SourceURL,
+			TargetUrl:           i.Redirect.TargetURL,
+			StatusCode:          i.Redirect.StatusCode,
+			IncludeSubdomains:   i.Redirect.IncludeSubdomains,
+			SubpathMatching:     i.Redirect.SubpathMatching,
+			PreserveQueryString: i.Redirect.PreserveQueryString,
+			PreservePathSuffix:  i.Redirect.PreservePathSuffix,
+		}
+	default:
+		return r, errors.Errorf(errUnknownKind, kind)
+	}
+	return r, nil
+}
+
+// normalizeIP returns the form Cloudflare stores an IP address or CIDR
+// range in, so that desired and observed items compare equal.
+func normalizeIP(v string) (string, error) {
+	v = strings.TrimSpace(v)
+	if p, err := netip.ParsePrefix(v); err == nil {
+		if p.IsSingleIP() {
+			return p.Addr().String(), nil
+		}
+		return p.Masked().String(), nil
+	}
+	a, err := netip.ParseAddr(v)
+	if err != nil {
+		return "", errors.Errorf(errInvalidIP, v)
+	}
+	return a.String(), nil
+}
+
+// itemKey identifies an item within its list. Lists hold at most one item
+// per key.
+func itemKey(ip *string, hostname *cloudflare.Hostname, asn *uint32, redirect *cloudflare.Redirect) string {
+	switch {
+	case ip != nil:
+		if v, err := normalizeIP(*ip); err == nil {
+			return v
+		}
+		return *ip
+	case hostname != nil:
+		return strings.ToLower(hostname.UrlHostname)
+	case asn != nil:
+		return strconv.FormatUint(uint64(*asn), 10)
+	case redirect != nil:
+		return redirect.SourceUrl
+	}
+	return ""
+}
+
+// ItemKind returns the kind of list an observed item belongs to.
+func ItemKind(i cloudflare.ListItem) string {
+	switch {
+	case i.IP != nil:
+		return v1beta1.ListKindIP
+	case i.Hostname != nil:
+		return v1beta1.ListKindHostname
+	case i.ASN != nil:
+		return v1beta1.ListKindASN
+	case i.Redirect != nil:
+		return v1beta1.ListKindRedirect
+	}
+	return ""
+}
+
+// ItemKey returns the key identifying an observed item within its list.
+func ItemKey(i cloudflare.ListItem) string {
+	return itemKey(i.IP, i.Hostname, i.ASN, i.Redirect)
+}
+
+// RequestKey returns the key identifying the item a create request adds.
+func RequestKey(r cloudflare.ListItemCreateRequest) string {
+	return itemKey(r.IP, r.Hostname, r.ASN, r.Redirect)
+}
+
+// ItemUpToDate returns true if an observed item matches a create request
+// with the same key. Optional redirect settings that are not requested are
+// not compared, as Cloudflare reports its defaults for them.
+func ItemUpToDate(r cloudflare.ListItemCreateRequest, i cloudflare.ListItem) bool {
+	if RequestKey(r) != ItemKey(i) || r.Comment != i.Comment {
+		return false
+	}
+	if r.Redirect == nil {
+		return true
+	}
+	if i.Redirect == nil {
+		return false
+	}
+	want, got := r.Redirect, i.Redirect
+	return want.TargetUrl == got.TargetUrl &&
+		optionalEqual(want.StatusCode, got.StatusCode, 301) &&
+		optionalEqual(want.IncludeSubdomains, got.IncludeSubdomains, false) &&
+		optionalEqual(want.SubpathMatching, got.SubpathMatching, false) &&
+		optionalEqual(want.PreserveQueryString, got.PreserveQueryString, false) &&
+		optionalEqual(want.PreservePathSuffix, got.PreservePathSuffix, false)
+}
+
+// optionalEqual compares an optional desired value with an observed one.
+// An unset desired value matches anything; an unset observed value is
+// taken to be Cloudflare's default.
+func optionalEqual[T comparable](want, got *T, def T) bool {
+	if want == nil {
+		return true
+	}
+	return *want == ptr.Deref(got, def)
+}
+
+// DiffItems works out the bulk changes that turn the current items of a
+// list of the supplied kind into the desired ones. Items that changed are
+// deleted and created again, as Cloudflare cannot update single items.
+func DiffItems(kind string, desired []v1beta1.Item, current []cloudflare.ListItem) (ItemsDiff, error) {
+	want := make(map[string]cloudflare.ListItemCreateRequest, len(desired))
+	order := make([]string, 0, len(desired))
+	for _, i := range desired {
+		r, err := CreateRequest(kind, i)
+		if err != nil {
+			return ItemsDiff{}, err
+		}
+		k := RequestKey(r)
+		if _, ok := want[k]; ok {
+			return ItemsDiff{}, errors.Errorf(errDuplicateItem, k)
+		}
+		want[k] = r
+		order = append(order, k)
+	}
+
+	d := ItemsDiff{}
+	kept := make(map[string]bool, len(current))
+	for _, i := range current {
+		k := ItemKey(i)
+		if r, ok := want[k]; ok && !kept[k] && ItemUpToDate(r, i) {
+			kept[k] = true
+			continue
+		}
+		d.Delete = append(d.Delete, cloudflare.ListItemDeleteItemRequest{ID: i.ID})
+	}
+	for _, k := range order {
+		if !kept[k] {
+			d.Create = append(d.Create, want[k])
+		}
+	}
+	return d, nil
+}
+
+// FindItem returns the item of a list matching the key of a create
+// request, or nil if the list holds none.
+func FindItem(r cloudflare.ListItemCreateRequest, items []cloudflare.ListItem) *cloudflare.ListItem {
+	k := RequestKey(r)
+	for i := range items {
+		if ItemKey(items[i]) == k {
+			return &items[i]
+		}
+	}
+	return nil
+}
+
+// ParseItems parses list items held in a ConfigMap. The data is either a
+// YAML list of items using the schema of the List items field, a YAML list
+// of values, or plain text holding one value per line. In plain text blank
+// lines and lines starting with # are ignored, and anything after a # is
+// taken as the comment of the item. Data without items, such as empty data
+// or data holding only comments, is an error rather than a list without
+// items, so that a ConfigMap that is not populated yet does not delete
+// every item of the list. An explicitly empty YAML list holds no items.
+func ParseItems(data string) ([]v1beta1.Item, error) {
+	if strings.TrimSpace(data) == "" {
+		return nil, errors.New(errNoItems)
+	}
+
+	var items []v1beta1.Item
+	if err := yaml.Unmarshal([]byte(data), &items); err == nil {
+		// Data holding only comments decodes as null rather than a list.
+		if items == nil {
+			return nil, errors.New(errNoItems)
+		}
+		return items, nil
+	}
+
+	// Values are decoded as numbers where possible so that AS numbers can
+	// be listed without quotes.
+	items = nil
+	var values []any
+	if err := yaml.Unmarshal([]byte(data), &values, func(d *json.Decoder) *json.Decoder {
+		d.UseNumber()
+		return d
+	}); err == nil {
+		for _, v := range values {
+			items = append(items, v1beta1.Item{Value: ptr.To(fmt.Sprint(v))})
+		}
+		return items, nil
+	}
+
+	items = nil
+	s := bufio.NewScanner(strings.NewReader(data))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		i := v1beta1.Item{}
+		if v, comment, ok := strings.Cut(line, "#"); ok {
+			line = strings.TrimSpace(v)
+			i.Comment = ptr.To(strings.TrimSpace(comment))
+		}
+		i.Value = ptr.To(line)
+		items = append(items, i)
+	}
+	if err := s.Err(); err != nil {
+		return nil, errors.Wrap(err, errParseItems)
+	}
+	if len(items) == 0 {
+		return nil, errors.New(errNoItems)
+	}
+	return items, nil
+}
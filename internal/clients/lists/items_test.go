@@ -0,0 +1,303 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lists
+
+import (
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/lists/v1beta1"
+)
+
+func TestCreateRequest(t *testing.T) {
+	type want struct {
+		r   cloudflare.ListItemCreateRequest
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		kind   string
+		item   v1beta1.Item
+		want   want
+	}{
+		"SingleIP": {
+			reason: "A /32 range should be stored as a plain address",
+			kind:   v1beta1.ListKindIP,
+			item:   v1beta1.Item{Value: ptr.To("192.0.2.1/32"), Comment: ptr.To("office")},
+			want:   want{r: cloudflare.ListItemCreateRequest{IP: ptr.To("192.0.2.1"), Comment: "office"}},
+		},
+		"CIDR": {
+			reason: "A range should be masked to its network address",
+			kind:   v1beta1.ListKindIP,
+			item:   v1beta1.Item{Value: ptr.To("192.0.2.7/24")},
+			want:   want{r: cloudflare.ListItemCreateRequest{IP: ptr.To("192.0.2.0/24")}},
+		},
+		"InvalidIP": {
+			reason: "A value that is not an IP should be rejected",
+			kind:   v1beta1.ListKindIP,
+			item:   v1beta1.Item{Value: ptr.To("example.com")},
+			want:   want{err: errors.Errorf(errInvalidIP, "example.com")},
+		},
+		"Hostname": {
+			reason: "Hostnames should be lower cased",
+			kind:   v1beta1.ListKindHostname,
+			item:   v1beta1.Item{Value: ptr.To("WWW.Example.com")},
+			want:   want{r: cloudflare.ListItemCreateRequest{Hostname: &cloudflare.Hostname{UrlHostname: "www.example.com"}}},
+		},
+		"ASN": {
+			reason: "AS numbers may carry an AS prefix",
+			kind:   v1beta1.ListKindASN,
+			item:   v1beta1.Item{Value: ptr.To("AS13335")},
+			want:   want{r: cloudflare.ListItemCreateRequest{ASN: ptr.To(uint32(13335))}},
+		},
+		"Redirect": {
+			reason: "Redirect items should carry all their settings",
+			kind:   v1beta1.ListKindRedirect,
+			item: v1beta1.Item{Redirect: &v1beta1.Redirect{
+				SourceURL:  "example.com/old",
+				TargetURL:  "https://example.com/new",
+				StatusCode: ptr.To(302),
+			}},
+			want: want{r: cloudflare.ListItemCreateRequest{Redirect: &cloudflare.Redirect{
+				SourceUrl:  "example.com/old",
+				TargetUrl:  "https://example.com/new",
+				StatusCode: ptr.To(302),
+			}}},
+		},
+		"NoRedirect": {
+			reason: "Items of a redirect list must set redirect",
+			kind:   v1beta1.ListKindRedirect,
+			item:   v1beta1.Item{},
+			want:   want{err: errors.New(errNoRedirect)},
+		},
+		"NoValue": {
+			reason: "Items of other lists must set value",
+			kind:   v1beta1.ListKindASN,
+			item:   v1beta1.Item{},
+			want:   want{err: errors.Errorf(errNoValue, v1beta1.ListKindASN)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := CreateRequest(tc.kind, tc.item)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreateRequest(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.r, r); tc.want.err == nil && diff != "" {
+				t.Errorf("\n%s\nCreateRequest(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDiffItems(t *testing.T) {
+	current := []cloudflare.ListItem{
+		{ID: "1", IP: ptr.To("192.0.2.1"), Comment: "office"},
+		{ID: "2", IP: ptr.To("192.0.2.2")},
+		{ID: "3", IP: ptr.To("198.51.100.0/24"), Comment: "old"},
+	}
+
+	type want struct {
+		d   ItemsDiff
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		desired []v1beta1.Item
+		want    want
+	}{
+		"UpToDate": {
+			reason: "No changes should be needed when the items match",
+			desired: []v1beta1.Item{
+				{Value: ptr.To("192.0.2.1/32"), Comment: ptr.To("office")},
+				{Value: ptr.To("192.0.2.2")},
+				{Value: ptr.To("198.51.100.0/24"), Comment: ptr.To("old")},
+			},
+			want: want{d: ItemsDiff{}},
+		},
+		"AddRemoveChange": {
+			reason: "Removed items should be deleted, new items created and changed items replaced",
+			desired: []v1beta1.Item{
+				{Value: ptr.To("192.0.2.1"), Comment: ptr.To("office")},
+				{Value: ptr.To("198.51.100.0/24"), Comment: ptr.To("new")},
+				{Value: ptr.To("203.0.113.9")},
+			},
+			want: want{d: ItemsDiff{
+				Delete: []cloudflare.ListItemDeleteItemRequest{{ID: "2"}, {ID: "3"}},
+				Create: []cloudflare.ListItemCreateRequest{
+					{IP: ptr.To("198.51.100.0/24"), Comment: "new"},
+					{IP: ptr.To("203.0.113.9")},
+				},
+			}},
+		},
+		"Emptied": {
+			reason:  "All items should be deleted when none are desired",
+			desired: []v1beta1.Item{},
+			want: want{d: ItemsDiff{
+				Delete: []cloudflare.ListItemDeleteItemRequest{{ID: "1"}, {ID: "2"}, {ID: "3"}},
+			}},
+		},
+		"Duplicate": {
+			reason: "The same item should not be desired twice",
+			desired: []v1beta1.Item{
+				{Value: ptr.To("192.0.2.1")},
+				{Value: ptr.To("192.0.2.1/32")},
+			},
+			want: want{err: errors.Errorf(errDuplicateItem, "192.0.2.1")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, err := DiffItems(v1beta1.ListKindIP, tc.desired, current)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDiffItems(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.d, d); diff != "" {
+				t.Errorf("\n%s\nDiffItems(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestItemUpToDate(t *testing.T) {
+	redirect := func(r cloudflare.Redirect) cloudflare.ListItemCreateRequest {
+		r.SourceUrl = "example.com/old"
+		r.TargetUrl = "https://example.com/new"
+		return cloudflare.ListItemCreateRequest{Redirect: &r}
+	}
+	observed := cloudflare.ListItem{Redirect: &cloudflare.Redirect{
+		SourceUrl:         "example.com/old",
+		TargetUrl:         "https://example.com/new",
+		StatusCode:        ptr.To(301),
+		IncludeSubdomains: ptr.To(false),
+	}}
+
+	cases := map[string]struct {
+		reason string
+		r      cloudflare.ListItemCreateRequest
+		want   bool
+	}{
+		"DefaultsIgnored": {
+			reason: "Settings that are not requested should not be compared",
+			r:      redirect(cloudflare.Redirect{}),
+			want:   true,
+		},
+		"DefaultRequested": {
+			reason: "Requesting a default should match the observed default",
+			r:      redirect(cloudflare.Redirect{StatusCode: ptr.To(301), SubpathMatching: ptr.To(false)}),
+			want:   true,
+		},
+		"StatusCodeChanged": {
+			reason: "A different status code should need an update",
+			r:      redirect(cloudflare.Redirect{StatusCode: ptr.To(308)}),
+			want:   false,
+		},
+		"CommentChanged": {
+			reason: "A different comment should need an update",
+			r: func() cloudflare.ListItemCreateRequest {
+				r := redirect(cloudflare.Redirect{})
+				r.Comment = "moved"
+				return r
+			}(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ItemUpToDate(tc.r, observed)); diff != "" {
+				t.Errorf("\n%s\nItemUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestParseItems(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		data   string
+		want   []v1beta1.Item
+		err    error
+	}{
+		"Lines": {
+			reason: "Plain text should hold one value per line with optional comments",
+			data:   "# office ranges\n192.0.2.0/24 # London\n\n198.51.100.7\n",
+			want: []v1beta1.Item{
+				{Value: ptr.To("192.0.2.0/24"), Comment: ptr.To("London")},
+				{Value: ptr.To("198.51.100.7")},
+			},
+		},
+		"Values": {
+			reason: "A YAML list of values should be read as values, including unquoted numbers",
+			data:   "- 13335\n- AS15169\n",
+			want: []v1beta1.Item{
+				{Value: ptr.To("13335")},
+				{Value: ptr.To("AS15169")},
+			},
+		},
+		"Items": {
+			reason: "A YAML list of items should use the items schema",
+			data:   "- redirect:\n    sourceUrl: example.com/old\n    targetUrl: https://example.com/new\n  comment: moved\n",
+			want: []v1beta1.Item{{
+				Redirect: &v1beta1.Redirect{SourceURL: "example.com/old", TargetURL: "https://example.com/new"},
+				Comment:  ptr.To("moved"),
+			}},
+		},
+		"EmptyList": {
+			reason: "An explicitly empty YAML list should hold no items",
+			data:   "[]\n",
+			want:   []v1beta1.Item{},
+		},
+		"Empty": {
+			reason: "Empty data should be an error rather than a list without items",
+			data:   " \n\t\n",
+			err:    errors.New(errNoItems),
+		},
+		"CommentsOnly": {
+			reason: "Data holding only comments and blank lines should be an error rather than a list without items",
+			data:   "# blocked ranges\n\n# none yet\n",
+			err:    errors.New(errNoItems),
+		},
+		"Null": {
+			reason: "A YAML null should be an error rather than a list without items",
+			data:   "null\n",
+			err:    errors.New(errNoItems),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseItems(tc.data)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nParseItems(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nParseItems(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
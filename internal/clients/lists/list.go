@@ -0,0 +1,126 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lists
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/lists/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+const (
+	errCreateItems = "cannot create list items"
+	errDeleteItems = "cannot delete list items"
+)
+
+// API is the part of the Cloudflare API used to manage Lists and their
+// items.
+type API interface {
+	clients.AccountLister
+	CreateList(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListCreateParams) (cloudflare.List, error)
+	GetList(ctx context.Context, rc *cloudflare.ResourceContainer, listID string) (cloudflare.List, error)
+	UpdateList(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListUpdateParams) (cloudflare.List, error)
+	DeleteList(ctx context.Context, rc *cloudflare.ResourceContainer, listID string) (cloudflare.ListDeleteResponse, error)
+	ListListItems(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListListItemsParams) ([]cloudflare.ListItem, error)
+	GetListItem(ctx context.Context, rc *cloudflare.ResourceContainer, listID, itemID string) (cloudflare.ListItem, error)
+	CreateListItems(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListCreateItemsParams) ([]cloudflare.ListItem, error)
+	DeleteListItems(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDeleteItemsParams) ([]cloudflare.ListItem, error)
+}
+
+// NewClient returns a new Cloudflare API client for working with Lists.
+func NewClient(cfg clients.Config, hc *http.Client) (API, error) {
+	return clients.NewClient(cfg, hc)
+}
+
+// GenerateObservation creates an observation of a Cloudflare List.
+func GenerateObservation(l cloudflare.List) v1beta1.ListObservation {
+	o := v1beta1.ListObservation{
+		ID:                    l.ID,
+		NumItems:              l.NumItems,
+		NumReferencingFilters: l.NumReferencingFilters,
+	}
+	if l.CreatedOn != nil {
+		o.CreatedOn = &metav1.Time{Time: *l.CreatedOn}
+	}
+	if l.ModifiedOn != nil {
+		o.ModifiedOn = &metav1.Time{Time: *l.ModifiedOn}
+	}
+	return o
+}
+
+// GenerateItemObservation creates an observation of a Cloudflare List item.
+func GenerateItemObservation(i cloudflare.ListItem) v1beta1.ListItemObservation {
+	o := v1beta1.ListItemObservation{ID: i.ID}
+	if i.CreatedOn != nil {
+		o.CreatedOn = &metav1.Time{Time: *i.CreatedOn}
+	}
+	if i.ModifiedOn != nil {
+		o.ModifiedOn = &metav1.Time{Time: *i.ModifiedOn}
+	}
+	return o
+}
+
+// LateInitialize fills the unset description of a List from Cloudflare. It
+// returns true if the parameters were changed.
+func LateInitialize(p *v1beta1.ListParameters, l cloudflare.List) bool {
+	if p.Description == nil && l.Description != "" {
+		p.Description = ptr.To(l.Description)
+		return true
+	}
+	return false
+}
+
+// UpToDate checks whether the attributes of a List, other than its items,
+// match the desired parameters.
+func UpToDate(p *v1beta1.ListParameters, l cloudflare.List) bool {
+	return ptr.Deref(p.Description, "") == l.Description
+}
+
+// ManagesItems returns true if the List manages its items itself, rather
+// than leaving them to ListItems.
+func ManagesItems(p *v1beta1.ListParameters) bool {
+	return p.Items != nil || p.ItemsFrom != nil
+}
+
+// ApplyItems deletes and then creates list items in bulk. Deleting first
+// lets a changed item be replaced by its new version.
+func ApplyItems(ctx context.Context, api API, rc *cloudflare.ResourceContainer, listID string, d ItemsDiff) error {
+	if len(d.Delete) > 0 {
+		if _, err := api.DeleteListItems(ctx, rc, cloudflare.ListDeleteItemsParams{
+			ID:    listID,
+			Items: cloudflare.ListItemDeleteRequest{Items: d.Delete},
+		}); err != nil {
+			return errors.Wrap(err, errDeleteItems)
+		}
+	}
+	if len(d.Create) > 0 {
+		if _, err := api.CreateListItems(ctx, rc, cloudflare.ListCreateItemsParams{
+			ID:    listID,
+			Items: d.Create,
+		}); err != nil {
+			return errors.Wrap(err, errCreateItems)
+		}
+	}
+	return nil
+}
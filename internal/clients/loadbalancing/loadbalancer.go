@@ -93,7 +93,7 @@ func NewPoolClient(cfg clients.Config, httpClient *http.Client) (PoolClient, err
 	if err != nil {
 		return nil, err
 	}
-	return &poolClient{api: api}, nil
+	return &poolClient{api: api, accountID: clients.AccountID(nil, cfg)}, nil
 }
 
 type loadBalancerClient struct {
@@ -105,7 +105,8 @@ type monitorClient struct {
 }
 
 type poolClient struct {
-	api *cloudflare.API
+	api       *cloudflare.API
+	accountID string
 }
 
 // CreateLoadBalancer creates a new Cloudflare load balancer
@@ -309,18 +310,6 @@ func (c *loadBalancerClient) DeleteLoadBalancer(ctx context.Context, lbID string
 	return nil
 }
 
-// IsLoadBalancerNotFound checks if error indicates load balancer not found
-func IsLoadBalancerNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	// Check for Cloudflare API not found errors
-	if cfErr := (*cloudflare.Error)(nil); errors.As(err, &cfErr) {
-		return cfErr.StatusCode == 404
-	}
-	return false
-}
-
 // convertSessionAffinityAttributesToCloudflare converts session affinity attributes to Cloudflare format
 func convertSessionAffinityAttributesToCloudflare(attrs v1beta1.SessionAffinityAttributes) *cloudflare.SessionAffinityAttributes {
 	cfAttrs := &cloudflare.SessionAffinityAttributes{}
@@ -938,18 +927,6 @@ func (c *monitorClient) DeleteMonitor(ctx context.Context, monitorID string, par
 	return nil
 }
 
-// IsMonitorNotFound checks if error indicates monitor not found
-func IsMonitorNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	// Check for Cloudflare API not found errors
-	if cfErr := (*cloudflare.Error)(nil); errors.As(err, &cfErr) {
-		return cfErr.StatusCode == 404
-	}
-	return false
-}
-
 // CreatePool creates a new Cloudflare load balancer pool
 func (c *poolClient) CreatePool(ctx context.Context, params v1beta1.LoadBalancerPoolParameters) (*cloudflare.LoadBalancerPool, error) {
 	pool := cloudflare.LoadBalancerPool{}
@@ -1002,7 +979,7 @@ func (c *poolClient) CreatePool(ctx context.Context, params v1beta1.LoadBalancer
 	}
 
 	// Pools are account-level resources
-	rc := cloudflare.AccountIdentifier("") // Empty string means use the account from credentials
+	rc := cloudflare.AccountIdentifier(c.accountID)
 
 	createParams := cloudflare.CreateLoadBalancerPoolParams{
 		LoadBalancerPool: pool,
@@ -1019,7 +996,7 @@ func (c *poolClient) CreatePool(ctx context.Context, params v1beta1.LoadBalancer
 // GetPool retrieves a Cloudflare load balancer pool
 func (c *poolClient) GetPool(ctx context.Context, poolID string, params v1beta1.LoadBalancerPoolParameters) (*cloudflare.LoadBalancerPool, error) {
 	// Pools are account-level resources
-	rc := cloudflare.AccountIdentifier("") // Empty string means use the account from credentials
+	rc := cloudflare.AccountIdentifier(c.accountID)
 
 	pool, err := c.api.GetLoadBalancerPool(ctx, rc, poolID)
 	if err != nil {
@@ -1083,7 +1060,7 @@ func (c *poolClient) UpdatePool(ctx context.Context, poolID string, params v1bet
 	}
 
 	// Pools are account-level resources
-	rc := cloudflare.AccountIdentifier("") // Empty string means use the account from credentials
+	rc := cloudflare.AccountIdentifier(c.accountID)
 
 	updateParams := cloudflare.UpdateLoadBalancerPoolParams{
 		LoadBalancer: pool,
@@ -1100,7 +1077,7 @@ func (c *poolClient) UpdatePool(ctx context.Context, poolID string, params v1bet
 // DeletePool deletes a Cloudflare load balancer pool
 func (c *poolClient) DeletePool(ctx context.Context, poolID string, params v1beta1.LoadBalancerPoolParameters) error {
 	// Pools are account-level resources
-	rc := cloudflare.AccountIdentifier("") // Empty string means use the account from credentials
+	rc := cloudflare.AccountIdentifier(c.accountID)
 
 	err := c.api.DeleteLoadBalancerPool(ctx, rc, poolID)
 	if err != nil {
@@ -1110,18 +1087,6 @@ func (c *poolClient) DeletePool(ctx context.Context, poolID string, params v1bet
 	return nil
 }
 
-// IsPoolNotFound checks if error indicates pool not found
-func IsPoolNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	// Check for Cloudflare API not found errors
-	if cfErr := (*cloudflare.Error)(nil); errors.As(err, &cfErr) {
-		return cfErr.StatusCode == 404
-	}
-	return false
-}
-
 // IsPoolUpToDate determines if the Cloudflare load balancer pool is up to date
 func IsPoolUpToDate(params *v1beta1.LoadBalancerPoolParameters, pool *cloudflare.LoadBalancerPool) bool {
 	if params.Name != nil && *params.Name != pool.Name {
@@ -22,10 +22,13 @@ import (
 	"strconv"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/rossigee/provider-cloudflare/apis/logpush/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // LogpushJobAPI defines the interface for Logpush Job operations
@@ -44,44 +47,65 @@ const (
 	errGetJob    = "cannot get logpush job"
 	errDeleteJob = "cannot delete logpush job"
 	errListJobs  = "cannot list logpush jobs"
+
+	errResolveScope = "cannot resolve job scope"
 )
 
 // JobClient provides operations for Logpush Jobs.
 type JobClient struct {
 	client    LogpushJobAPI
 	accountID string
+	zoneID    string
 }
 
-// NewClient creates a new Logpush Job client.
-func NewClient(client LogpushJobAPI) *JobClient {
+// NewClient creates a new Logpush Job client that manages resources under
+// accountID. When accountID is empty the account is discovered from the
+// credential, which must have access to exactly one account.
+func NewClient(client LogpushJobAPI, accountID string) *JobClient {
 	return &JobClient{
 		client:    client,
-		accountID: "", // Account ID will be retrieved when needed
+		accountID: accountID,
+	}
+}
+
+// NewZoneClient creates a new Logpush Job client that manages jobs on the
+// supplied zone rather than on an account.
+func NewZoneClient(client LogpushJobAPI, zoneID string) *JobClient {
+	return &JobClient{
+		client: client,
+		zoneID: zoneID,
 	}
 }
 
-// getAccountID gets the account ID from the Cloudflare API
+// getAccountID returns the configured account ID, discovering it from
+// the Cloudflare API if none was configured.
 func (c *JobClient) getAccountID(ctx context.Context) (string, error) {
 	if c.accountID != "" {
 		return c.accountID, nil
 	}
-	
-	// Get account ID from Cloudflare API by listing accounts
-	// Most users have access to only one account, so we'll use the first one
-	accounts, _, err := c.client.Accounts(ctx, cloudflare.AccountsListParams{})
+
+	accountID, err := clients.DiscoverAccountID(ctx, c.client)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to list accounts")
+		return "", err
 	}
-	
-	if len(accounts) == 0 {
-		return "", errors.New("no accounts found")
-	}
-	
-	// Use the first account (most common case for users)
-	c.accountID = accounts[0].ID
+
+	c.accountID = accountID
 	return c.accountID, nil
 }
 
+// resourceContainer returns the zone or account that jobs are managed on.
+func (c *JobClient) resourceContainer(ctx context.Context) (*cloudflare.ResourceContainer, error) {
+	if c.zoneID != "" {
+		return cloudflare.ZoneIdentifier(c.zoneID), nil
+	}
+
+	accountID, err := c.getAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cloudflare.AccountIdentifier(accountID), nil
+}
+
 // convertToObservation converts cloudflare-go logpush job to Crossplane observation.
 func convertToObservation(job cloudflare.LogpushJob) v1beta1.JobObservation {
 	obs := v1beta1.JobObservation{
@@ -382,11 +406,10 @@ func convertToCloudflareJobFilter(filter *v1beta1.JobFilter) *cloudflare.Logpush
 
 // Create creates a new Logpush Job.
 func (c *JobClient) Create(ctx context.Context, params v1beta1.JobParameters) (*v1beta1.JobObservation, error) {
-	accountID, err := c.getAccountID(ctx)
+	rc, err := c.resourceContainer(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get account ID")
+		return nil, errors.Wrap(err, errResolveScope)
 	}
-	rc := cloudflare.AccountIdentifier(accountID)
 	
 	createParams := convertToCloudflareParams(params)
 	
@@ -401,11 +424,10 @@ func (c *JobClient) Create(ctx context.Context, params v1beta1.JobParameters) (*
 
 // Get retrieves a Logpush Job.
 func (c *JobClient) Get(ctx context.Context, jobID int) (*v1beta1.JobObservation, error) {
-	accountID, err := c.getAccountID(ctx)
+	rc, err := c.resourceContainer(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get account ID")
+		return nil, errors.Wrap(err, errResolveScope)
 	}
-	rc := cloudflare.AccountIdentifier(accountID)
 
 	job, err := c.client.GetLogpushJob(ctx, rc, jobID)
 	if err != nil {
@@ -418,11 +440,10 @@ func (c *JobClient) Get(ctx context.Context, jobID int) (*v1beta1.JobObservation
 
 // Update updates an existing Logpush Job.
 func (c *JobClient) Update(ctx context.Context, jobID int, params v1beta1.JobParameters) (*v1beta1.JobObservation, error) {
-	accountID, err := c.getAccountID(ctx)
+	rc, err := c.resourceContainer(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get account ID")
+		return nil, errors.Wrap(err, errResolveScope)
 	}
-	rc := cloudflare.AccountIdentifier(accountID)
 	
 	updateParams := cloudflare.UpdateLogpushJobParams{
 		ID:              jobID,
@@ -478,14 +499,13 @@ func (c *JobClient) Update(ctx context.Context, jobID int, params v1beta1.JobPar
 
 // Delete removes a Logpush Job.
 func (c *JobClient) Delete(ctx context.Context, jobID int) error {
-	accountID, err := c.getAccountID(ctx)
+	rc, err := c.resourceContainer(ctx)
 	if err != nil {
-		return errors.Wrap(err, "failed to get account ID")
+		return errors.Wrap(err, errResolveScope)
 	}
-	rc := cloudflare.AccountIdentifier(accountID)
 
 	err = c.client.DeleteLogpushJob(ctx, rc, jobID)
-	if err != nil && !IsJobNotFound(err) {
+	if err != nil && !cferrors.IsNotFound(err) {
 		return errors.Wrap(err, errDeleteJob)
 	}
 
@@ -494,11 +514,10 @@ func (c *JobClient) Delete(ctx context.Context, jobID int) error {
 
 // List retrieves all Logpush Jobs.
 func (c *JobClient) List(ctx context.Context) ([]v1beta1.JobObservation, error) {
-	accountID, err := c.getAccountID(ctx)
+	rc, err := c.resourceContainer(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get account ID")
+		return nil, errors.Wrap(err, errResolveScope)
 	}
-	rc := cloudflare.AccountIdentifier(accountID)
 
 	jobs, err := c.client.ListLogpushJobs(ctx, rc, cloudflare.ListLogpushJobsParams{})
 	if err != nil {
@@ -526,20 +545,92 @@ func (c *JobClient) IsUpToDate(ctx context.Context, params v1beta1.JobParameters
 		return false, nil
 	}
 
+	if !optionalStringUpToDate(params.Kind, obs.Kind) ||
+		!optionalStringUpToDate(params.Frequency, obs.Frequency) ||
+		!optionalStringUpToDate(params.LogpullOptions, obs.LogpullOptions) {
+		return false, nil
+	}
+
+	if !optionalIntUpToDate(params.MaxUploadBytes, obs.MaxUploadBytes) ||
+		!optionalIntUpToDate(params.MaxUploadRecords, obs.MaxUploadRecords) ||
+		!optionalIntUpToDate(params.MaxUploadIntervalSeconds, obs.MaxUploadIntervalSeconds) {
+		return false, nil
+	}
+
+	if params.OutputOptions != nil && !cmp.Equal(params.OutputOptions, obs.OutputOptions) {
+		return false, nil
+	}
+
+	if params.Filter != nil && !cmp.Equal(params.Filter, obs.Filter) {
+		return false, nil
+	}
+
 	return true, nil
 }
 
-// IsJobNotFound returns true if the error indicates the job was not found
-func IsJobNotFound(err error) bool {
-	if err == nil {
+// optionalStringUpToDate reports whether an optional desired value is
+// either unset or matches the observed value.
+func optionalStringUpToDate(desired, observed *string) bool {
+	return desired == nil || (observed != nil && *desired == *observed)
+}
+
+// optionalIntUpToDate reports whether an optional desired value is
+// either unset or matches the observed value.
+func optionalIntUpToDate(desired, observed *int) bool {
+	return desired == nil || (observed != nil && *desired == *observed)
+}
+
+// LateInitialize initializes JobParameters based on the remote resource.
+func LateInitialize(spec *v1beta1.JobParameters, obs v1beta1.JobObservation) bool {
+	if spec == nil {
 		return false
 	}
-	return err.Error() == "job not found" ||
-		err.Error() == "404" ||
-		err.Error() == "Not found"
+
+	li := false
+	if spec.Enabled == nil && obs.Enabled != nil {
+		spec.Enabled = obs.Enabled
+		li = true
+	}
+
+	if spec.Kind == nil && obs.Kind != nil {
+		spec.Kind = obs.Kind
+		li = true
+	}
+
+	if spec.Frequency == nil && obs.Frequency != nil {
+		spec.Frequency = obs.Frequency
+		li = true
+	}
+
+	if spec.LogpullOptions == nil && obs.LogpullOptions != nil {
+		spec.LogpullOptions = obs.LogpullOptions
+		li = true
+	}
+
+	if spec.OutputOptions == nil && obs.OutputOptions != nil {
+		spec.OutputOptions = obs.OutputOptions.DeepCopy()
+		li = true
+	}
+
+	if spec.MaxUploadBytes == nil && obs.MaxUploadBytes != nil {
+		spec.MaxUploadBytes = obs.MaxUploadBytes
+		li = true
+	}
+
+	if spec.MaxUploadRecords == nil && obs.MaxUploadRecords != nil {
+		spec.MaxUploadRecords = obs.MaxUploadRecords
+		li = true
+	}
+
+	if spec.MaxUploadIntervalSeconds == nil && obs.MaxUploadIntervalSeconds != nil {
+		spec.MaxUploadIntervalSeconds = obs.MaxUploadIntervalSeconds
+		li = true
+	}
+
+	return li
 }
 
 // ParseJobID parses a string job ID to int
 func ParseJobID(jobIDStr string) (int, error) {
 	return strconv.Atoi(jobIDStr)
-}
\ No newline at end of file
+}
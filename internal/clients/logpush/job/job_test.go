@@ -159,6 +159,27 @@ func TestGetAccountID(t *testing.T) {
 				err:       errors.New("no accounts found"),
 			},
 		},
+		"GetAccountIDAmbiguous": {
+			reason: "getAccountID should refuse to guess when the credential can see several accounts",
+			fields: fields{
+				client: &MockLogpushJobAPI{
+					MockAccounts: func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+						return []cloudflare.Account{
+							{ID: "account-a", Name: "Account A"},
+							{ID: "account-b", Name: "Account B"},
+						}, cloudflare.ResultInfo{}, nil
+					},
+				},
+				accountID: "",
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				accountID: "",
+				err:       errors.New("credential has access to 2 accounts; set accountId on the ProviderConfig or the resource"),
+			},
+		},
 		"GetAccountIDAPIError": {
 			reason: "getAccountID should return wrapped error when API call fails",
 			fields: fields{
@@ -343,7 +364,7 @@ func TestCreate(t *testing.T) {
 			},
 			want: want{
 				obs: nil,
-				err: errors.Wrap(errors.Wrap(errBoom, "failed to list accounts"), "failed to get account ID"),
+				err: errors.Wrap(errors.Wrap(errBoom, "failed to list accounts"), errResolveScope),
 			},
 		},
 		"CreateLogpushJobAPIError": {
@@ -377,7 +398,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.fields.client)
+			client := NewClient(tc.fields.client, "")
 			got, err := client.Create(tc.args.ctx, tc.args.params)
 			
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -528,7 +549,7 @@ func TestGet(t *testing.T) {
 			},
 			want: want{
 				obs: nil,
-				err: errors.Wrap(errors.Wrap(errBoom, "failed to list accounts"), "failed to get account ID"),
+				err: errors.Wrap(errors.Wrap(errBoom, "failed to list accounts"), errResolveScope),
 			},
 		},
 		"GetLogpushJobAPIError": {
@@ -558,7 +579,7 @@ func TestGet(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.fields.client)
+			client := NewClient(tc.fields.client, "")
 			got, err := client.Get(tc.args.ctx, tc.args.jobID)
 			
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -671,7 +692,7 @@ func TestUpdate(t *testing.T) {
 			},
 			want: want{
 				obs: nil,
-				err: errors.Wrap(errors.Wrap(errBoom, "failed to list accounts"), "failed to get account ID"),
+				err: errors.Wrap(errors.Wrap(errBoom, "failed to list accounts"), errResolveScope),
 			},
 		},
 		"UpdateLogpushJobAPIError": {
@@ -706,7 +727,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.fields.client)
+			client := NewClient(tc.fields.client, "")
 			got, err := client.Update(tc.args.ctx, tc.args.jobID, tc.args.params)
 			
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -783,7 +804,7 @@ func TestDelete(t *testing.T) {
 						}, cloudflare.ResultInfo{}, nil
 					},
 					MockDeleteLogpushJob: func(ctx context.Context, rc *cloudflare.ResourceContainer, jobID int) error {
-						return errors.New("job not found")
+						return &cloudflare.NotFoundError{}
 					},
 				},
 			},
@@ -809,7 +830,7 @@ func TestDelete(t *testing.T) {
 				jobID: jobID,
 			},
 			want: want{
-				err: errors.Wrap(errors.Wrap(errBoom, "failed to list accounts"), "failed to get account ID"),
+				err: errors.Wrap(errors.Wrap(errBoom, "failed to list accounts"), errResolveScope),
 			},
 		},
 		"DeleteLogpushJobAPIError": {
@@ -838,7 +859,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.fields.client)
+			client := NewClient(tc.fields.client, "")
 			err := client.Delete(tc.args.ctx, tc.args.jobID)
 			
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -969,7 +990,7 @@ func TestList(t *testing.T) {
 			},
 			want: want{
 				obs: nil,
-				err: errors.Wrap(errors.Wrap(errBoom, "failed to list accounts"), "failed to get account ID"),
+				err: errors.Wrap(errors.Wrap(errBoom, "failed to list accounts"), errResolveScope),
 			},
 		},
 		"ListLogpushJobsAPIError": {
@@ -998,7 +1019,7 @@ func TestList(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.fields.client)
+			client := NewClient(tc.fields.client, "")
 			got, err := client.List(tc.args.ctx)
 			
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -1156,7 +1177,7 @@ func TestIsUpToDate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.fields.client)
+			client := NewClient(tc.fields.client, "")
 			got, err := client.IsUpToDate(tc.args.ctx, tc.args.params, tc.args.obs)
 			
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -1169,77 +1190,6 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
-func TestIsJobNotFound(t *testing.T) {
-	type args struct {
-		err error
-	}
-
-	type want struct {
-		isNotFound bool
-	}
-
-	cases := map[string]struct {
-		reason string
-		args   args
-		want   want
-	}{
-		"NilError": {
-			reason: "IsJobNotFound should return false for nil error",
-			args: args{
-				err: nil,
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-		"JobNotFoundError": {
-			reason: "IsJobNotFound should return true for 'job not found' error",
-			args: args{
-				err: errors.New("job not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"404Error": {
-			reason: "IsJobNotFound should return true for '404' error",
-			args: args{
-				err: errors.New("404"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"NotFoundError": {
-			reason: "IsJobNotFound should return true for 'Not found' error",
-			args: args{
-				err: errors.New("Not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"OtherError": {
-			reason: "IsJobNotFound should return false for other errors",
-			args: args{
-				err: errors.New("some other error"),
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got := IsJobNotFound(tc.args.err)
-			if diff := cmp.Diff(tc.want.isNotFound, got); diff != "" {
-				t.Errorf("\n%s\nIsJobNotFound(...): -want, +got:\n%s\n", tc.reason, diff)
-			}
-		})
-	}
-}
-
 func TestParseJobID(t *testing.T) {
 	type args struct {
 		jobIDStr string
@@ -19,7 +19,6 @@ package clients
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/rossigee/provider-cloudflare/apis/workers/v1beta1"
@@ -1096,21 +1095,3 @@ func (m *MockClient) DeleteWorkerSubdomain(ctx context.Context, accountID, subdo
 	}
 	return nil
 }
-
-
-
-// NewNotFoundError creates a not found error for testing
-func NewNotFoundError(message string) error {
-	return fmt.Errorf("not found: %s", message)
-}
-
-// IsNotFound checks if an error indicates a resource was not found
-func IsNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	errMsg := err.Error()
-	return strings.Contains(errMsg, "not found") ||
-		strings.Contains(errMsg, "does not exist") ||
-		strings.Contains(errMsg, "10007") // CloudFlare Worker not found error code
-}
@@ -18,14 +18,13 @@ package certificate
 
 import (
 	"context"
-	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/rossigee/provider-cloudflare/apis/originssl/v1beta1"
-	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // OriginCACertificateAPI defines the interface for Origin CA Certificate operations
@@ -55,8 +54,8 @@ func NewClientFromAPI(api *cloudflare.API) *CloudflareOriginCertificateClient {
 func (c *CloudflareOriginCertificateClient) Get(ctx context.Context, certificateID string) (*v1beta1.CertificateObservation, error) {
 	cert, err := c.client.GetOriginCACertificate(ctx, certificateID)
 	if err != nil {
-		if isNotFound(err) {
-			return nil, clients.NewNotFoundError("origin ca certificate not found")
+		if cferrors.IsNotFound(err) {
+			return nil, cferrors.NewNotFound("origin ca certificate not found")
 		}
 		return nil, errors.Wrap(err, "cannot get origin ca certificate")
 	}
@@ -85,7 +84,7 @@ func (c *CloudflareOriginCertificateClient) Update(ctx context.Context, certific
 // Delete revokes an Origin CA certificate.
 func (c *CloudflareOriginCertificateClient) Delete(ctx context.Context, certificateID string) error {
 	_, err := c.client.RevokeOriginCACertificate(ctx, certificateID)
-	if err != nil && !isNotFound(err) {
+	if err != nil && !cferrors.IsNotFound(err) {
 		return errors.Wrap(err, "cannot revoke origin ca certificate")
 	}
 	return nil
@@ -157,15 +156,3 @@ func convertCertificateToObservation(cert *cloudflare.OriginCACertificate) *v1be
 	
 	return obs
 }
-
-// isNotFound checks if an error indicates that the certificate was not found.
-func isNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	
-	errStr := strings.ToLower(err.Error())
-	return strings.Contains(errStr, "not found") ||
-		strings.Contains(errStr, "resource not found") ||
-		strings.Contains(errStr, "certificate not found")
-}
\ No newline at end of file
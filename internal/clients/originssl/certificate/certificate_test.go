@@ -30,7 +30,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 
 	"github.com/rossigee/provider-cloudflare/apis/originssl/v1beta1"
-	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // MockOriginCACertificateAPI implements the OriginCACertificateAPI interface for testing
@@ -154,7 +154,7 @@ func TestGet(t *testing.T) {
 			fields: fields{
 				client: &MockOriginCACertificateAPI{
 					MockGetOriginCACertificate: func(ctx context.Context, certificateID string) (*cloudflare.OriginCACertificate, error) {
-						return nil, errors.New("certificate not found")
+						return nil, &cloudflare.NotFoundError{}
 					},
 				},
 			},
@@ -164,7 +164,7 @@ func TestGet(t *testing.T) {
 			},
 			want: want{
 				obs: nil,
-				err: clients.NewNotFoundError("origin ca certificate not found"),
+				err: cferrors.NewNotFound("origin ca certificate not found"),
 			},
 		},
 		"GetOriginCACertificateAPIError": {
@@ -482,7 +482,7 @@ func TestDelete(t *testing.T) {
 			fields: fields{
 				client: &MockOriginCACertificateAPI{
 					MockRevokeOriginCACertificate: func(ctx context.Context, certificateID string) (*cloudflare.OriginCACertificateID, error) {
-						return nil, errors.New("certificate not found")
+						return nil, &cloudflare.NotFoundError{}
 					},
 				},
 			},
@@ -812,82 +812,3 @@ func TestConvertCertificateToObservation(t *testing.T) {
 	}
 }
 
-func TestIsNotFound(t *testing.T) {
-	type args struct {
-		err error
-	}
-
-	type want struct {
-		isNotFound bool
-	}
-
-	cases := map[string]struct {
-		reason string
-		args   args
-		want   want
-	}{
-		"NilError": {
-			reason: "isNotFound should return false for nil error",
-			args: args{
-				err: nil,
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-		"NotFoundError": {
-			reason: "isNotFound should return true for 'not found' error",
-			args: args{
-				err: errors.New("not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"ResourceNotFoundError": {
-			reason: "isNotFound should return true for 'resource not found' error",
-			args: args{
-				err: errors.New("resource not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"CertificateNotFoundError": {
-			reason: "isNotFound should return true for 'certificate not found' error",
-			args: args{
-				err: errors.New("certificate not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"CaseInsensitiveError": {
-			reason: "isNotFound should be case insensitive",
-			args: args{
-				err: errors.New("CERTIFICATE NOT FOUND"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"OtherError": {
-			reason: "isNotFound should return false for other errors",
-			args: args{
-				err: errors.New("some other error"),
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got := isNotFound(tc.args.err)
-			if diff := cmp.Diff(tc.want.isNotFound, got); diff != "" {
-				t.Errorf("\n%s\nisNotFound(...): -want, +got:\n%s\n", tc.reason, diff)
-			}
-		})
-	}
-}
\ No newline at end of file
@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	cachev1beta1 "github.com/rossigee/provider-cloudflare/apis/cache/v1beta1"
+	dnsv1beta1 "github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	emailroutingv1beta1 "github.com/rossigee/provider-cloudflare/apis/emailrouting/v1beta1"
+	firewallv1beta1 "github.com/rossigee/provider-cloudflare/apis/firewall/v1beta1"
+	listsv1beta1 "github.com/rossigee/provider-cloudflare/apis/lists/v1beta1"
+	loadbalancingv1beta1 "github.com/rossigee/provider-cloudflare/apis/loadbalancing/v1beta1"
+	logpushv1beta1 "github.com/rossigee/provider-cloudflare/apis/logpush/v1beta1"
+	originsslv1beta1 "github.com/rossigee/provider-cloudflare/apis/originssl/v1beta1"
+	r2v1beta1 "github.com/rossigee/provider-cloudflare/apis/r2/v1beta1"
+	rulesetsv1beta1 "github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+	securityv1beta1 "github.com/rossigee/provider-cloudflare/apis/security/v1beta1"
+	spectrumv1beta1 "github.com/rossigee/provider-cloudflare/apis/spectrum/v1beta1"
+	sslv1beta1 "github.com/rossigee/provider-cloudflare/apis/ssl/v1beta1"
+	sslsaasv1beta1 "github.com/rossigee/provider-cloudflare/apis/sslsaas/v1beta1"
+	transformv1beta1 "github.com/rossigee/provider-cloudflare/apis/transform/v1beta1"
+	workersv1beta1 "github.com/rossigee/provider-cloudflare/apis/workers/v1beta1"
+	zonev1beta1 "github.com/rossigee/provider-cloudflare/apis/zone/v1beta1"
+)
+
+// Names of the Cloudflare API token permission groups managed resources
+// need.
+const (
+	permBotManagementWrite   = "Bot Management Write"
+	permCacheSettingsWrite   = "Cache Settings Write"
+	permDNSWrite             = "DNS Write"
+	permEmailRoutingWrite    = "Email Routing Rules Write"
+	permFirewallWrite        = "Firewall Services Write"
+	permLoadBalancersWrite   = "Load Balancers Write"
+	permLBMonitorsPoolsWrite = "Load Balancing: Monitors and Pools Write"
+	permLogsWrite            = "Logs Write"
+	permR2Write              = "Workers R2 Storage Write"
+	permSSLWrite             = "SSL and Certificates Write"
+	permTransformRulesWrite  = "Transform Rules Write"
+	permTurnstileWrite       = "Turnstile Sites Write"
+	permWorkersKVWrite       = "Workers KV Storage Write"
+	permWorkersRoutesWrite   = "Workers Routes Write"
+	permWorkersScriptsWrite  = "Workers Scripts Write"
+	permZoneWrite            = "Zone Write"
+)
+
+// requiredPermissions are the permission groups a credential must be
+// allowed to manage each kind of managed resource. Every kind is listed.
+// Kinds that need no permission groups are not checked; these are kinds
+// whose permissions depend on what they manage, such as rulesets in
+// different phases.
+var requiredPermissions = map[schema.GroupKind][]string{
+	cachev1beta1.CacheRuleGroupVersionKind.GroupKind():                   {permCacheSettingsWrite},
+	dnsv1beta1.RecordGroupVersionKind.GroupKind():                        {permDNSWrite},
+	dnsv1beta1.RecordSetGroupVersionKind.GroupKind():                     {permDNSWrite},
+	emailroutingv1beta1.RuleGroupVersionKind.GroupKind():                 {permEmailRoutingWrite},
+	firewallv1beta1.FilterGroupVersionKind.GroupKind():                   {permFirewallWrite},
+	firewallv1beta1.RuleGroupVersionKind.GroupKind():                     {permFirewallWrite},
+	listsv1beta1.ListGroupVersionKind.GroupKind():                        nil,
+	listsv1beta1.ListItemGroupVersionKind.GroupKind():                    nil,
+	listsv1beta1.BulkRedirectGroupVersionKind.GroupKind():                nil,
+	loadbalancingv1beta1.LoadBalancerGroupVersionKind.GroupKind():        {permLoadBalancersWrite},
+	loadbalancingv1beta1.LoadBalancerMonitorGroupVersionKind.GroupKind(): {permLBMonitorsPoolsWrite},
+	loadbalancingv1beta1.LoadBalancerPoolGroupVersionKind.GroupKind():    {permLBMonitorsPoolsWrite},
+	logpushv1beta1.JobGroupVersionKind.GroupKind():                       {permLogsWrite},
+	originsslv1beta1.CertificateGroupVersionKind.GroupKind():             {permSSLWrite},
+	r2v1beta1.BucketGroupVersionKind.GroupKind():                         {permR2Write},
+	rulesetsv1beta1.RulesetGroupVersionKind.GroupKind():                  nil,
+	rulesetsv1beta1.PhaseRuleGroupVersionKind.GroupKind():                nil,
+	rulesetsv1beta1.ManagedRulesetDeploymentGroupVersionKind.GroupKind(): nil,
+	securityv1beta1.BotManagementGroupVersionKind.GroupKind():            {permBotManagementWrite},
+	securityv1beta1.RateLimitGroupVersionKind.GroupKind():                nil,
+	securityv1beta1.TurnstileGroupVersionKind.GroupKind():                {permTurnstileWrite},
+	spectrumv1beta1.ApplicationGroupVersionKind.GroupKind():              nil,
+	sslv1beta1.CertificatePackGroupVersionKind.GroupKind():               {permSSLWrite},
+	sslv1beta1.TotalTLSGroupVersionKind.GroupKind():                      {permSSLWrite},
+	sslv1beta1.UniversalSSLGroupVersionKind.GroupKind():                  {permSSLWrite},
+	sslsaasv1beta1.CustomHostnameGroupVersionKind.GroupKind():            {permSSLWrite},
+	sslsaasv1beta1.FallbackOriginGroupVersionKind.GroupKind():            {permSSLWrite},
+	transformv1beta1.RuleGroupVersionKind.GroupKind():                    {permTransformRulesWrite},
+	workersv1beta1.CronTriggerGroupVersionKind.GroupKind():               {permWorkersScriptsWrite},
+	workersv1beta1.DomainGroupVersionKind.GroupKind():                    {permWorkersScriptsWrite},
+	workersv1beta1.KVNamespaceGroupVersionKind.GroupKind():               {permWorkersKVWrite},
+	workersv1beta1.RouteGroupVersionKind.GroupKind():                     {permWorkersRoutesWrite},
+	workersv1beta1.ScriptGroupVersionKind.GroupKind():                    {permWorkersScriptsWrite},
+	workersv1beta1.SubdomainGroupVersionKind.GroupKind():                 {permWorkersScriptsWrite},
+	zonev1beta1.ZoneGroupVersionKind.GroupKind():                         {permZoneWrite},
+}
+
+// RequiredPermissions returns the permission groups a credential must be
+// allowed to manage the supplied kind of managed resource.
+func RequiredPermissions(gk schema.GroupKind) []string {
+	return requiredPermissions[gk]
+}
+
+// MissingPermissions returns the permission groups needed by the supplied
+// kind of managed resource that are not among those granted, sorted by
+// name.
+func MissingPermissions(gk schema.GroupKind, granted []string) []string {
+	has := make(map[string]bool, len(granted))
+	for _, g := range granted {
+		has[g] = true
+	}
+	var missing []string
+	for _, p := range RequiredPermissions(gk) {
+		if !has[p] {
+			missing = append(missing, p)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis"
+	dnsv1beta1 "github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	rulesetsv1beta1 "github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+)
+
+func TestRequiredPermissionsCoverEveryKind(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme(...): %v", err)
+	}
+
+	for gvk, typ := range s.AllKnownTypes() {
+		if _, ok := reflect.New(typ).Interface().(resource.Managed); !ok {
+			continue
+		}
+		if _, ok := requiredPermissions[gvk.GroupKind()]; !ok {
+			t.Errorf("requiredPermissions: managed resource kind %s is not listed", gvk.GroupKind())
+		}
+	}
+}
+
+func TestMissingPermissions(t *testing.T) {
+	record := dnsv1beta1.RecordGroupVersionKind.GroupKind()
+
+	cases := map[string]struct {
+		reason  string
+		gk      schema.GroupKind
+		granted []string
+		want    []string
+	}{
+		"Granted": {
+			reason:  "No permission groups should be missing when all are granted.",
+			gk:      record,
+			granted: []string{"Zone Write", "DNS Write"},
+		},
+		"Missing": {
+			reason:  "Permission groups a kind needs that are not granted should be missing.",
+			gk:      record,
+			granted: []string{"DNS Read"},
+			want:    []string{"DNS Write"},
+		},
+		"NotChecked": {
+			reason: "Kinds that need no permission groups should never be missing any.",
+			gk:     rulesetsv1beta1.RulesetGroupVersionKind.GroupKind(),
+		},
+		"UnknownKind": {
+			reason: "Unknown kinds should never be missing any permission groups.",
+			gk:     schema.GroupKind{Group: "example.org", Kind: "Unknown"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := MissingPermissions(tc.gk, tc.granted)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nMissingPermissions(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package phaselock serializes writes to the entrypoint ruleset of a phase.
+//
+// Several controllers edit the entrypoint ruleset of a phase by reading it,
+// changing their own rules and writing it back. Writers of the same ruleset
+// must hold its lock so that concurrent reconciles do not overwrite each
+// other's changes. Locks are held in process, which is sufficient because
+// only the elected leader reconciles.
+package phaselock
+
+import (
+	"context"
+	"sync"
+)
+
+// A Key identifies the entrypoint ruleset of a phase in a zone or account.
+type Key struct {
+	// Container is the zone or account the ruleset belongs to, in the form
+	// used by the API, i.e. "zones/<id>" or "accounts/<id>".
+	Container string
+
+	// Phase is the phase of the ruleset.
+	Phase string
+}
+
+// ZoneKey returns the key of the entrypoint ruleset of a phase in a zone.
+func ZoneKey(zoneID, phase string) Key {
+	return Key{Container: "zones/" + zoneID, Phase: phase}
+}
+
+// AccountKey returns the key of the entrypoint ruleset of a phase in an
+// account.
+func AccountKey(accountID, phase string) Key {
+	return Key{Container: "accounts/" + accountID, Phase: phase}
+}
+
+// A Locker hands out one lock per key.
+type Locker struct {
+	mu    sync.Mutex
+	locks map[Key]*lock
+}
+
+type lock struct {
+	held chan struct{}
+	refs int
+}
+
+// New returns a Locker without any locks held.
+func New() *Locker {
+	return &Locker{locks: map[Key]*lock{}}
+}
+
+// Lock blocks until the lock for the key is acquired or the context is done.
+// It returns a function that releases the lock.
+func (l *Locker) Lock(ctx context.Context, k Key) (func(), error) {
+	l.mu.Lock()
+	lk, ok := l.locks[k]
+	if !ok {
+		lk = &lock{held: make(chan struct{}, 1)}
+		l.locks[k] = lk
+	}
+	lk.refs++
+	l.mu.Unlock()
+
+	select {
+	case lk.held <- struct{}{}:
+		return func() {
+			<-lk.held
+			l.release(k, lk)
+		}, nil
+	case <-ctx.Done():
+		l.release(k, lk)
+		return nil, ctx.Err()
+	}
+}
+
+// release drops a reference to the lock of the key, forgetting the lock
+// once nobody holds or waits for it.
+func (l *Locker) release(k Key, lk *lock) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	lk.refs--
+	if lk.refs == 0 {
+		delete(l.locks, k)
+	}
+}
+
+// held returns the number of keys with a lock held or waited for.
+func (l *Locker) held() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.locks)
+}
+
+var defaultLocker = New()
+
+// Lock acquires the process wide lock for the key. See Locker.Lock.
+func Lock(ctx context.Context, k Key) (func(), error) {
+	return defaultLocker.Lock(ctx, k)
+}
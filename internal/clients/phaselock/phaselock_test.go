@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package phaselock
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestLockSerializes(t *testing.T) {
+	l := New()
+	k := ZoneKey("zone", "http_request_cache_settings")
+
+	// Each writer reads the shared value, yields and writes it back; without
+	// the lock increments would be lost.
+	value := 0
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock, err := l.Lock(context.Background(), k)
+			if err != nil {
+				t.Errorf("Lock(...): unexpected error: %v", err)
+				return
+			}
+			defer unlock()
+			v := value
+			time.Sleep(time.Millisecond)
+			value = v + 1
+		}()
+	}
+	wg.Wait()
+
+	if diff := cmp.Diff(50, value); diff != "" {
+		t.Errorf("Lock(...): -want value, +got value:\n%s", diff)
+	}
+	if diff := cmp.Diff(0, l.held()); diff != "" {
+		t.Errorf("Lock(...): -want held locks, +got held locks:\n%s", diff)
+	}
+}
+
+func TestLockKeys(t *testing.T) {
+	l := New()
+
+	unlock, err := l.Lock(context.Background(), ZoneKey("zone", "phase"))
+	if err != nil {
+		t.Fatalf("Lock(...): unexpected error: %v", err)
+	}
+	defer unlock()
+
+	// Other phases, zones and accounts must not wait for the lock.
+	for _, k := range []Key{ZoneKey("zone", "other"), ZoneKey("other", "phase"), AccountKey("zone", "phase")} {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		u, err := l.Lock(ctx, k)
+		cancel()
+		if err != nil {
+			t.Fatalf("Lock(%v): unexpected error: %v", k, err)
+		}
+		u()
+	}
+}
+
+func TestLockContextDone(t *testing.T) {
+	l := New()
+	k := AccountKey("account", "http_request_redirect")
+
+	unlock, err := l.Lock(context.Background(), k)
+	if err != nil {
+		t.Fatalf("Lock(...): unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = l.Lock(ctx, k)
+	if diff := cmp.Diff(context.Canceled, errors.Cause(err), test.EquateErrors()); diff != "" {
+		t.Errorf("Lock(...): -want error, +got error:\n%s", diff)
+	}
+
+	unlock()
+	if diff := cmp.Diff(0, l.held()); diff != "" {
+		t.Errorf("Lock(...): -want held locks, +got held locks:\n%s", diff)
+	}
+}
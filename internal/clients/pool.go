@@ -0,0 +1,169 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+)
+
+// DefaultPool is the Pool shared by every controller in the provider.
+var DefaultPool = NewPool()
+
+// A Pool holds a Session per ProviderConfig, so that API clients and the
+// lookups made with them outlive a single reconcile. A ProviderConfig's
+// Session is replaced when its credential changes.
+type Pool struct {
+	mu       sync.Mutex
+	sessions map[types.UID]*Session
+}
+
+// NewPool returns an empty Pool.
+func NewPool() *Pool {
+	return &Pool{sessions: make(map[types.UID]*Session)}
+}
+
+// Session returns the Session of the supplied ProviderConfig. The Session
+// is created, or replaced, unless the existing one was made with the same
+// credential.
+func (p *Pool) Session(uid types.UID, credential []byte) *Session {
+	sum := sha256.Sum256(credential)
+	hash := hex.EncodeToString(sum[:])
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if s, ok := p.sessions[uid]; ok && s.credential == hash {
+		return s
+	}
+	s := &Session{
+		credential: hash,
+		apis:       make(map[*http.Client]*cloudflare.API),
+		values:     make(map[any]any),
+	}
+	p.sessions[uid] = s
+	return s
+}
+
+// Forget drops the Session of the supplied ProviderConfig.
+func (p *Pool) Forget(uid types.UID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.sessions, uid)
+}
+
+// A Session holds the API clients made with one ProviderConfig credential,
+// and caches lookups that rarely change for as long as the credential
+// does.
+type Session struct {
+	credential string
+
+	mu        sync.Mutex
+	apis      map[*http.Client]*cloudflare.API
+	accountID string
+	values    map[any]any
+
+	verified   *v1beta1.CredentialStatus
+	verifyErr  error
+	verifiedAt time.Time
+}
+
+// api returns the client made for the supplied HTTP client, making it with
+// fn if there is none.
+func (s *Session) api(hc *http.Client, fn func() (*cloudflare.API, error)) (*cloudflare.API, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if api, ok := s.apis[hc]; ok {
+		return api, nil
+	}
+	api, err := fn()
+	if err != nil {
+		return nil, err
+	}
+	s.apis[hc] = api
+	return api, nil
+}
+
+// account returns the discovered account ID, discovering it with fn if it
+// is not yet known.
+func (s *Session) account(ctx context.Context, fn func(ctx context.Context) (string, error)) (string, error) {
+	s.mu.Lock()
+	id := s.accountID
+	s.mu.Unlock()
+	if id != "" {
+		return id, nil
+	}
+
+	id, err := fn(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	s.accountID = id
+	s.mu.Unlock()
+	return id, nil
+}
+
+// Value returns the value stored under key, storing the result of fn if
+// there is none. Clients use it to keep caches of API responses for as
+// long as the credential is unchanged, rather than for a single reconcile.
+func (s *Session) Value(key any, fn func() any) any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if v, ok := s.values[key]; ok {
+		return v
+	}
+	v := fn()
+	s.values[key] = v
+	return v
+}
+
+// verification returns the result of verifying the credential, verifying
+// it with fn if it has not been verified within ttl. Only results that
+// depend on the credential, rather than on whether Cloudflare could be
+// reached, are cached.
+func (s *Session) verification(ctx context.Context, ttl time.Duration, fn func(ctx context.Context) (*v1beta1.CredentialStatus, error)) (*v1beta1.CredentialStatus, error) {
+	s.mu.Lock()
+	if !s.verifiedAt.IsZero() && time.Since(s.verifiedAt) < ttl {
+		defer s.mu.Unlock()
+		return s.verified, s.verifyErr
+	}
+	s.mu.Unlock()
+
+	cs, err := fn(ctx)
+	if err != nil && !cferrors.IsAuth(err) {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.verified, s.verifyErr, s.verifiedAt = cs, err, time.Now()
+	s.mu.Unlock()
+	return cs, err
+}
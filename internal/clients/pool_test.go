@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+)
+
+func TestPoolSession(t *testing.T) {
+	p := NewPool()
+
+	s := p.Session("pc-a", []byte("token-1"))
+	if got := p.Session("pc-a", []byte("token-1")); got != s {
+		t.Errorf("Session(...): want the same Session for an unchanged credential")
+	}
+	if got := p.Session("pc-b", []byte("token-1")); got == s {
+		t.Errorf("Session(...): want a different Session for a different ProviderConfig")
+	}
+
+	rotated := p.Session("pc-a", []byte("token-2"))
+	if rotated == s {
+		t.Errorf("Session(...): want a new Session when the credential changes")
+	}
+	if got := p.Session("pc-a", []byte("token-2")); got != rotated {
+		t.Errorf("Session(...): want the new Session to replace the old one")
+	}
+
+	p.Forget("pc-a")
+	if got := p.Session("pc-a", []byte("token-2")); got == rotated {
+		t.Errorf("Session(...): want a new Session after Forget")
+	}
+}
+
+func TestNewClientSession(t *testing.T) {
+	cfg := Config{
+		AuthByAPIToken: &AuthByAPIToken{Token: ptr.To("beef")},
+		Session:        NewPool().Session("pc", []byte("beef")),
+	}
+	hc := &http.Client{}
+
+	a, err := NewClient(cfg, hc)
+	if err != nil {
+		t.Fatalf("NewClient(...): %v", err)
+	}
+	b, err := NewClient(cfg, hc)
+	if err != nil {
+		t.Fatalf("NewClient(...): %v", err)
+	}
+	if a != b {
+		t.Errorf("NewClient(...): want the client to be reused for the same HTTP client")
+	}
+
+	c, err := NewClient(cfg, &http.Client{})
+	if err != nil {
+		t.Fatalf("NewClient(...): %v", err)
+	}
+	if c == a {
+		t.Errorf("NewClient(...): want a different client for a different HTTP client")
+	}
+
+	if _, err := NewClient(Config{Session: cfg.Session}, &http.Client{}); err == nil {
+		t.Errorf("NewClient(...): want an error for a configuration without credentials")
+	}
+}
+
+func TestResolveAccountIDSession(t *testing.T) {
+	api := &fakeAccountLister{accounts: []cloudflare.Account{{ID: "only"}}}
+	cfg := Config{Session: NewPool().Session("pc", []byte("beef"))}
+
+	for i := 0; i < 3; i++ {
+		id, err := ResolveAccountID(context.Background(), api, nil, cfg)
+		if err != nil {
+			t.Fatalf("ResolveAccountID(...): %v", err)
+		}
+		if id != "only" {
+			t.Errorf("ResolveAccountID(...): want only, got %s", id)
+		}
+	}
+	if api.calls != 1 {
+		t.Errorf("ResolveAccountID(...): want 1 call to list accounts, got %d", api.calls)
+	}
+
+	failing := &fakeAccountLister{err: errors.New("boom")}
+	cfg = Config{Session: NewPool().Session("pc", []byte("beef"))}
+	for i := 0; i < 2; i++ {
+		if _, err := ResolveAccountID(context.Background(), failing, nil, cfg); err == nil {
+			t.Errorf("ResolveAccountID(...): want an error")
+		}
+	}
+	if failing.calls != 2 {
+		t.Errorf("ResolveAccountID(...): want failed lookups to be retried, got %d calls", failing.calls)
+	}
+}
+
+func TestSessionValue(t *testing.T) {
+	type key string
+	s := NewPool().Session("pc", []byte("beef"))
+
+	made := 0
+	fn := func() any {
+		made++
+		return &made
+	}
+	first := s.Value(key("a"), fn)
+	if got := s.Value(key("a"), fn); got != first {
+		t.Errorf("Value(...): want the stored value for a known key")
+	}
+	if made != 1 {
+		t.Errorf("Value(...): want 1 value made, got %d", made)
+	}
+
+	s.Value(key("b"), fn)
+	if made != 2 {
+		t.Errorf("Value(...): want a value made for a new key, got %d", made)
+	}
+}
+
+func TestSessionVerification(t *testing.T) {
+	s := NewPool().Session("pc", []byte("beef"))
+	calls := 0
+	verify := func(err error) func(context.Context) (*v1beta1.CredentialStatus, error) {
+		return func(context.Context) (*v1beta1.CredentialStatus, error) {
+			calls++
+			return &v1beta1.CredentialStatus{TokenID: "tok"}, err
+		}
+	}
+
+	if _, err := s.verification(context.Background(), time.Hour, verify(errors.New("unreachable"))); err == nil {
+		t.Fatal("verification(...): want an error")
+	}
+	if _, err := s.verification(context.Background(), time.Hour, verify(nil)); err != nil {
+		t.Fatalf("verification(...): %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("verification(...): want a failure to reach Cloudflare not to be cached, got %d verifications", calls)
+	}
+
+	cs, err := s.verification(context.Background(), time.Hour, verify(nil))
+	if err != nil || cs.TokenID != "tok" {
+		t.Fatalf("verification(...): want the cached result, got %v, %v", cs, err)
+	}
+	if calls != 2 {
+		t.Errorf("verification(...): want a fresh result to be reused, got %d verifications", calls)
+	}
+
+	rejected := cferrors.New(cferrors.Auth, "API token is disabled")
+	if _, err := s.verification(context.Background(), 0, verify(rejected)); err != rejected {
+		t.Fatalf("verification(...): want %v, got %v", rejected, err)
+	}
+	if _, err := s.verification(context.Background(), time.Hour, verify(nil)); err != rejected {
+		t.Errorf("verification(...): want a rejection to be cached, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("verification(...): want a stale result to be verified again, got %d verifications", calls)
+	}
+}
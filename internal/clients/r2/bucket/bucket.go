@@ -24,6 +24,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/rossigee/provider-cloudflare/apis/r2/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // R2BucketAPI defines the interface for R2 Bucket operations
@@ -49,33 +51,29 @@ type BucketClient struct {
 	accountID string
 }
 
-// NewClient creates a new R2 Bucket client.
-func NewClient(client R2BucketAPI) *BucketClient {
+// NewClient creates a new R2 Bucket client that manages resources under
+// accountID. When accountID is empty the account is discovered from the
+// credential, which must have access to exactly one account.
+func NewClient(client R2BucketAPI, accountID string) *BucketClient {
 	return &BucketClient{
 		client:    client,
-		accountID: "", // Account ID will be retrieved when needed
+		accountID: accountID,
 	}
 }
 
-// getAccountID gets the account ID from the Cloudflare API
+// getAccountID returns the configured account ID, discovering it from
+// the Cloudflare API if none was configured.
 func (c *BucketClient) getAccountID(ctx context.Context) (string, error) {
 	if c.accountID != "" {
 		return c.accountID, nil
 	}
-	
-	// Get account ID from Cloudflare API by listing accounts
-	// Most users have access to only one account, so we'll use the first one
-	accounts, _, err := c.client.Accounts(ctx, cloudflare.AccountsListParams{})
+
+	accountID, err := clients.DiscoverAccountID(ctx, c.client)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to list accounts")
-	}
-	
-	if len(accounts) == 0 {
-		return "", errors.New("no accounts found")
+		return "", err
 	}
-	
-	// Use the first account (most common case for users)
-	c.accountID = accounts[0].ID
+
+	c.accountID = accountID
 	return c.accountID, nil
 }
 
@@ -151,7 +149,7 @@ func (c *BucketClient) Delete(ctx context.Context, bucketName string) error {
 	rc := cloudflare.AccountIdentifier(accountID)
 
 	err = c.client.DeleteR2Bucket(ctx, rc, bucketName)
-	if err != nil && !IsBucketNotFound(err) {
+	if err != nil && !cferrors.IsNotFound(err) {
 		return errors.Wrap(err, errDeleteBucket)
 	}
 
@@ -185,13 +183,3 @@ func (c *BucketClient) IsUpToDate(ctx context.Context, params v1beta1.BucketPara
 	// Main check is if the bucket exists with the correct name
 	return obs.Name == params.Name, nil
 }
-
-// IsBucketNotFound returns true if the error indicates the bucket was not found
-func IsBucketNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return err.Error() == "bucket not found" ||
-		err.Error() == "404" ||
-		err.Error() == "Not found"
-}
\ No newline at end of file
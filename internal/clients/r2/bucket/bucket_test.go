@@ -151,6 +151,27 @@ func TestGetAccountID(t *testing.T) {
 				err:       errors.New("no accounts found"),
 			},
 		},
+		"GetAccountIDAmbiguous": {
+			reason: "getAccountID should refuse to guess when the credential can see several accounts",
+			fields: fields{
+				client: &MockR2BucketAPI{
+					MockAccounts: func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+						return []cloudflare.Account{
+							{ID: "account-a", Name: "Account A"},
+							{ID: "account-b", Name: "Account B"},
+						}, cloudflare.ResultInfo{}, nil
+					},
+				},
+				accountID: "",
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				accountID: "",
+				err:       errors.New("credential has access to 2 accounts; set accountId on the ProviderConfig or the resource"),
+			},
+		},
 		"GetAccountIDAPIError": {
 			reason: "getAccountID should return wrapped error when API call fails",
 			fields: fields{
@@ -339,7 +360,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.fields.client)
+			client := NewClient(tc.fields.client, "")
 			got, err := client.Create(tc.args.ctx, tc.args.params)
 			
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -491,7 +512,7 @@ func TestGet(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.fields.client)
+			client := NewClient(tc.fields.client, "")
 			got, err := client.Get(tc.args.ctx, tc.args.bucketName)
 			
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -568,7 +589,7 @@ func TestDelete(t *testing.T) {
 						}, cloudflare.ResultInfo{}, nil
 					},
 					MockDeleteR2Bucket: func(ctx context.Context, rc *cloudflare.ResourceContainer, bucketName string) error {
-						return errors.New("bucket not found")
+						return &cloudflare.NotFoundError{}
 					},
 				},
 			},
@@ -623,7 +644,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.fields.client)
+			client := NewClient(tc.fields.client, "")
 			err := client.Delete(tc.args.ctx, tc.args.bucketName)
 			
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -772,7 +793,7 @@ func TestList(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.fields.client)
+			client := NewClient(tc.fields.client, "")
 			got, err := client.List(tc.args.ctx)
 			
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -851,7 +872,7 @@ func TestIsUpToDate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.fields.client)
+			client := NewClient(tc.fields.client, "")
 			got, err := client.IsUpToDate(tc.args.ctx, tc.args.params, tc.args.obs)
 			
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -1007,74 +1028,3 @@ func TestConvertToCloudflareParams(t *testing.T) {
 		})
 	}
 }
-
-func TestIsBucketNotFound(t *testing.T) {
-	type args struct {
-		err error
-	}
-
-	type want struct {
-		isNotFound bool
-	}
-
-	cases := map[string]struct {
-		reason string
-		args   args
-		want   want
-	}{
-		"NilError": {
-			reason: "IsBucketNotFound should return false for nil error",
-			args: args{
-				err: nil,
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-		"BucketNotFoundError": {
-			reason: "IsBucketNotFound should return true for 'bucket not found' error",
-			args: args{
-				err: errors.New("bucket not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"404Error": {
-			reason: "IsBucketNotFound should return true for '404' error",
-			args: args{
-				err: errors.New("404"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"NotFoundError": {
-			reason: "IsBucketNotFound should return true for 'Not found' error",
-			args: args{
-				err: errors.New("Not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"OtherError": {
-			reason: "IsBucketNotFound should return false for other errors",
-			args: args{
-				err: errors.New("some other error"),
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got := IsBucketNotFound(tc.args.err)
-			if diff := cmp.Diff(tc.want.isNotFound, got); diff != "" {
-				t.Errorf("\n%s\nIsBucketNotFound(...): -want, +got:\n%s\n", tc.reason, diff)
-			}
-		})
-	}
-}
\ No newline at end of file
@@ -0,0 +1,209 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package records
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+)
+
+const (
+	errDataBlock      = "data of a %s record must set %s"
+	errDataOtherBlock = "data of a %s record must not set %s"
+	errDecodeData     = "cannot decode record data"
+)
+
+// Folds of the data fields that Cloudflare normalizes, keyed by record type
+// and field. Folded values are compared rather than the values themselves.
+var dataFolds = map[string]func(string) string{
+	"CAA.tag":            strings.ToLower,
+	"CERT.certificate":   withoutSpaces,
+	"DNSKEY.public_key":  withoutSpaces,
+	"DS.digest":          strings.ToLower,
+	"NAPTR.replacement":  hostname,
+	"SMIMEA.certificate": strings.ToLower,
+	"SRV.target":         hostname,
+	"SSHFP.fingerprint":  strings.ToLower,
+	"TLSA.certificate":   strings.ToLower,
+}
+
+// ConvertData returns the data Cloudflare expects for the supplied record,
+// or nil if the record is described by its content. SRV records that set
+// priority, weight and port, and TLSA records whose content holds their
+// fields, are converted to data too.
+func ConvertData(spec *v1beta1.RecordParameters) (map[string]interface{}, error) {
+	rtype := ptr.Deref(spec.Type, "A")
+	if spec.Data != nil {
+		blocks, err := dataBlocks(spec.Data)
+		if err != nil {
+			return nil, err
+		}
+		block := strings.ToLower(rtype)
+		data, ok := blocks[block]
+		if !ok {
+			return nil, errors.Errorf(errDataBlock, rtype, block)
+		}
+		for name := range blocks {
+			if name != block {
+				return nil, errors.Errorf(errDataOtherBlock, rtype, name)
+			}
+		}
+		return renameKeys(data, snakeCase), nil
+	}
+
+	switch rtype {
+	case "SRV":
+		if spec.Priority == nil || spec.Weight == nil || spec.Port == nil {
+			return nil, nil
+		}
+		return map[string]interface{}{
+			"priority": int(*spec.Priority),
+			"weight":   int(*spec.Weight),
+			"port":     int(*spec.Port),
+			"target":   spec.Content,
+		}, nil
+	case "TLSA":
+		return parseTLSAContent(spec.Content)
+	}
+	return nil, nil
+}
+
+// GenerateData returns the typed data of a Cloudflare record of the
+// supplied type, or nil if it has none.
+func GenerateData(rtype string, data interface{}) *v1beta1.RecordData {
+	values, err := toMap(data)
+	if err != nil || len(values) == 0 {
+		return nil
+	}
+	raw, err := json.Marshal(map[string]interface{}{strings.ToLower(rtype): renameKeys(values, camelCase)})
+	if err != nil {
+		return nil
+	}
+	out := &v1beta1.RecordData{}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return nil
+	}
+	if blocks, err := dataBlocks(out); err != nil || len(blocks) == 0 {
+		return nil
+	}
+	return out
+}
+
+// dataUpToDate returns true if the data of a Cloudflare record has all the
+// wanted fields, once folded as Cloudflare folds them. Fields that are not
+// wanted are ignored.
+func dataUpToDate(rtype string, want map[string]interface{}, got interface{}) bool {
+	w, err := toMap(want)
+	if err != nil {
+		return false
+	}
+	g, err := toMap(got)
+	if err != nil {
+		return false
+	}
+	for k, wv := range w {
+		gv, ok := g[k]
+		if !ok {
+			return false
+		}
+		if fold, ok := dataFolds[rtype+"."+k]; ok {
+			ws, wok := wv.(string)
+			gs, gok := gv.(string)
+			if wok && gok {
+				wv, gv = fold(ws), fold(gs)
+			}
+		}
+		if wv != gv {
+			return false
+		}
+	}
+	return true
+}
+
+// dataBlocks returns the blocks that are set in the supplied data, keyed by
+// their lower case record type.
+func dataBlocks(d *v1beta1.RecordData) (map[string]map[string]interface{}, error) {
+	raw, err := json.Marshal(d)
+	if err != nil {
+		return nil, errors.Wrap(err, errDecodeData)
+	}
+	blocks := map[string]map[string]interface{}{}
+	return blocks, errors.Wrap(json.Unmarshal(raw, &blocks), errDecodeData)
+}
+
+// toMap returns the supplied data as decoded JSON, so that values of any Go
+// type can be compared.
+func toMap(data interface{}) (map[string]interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errDecodeData)
+	}
+	m := map[string]interface{}{}
+	return m, errors.Wrap(json.Unmarshal(raw, &m), errDecodeData)
+}
+
+// renameKeys returns a copy of the supplied map whose keys are renamed.
+func renameKeys(in map[string]interface{}, rename func(string) string) map[string]interface{} {
+	out := make(map[string]interface{}, len(in))
+	for k, v := range in {
+		out[rename(k)] = v
+	}
+	return out
+}
+
+// snakeCase returns a camel case field name, such as matchingType, as
+// Cloudflare names it, such as matching_type.
+func snakeCase(s string) string {
+	b := strings.Builder{}
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			b.WriteByte('_')
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// camelCase returns a field name as Cloudflare names it, such as
+// matching_type, in camel case, such as matchingType.
+func camelCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+func withoutSpaces(s string) string {
+	return strings.Join(strings.Fields(s), "")
+}
+
+func hostname(s string) string {
+	return strings.ToLower(strings.TrimSuffix(s, "."))
+}
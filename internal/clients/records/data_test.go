@@ -0,0 +1,151 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package records
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+)
+
+func TestConvertData(t *testing.T) {
+	type want struct {
+		data map[string]interface{}
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		spec   *v1beta1.RecordParameters
+		want   want
+	}{
+		"NoData": {
+			reason: "A record without data should be described by its content.",
+			spec:   &v1beta1.RecordParameters{Type: ptr.To("A"), Content: "192.0.2.1"},
+		},
+		"CAA": {
+			reason: "The fields of a data block should be named as Cloudflare names them.",
+			spec: &v1beta1.RecordParameters{Type: ptr.To("CAA"), Data: &v1beta1.RecordData{
+				CAA: &v1beta1.CAARecordData{Flags: 0, Tag: "issue", Value: "letsencrypt.org"},
+			}},
+			want: want{data: map[string]interface{}{"flags": float64(0), "tag": "issue", "value": "letsencrypt.org"}},
+		},
+		"LOC": {
+			reason: "Fields with more than one word should be converted to snake case.",
+			spec: &v1beta1.RecordParameters{Type: ptr.To("LOC"), Data: &v1beta1.RecordData{
+				LOC: &v1beta1.LOCRecordData{
+					LatDegrees: 51, LatMinutes: 30, LatSeconds: 12.5, LatDirection: "N",
+					LongDegrees: 0, LongDirection: "W", PrecisionHorz: 10,
+				},
+			}},
+			want: want{data: map[string]interface{}{
+				"lat_degrees": float64(51), "lat_minutes": float64(30), "lat_seconds": 12.5, "lat_direction": "N",
+				"long_degrees": float64(0), "long_direction": "W", "precision_horz": float64(10),
+			}},
+		},
+		"MissingBlock": {
+			reason: "Data that does not set the block of the record type should be an error.",
+			spec: &v1beta1.RecordParameters{Type: ptr.To("SRV"), Data: &v1beta1.RecordData{
+				URI: &v1beta1.URIRecordData{Weight: 1, Target: "https://example.org"},
+			}},
+			want: want{err: errors.Errorf(errDataBlock, "SRV", "srv")},
+		},
+		"OtherBlock": {
+			reason: "Data that sets the block of another record type should be an error.",
+			spec: &v1beta1.RecordParameters{Type: ptr.To("SRV"), Data: &v1beta1.RecordData{
+				SRV: &v1beta1.SRVRecordData{Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.org"},
+				URI: &v1beta1.URIRecordData{Weight: 1, Target: "https://example.org"},
+			}},
+			want: want{err: errors.Errorf(errDataOtherBlock, "SRV", "uri")},
+		},
+		"LegacySRV": {
+			reason: "An SRV record that sets priority, weight and port should be converted to data.",
+			spec: &v1beta1.RecordParameters{
+				Type: ptr.To("SRV"), Content: "sip.example.org",
+				Priority: ptr.To[int32](10), Weight: ptr.To[int32](5), Port: ptr.To[int32](5060),
+			},
+			want: want{data: map[string]interface{}{"priority": 10, "weight": 5, "port": 5060, "target": "sip.example.org"}},
+		},
+		"LegacyTLSA": {
+			reason: "A TLSA record whose content holds its fields should be converted to data.",
+			spec:   &v1beta1.RecordParameters{Type: ptr.To("TLSA"), Content: "3 1 1 abcd"},
+			want:   want{data: map[string]interface{}{"usage": 3, "selector": 1, "matching_type": 1, "certificate": "abcd"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ConvertData(tc.spec)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nConvertData(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.data, got); diff != "" {
+				t.Errorf("\n%s\nConvertData(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGenerateData(t *testing.T) {
+	type args struct {
+		rtype string
+		data  interface{}
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   *v1beta1.RecordData
+	}{
+		"NoData": {
+			reason: "A record without data should have no observed data.",
+			args:   args{rtype: "A"},
+		},
+		"TLSA": {
+			reason: "The data of a record should be observed in the block of its type.",
+			args: args{rtype: "TLSA", data: map[string]interface{}{
+				"usage": float64(3), "selector": float64(1), "matching_type": float64(1), "certificate": "abcd",
+			}},
+			want: &v1beta1.RecordData{TLSA: &v1beta1.TLSARecordData{Usage: 3, Selector: 1, MatchingType: 1, Certificate: "abcd"}},
+		},
+		"UnknownFields": {
+			reason: "Fields of the data that have no typed field should be ignored.",
+			args: args{rtype: "SRV", data: map[string]interface{}{
+				"priority": float64(10), "weight": float64(5), "port": float64(5060), "target": "sip.example.org", "service": "_sip",
+			}},
+			want: &v1beta1.RecordData{SRV: &v1beta1.SRVRecordData{Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.org"}},
+		},
+		"UnknownType": {
+			reason: "The data of a type with no typed block should not be observed.",
+			args:   args{rtype: "HTTPS", data: map[string]interface{}{"priority": float64(1), "target": "."}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateData(tc.args.rtype, tc.args.data)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nGenerateData(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -20,38 +20,50 @@ import (
 	"context"
 
 	"github.com/cloudflare/cloudflare-go"
+
+	"github.com/rossigee/provider-cloudflare/internal/clients/records"
 )
 
 // A MockClient acts as a testable representation of the Cloudflare API.
 type MockClient struct {
-	MockCreateDNSRecord func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error)
-	MockUpdateDNSRecord func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error)
-	MockGetDNSRecord    func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error)
+	MockCreateDNSRecord func(ctx context.Context, rc *cloudflare.ResourceContainer, params records.CreateParams) (records.Record, error)
+	MockUpdateDNSRecord func(ctx context.Context, rc *cloudflare.ResourceContainer, params records.UpdateParams) (records.Record, error)
+	MockGetDNSRecord    func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (records.Record, error)
+	MockListDNSRecords  func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error)
 	MockDeleteDNSRecord func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error
+	MockZoneDetails     func(ctx context.Context, zoneID string) (cloudflare.Zone, error)
 }
 
 // CreateDNSRecord mocks the CreateDNSRecord method of the Cloudflare API.
-func (m MockClient) CreateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
+func (m MockClient) CreateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params records.CreateParams) (records.Record, error) {
 	if m.MockCreateDNSRecord != nil {
 		return m.MockCreateDNSRecord(ctx, rc, params)
 	}
-	return cloudflare.DNSRecord{}, nil
+	return records.Record{}, nil
 }
 
 // UpdateDNSRecord mocks the UpdateDNSRecord method of the Cloudflare API.
-func (m MockClient) UpdateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
+func (m MockClient) UpdateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params records.UpdateParams) (records.Record, error) {
 	if m.MockUpdateDNSRecord != nil {
 		return m.MockUpdateDNSRecord(ctx, rc, params)
 	}
-	return cloudflare.DNSRecord{}, nil
+	return records.Record{}, nil
 }
 
 // GetDNSRecord mocks the GetDNSRecord method of the Cloudflare API.
-func (m MockClient) GetDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
+func (m MockClient) GetDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (records.Record, error) {
 	if m.MockGetDNSRecord != nil {
 		return m.MockGetDNSRecord(ctx, rc, recordID)
 	}
-	return cloudflare.DNSRecord{}, nil
+	return records.Record{}, nil
+}
+
+// ListDNSRecords mocks the ListDNSRecords method of the Cloudflare API.
+func (m MockClient) ListDNSRecords(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+	if m.MockListDNSRecords != nil {
+		return m.MockListDNSRecords(ctx, rc, params)
+	}
+	return nil, &cloudflare.ResultInfo{}, nil
 }
 
 // DeleteDNSRecord mocks the DeleteDNSRecord method of the Cloudflare API.
@@ -61,3 +73,11 @@ func (m MockClient) DeleteDNSRecord(ctx context.Context, rc *cloudflare.Resource
 	}
 	return nil
 }
+
+// ZoneDetails mocks the ZoneDetails method of the Cloudflare API.
+func (m MockClient) ZoneDetails(ctx context.Context, zoneID string) (cloudflare.Zone, error) {
+	if m.MockZoneDetails != nil {
+		return m.MockZoneDetails(ctx, zoneID)
+	}
+	return cloudflare.Zone{}, nil
+}
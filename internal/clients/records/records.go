@@ -18,45 +18,136 @@ package records
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"golang.org/x/net/idna"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
 )
 
-const (
-	// Cloudflare returns this code when a record isnt found.
-	errRecordNotFound = "81044"
-)
+const errDecodeRecord = "cannot decode DNS record"
 
 // Client is a Cloudflare API client that implements methods for working
 // with DNS Records.
 type Client interface {
-	CreateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error)
-	UpdateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error)
-	GetDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error)
+	CreateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params CreateParams) (Record, error)
+	UpdateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params UpdateParams) (Record, error)
+	GetDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (Record, error)
+	ListDNSRecords(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error)
 	DeleteDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) error
+	ZoneDetails(ctx context.Context, zoneID string) (cloudflare.Zone, error)
+}
+
+// Settings are the per-record settings of a DNS Record. cloudflare-go only
+// covers flatten_cname.
+type Settings struct {
+	FlattenCNAME *bool `json:"flatten_cname,omitempty"`
+	IPv4Only     *bool `json:"ipv4_only,omitempty"`
+	IPv6Only     *bool `json:"ipv6_only,omitempty"`
+}
+
+// A Record is a DNS Record and all of its settings.
+type Record struct {
+	cloudflare.DNSRecord
+	Settings Settings `json:"settings"`
+}
+
+// CreateParams are the parameters of a DNS Record to create.
+type CreateParams struct {
+	cloudflare.CreateDNSRecordParams
+	Settings *Settings `json:"settings,omitempty"`
+}
+
+// UpdateParams are the parameters of a DNS Record to update. Settings that
+// are not sent are left unchanged.
+type UpdateParams struct {
+	cloudflare.UpdateDNSRecordParams
+	Settings *Settings `json:"settings,omitempty"`
 }
 
 // NewClient returns a new Cloudflare API client for working with DNS Records.
 func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
-	return clients.NewClient(cfg, hc)
+	api, err := clients.NewClient(cfg, hc)
+	if err != nil {
+		return nil, err
+	}
+	return &client{API: api}, nil
+}
+
+// client creates, updates and gets DNS Records with raw requests, so that
+// the settings cloudflare-go does not cover are sent and observed.
+type client struct {
+	*cloudflare.API
+}
+
+// CreateDNSRecord creates a DNS Record.
+func (c *client) CreateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params CreateParams) (Record, error) {
+	if rc.Identifier == "" {
+		return Record{}, cloudflare.ErrMissingZoneID
+	}
+	params.Name = toASCII(params.Name)
+	return c.raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/dns_records", rc.Identifier), params)
+}
+
+// UpdateDNSRecord updates a DNS Record.
+func (c *client) UpdateDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, params UpdateParams) (Record, error) {
+	if rc.Identifier == "" {
+		return Record{}, cloudflare.ErrMissingZoneID
+	}
+	if params.ID == "" {
+		return Record{}, cloudflare.ErrMissingDNSRecordID
+	}
+	params.Name = toASCII(params.Name)
+	return c.raw(ctx, http.MethodPatch, fmt.Sprintf("/zones/%s/dns_records/%s", rc.Identifier, params.ID), params)
+}
+
+// GetDNSRecord gets a DNS Record.
+func (c *client) GetDNSRecord(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (Record, error) {
+	if rc.Identifier == "" {
+		return Record{}, cloudflare.ErrMissingZoneID
+	}
+	if recordID == "" {
+		return Record{}, cloudflare.ErrMissingDNSRecordID
+	}
+	return c.raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/dns_records/%s", rc.Identifier, recordID), nil)
+}
+
+// raw calls a DNS Record endpoint and decodes the record returned by the
+// API.
+func (c *client) raw(ctx context.Context, method, endpoint string, body interface{}) (Record, error) {
+	res, err := c.Raw(ctx, method, endpoint, body, nil)
+	if err != nil {
+		return Record{}, err
+	}
+	r := Record{}
+	if err := json.Unmarshal(res.Result, &r); err != nil {
+		return Record{}, errors.Wrap(err, errDecodeRecord)
+	}
+	return r, nil
 }
 
-// IsRecordNotFound returns true if the passed error indicates
-// a Record was not found.
-func IsRecordNotFound(err error) bool {
-	return strings.Contains(err.Error(), errRecordNotFound)
+// lookup converts internationalised names as cloudflare-go does.
+var lookup = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.Transitional(false))
+
+// toASCII converts an internationalised name to the ASCII form Cloudflare
+// stores.
+func toASCII(name string) string {
+	name, _ = lookup.ToASCII(name)
+	return name
 }
 
 // GenerateObservation creates an observation of a cloudflare Record.
-func GenerateObservation(in cloudflare.DNSRecord) v1beta1.RecordObservation {
+func GenerateObservation(in Record) v1beta1.RecordObservation {
 	return v1beta1.RecordObservation{
 		Proxiable:  in.Proxiable,
 		FQDN:       in.Name,
@@ -64,11 +155,34 @@ func GenerateObservation(in cloudflare.DNSRecord) v1beta1.RecordObservation {
 		Locked:     false, // Locked field not available in new API response
 		CreatedOn:  &metav1.Time{Time: in.CreatedOn},
 		ModifiedOn: &metav1.Time{Time: in.ModifiedOn},
+		Comment:    in.Comment,
+		Tags:       in.Tags,
+		Settings:   GenerateSettings(in.Settings),
+		Content:    in.Content,
+		Data:       GenerateData(in.Type, in.Data),
+	}
+}
+
+// GenerateSettings returns the settings of a cloudflare Record, or nil if
+// it has none.
+func GenerateSettings(in Settings) *v1beta1.RecordSettings {
+	if in == (Settings{}) {
+		return nil
 	}
+	return &v1beta1.RecordSettings{FlattenCNAME: in.FlattenCNAME, IPv4Only: in.IPv4Only, IPv6Only: in.IPv6Only}
+}
+
+// ConvertSettings returns the cloudflare settings of the supplied record
+// settings, or nil if there are none.
+func ConvertSettings(in *v1beta1.RecordSettings) *Settings {
+	if in == nil {
+		return nil
+	}
+	return &Settings{FlattenCNAME: in.FlattenCNAME, IPv4Only: in.IPv4Only, IPv6Only: in.IPv6Only}
 }
 
 // LateInitialize initializes RecordParameters based on the remote resource.
-func LateInitialize(spec *v1beta1.RecordParameters, o cloudflare.DNSRecord) bool {
+func LateInitialize(spec *v1beta1.RecordParameters, o Record) bool {
 	if spec == nil {
 		return false
 	}
@@ -85,12 +199,38 @@ func LateInitialize(spec *v1beta1.RecordParameters, o cloudflare.DNSRecord) bool
 		li = true
 	}
 
+	if spec.Comment == nil && o.Comment != "" {
+		spec.Comment = &o.Comment
+		li = true
+	}
+
+	if spec.Tags == nil && len(o.Tags) > 0 {
+		spec.Tags = o.Tags
+		li = true
+	}
+
+	if o.Settings != (Settings{}) {
+		if spec.Settings == nil {
+			spec.Settings = &v1beta1.RecordSettings{}
+		}
+		for _, f := range []struct{ spec, observed **bool }{
+			{&spec.Settings.FlattenCNAME, &o.Settings.FlattenCNAME},
+			{&spec.Settings.IPv4Only, &o.Settings.IPv4Only},
+			{&spec.Settings.IPv6Only, &o.Settings.IPv6Only},
+		} {
+			if *f.spec == nil && *f.observed != nil {
+				*f.spec = *f.observed
+				li = true
+			}
+		}
+	}
+
 	return li
 }
 
 // UpToDate checks if the remote Record is up to date with the
 // requested resource parameters.
-func UpToDate(spec *v1beta1.RecordParameters, o cloudflare.DNSRecord) bool { //nolint:gocyclo
+func UpToDate(spec *v1beta1.RecordParameters, o Record) bool { //nolint:gocyclo
 	// NOTE(bagricola): The complexity here is simply repeated
 	// if statements checking for updated fields. You should think
 	// before adding further complexity to this method, but adding
@@ -108,7 +248,17 @@ func UpToDate(spec *v1beta1.RecordParameters, o cloudflare.DNSRecord) bool { //n
 		return false
 	}
 
-	if spec.Content != o.Content {
+	// Cloudflare computes the content of records that have data, so
+	// their data is compared instead.
+	data, err := ConvertData(spec)
+	switch {
+	case err != nil:
+		return false
+	case data != nil:
+		if !dataUpToDate(ptr.Deref(spec.Type, "A"), data, o.Data) {
+			return false
+		}
+	case spec.Content != o.Content:
 		return false
 	}
 
@@ -124,18 +274,81 @@ func UpToDate(spec *v1beta1.RecordParameters, o cloudflare.DNSRecord) bool { //n
 		return false
 	}
 
+	if spec.Comment != nil && *spec.Comment != o.Comment {
+		return false
+	}
+
+	if spec.Tags != nil && !sets.New(spec.Tags...).Equal(sets.New(o.Tags...)) {
+		return false
+	}
+
+	if spec.Settings != nil && !settingsUpToDate(*spec.Settings, o.Settings) {
+		return false
+	}
+
+	return true
+}
+
+// settingsUpToDate returns true unless a setting of spec differs from the
+// observed one. Settings that are not set are not compared.
+func settingsUpToDate(spec v1beta1.RecordSettings, o Settings) bool {
+	for _, f := range []struct{ spec, observed *bool }{
+		{spec.FlattenCNAME, o.FlattenCNAME},
+		{spec.IPv4Only, o.IPv4Only},
+		{spec.IPv6Only, o.IPv6Only},
+	} {
+		if f.spec != nil && *f.spec != ptr.Deref(f.observed, false) {
+			return false
+		}
+	}
 	return true
 }
 
+// CreateRecord creates a DNS Record.
+func CreateRecord(ctx context.Context, client Client, zoneID string, spec *v1beta1.RecordParameters) (Record, error) {
+	params := CreateParams{
+		CreateDNSRecordParams: cloudflare.CreateDNSRecordParams{
+			Type:    ptr.Deref(spec.Type, "A"),
+			Name:    spec.Name,
+			Content: spec.Content,
+			TTL:     int(ptr.Deref(spec.TTL, 1)),
+			Proxied: spec.Proxied,
+			Comment: ptr.Deref(spec.Comment, ""),
+			Tags:    spec.Tags,
+		},
+		Settings: ConvertSettings(spec.Settings),
+	}
+	if spec.Priority != nil {
+		priority := uint16(*spec.Priority)
+		params.Priority = &priority
+	}
+
+	data, err := ConvertData(spec)
+	if err != nil {
+		return Record{}, err
+	}
+	if data != nil {
+		params.Data = data
+		params.Content = ""
+		if params.Type == "SRV" {
+			params.Priority = nil
+		}
+	}
+
+	return client.CreateDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), params)
+}
+
 // UpdateRecord updates mutable values on a DNS Record.
 func UpdateRecord(ctx context.Context, client Client, zoneID, recordID string, spec *v1beta1.RecordParameters) error {
 	rc := cloudflare.ZoneIdentifier(zoneID)
 
-	params := cloudflare.UpdateDNSRecordParams{
-		ID:      recordID,
-		Type:    *spec.Type,
-		Name:    spec.Name,
-		Content: spec.Content,
+	params := UpdateParams{
+		UpdateDNSRecordParams: cloudflare.UpdateDNSRecordParams{
+			ID:      recordID,
+			Type:    *spec.Type,
+			Name:    spec.Name,
+			Content: spec.Content,
+		},
 	}
 
 	if spec.TTL != nil {
@@ -151,30 +364,23 @@ func UpdateRecord(ctx context.Context, client Client, zoneID, recordID string, s
 		params.Priority = &priority
 	}
 
-	// For SRV records, use the Data field
-	if *spec.Type == "SRV" && spec.Priority != nil && spec.Weight != nil && spec.Port != nil {
-		srvData := map[string]interface{}{
-			"priority": int(*spec.Priority),
-			"weight":   int(*spec.Weight),
-			"port":     int(*spec.Port),
-			"target":   spec.Content,
-		}
-		params.Data = srvData
-		params.Priority = nil
-		params.Content = ""
-	}
+	params.Comment = spec.Comment
+	params.Tags = spec.Tags
+	params.Settings = ConvertSettings(spec.Settings)
 
-	// For TLSA records, parse content and use Data field
-	if *spec.Type == "TLSA" {
-		tlsaData, err := parseTLSAContent(spec.Content)
-		if err != nil {
-			return err
-		}
-		params.Data = tlsaData
+	data, err := ConvertData(spec)
+	if err != nil {
+		return err
+	}
+	if data != nil {
+		params.Data = data
 		params.Content = ""
+		if *spec.Type == "SRV" {
+			params.Priority = nil
+		}
 	}
 
-	_, err := client.UpdateDNSRecord(ctx, rc, params)
+	_, err = client.UpdateDNSRecord(ctx, rc, params)
 	return err
 }
 
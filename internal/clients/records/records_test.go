@@ -37,6 +37,7 @@ func TestLateInitialize(t *testing.T) {
 	type args struct {
 		rp *v1beta1.RecordParameters
 		r  cloudflare.DNSRecord
+		s  Settings
 	}
 
 	type want struct {
@@ -83,13 +84,42 @@ func TestLateInitialize(t *testing.T) {
 				r: cloudflare.DNSRecord{
 					Proxied:  ptr.To(true),
 					Priority: uint16Ptr(1),
+					Comment:  "owned by dns-team",
+					Tags:     []string{"team:dns"},
 				},
+				s: Settings{FlattenCNAME: ptr.To(true), IPv4Only: ptr.To(true)},
 			},
 			want: want{
 				o: true,
 				rp: &v1beta1.RecordParameters{
 					Proxied:  ptr.To(true),
 					Priority: ptr.To[int32](1),
+					Comment:  ptr.To("owned by dns-team"),
+					Tags:     []string{"team:dns"},
+					Settings: &v1beta1.RecordSettings{FlattenCNAME: ptr.To(true), IPv4Only: ptr.To(true)},
+				},
+			},
+		},
+		"LateInitDontUpdateCommentTagsSettings": {
+			reason: "LateInit should not update an already-set comment, tags or settings from a Record",
+			args: args{
+				rp: &v1beta1.RecordParameters{
+					Comment:  ptr.To(""),
+					Tags:     []string{"team:web"},
+					Settings: &v1beta1.RecordSettings{FlattenCNAME: ptr.To(false)},
+				},
+				r: cloudflare.DNSRecord{
+					Comment: "owned by dns-team",
+					Tags:    []string{"team:dns"},
+				},
+				s: Settings{FlattenCNAME: ptr.To(true)},
+			},
+			want: want{
+				o: false,
+				rp: &v1beta1.RecordParameters{
+					Comment:  ptr.To(""),
+					Tags:     []string{"team:web"},
+					Settings: &v1beta1.RecordSettings{FlattenCNAME: ptr.To(false)},
 				},
 			},
 		},
@@ -97,7 +127,7 @@ func TestLateInitialize(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := LateInitialize(tc.args.rp, tc.args.r)
+			got := LateInitialize(tc.args.rp, Record{DNSRecord: tc.args.r, Settings: tc.args.s})
 			if diff := cmp.Diff(tc.want.o, got); diff != "" {
 				t.Errorf("\n%s\nLateInit(...): -want, +got:\n%s\n", tc.reason, diff)
 			}
@@ -112,6 +142,7 @@ func TestUpToDate(t *testing.T) {
 	type args struct {
 		rp *v1beta1.RecordParameters
 		r  cloudflare.DNSRecord
+		s  Settings
 	}
 
 	type want struct {
@@ -184,11 +215,122 @@ func TestUpToDate(t *testing.T) {
 				o: true,
 			},
 		},
+		"UpToDateCommentDifferent": {
+			reason: "UpToDate should return false if the comment does not match the record",
+			args: args{
+				rp: &v1beta1.RecordParameters{Comment: ptr.To("owned by web-team")},
+				r:  cloudflare.DNSRecord{Comment: "owned by dns-team"},
+			},
+			want: want{
+				o: false,
+			},
+		},
+		"UpToDateCommentRemoved": {
+			reason: "UpToDate should return false if an empty comment is requested but the record has one",
+			args: args{
+				rp: &v1beta1.RecordParameters{Comment: ptr.To("")},
+				r:  cloudflare.DNSRecord{Comment: "owned by dns-team"},
+			},
+			want: want{
+				o: false,
+			},
+		},
+		"UpToDateTagsDifferent": {
+			reason: "UpToDate should return false if the tags do not match the record",
+			args: args{
+				rp: &v1beta1.RecordParameters{Tags: []string{"team:dns", "env:prod"}},
+				r:  cloudflare.DNSRecord{Tags: []string{"team:dns"}},
+			},
+			want: want{
+				o: false,
+			},
+		},
+		"UpToDateTagsReordered": {
+			reason: "UpToDate should ignore the order of tags",
+			args: args{
+				rp: &v1beta1.RecordParameters{Tags: []string{"team:dns", "env:prod"}},
+				r:  cloudflare.DNSRecord{Tags: []string{"env:prod", "team:dns"}},
+			},
+			want: want{
+				o: true,
+			},
+		},
+		"UpToDateDataNormalized": {
+			reason: "UpToDate should return true if the data matches the record once normalized by Cloudflare",
+			args: args{
+				rp: &v1beta1.RecordParameters{Type: ptr.To("SRV"), Data: &v1beta1.RecordData{
+					SRV: &v1beta1.SRVRecordData{Priority: 10, Weight: 5, Port: 5060, Target: "SIP.example.org."},
+				}},
+				r: cloudflare.DNSRecord{
+					Type:    "SRV",
+					Content: "5 5060 sip.example.org",
+					Data:    map[string]interface{}{"priority": float64(10), "weight": float64(5), "port": float64(5060), "target": "sip.example.org"},
+				},
+			},
+			want: want{
+				o: true,
+			},
+		},
+		"UpToDateDataDifferent": {
+			reason: "UpToDate should return false if the data does not match the record",
+			args: args{
+				rp: &v1beta1.RecordParameters{Type: ptr.To("TLSA"), Data: &v1beta1.RecordData{
+					TLSA: &v1beta1.TLSARecordData{Usage: 3, Selector: 1, MatchingType: 1, Certificate: "ABCD"},
+				}},
+				r: cloudflare.DNSRecord{
+					Type: "TLSA",
+					Data: map[string]interface{}{"usage": float64(3), "selector": float64(0), "matching_type": float64(1), "certificate": "abcd"},
+				},
+			},
+			want: want{
+				o: false,
+			},
+		},
+		"UpToDateFlattenCNAMEDifferent": {
+			reason: "UpToDate should return false if CNAME flattening does not match the record",
+			args: args{
+				rp: &v1beta1.RecordParameters{Settings: &v1beta1.RecordSettings{FlattenCNAME: ptr.To(true)}},
+				r:  cloudflare.DNSRecord{},
+			},
+			want: want{
+				o: false,
+			},
+		},
+		"UpToDateFlattenCNAMEIdentical": {
+			reason: "UpToDate should return true if CNAME flattening matches the record",
+			args: args{
+				rp: &v1beta1.RecordParameters{Settings: &v1beta1.RecordSettings{FlattenCNAME: ptr.To(true)}},
+				s:  Settings{FlattenCNAME: ptr.To(true)},
+			},
+			want: want{
+				o: true,
+			},
+		},
+		"UpToDateIPv6OnlyDifferent": {
+			reason: "UpToDate should return false if IPv6-only resolution does not match the record",
+			args: args{
+				rp: &v1beta1.RecordParameters{Settings: &v1beta1.RecordSettings{IPv6Only: ptr.To(true)}},
+				s:  Settings{IPv4Only: ptr.To(false)},
+			},
+			want: want{
+				o: false,
+			},
+		},
+		"UpToDateIPv4OnlyIdentical": {
+			reason: "UpToDate should return true if IPv4-only resolution matches the record",
+			args: args{
+				rp: &v1beta1.RecordParameters{Settings: &v1beta1.RecordSettings{IPv4Only: ptr.To(true)}},
+				s:  Settings{IPv4Only: ptr.To(true)},
+			},
+			want: want{
+				o: true,
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := UpToDate(tc.args.rp, tc.args.r)
+			got := UpToDate(tc.args.rp, Record{DNSRecord: tc.args.r, Settings: tc.args.s})
 			if diff := cmp.Diff(tc.want.o, got); diff != "" {
 				t.Errorf("\n%s\nUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
 			}
@@ -0,0 +1,193 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package records
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+)
+
+// MemberParameters returns the parameters of the DNS Record of the supplied
+// value of a set.
+func MemberParameters(spec *v1beta1.RecordSetParameters, v v1beta1.RecordSetValue) v1beta1.RecordParameters {
+	return v1beta1.RecordParameters{
+		Type:     spec.Type,
+		Name:     spec.Name,
+		Content:  v.Content,
+		Data:     v.Data,
+		TTL:      spec.TTL,
+		Proxied:  spec.Proxied,
+		Priority: v.Priority,
+		Weight:   v.Weight,
+		Port:     v.Port,
+		Comment:  spec.Comment,
+		Zone:     spec.Zone,
+	}
+}
+
+// ListRecordSet returns the DNS Records of the supplied zone that have the
+// supplied name and type.
+func ListRecordSet(ctx context.Context, client Client, zoneID, name, rtype string) ([]cloudflare.DNSRecord, error) {
+	rs, _, err := client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.ListDNSRecordsParams{
+		Name: strings.ToLower(strings.TrimSuffix(name, ".")),
+		Type: rtype,
+	})
+	return rs, err
+}
+
+// FQDN returns the fully qualified name Cloudflare gives a record of the
+// supplied name in the supplied zone. Names that are not within the zone
+// are relative to it.
+func FQDN(name, zone string) string {
+	n := strings.ToLower(strings.TrimSuffix(name, "."))
+	z := strings.ToLower(zone)
+	switch {
+	case z == "":
+		return n
+	case n == "" || n == "@":
+		return z
+	case n == z || strings.HasSuffix(n, "."+z):
+		return n
+	}
+	return n + "." + z
+}
+
+// GenerateRecordSetObservation creates an observation of the cloudflare
+// Records of a set.
+func GenerateRecordSetObservation(in []cloudflare.DNSRecord) v1beta1.RecordSetObservation {
+	o := v1beta1.RecordSetObservation{}
+	for _, r := range in {
+		o.FQDN = r.Name
+		m := v1beta1.RecordSetMemberObservation{ID: r.ID, Content: r.Content}
+		if r.Priority != nil {
+			m.Priority = ptr.To(int32(*r.Priority))
+		}
+		o.Records = append(o.Records, m)
+	}
+	return o
+}
+
+// A RecordSetUpdate updates one DNS Record of a set.
+type RecordSetUpdate struct {
+	// ID of the DNS Record to update.
+	ID string
+
+	// Parameters to update it with.
+	Parameters v1beta1.RecordParameters
+}
+
+// A RecordSetPlan lists the changes that converge the DNS Records of a set
+// on its values.
+type RecordSetPlan struct {
+	Create []v1beta1.RecordParameters
+	Update []RecordSetUpdate
+	Delete []string
+}
+
+// Empty returns true if the plan makes no changes.
+func (p RecordSetPlan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Update) == 0 && len(p.Delete) == 0
+}
+
+// PlanRecordSet plans the changes that converge the supplied DNS Records on
+// the values of a set. Records that are up to date are kept. Records that
+// have a value of the set, but differ in another way such as their TTL,
+// are updated. Other records are updated to the remaining values, and any
+// records or values left over are deleted or created.
+func PlanRecordSet(spec *v1beta1.RecordSetParameters, observed []cloudflare.DNSRecord) RecordSetPlan {
+	desired := make([]v1beta1.RecordParameters, len(spec.Values))
+	for i, v := range spec.Values {
+		desired[i] = MemberParameters(spec, v)
+	}
+
+	matched := make([]bool, len(desired))
+	used := make([]bool, len(observed))
+	plan := RecordSetPlan{}
+
+	match := func(same func(p v1beta1.RecordParameters, o cloudflare.DNSRecord) bool, update bool) {
+		for i, p := range desired {
+			if matched[i] {
+				continue
+			}
+			for j, o := range observed {
+				if used[j] || !same(p, o) {
+					continue
+				}
+				matched[i], used[j] = true, true
+				if update {
+					plan.Update = append(plan.Update, RecordSetUpdate{ID: o.ID, Parameters: p})
+				}
+				break
+			}
+		}
+	}
+
+	// Records were listed by name, so each is compared as if it had the
+	// name of the set.
+	match(func(p v1beta1.RecordParameters, o cloudflare.DNSRecord) bool {
+		p.Name = o.Name
+		return UpToDate(&p, Record{DNSRecord: o})
+	}, false)
+	match(func(p v1beta1.RecordParameters, o cloudflare.DNSRecord) bool {
+		p.Name, p.TTL, p.Proxied, p.Comment = o.Name, nil, nil, nil
+		return UpToDate(&p, Record{DNSRecord: o})
+	}, true)
+	match(func(_ v1beta1.RecordParameters, _ cloudflare.DNSRecord) bool { return true }, true)
+
+	for i, p := range desired {
+		if !matched[i] {
+			plan.Create = append(plan.Create, p)
+		}
+	}
+	for j, o := range observed {
+		if !used[j] {
+			plan.Delete = append(plan.Delete, o.ID)
+		}
+	}
+	return plan
+}
+
+// ApplyRecordSetPlan makes the changes of the supplied plan to the DNS
+// Records of the supplied zone. Records are updated and created before any
+// are deleted, so that the name keeps resolving while a set changes. It
+// returns the records it created.
+func ApplyRecordSetPlan(ctx context.Context, client Client, zoneID string, plan RecordSetPlan) ([]cloudflare.DNSRecord, error) {
+	var out []cloudflare.DNSRecord
+	for _, u := range plan.Update {
+		if err := UpdateRecord(ctx, client, zoneID, u.ID, &u.Parameters); err != nil {
+			return out, err
+		}
+	}
+	for i := range plan.Create {
+		r, err := CreateRecord(ctx, client, zoneID, &plan.Create[i])
+		if err != nil {
+			return out, err
+		}
+		out = append(out, r.DNSRecord)
+	}
+	for _, id := range plan.Delete {
+		if err := client.DeleteDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), id); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package records
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+)
+
+// A planClient makes the changes of a plan with its functions.
+type planClient struct {
+	Client
+	create func(params CreateParams) (Record, error)
+	update func(params UpdateParams) (Record, error)
+	delete func(id string) error
+}
+
+func (c planClient) CreateDNSRecord(_ context.Context, _ *cloudflare.ResourceContainer, params CreateParams) (Record, error) {
+	return c.create(params)
+}
+
+func (c planClient) UpdateDNSRecord(_ context.Context, _ *cloudflare.ResourceContainer, params UpdateParams) (Record, error) {
+	return c.update(params)
+}
+
+func (c planClient) DeleteDNSRecord(_ context.Context, _ *cloudflare.ResourceContainer, id string) error {
+	return c.delete(id)
+}
+
+func TestPlanRecordSet(t *testing.T) {
+	spec := func(ttl int64, values ...string) *v1beta1.RecordSetParameters {
+		p := &v1beta1.RecordSetParameters{
+			Type: ptr.To("A"),
+			Name: "www.example.com",
+			TTL:  ptr.To(ttl),
+		}
+		for _, v := range values {
+			p.Values = append(p.Values, v1beta1.RecordSetValue{Content: v})
+		}
+		return p
+	}
+	record := func(id, content string, ttl int) cloudflare.DNSRecord {
+		return cloudflare.DNSRecord{ID: id, Type: "A", Name: "www.example.com", Content: content, TTL: ttl}
+	}
+	member := func(p *v1beta1.RecordSetParameters, content string) v1beta1.RecordParameters {
+		return MemberParameters(p, v1beta1.RecordSetValue{Content: content})
+	}
+
+	type args struct {
+		spec     *v1beta1.RecordSetParameters
+		observed []cloudflare.DNSRecord
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   RecordSetPlan
+	}{
+		"Create": {
+			reason: "Every value should be created if there are no records.",
+			args: args{
+				spec: spec(300, "192.0.2.1", "192.0.2.2"),
+			},
+			want: RecordSetPlan{
+				Create: []v1beta1.RecordParameters{
+					member(spec(300), "192.0.2.1"),
+					member(spec(300), "192.0.2.2"),
+				},
+			},
+		},
+		"UpToDate": {
+			reason: "Records that are up to date should be kept, whatever their order.",
+			args: args{
+				spec: spec(300, "192.0.2.1", "192.0.2.2"),
+				observed: []cloudflare.DNSRecord{
+					record("b", "192.0.2.2", 300),
+					record("a", "192.0.2.1", 300),
+				},
+			},
+			want: RecordSetPlan{},
+		},
+		"UpdateTTL": {
+			reason: "Records with a value of the set should be updated in place if another field differs.",
+			args: args{
+				spec: spec(600, "192.0.2.1", "192.0.2.2"),
+				observed: []cloudflare.DNSRecord{
+					record("b", "192.0.2.2", 300),
+					record("a", "192.0.2.1", 300),
+				},
+			},
+			want: RecordSetPlan{
+				Update: []RecordSetUpdate{
+					{ID: "a", Parameters: member(spec(600), "192.0.2.1")},
+					{ID: "b", Parameters: member(spec(600), "192.0.2.2")},
+				},
+			},
+		},
+		"ReplaceValue": {
+			reason: "A record whose value was removed should be updated to a value that was added.",
+			args: args{
+				spec: spec(300, "192.0.2.1", "192.0.2.3"),
+				observed: []cloudflare.DNSRecord{
+					record("a", "192.0.2.1", 300),
+					record("b", "192.0.2.2", 300),
+				},
+			},
+			want: RecordSetPlan{
+				Update: []RecordSetUpdate{
+					{ID: "b", Parameters: member(spec(300), "192.0.2.3")},
+				},
+			},
+		},
+		"AddAndRemove": {
+			reason: "Values without a record should be created, and records without a value deleted.",
+			args: args{
+				spec: spec(300, "192.0.2.1", "192.0.2.2", "192.0.2.3"),
+				observed: []cloudflare.DNSRecord{
+					record("a", "192.0.2.1", 300),
+				},
+			},
+			want: RecordSetPlan{
+				Create: []v1beta1.RecordParameters{
+					member(spec(300), "192.0.2.2"),
+					member(spec(300), "192.0.2.3"),
+				},
+			},
+		},
+		"Remove": {
+			reason: "Records without a value should be deleted.",
+			args: args{
+				spec: spec(300, "192.0.2.2"),
+				observed: []cloudflare.DNSRecord{
+					record("a", "192.0.2.1", 300),
+					record("b", "192.0.2.2", 300),
+					record("c", "192.0.2.3", 300),
+				},
+			},
+			want: RecordSetPlan{
+				Delete: []string{"a", "c"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := PlanRecordSet(tc.args.spec, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nPlanRecordSet(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestApplyRecordSetPlan(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	plan := RecordSetPlan{
+		Create: []v1beta1.RecordParameters{{Type: ptr.To("A"), Name: "www.example.com", Content: "192.0.2.3"}},
+		Update: []RecordSetUpdate{{ID: "b", Parameters: v1beta1.RecordParameters{Type: ptr.To("A"), Name: "www.example.com", Content: "192.0.2.2"}}},
+		Delete: []string{"a"},
+	}
+
+	type want struct {
+		calls   []string
+		created []cloudflare.DNSRecord
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		failing string
+		want    want
+	}{
+		"Success": {
+			reason: "Records should be updated and created before any are deleted.",
+			want: want{
+				calls:   []string{"update b", "create 192.0.2.3", "delete a"},
+				created: []cloudflare.DNSRecord{{ID: "c", Name: "www.example.com", Content: "192.0.2.3"}},
+			},
+		},
+		"CreateFails": {
+			reason:  "No records should be deleted if a record cannot be created.",
+			failing: "create",
+			want: want{
+				calls: []string{"update b", "create 192.0.2.3"},
+				err:   errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			fail := func(call string) error {
+				if call == tc.failing {
+					return errBoom
+				}
+				return nil
+			}
+			client := planClient{
+				create: func(params CreateParams) (Record, error) {
+					calls = append(calls, "create "+params.Content)
+					if err := fail("create"); err != nil {
+						return Record{}, err
+					}
+					return Record{DNSRecord: cloudflare.DNSRecord{ID: "c", Name: params.Name, Content: params.Content}}, nil
+				},
+				update: func(params UpdateParams) (Record, error) {
+					calls = append(calls, "update "+params.ID)
+					return Record{}, fail("update")
+				},
+				delete: func(id string) error {
+					calls = append(calls, "delete "+id)
+					return fail("delete")
+				},
+			}
+			created, err := ApplyRecordSetPlan(context.Background(), client, "zone", plan)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nApplyRecordSetPlan(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("\n%s\nApplyRecordSetPlan(...): -want created, +got created:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\nApplyRecordSetPlan(...): -want calls, +got calls:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
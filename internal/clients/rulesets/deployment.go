@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ruleset
+
+import (
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+)
+
+const (
+	// PhaseFirewallManaged is the phase managed WAF rulesets are executed
+	// from by default.
+	PhaseFirewallManaged = "http_request_firewall_managed"
+
+	skipRefInfix = "_skip_"
+)
+
+// DeploymentRef returns the ref of the execute rule of a deployment.
+func DeploymentRef(p v1beta1.ManagedRulesetDeploymentParameters) string {
+	if p.Ref != nil && *p.Ref != "" {
+		return *p.Ref
+	}
+	return p.ManagedRulesetID
+}
+
+// DeploymentEntrypoint returns the parameters that address the entrypoint
+// ruleset a deployment belongs to.
+func DeploymentEntrypoint(p v1beta1.ManagedRulesetDeploymentParameters) v1beta1.RulesetParameters {
+	return v1beta1.RulesetParameters{
+		Zone:    p.Zone,
+		Account: p.Account,
+		Phase:   ptr.Deref(p.Phase, PhaseFirewallManaged),
+		Mode:    ptr.To(v1beta1.RulesetModeEntrypoint),
+	}
+}
+
+// DeploymentRules returns the rules of a deployment in order: its skip
+// exceptions followed by the rule that executes the managed ruleset.
+func DeploymentRules(p v1beta1.ManagedRulesetDeploymentParameters) []v1beta1.RulesetRule {
+	ref := DeploymentRef(p)
+	rules := make([]v1beta1.RulesetRule, 0, len(p.SkipExceptions)+1)
+
+	for _, s := range p.SkipExceptions {
+		ap := &v1beta1.RuleActionParameters{}
+		if len(s.Rules) > 0 {
+			ap.Rules = map[string][]string{p.ManagedRulesetID: s.Rules}
+		} else {
+			ap.Rulesets = []string{p.ManagedRulesetID}
+		}
+		rules = append(rules, v1beta1.RulesetRule{
+			Ref:              ptr.To(ref + skipRefInfix + s.Name),
+			Action:           "skip",
+			Expression:       s.Expression,
+			Description:      s.Description,
+			Enabled:          s.Enabled,
+			ActionParameters: ap,
+			Logging:          s.Logging,
+		})
+	}
+
+	return append(rules, v1beta1.RulesetRule{
+		Ref:         ptr.To(ref),
+		Action:      "execute",
+		Expression:  ptr.Deref(p.Expression, "true"),
+		Description: p.Description,
+		Enabled:     p.Enabled,
+		ActionParameters: &v1beta1.RuleActionParameters{
+			ID:          ptr.To(p.ManagedRulesetID),
+			Overrides:   p.Overrides,
+			MatchedData: p.MatchedData,
+		},
+	})
+}
+
+// DeploymentOwns returns true if a rule of the entrypoint ruleset belongs to
+// the deployment.
+func DeploymentOwns(p v1beta1.ManagedRulesetDeploymentParameters, rule cloudflare.RulesetRule) bool {
+	ref := DeploymentRef(p)
+	return rule.Ref == ref || strings.HasPrefix(rule.Ref, ref+skipRefInfix)
+}
+
+// OwnedRules returns the rules of the entrypoint ruleset that belong to the
+// deployment, and the 1-based position of the first of them. The position
+// is 0 when the deployment has no rules.
+func OwnedRules(p v1beta1.ManagedRulesetDeploymentParameters, rules []cloudflare.RulesetRule) ([]cloudflare.RulesetRule, int) {
+	return ownedRules(rules, func(r cloudflare.RulesetRule) bool { return DeploymentOwns(p, r) })
+}
+
+// GenerateDeploymentObservation creates an observation from the rules of
+// the entrypoint ruleset that belong to the deployment.
+func GenerateDeploymentObservation(p v1beta1.ManagedRulesetDeploymentParameters, rs *cloudflare.Ruleset) v1beta1.ManagedRulesetDeploymentObservation {
+	o := v1beta1.ManagedRulesetDeploymentObservation{RulesetID: rs.ID}
+	owned, _ := OwnedRules(p, rs.Rules)
+	for _, r := range owned {
+		ro := v1beta1.RulesetRuleObservation{ID: r.ID, Ref: r.Ref}
+		if r.Version != nil {
+			ro.Version = *r.Version
+		}
+		if r.Ref == DeploymentRef(p) {
+			o.RuleID = ro.ID
+			o.Version = ro.Version
+		}
+		o.Rules = append(o.Rules, ro)
+	}
+	return o
+}
+
+// DeploymentUpToDate returns true if the rules of the deployment in the
+// entrypoint ruleset match the desired skip exceptions and execute rule.
+func DeploymentUpToDate(p v1beta1.ManagedRulesetDeploymentParameters, rs *cloudflare.Ruleset) bool {
+	owned, _ := OwnedRules(p, rs.Rules)
+	return RulesUpToDate(DeploymentRules(p), owned)
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+)
+
+func TestDeploymentRules(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		params v1beta1.ManagedRulesetDeploymentParameters
+		want   []v1beta1.RulesetRule
+	}{
+		"ExecuteOnly": {
+			reason: "A deployment without exceptions should only execute the managed ruleset",
+			params: v1beta1.ManagedRulesetDeploymentParameters{
+				ManagedRulesetID: "managed",
+				Overrides:        &v1beta1.RuleOverrides{SensitivityLevel: ptr.To("low")},
+			},
+			want: []v1beta1.RulesetRule{{
+				Ref:        ptr.To("managed"),
+				Action:     "execute",
+				Expression: "true",
+				ActionParameters: &v1beta1.RuleActionParameters{
+					ID:        ptr.To("managed"),
+					Overrides: &v1beta1.RuleOverrides{SensitivityLevel: ptr.To("low")},
+				},
+			}},
+		},
+		"SkipExceptions": {
+			reason: "Skip exceptions should precede the execute rule and skip the whole ruleset or some of its rules",
+			params: v1beta1.ManagedRulesetDeploymentParameters{
+				ManagedRulesetID: "managed",
+				Ref:              ptr.To("waf"),
+				Expression:       ptr.To("http.host eq \"example.com\""),
+				SkipExceptions: []v1beta1.ManagedRulesetSkipException{
+					{Name: "office", Expression: "ip.src in {192.0.2.0/24}"},
+					{Name: "uploads", Expression: "http.request.uri.path eq \"/upload\"", Rules: []string{"r1", "r2"}},
+				},
+			},
+			want: []v1beta1.RulesetRule{
+				{
+					Ref:              ptr.To("waf_skip_office"),
+					Action:           "skip",
+					Expression:       "ip.src in {192.0.2.0/24}",
+					ActionParameters: &v1beta1.RuleActionParameters{Rulesets: []string{"managed"}},
+				},
+				{
+					Ref:              ptr.To("waf_skip_uploads"),
+					Action:           "skip",
+					Expression:       "http.request.uri.path eq \"/upload\"",
+					ActionParameters: &v1beta1.RuleActionParameters{Rules: map[string][]string{"managed": {"r1", "r2"}}},
+				},
+				{
+					Ref:              ptr.To("waf"),
+					Action:           "execute",
+					Expression:       "http.host eq \"example.com\"",
+					ActionParameters: &v1beta1.RuleActionParameters{ID: ptr.To("managed")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DeploymentRules(tc.params)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDeploymentRules(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestOwnedRules(t *testing.T) {
+	params := v1beta1.ManagedRulesetDeploymentParameters{ManagedRulesetID: "managed", Ref: ptr.To("waf")}
+	rules := []cloudflare.RulesetRule{
+		{ID: "1", Ref: "other"},
+		{ID: "2", Ref: "waf_skip_office"},
+		{ID: "3", Ref: "waf"},
+		{ID: "4", Ref: "waf2"},
+	}
+
+	owned, first := OwnedRules(params, rules)
+	if diff := cmp.Diff([]cloudflare.RulesetRule{rules[1], rules[2]}, owned); diff != "" {
+		t.Errorf("OwnedRules(...): -want rules, +got rules:\n%s", diff)
+	}
+	if first != 2 {
+		t.Errorf("OwnedRules(...): want first 2, got %d", first)
+	}
+}
+
+func TestDeploymentUpToDate(t *testing.T) {
+	params := v1beta1.ManagedRulesetDeploymentParameters{
+		ManagedRulesetID: "managed",
+		Overrides:        &v1beta1.RuleOverrides{Action: ptr.To("log")},
+	}
+	execute := func(action string) cloudflare.RulesetRule {
+		return cloudflare.RulesetRule{
+			ID:         "id",
+			Ref:        "managed",
+			Action:     "execute",
+			Expression: "true",
+			ActionParameters: &cloudflare.RulesetRuleActionParameters{
+				ID:        "managed",
+				Overrides: &cloudflare.RulesetRuleActionParametersOverrides{Action: action},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		rules  []cloudflare.RulesetRule
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "Rules of other deployments should be ignored",
+			rules:  []cloudflare.RulesetRule{{ID: "other", Ref: "other", Action: "execute"}, execute("log")},
+			want:   true,
+		},
+		"LatestVersion": {
+			reason: "The latest version Cloudflare reports on an execute rule that does not pin one should be ignored",
+			rules: []cloudflare.RulesetRule{func() cloudflare.RulesetRule {
+				r := execute("log")
+				r.ActionParameters.Version = ptr.To("latest")
+				return r
+			}()},
+			want: true,
+		},
+		"OverrideChanged": {
+			reason: "A changed override should be detected",
+			rules:  []cloudflare.RulesetRule{execute("block")},
+			want:   false,
+		},
+		"Missing": {
+			reason: "A deployment without rules should not be up to date",
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DeploymentUpToDate(params, &cloudflare.Ruleset{Rules: tc.rules})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDeploymentUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
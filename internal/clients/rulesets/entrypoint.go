@@ -0,0 +1,172 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ruleset
+
+import (
+	"context"
+
+	"github.com/cloudflare/cloudflare-go"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+)
+
+// EntrypointOwns returns true if a rule of the phase entrypoint belongs to a
+// ruleset in entrypoint mode, because it carries the ref of one of its
+// rules or was one of its rules when the ruleset was last observed. Every
+// other rule of the entrypoint belongs to someone else and is left alone.
+func EntrypointOwns(params v1beta1.RulesetParameters, observed v1beta1.RulesetObservation, rule cloudflare.RulesetRule) bool {
+	for _, r := range params.Rules {
+		if r.Ref != nil && *r.Ref != "" && *r.Ref == rule.Ref {
+			return true
+		}
+	}
+	for _, r := range observed.Rules {
+		if r.ID != "" && r.ID == rule.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// EntrypointRules returns the rules of the phase entrypoint that belong to
+// a ruleset in entrypoint mode.
+func EntrypointRules(params v1beta1.RulesetParameters, observed v1beta1.RulesetObservation, rs *cloudflare.Ruleset) []cloudflare.RulesetRule {
+	owned, _ := ownedRules(rs.Rules, func(r cloudflare.RulesetRule) bool {
+		return EntrypointOwns(params, observed, r)
+	})
+	return owned
+}
+
+// GenerateEntrypointObservation creates an observation from the phase
+// entrypoint that lists only the rules that belong to the ruleset.
+func GenerateEntrypointObservation(params v1beta1.RulesetParameters, observed v1beta1.RulesetObservation, rs *cloudflare.Ruleset) v1beta1.RulesetObservation {
+	owned := *rs
+	owned.Rules = EntrypointRules(params, observed, rs)
+	return GenerateObservation(&owned)
+}
+
+// EntrypointUpToDate returns true if the rules of the phase entrypoint that
+// belong to the ruleset match its desired rules.
+func EntrypointUpToDate(params v1beta1.RulesetParameters, observed v1beta1.RulesetObservation, rs *cloudflare.Ruleset) bool {
+	return RulesUpToDate(params.Rules, EntrypointRules(params, observed, rs))
+}
+
+// SyncEntrypointRules brings the rules of the phase entrypoint that satisfy
+// owns in line with the desired rules, rule by rule, keeping them together
+// in the position of the first of them. Rules that do not satisfy owns are
+// never written. The entrypoint is created holding only the desired rules
+// if the phase has none yet. Callers must hold the lock of the entrypoint.
+//
+// The rules are written one by one rather than by putting the whole rule
+// list of the entrypoint, since a put would also write the rules of other
+// owners. Those would be lost if they changed since they were read, and
+// any of their fields cloudflare-go does not know would be dropped.
+func SyncEntrypointRules(ctx context.Context, c Client, params v1beta1.RulesetParameters, desired []v1beta1.RulesetRule, owns func(cloudflare.RulesetRule) bool) (*cloudflare.Ruleset, error) {
+	rs, err := c.GetEntrypointRuleset(ctx, params)
+	if cferrors.IsNotFound(err) {
+		params.Rules = desired
+		return c.UpdateEntrypointRuleset(ctx, params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	id := rs.ID
+	owned, _ := ownedRules(rs.Rules, owns)
+	diff, ok := DiffRules(desired, owned)
+	if !ok {
+		// The rules cannot be matched one by one, so they are recreated.
+		diff = RuleDiff{}
+		for _, r := range owned {
+			diff.Delete = append(diff.Delete, r.ID)
+		}
+		for i, r := range desired {
+			cf, err := ConvertRule(r)
+			if err != nil {
+				return nil, err
+			}
+			diff.Create = append(diff.Create, PositionedRule{Rule: cf, Index: i + 1})
+		}
+	}
+
+	for _, ruleID := range diff.Delete {
+		if rs, err = c.DeleteRulesetRule(ctx, id, ruleID, params); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, r := range diff.Update {
+		if rs, err = c.UpdateRulesetRule(ctx, id, PositionedRule{Rule: r}, params); err != nil {
+			return nil, err
+		}
+	}
+
+	// Indexes are relative to the owned rules. New rules are appended when
+	// none of the owned rules remain.
+	_, first := ownedRules(rs.Rules, owns)
+	for _, r := range diff.Create {
+		if first > 0 {
+			r.Index += first - 1
+		} else {
+			r.Index = 0
+		}
+		if rs, err = c.CreateRulesetRule(ctx, id, r, params); err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+// DeleteEntrypointRules deletes the rules of the phase entrypoint that
+// satisfy owns, leaving the entrypoint and every other rule in place.
+// Callers must hold the lock of the entrypoint.
+func DeleteEntrypointRules(ctx context.Context, c Client, params v1beta1.RulesetParameters, owns func(cloudflare.RulesetRule) bool) error {
+	rs, err := c.GetEntrypointRuleset(ctx, params)
+	if cferrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	owned, _ := ownedRules(rs.Rules, owns)
+	for _, r := range owned {
+		if _, err := c.DeleteRulesetRule(ctx, rs.ID, r.ID, params); err != nil && !cferrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ownedRules returns the rules that satisfy owns, and the 1-based position
+// of the first of them. The position is 0 when no rule does.
+func ownedRules(rules []cloudflare.RulesetRule, owns func(cloudflare.RulesetRule) bool) ([]cloudflare.RulesetRule, int) {
+	var owned []cloudflare.RulesetRule
+	first := 0
+	for i, r := range rules {
+		if !owns(r) {
+			continue
+		}
+		if first == 0 {
+			first = i + 1
+		}
+		owned = append(owned, r)
+	}
+	return owned, first
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+)
+
+func TestEntrypointRules(t *testing.T) {
+	params := v1beta1.RulesetParameters{Rules: []v1beta1.RulesetRule{{Ref: ptr.To("own")}}}
+	observed := v1beta1.RulesetObservation{Rules: []v1beta1.RulesetRuleObservation{{ID: "3", Ref: "removed"}}}
+	rs := &cloudflare.Ruleset{Rules: []cloudflare.RulesetRule{
+		{ID: "1", Ref: "other"},
+		{ID: "2", Ref: "own"},
+		{ID: "3", Ref: "removed"},
+		{ID: "4", Ref: "own2"},
+	}}
+
+	got := EntrypointRules(params, observed, rs)
+	if diff := cmp.Diff([]cloudflare.RulesetRule{rs.Rules[1], rs.Rules[2]}, got); diff != "" {
+		t.Errorf("EntrypointRules(...): -want rules, +got rules:\n%s", diff)
+	}
+}
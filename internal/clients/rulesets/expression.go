@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ruleset
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errEmptyExpression      = "expression must not be empty"
+	errUnterminatedString   = "unterminated string at offset %d"
+	errUnexpectedClose      = "unexpected %q at offset %d"
+	errUnclosed             = "unclosed %q at offset %d"
+	errDanglingOperator     = "%q at offset %d is missing an operand"
+	errConsecutiveOperators = "%q at offset %d follows another operator"
+)
+
+// Logical operators of Cloudflare's rules language, which join or negate
+// conditions.
+var (
+	binaryOperators = map[string]bool{"and": true, "or": true, "xor": true, "&&": true, "||": true, "^^": true}
+	unaryOperators  = map[string]bool{"not": true, "!": true}
+	closers         = map[byte]byte{')': '(', ']': '[', '}': '{'}
+)
+
+// A token of an expression, and its offset.
+type token struct {
+	text   string
+	offset int
+}
+
+// ValidateExpression checks the syntax of a rule expression written in
+// Cloudflare's rules language. It checks that strings are terminated, that
+// brackets are balanced and that logical operators have operands. It does
+// not check field names or the types of values, which Cloudflare checks
+// when the rule is created.
+func ValidateExpression(expr string) error {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return errors.New(errEmptyExpression)
+	}
+
+	var open []token
+	var last token
+	for _, t := range tokens {
+		prev := last.text
+		switch {
+		case t.text == "(" || t.text == "[" || t.text == "{":
+			open = append(open, t)
+		case len(t.text) == 1 && closers[t.text[0]] != 0:
+			if len(open) == 0 || open[len(open)-1].text[0] != closers[t.text[0]] {
+				return errors.Errorf(errUnexpectedClose, t.text, t.offset)
+			}
+			if binaryOperators[prev] || unaryOperators[prev] {
+				return errors.Errorf(errDanglingOperator, prev, last.offset)
+			}
+			open = open[:len(open)-1]
+		case binaryOperators[t.text] && (binaryOperators[prev] || unaryOperators[prev]):
+			return errors.Errorf(errConsecutiveOperators, t.text, t.offset)
+		case binaryOperators[t.text] && (prev == "" || prev == "("):
+			return errors.Errorf(errDanglingOperator, t.text, t.offset)
+		}
+		last = t
+	}
+	if len(open) > 0 {
+		t := open[len(open)-1]
+		return errors.Errorf(errUnclosed, t.text, t.offset)
+	}
+	if binaryOperators[last.text] || unaryOperators[last.text] {
+		return errors.Errorf(errDanglingOperator, last.text, last.offset)
+	}
+	return nil
+}
+
+// tokenize splits an expression into brackets, strings, operators and
+// words, such as field names and values.
+func tokenize(expr string) ([]token, error) { //nolint:gocyclo
+	var tokens []token
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case strings.IndexByte("()[]{}", c) >= 0:
+			tokens = append(tokens, token{text: string(c), offset: i})
+			i++
+		case c == '"':
+			end, err := stringEnd(expr, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{text: expr[i:end], offset: i})
+			i = end
+		case c == 'r' && i+1 < len(expr) && (expr[i+1] == '"' || expr[i+1] == '#'):
+			end, err := rawStringEnd(expr, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{text: expr[i:end], offset: i})
+			i = end
+		case strings.IndexByte("=!<>~&|^", c) >= 0:
+			end := i + 1
+			for end < len(expr) && strings.IndexByte("=<>&|^", expr[end]) >= 0 {
+				end++
+			}
+			tokens = append(tokens, token{text: expr[i:end], offset: i})
+			i = end
+		default:
+			end := i + 1
+			for end < len(expr) && strings.IndexByte(" \t\n\r()[]{}\"=!<>~&|^", expr[end]) < 0 {
+				end++
+			}
+			tokens = append(tokens, token{text: expr[i:end], offset: i})
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+// stringEnd returns the offset after the quoted string that starts at the
+// supplied offset. Quotes may be escaped with a backslash.
+func stringEnd(expr string, start int) (int, error) {
+	for i := start + 1; i < len(expr); i++ {
+		switch expr[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1, nil
+		}
+	}
+	return 0, errors.Errorf(errUnterminatedString, start)
+}
+
+// rawStringEnd returns the offset after the raw string, such as r"a\b" or
+// r#"a"b"#, that starts at the supplied offset.
+func rawStringEnd(expr string, start int) (int, error) {
+	i := start + 1
+	hashes := 0
+	for i < len(expr) && expr[i] == '#' {
+		hashes++
+		i++
+	}
+	if i == len(expr) || expr[i] != '"' {
+		return 0, errors.Errorf(errUnterminatedString, start)
+	}
+	closing := `"` + strings.Repeat("#", hashes)
+	end := strings.Index(expr[i+1:], closing)
+	if end < 0 {
+		return 0, errors.Errorf(errUnterminatedString, start)
+	}
+	return i + 1 + end + len(closing), nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestValidateExpression(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		expr   string
+		want   error
+	}{
+		"True": {
+			reason: "The expression that matches every request should be valid",
+			expr:   "true",
+		},
+		"Comparison": {
+			reason: "A comparison should be valid",
+			expr:   `http.host eq "example.org"`,
+		},
+		"Compound": {
+			reason: "Conditions joined by logical operators and grouped by parentheses should be valid",
+			expr:   `(http.request.uri.path contains "/admin" and not ip.src in {192.0.2.0/24 2001:db8::/32}) or cf.threat_score gt 10`,
+		},
+		"SymbolOperators": {
+			reason: "Logical operators written as symbols should be valid",
+			expr:   `http.host == "example.org" && !(ip.geoip.country in {"FR" "DE"})`,
+		},
+		"EscapedQuote": {
+			reason: "Strings may contain escaped quotes and brackets",
+			expr:   `http.user_agent contains "say \"hi\" (now)"`,
+		},
+		"RawString": {
+			reason: "Raw strings may contain quotes",
+			expr:   `http.request.uri.path matches r#"^/a"b/(c|d)$"#`,
+		},
+		"Index": {
+			reason: "Fields may be indexed",
+			expr:   `any(http.request.headers.names[*] == "x-debug")`,
+		},
+		"Empty": {
+			reason: "An empty expression should be invalid",
+			expr:   "  ",
+			want:   errors.New(errEmptyExpression),
+		},
+		"UnterminatedString": {
+			reason: "An unterminated string should be invalid",
+			expr:   `http.host eq "example.org`,
+			want:   errors.Errorf(errUnterminatedString, 13),
+		},
+		"UnterminatedRawString": {
+			reason: "An unterminated raw string should be invalid",
+			expr:   `http.host matches r#"example"`,
+			want:   errors.Errorf(errUnterminatedString, 18),
+		},
+		"Unclosed": {
+			reason: "An unclosed parenthesis should be invalid",
+			expr:   `(http.host eq "example.org"`,
+			want:   errors.Errorf(errUnclosed, "(", 0),
+		},
+		"UnexpectedClose": {
+			reason: "A closing parenthesis without an opening one should be invalid",
+			expr:   `http.host eq "example.org")`,
+			want:   errors.Errorf(errUnexpectedClose, ")", 26),
+		},
+		"MismatchedBrackets": {
+			reason: "Brackets must be closed by the matching bracket",
+			expr:   `ip.src in {192.0.2.1)`,
+			want:   errors.Errorf(errUnexpectedClose, ")", 20),
+		},
+		"LeadingOperator": {
+			reason: "An expression must not start with a binary operator",
+			expr:   `and http.host eq "example.org"`,
+			want:   errors.Errorf(errDanglingOperator, "and", 0),
+		},
+		"TrailingOperator": {
+			reason: "An expression must not end with an operator",
+			expr:   `http.host eq "example.org" or`,
+			want:   errors.Errorf(errDanglingOperator, "or", 27),
+		},
+		"OperatorBeforeClose": {
+			reason: "A group must not end with an operator",
+			expr:   `(http.host eq "example.org" &&)`,
+			want:   errors.Errorf(errDanglingOperator, "&&", 28),
+		},
+		"ConsecutiveOperators": {
+			reason: "Binary operators must not follow each other",
+			expr:   `http.host eq "a" and or ssl`,
+			want:   errors.Errorf(errConsecutiveOperators, "or", 21),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateExpression(tc.expr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateExpression(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ruleset
+
+import (
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+)
+
+const (
+	errNoAnchor = "no rule with ref %q in the phase entrypoint"
+)
+
+// PhaseRuleEntrypoint returns the parameters that address the entrypoint
+// ruleset holding a phase rule.
+func PhaseRuleEntrypoint(p v1beta1.PhaseRuleParameters) v1beta1.RulesetParameters {
+	return v1beta1.RulesetParameters{
+		Zone:    p.Zone,
+		Account: p.Account,
+		Phase:   p.Phase,
+		Mode:    ptr.To(v1beta1.RulesetModeEntrypoint),
+	}
+}
+
+// FindPhaseRule returns the rule of the ruleset with the given ID, or with
+// the ref of the phase rule if no rule has that ID, and its 1-based index.
+// It returns nil if neither matches.
+func FindPhaseRule(p v1beta1.PhaseRuleParameters, id string, rs *cloudflare.Ruleset) (*cloudflare.RulesetRule, int) {
+	if id != "" {
+		if r, i := findRule(rs, func(r cloudflare.RulesetRule) bool { return r.ID == id }); r != nil {
+			return r, i
+		}
+	}
+	if ref := ptr.Deref(p.Ref, ""); ref != "" {
+		return findRule(rs, func(r cloudflare.RulesetRule) bool { return r.Ref == ref })
+	}
+	return nil, 0
+}
+
+func findRule(rs *cloudflare.Ruleset, match func(cloudflare.RulesetRule) bool) (*cloudflare.RulesetRule, int) {
+	for i := range rs.Rules {
+		if match(rs.Rules[i]) {
+			return &rs.Rules[i], i + 1
+		}
+	}
+	return nil, 0
+}
+
+// PositionPhaseRule returns the rule to write for a phase rule, placed as
+// requested relative to the current rules of the ruleset. Refs are resolved
+// to the rule IDs the API expects.
+func PositionPhaseRule(p v1beta1.PhaseRuleParameters, rs *cloudflare.Ruleset) (PositionedRule, error) {
+	cf, err := ConvertRule(p.RulesetRule)
+	if err != nil {
+		return PositionedRule{}, err
+	}
+
+	pr := PositionedRule{Rule: cf}
+	if p.Position == nil {
+		return pr, nil
+	}
+
+	switch {
+	case p.Position.Index != nil:
+		pr.Index = *p.Position.Index
+	case p.Position.Before != nil:
+		anchor, _ := findRule(rs, func(r cloudflare.RulesetRule) bool { return r.Ref == *p.Position.Before })
+		if anchor == nil {
+			return PositionedRule{}, errors.Errorf(errNoAnchor, *p.Position.Before)
+		}
+		pr.Before = anchor.ID
+	case p.Position.After != nil:
+		anchor, _ := findRule(rs, func(r cloudflare.RulesetRule) bool { return r.Ref == *p.Position.After })
+		if anchor == nil {
+			return PositionedRule{}, errors.Errorf(errNoAnchor, *p.Position.After)
+		}
+		pr.After = anchor.ID
+	}
+
+	return pr, nil
+}
+
+// PositionUpToDate returns true if the rule at the given 1-based index of the
+// ruleset satisfies the requested position. A position relative to a rule
+// that does not exist is never satisfied.
+func PositionUpToDate(p v1beta1.PhaseRuleParameters, rs *cloudflare.Ruleset, index int) bool {
+	if p.Position == nil {
+		return true
+	}
+
+	switch {
+	case p.Position.Index != nil:
+		// An index beyond the end of the ruleset places the rule last.
+		return index == *p.Position.Index || (*p.Position.Index > len(rs.Rules) && index == len(rs.Rules))
+	case p.Position.Before != nil:
+		_, anchor := findRule(rs, func(r cloudflare.RulesetRule) bool { return r.Ref == *p.Position.Before })
+		return anchor > 0 && index < anchor
+	case p.Position.After != nil:
+		_, anchor := findRule(rs, func(r cloudflare.RulesetRule) bool { return r.Ref == *p.Position.After })
+		return anchor > 0 && index > anchor
+	}
+
+	return true
+}
+
+// PhaseRuleUpToDate returns true if the observed rule matches the phase rule
+// and sits in the requested position.
+func PhaseRuleUpToDate(p v1beta1.PhaseRuleParameters, rs *cloudflare.Ruleset, rule cloudflare.RulesetRule, index int) bool {
+	cf, err := ConvertRule(p.RulesetRule)
+	if err != nil {
+		return false
+	}
+	return RuleUpToDate(cf, rule) && PositionUpToDate(p, rs, index)
+}
+
+// GeneratePhaseRuleObservation creates an observation from a rule of the
+// entrypoint ruleset.
+func GeneratePhaseRuleObservation(rs *cloudflare.Ruleset, rule cloudflare.RulesetRule, index int) v1beta1.PhaseRuleObservation {
+	o := v1beta1.PhaseRuleObservation{
+		ID:        rule.ID,
+		RulesetID: rs.ID,
+		Index:     index,
+	}
+
+	if rule.Version != nil {
+		o.Version = *rule.Version
+	}
+
+	if rule.LastUpdated != nil {
+		o.LastModified = &metav1.Time{Time: *rule.LastUpdated}
+	}
+
+	return o
+}
+
+// AddedRule returns the first rule of the updated ruleset that was not in the
+// original ruleset, and its 1-based index. It returns nil if no rule was
+// added.
+func AddedRule(original, updated *cloudflare.Ruleset) (*cloudflare.RulesetRule, int) {
+	existing := make(map[string]bool, len(original.Rules))
+	for _, r := range original.Rules {
+		existing[r.ID] = true
+	}
+	return findRule(updated, func(r cloudflare.RulesetRule) bool { return !existing[r.ID] })
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+)
+
+func phaseRuleset() *cloudflare.Ruleset {
+	return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{
+		{ID: "1", Ref: "first"},
+		{ID: "2", Ref: "mine"},
+		{ID: "3", Ref: "last"},
+	}}
+}
+
+func TestFindPhaseRule(t *testing.T) {
+	cases := map[string]struct {
+		reason    string
+		params    v1beta1.PhaseRuleParameters
+		id        string
+		wantID    string
+		wantIndex int
+	}{
+		"ByID": {
+			reason:    "A rule should be found by its ID",
+			id:        "3",
+			wantID:    "3",
+			wantIndex: 3,
+		},
+		"ByRef": {
+			reason:    "A rule without a known ID should be adopted by ref",
+			params:    v1beta1.PhaseRuleParameters{RulesetRule: v1beta1.RulesetRule{Ref: ptr.To("mine")}},
+			id:        "gone",
+			wantID:    "2",
+			wantIndex: 2,
+		},
+		"NotFound": {
+			reason: "A rule without an ID or ref should not be found",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, i := FindPhaseRule(tc.params, tc.id, phaseRuleset())
+			got := ""
+			if r != nil {
+				got = r.ID
+			}
+			if diff := cmp.Diff(tc.wantID, got); diff != "" {
+				t.Errorf("\n%s\nFindPhaseRule(...): -want ID, +got ID:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.wantIndex, i); diff != "" {
+				t.Errorf("\n%s\nFindPhaseRule(...): -want index, +got index:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPositionPhaseRule(t *testing.T) {
+	type want struct {
+		pr  PositionedRule
+		err error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		position *v1beta1.PhaseRulePosition
+		want     want
+	}{
+		"Append": {
+			reason: "A rule without a position should have none",
+		},
+		"Index": {
+			reason:   "An index should be passed through",
+			position: &v1beta1.PhaseRulePosition{Index: ptr.To(2)},
+			want:     want{pr: PositionedRule{Index: 2}},
+		},
+		"Before": {
+			reason:   "A before ref should be resolved to a rule ID",
+			position: &v1beta1.PhaseRulePosition{Before: ptr.To("last")},
+			want:     want{pr: PositionedRule{Before: "3"}},
+		},
+		"After": {
+			reason:   "An after ref should be resolved to a rule ID",
+			position: &v1beta1.PhaseRulePosition{After: ptr.To("first")},
+			want:     want{pr: PositionedRule{After: "1"}},
+		},
+		"MissingAnchor": {
+			reason:   "A ref that matches no rule should be an error",
+			position: &v1beta1.PhaseRulePosition{After: ptr.To("missing")},
+			want:     want{err: errors.Errorf(errNoAnchor, "missing")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1beta1.PhaseRuleParameters{Position: tc.position}
+			got, err := PositionPhaseRule(p, phaseRuleset())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nPositionPhaseRule(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			// Only the position is of interest here.
+			got.Rule = cloudflare.RulesetRule{}
+			if diff := cmp.Diff(tc.want.pr, got); diff != "" {
+				t.Errorf("\n%s\nPositionPhaseRule(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPositionUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		position *v1beta1.PhaseRulePosition
+		index    int
+		want     bool
+	}{
+		"NoPosition": {
+			reason: "A rule without a position is always in place",
+			index:  3,
+			want:   true,
+		},
+		"AtIndex": {
+			reason:   "A rule at its index is in place",
+			position: &v1beta1.PhaseRulePosition{Index: ptr.To(2)},
+			index:    2,
+			want:     true,
+		},
+		"IndexPastEnd": {
+			reason:   "An index past the end of the ruleset is satisfied by the last rule",
+			position: &v1beta1.PhaseRulePosition{Index: ptr.To(10)},
+			index:    3,
+			want:     true,
+		},
+		"MovedFromIndex": {
+			reason:   "A rule away from its index is out of place",
+			position: &v1beta1.PhaseRulePosition{Index: ptr.To(1)},
+			index:    2,
+			want:     false,
+		},
+		"Before": {
+			reason:   "A rule anywhere before its anchor is in place",
+			position: &v1beta1.PhaseRulePosition{Before: ptr.To("last")},
+			index:    1,
+			want:     true,
+		},
+		"NotAfter": {
+			reason:   "A rule before an anchor it should follow is out of place",
+			position: &v1beta1.PhaseRulePosition{After: ptr.To("last")},
+			index:    2,
+			want:     false,
+		},
+		"MissingAnchor": {
+			reason:   "A position relative to a missing rule is never satisfied",
+			position: &v1beta1.PhaseRulePosition{Before: ptr.To("missing")},
+			index:    1,
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := PositionUpToDate(v1beta1.PhaseRuleParameters{Position: tc.position}, phaseRuleset(), tc.index)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nPositionUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,536 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ruleset
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+)
+
+const (
+	errInvalidPolish        = "invalid polish action parameter"
+	errInvalidSecurityLevel = "invalid securityLevel action parameter"
+	errInvalidSSL           = "invalid ssl action parameter"
+)
+
+// RuleDiff describes the changes needed to bring the rules of a ruleset in
+// line with the desired rules.
+type RuleDiff struct {
+	// Delete lists the IDs of rules to delete.
+	Delete []string
+
+	// Update lists the rules to update. Each rule carries the ID of the
+	// existing rule it replaces.
+	Update []cloudflare.RulesetRule
+
+	// Create lists the rules to create, in ascending order of position.
+	Create []PositionedRule
+}
+
+// PositionedRule is a rule to place at a given 1-based index, or before or
+// after the rule with a given ID. A rule without a position is appended
+// when created and left in place when updated.
+type PositionedRule struct {
+	Rule   cloudflare.RulesetRule
+	Index  int
+	Before string
+	After  string
+}
+
+// Empty returns true if the diff contains no changes.
+func (d RuleDiff) Empty() bool {
+	return len(d.Delete) == 0 && len(d.Update) == 0 && len(d.Create) == 0
+}
+
+// DiffRules computes the rule level changes needed to turn the observed
+// rules into the desired rules, matching rules by ref. It returns false when
+// the rules cannot be reconciled one by one, because a desired rule has no
+// ref, refs are duplicated, rules that are kept changed order or a desired
+// rule cannot be converted. Callers should replace the whole rule list in
+// that case.
+func DiffRules(desired []v1beta1.RulesetRule, observed []cloudflare.RulesetRule) (RuleDiff, bool) {
+	want := make(map[string]int, len(desired))
+	for i, r := range desired {
+		if r.Ref == nil || *r.Ref == "" {
+			return RuleDiff{}, false
+		}
+		if _, dup := want[*r.Ref]; dup {
+			return RuleDiff{}, false
+		}
+		want[*r.Ref] = i
+	}
+
+	diff := RuleDiff{}
+	existing := make(map[string]cloudflare.RulesetRule, len(observed))
+	last := -1
+	for _, o := range observed {
+		i, ok := want[o.Ref]
+		if !ok {
+			diff.Delete = append(diff.Delete, o.ID)
+			continue
+		}
+		if _, dup := existing[o.Ref]; dup || i < last {
+			return RuleDiff{}, false
+		}
+		last = i
+		existing[o.Ref] = o
+	}
+
+	for i, r := range desired {
+		cf, err := ConvertRule(r)
+		if err != nil {
+			return RuleDiff{}, false
+		}
+		o, ok := existing[*r.Ref]
+		if !ok {
+			diff.Create = append(diff.Create, PositionedRule{Rule: cf, Index: i + 1})
+			continue
+		}
+		if !RuleUpToDate(cf, o) {
+			cf.ID = o.ID
+			diff.Update = append(diff.Update, cf)
+		}
+	}
+
+	return diff, true
+}
+
+// RulesUpToDate returns true if the observed rules match the desired rules
+// position by position.
+func RulesUpToDate(desired []v1beta1.RulesetRule, observed []cloudflare.RulesetRule) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	for i := range desired {
+		cf, err := ConvertRule(desired[i])
+		if err != nil || !RuleUpToDate(cf, observed[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// RuleUpToDate returns true if the observed rule equals the desired rule.
+// Fields that Cloudflare sets on every rule, such as its ID and version,
+// are ignored, as are the defaults Cloudflare fills in for fields the
+// desired rule leaves unset. Any other field that is set on only one of
+// the rules is drift, so removing a field from the desired rule is
+// detected.
+func RuleUpToDate(desired, observed cloudflare.RulesetRule) bool {
+	d, err := json.Marshal(normalizeRule(desired, desired))
+	if err != nil {
+		return false
+	}
+	o, err := json.Marshal(normalizeRule(observed, desired))
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(d, o)
+}
+
+// normalizeRule returns the supplied rule without the fields Cloudflare
+// sets on every rule, and without the defaults Cloudflare fills in for the
+// fields the desired rule leaves unset.
+func normalizeRule(r, desired cloudflare.RulesetRule) cloudflare.RulesetRule {
+	r.ID, r.Version, r.LastUpdated = "", nil, nil
+
+	// Cloudflare gives rules without a ref their ID as ref.
+	if desired.Ref == "" {
+		r.Ref = ""
+	}
+
+	// Rules are enabled, and log, unless told otherwise.
+	if desired.Enabled == nil && ptr.Deref(r.Enabled, true) {
+		r.Enabled = nil
+	}
+	if desired.Logging == nil && r.Logging != nil && ptr.Deref(r.Logging.Enabled, true) {
+		r.Logging = nil
+	}
+
+	// Cloudflare runs the latest version of a ruleset an execute rule
+	// deploys unless told otherwise, and says so.
+	if r.ActionParameters != nil && r.ActionParameters.Version != nil &&
+		(desired.ActionParameters == nil || desired.ActionParameters.Version == nil) {
+		ap := *r.ActionParameters
+		ap.Version = nil
+		r.ActionParameters = &ap
+	}
+
+	return r
+}
+
+// ConvertRule converts a v1beta1 rule to the Cloudflare API format. It
+// returns an error if the rule has an action parameter Cloudflare would not
+// accept.
+func ConvertRule(rule v1beta1.RulesetRule) (cloudflare.RulesetRule, error) {
+	ap, err := convertActionParameters(rule.ActionParameters)
+	if err != nil {
+		return cloudflare.RulesetRule{}, err
+	}
+
+	cfRule := cloudflare.RulesetRule{
+		Action:           rule.Action,
+		Expression:       rule.Expression,
+		Enabled:          rule.Enabled,
+		ActionParameters: ap,
+	}
+
+	if rule.Ref != nil {
+		cfRule.Ref = *rule.Ref
+	}
+
+	if rule.Description != nil {
+		cfRule.Description = *rule.Description
+	}
+
+	if rule.ScoreThreshold != nil {
+		cfRule.ScoreThreshold = *rule.ScoreThreshold
+	}
+
+	if rule.Logging != nil {
+		cfRule.Logging = &cloudflare.RulesetRuleLogging{Enabled: rule.Logging.Enabled}
+	}
+
+	if rl := rule.RateLimit; rl != nil {
+		cfRule.RateLimit = &cloudflare.RulesetRuleRateLimit{
+			Characteristics:         rl.Characteristics,
+			Period:                  rl.Period,
+			RequestsPerPeriod:       ptr.Deref(rl.RequestsPerPeriod, 0),
+			ScorePerPeriod:          ptr.Deref(rl.ScorePerPeriod, 0),
+			ScoreResponseHeaderName: ptr.Deref(rl.ScoreResponseHeaderName, ""),
+			MitigationTimeout:       ptr.Deref(rl.MitigationTimeout, 0),
+			CountingExpression:      ptr.Deref(rl.CountingExpression, ""),
+			RequestsToOrigin:        ptr.Deref(rl.RequestsToOrigin, false),
+		}
+	}
+
+	if ecc := rule.ExposedCredentialCheck; ecc != nil {
+		cfRule.ExposedCredentialCheck = &cloudflare.RulesetRuleExposedCredentialCheck{
+			UsernameExpression: ecc.UsernameExpression,
+			PasswordExpression: ecc.PasswordExpression,
+		}
+	}
+
+	return cfRule, nil
+}
+
+func convertActionParameters(ap *v1beta1.RuleActionParameters) (*cloudflare.RulesetRuleActionParameters, error) { //nolint:gocyclo // A flat mapping of every supported field.
+	if ap == nil {
+		return nil, nil
+	}
+
+	p := &cloudflare.RulesetRuleActionParameters{
+		ID:                       ptr.Deref(ap.ID, ""),
+		Version:                  ap.Version,
+		Ruleset:                  ptr.Deref(ap.Ruleset, ""),
+		Rulesets:                 ap.Rulesets,
+		Rules:                    ap.Rules,
+		Phases:                   ap.Phases,
+		Products:                 ap.Products,
+		Increment:                ptr.Deref(ap.Increment, 0),
+		HostHeader:               ptr.Deref(ap.HostHeader, ""),
+		RequestFields:            logCustomFields(ap.RequestFields),
+		ResponseFields:           logCustomFields(ap.ResponseFields),
+		CookieFields:             logCustomFields(ap.CookieFields),
+		Content:                  ptr.Deref(ap.Content, ""),
+		ContentType:              ptr.Deref(ap.ContentType, ""),
+		Cache:                    ap.Cache,
+		RespectStrongETags:       ap.RespectStrongETags,
+		OriginCacheControl:       ap.OriginCacheControl,
+		OriginErrorPagePassthru:  ap.OriginErrorPagePassthru,
+		AdditionalCacheablePorts: ap.AdditionalCacheablePorts,
+		AutomaticHTTPSRewrites:   ap.AutomaticHTTPSRewrites,
+		BrowserIntegrityCheck:    ap.BrowserIntegrityCheck,
+		DisableApps:              ap.DisableApps,
+		DisableZaraz:             ap.DisableZaraz,
+		DisableRailgun:           ap.DisableRailgun,
+		DisableRUM:               ap.DisableRUM,
+		EmailObfuscation:         ap.EmailObfuscation,
+		Fonts:                    ap.Fonts,
+		HotLinkProtection:        ap.HotLinkProtection,
+		Mirage:                   ap.Mirage,
+		OpportunisticEncryption:  ap.OpportunisticEncryption,
+		RocketLoader:             ap.RocketLoader,
+		ServerSideExcludes:       ap.ServerSideExcludes,
+		SXG:                      ap.SXG,
+	}
+
+	if ap.StatusCode != nil {
+		p.StatusCode = uint16(*ap.StatusCode) //nolint:gosec // Status codes are validated by the CRD.
+	}
+
+	if ap.ReadTimeout != nil {
+		p.ReadTimeout = ptr.To(uint(*ap.ReadTimeout)) //nolint:gosec // Timeouts are never negative.
+	}
+
+	if o := ap.Overrides; o != nil {
+		p.Overrides = &cloudflare.RulesetRuleActionParametersOverrides{
+			Enabled:          o.Enabled,
+			Action:           ptr.Deref(o.Action, ""),
+			SensitivityLevel: ptr.Deref(o.SensitivityLevel, ""),
+		}
+		for _, c := range o.Categories {
+			p.Overrides.Categories = append(p.Overrides.Categories, cloudflare.RulesetRuleActionParametersCategories{
+				Category: c.Category,
+				Action:   ptr.Deref(c.Action, ""),
+				Enabled:  c.Enabled,
+			})
+		}
+		for _, r := range o.Rules {
+			p.Overrides.Rules = append(p.Overrides.Rules, cloudflare.RulesetRuleActionParametersRules{
+				ID:               r.ID,
+				Action:           ptr.Deref(r.Action, ""),
+				Enabled:          r.Enabled,
+				ScoreThreshold:   ptr.Deref(r.ScoreThreshold, 0),
+				SensitivityLevel: ptr.Deref(r.SensitivityLevel, ""),
+			})
+		}
+	}
+
+	if ap.MatchedData != nil {
+		p.MatchedData = &cloudflare.RulesetRuleActionParametersMatchedData{PublicKey: ap.MatchedData.PublicKey}
+	}
+
+	if r := ap.Response; r != nil {
+		p.Response = &cloudflare.RulesetRuleActionParametersBlockResponse{
+			StatusCode:  uint16(r.StatusCode), //nolint:gosec // Status codes are validated by the CRD.
+			ContentType: r.ContentType,
+			Content:     r.Content,
+		}
+	}
+
+	if u := ap.URI; u != nil {
+		p.URI = &cloudflare.RulesetRuleActionParametersURI{Origin: u.Origin}
+		if u.Path != nil {
+			p.URI.Path = &cloudflare.RulesetRuleActionParametersURIPath{
+				Value:      ptr.Deref(u.Path.Value, ""),
+				Expression: ptr.Deref(u.Path.Expression, ""),
+			}
+		}
+		if u.Query != nil {
+			p.URI.Query = &cloudflare.RulesetRuleActionParametersURIQuery{
+				Value:      u.Query.Value,
+				Expression: ptr.Deref(u.Query.Expression, ""),
+			}
+		}
+	}
+
+	if len(ap.Headers) > 0 {
+		p.Headers = make(map[string]cloudflare.RulesetRuleActionParametersHTTPHeader, len(ap.Headers))
+		for name, h := range ap.Headers {
+			p.Headers[name] = cloudflare.RulesetRuleActionParametersHTTPHeader{
+				Operation:  h.Operation,
+				Value:      ptr.Deref(h.Value, ""),
+				Expression: ptr.Deref(h.Expression, ""),
+			}
+		}
+	}
+
+	if fl := ap.FromList; fl != nil {
+		p.FromList = &cloudflare.RulesetRuleActionParametersFromList{Name: fl.Name, Key: fl.Key}
+	}
+
+	if fv := ap.FromValue; fv != nil {
+		p.FromValue = &cloudflare.RulesetRuleActionParametersFromValue{
+			TargetURL: cloudflare.RulesetRuleActionParametersTargetURL{
+				Value:      ptr.Deref(fv.TargetURL.Value, ""),
+				Expression: ptr.Deref(fv.TargetURL.Expression, ""),
+			},
+			PreserveQueryString: fv.PreserveQueryString,
+		}
+		if fv.StatusCode != nil {
+			p.FromValue.StatusCode = uint16(*fv.StatusCode) //nolint:gosec // Status codes are validated by the CRD.
+		}
+	}
+
+	if o := ap.Origin; o != nil {
+		p.Origin = &cloudflare.RulesetRuleActionParametersOrigin{Host: ptr.Deref(o.Host, "")}
+		if o.Port != nil {
+			p.Origin.Port = uint16(*o.Port) //nolint:gosec // Ports are never larger than 65535.
+		}
+	}
+
+	if ap.SNI != nil {
+		p.SNI = &cloudflare.RulesetRuleActionParametersSni{Value: ap.SNI.Value}
+	}
+
+	p.CacheKey = convertCacheKey(ap.CacheKey)
+
+	if cr := ap.CacheReserve; cr != nil {
+		p.CacheReserve = &cloudflare.RulesetRuleActionParametersCacheReserve{
+			Eligible:        cr.Eligible,
+			MinimumFileSize: uintPtr(cr.MinimumFileSize),
+		}
+	}
+
+	if et := ap.EdgeTTL; et != nil {
+		p.EdgeTTL = &cloudflare.RulesetRuleActionParametersEdgeTTL{
+			Mode:    ptr.Deref(et.Mode, ""),
+			Default: uintPtr(et.Default),
+		}
+		for _, sc := range et.StatusCodeTTL {
+			t := cloudflare.RulesetRuleActionParametersStatusCodeTTL{
+				StatusCodeValue: uintPtr(sc.StatusCode),
+				Value:           ptr.To(sc.Value),
+			}
+			if sc.StatusCodeRange != nil {
+				t.StatusCodeRange = &cloudflare.RulesetRuleActionParametersStatusCodeRange{
+					From: uintPtr(sc.StatusCodeRange.From),
+					To:   uintPtr(sc.StatusCodeRange.To),
+				}
+			}
+			p.EdgeTTL.StatusCodeTTL = append(p.EdgeTTL.StatusCodeTTL, t)
+		}
+	}
+
+	if bt := ap.BrowserTTL; bt != nil {
+		p.BrowserTTL = &cloudflare.RulesetRuleActionParametersBrowserTTL{
+			Mode:    bt.Mode,
+			Default: uintPtr(bt.Default),
+		}
+	}
+
+	if ap.ServeStale != nil {
+		p.ServeStale = &cloudflare.RulesetRuleActionParametersServeStale{
+			DisableStaleWhileUpdating: ap.ServeStale.DisableStaleWhileUpdating,
+		}
+	}
+
+	if am := ap.AutoMinify; am != nil {
+		p.AutoMinify = &cloudflare.RulesetRuleActionParametersAutoMinify{HTML: am.HTML, CSS: am.CSS, JS: am.JS}
+	}
+
+	if ap.Polish != nil {
+		v, err := cloudflare.PolishFromString(*ap.Polish)
+		if err != nil {
+			return nil, errors.Wrap(err, errInvalidPolish)
+		}
+		p.Polish = v
+	}
+
+	if ap.SecurityLevel != nil {
+		v, err := cloudflare.SecurityLevelFromString(*ap.SecurityLevel)
+		if err != nil {
+			return nil, errors.Wrap(err, errInvalidSecurityLevel)
+		}
+		p.SecurityLevel = v
+	}
+
+	if ap.SSL != nil {
+		v, err := cloudflare.SSLFromString(*ap.SSL)
+		if err != nil {
+			return nil, errors.Wrap(err, errInvalidSSL)
+		}
+		p.SSL = v
+	}
+
+	for _, a := range ap.Algorithms {
+		p.Algorithms = append(p.Algorithms, cloudflare.RulesetRuleActionParametersCompressionAlgorithm{Name: a})
+	}
+
+	return p, nil
+}
+
+func convertCacheKey(ck *v1beta1.RuleCacheKey) *cloudflare.RulesetRuleActionParametersCacheKey {
+	if ck == nil {
+		return nil
+	}
+
+	k := &cloudflare.RulesetRuleActionParametersCacheKey{
+		CacheByDeviceType:       ck.CacheByDeviceType,
+		IgnoreQueryStringsOrder: ck.IgnoreQueryStringsOrder,
+		CacheDeceptionArmor:     ck.CacheDeceptionArmor,
+	}
+
+	ckey := ck.CustomKey
+	if ckey == nil {
+		return k
+	}
+
+	k.CustomKey = &cloudflare.RulesetRuleActionParametersCustomKey{}
+	if q := ckey.Query; q != nil {
+		k.CustomKey.Query = &cloudflare.RulesetRuleActionParametersCustomKeyQuery{
+			Include: customKeyList(q.Include),
+			Exclude: customKeyList(q.Exclude),
+			Ignore:  q.Ignore,
+		}
+	}
+	if h := ckey.Header; h != nil {
+		k.CustomKey.Header = &cloudflare.RulesetRuleActionParametersCustomKeyHeader{
+			RulesetRuleActionParametersCustomKeyFields: cloudflare.RulesetRuleActionParametersCustomKeyFields{
+				Include:       h.Include,
+				CheckPresence: h.CheckPresence,
+			},
+			ExcludeOrigin: h.ExcludeOrigin,
+			Contains:      h.Contains,
+		}
+	}
+	if c := ckey.Cookie; c != nil {
+		k.CustomKey.Cookie = &cloudflare.RulesetRuleActionParametersCustomKeyCookie{
+			Include:       c.Include,
+			CheckPresence: c.CheckPresence,
+		}
+	}
+	if u := ckey.User; u != nil {
+		k.CustomKey.User = &cloudflare.RulesetRuleActionParametersCustomKeyUser{
+			DeviceType: u.DeviceType,
+			Geo:        u.Geo,
+			Lang:       u.Lang,
+		}
+	}
+	if h := ckey.Host; h != nil {
+		k.CustomKey.Host = &cloudflare.RulesetRuleActionParametersCustomKeyHost{Resolved: h.Resolved}
+	}
+
+	return k
+}
+
+// customKeyList converts a list of query string parameters, where a single
+// "*" selects every parameter.
+func customKeyList(l []string) *cloudflare.RulesetRuleActionParametersCustomKeyList {
+	if len(l) == 0 {
+		return nil
+	}
+	if len(l) == 1 && l[0] == "*" {
+		return &cloudflare.RulesetRuleActionParametersCustomKeyList{All: true}
+	}
+	return &cloudflare.RulesetRuleActionParametersCustomKeyList{List: l}
+}
+
+func logCustomFields(names []string) []cloudflare.RulesetActionParametersLogCustomField {
+	if len(names) == 0 {
+		return nil
+	}
+	f := make([]cloudflare.RulesetActionParametersLogCustomField, len(names))
+	for i, n := range names {
+		f[i] = cloudflare.RulesetActionParametersLogCustomField{Name: n}
+	}
+	return f
+}
+
+func uintPtr(i *int) *uint {
+	if i == nil {
+		return nil
+	}
+	return ptr.To(uint(*i)) //nolint:gosec // TTLs and sizes are never negative.
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+)
+
+func TestRuleUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		desired  v1beta1.RulesetRule
+		observed cloudflare.RulesetRule
+		want     bool
+	}{
+		"Identical": {
+			reason:   "A rule that matches exactly should be up to date",
+			desired:  v1beta1.RulesetRule{Action: "block", Expression: "true"},
+			observed: cloudflare.RulesetRule{Action: "block", Expression: "true"},
+			want:     true,
+		},
+		"ServerDefaults": {
+			reason:  "Fields populated by Cloudflare but not set in the spec should be ignored",
+			desired: v1beta1.RulesetRule{Ref: ptr.To("r"), Action: "block", Expression: "true"},
+			observed: cloudflare.RulesetRule{
+				ID:         "id",
+				Version:    ptr.To("3"),
+				Ref:        "r",
+				Action:     "block",
+				Expression: "true",
+				Enabled:    ptr.To(true),
+				Logging:    &cloudflare.RulesetRuleLogging{Enabled: ptr.To(true)},
+			},
+			want: true,
+		},
+		"ExpressionChanged": {
+			reason:   "A changed expression should be detected",
+			desired:  v1beta1.RulesetRule{Action: "block", Expression: "false"},
+			observed: cloudflare.RulesetRule{Action: "block", Expression: "true"},
+			want:     false,
+		},
+		"ActionChanged": {
+			reason:   "A changed action should be detected",
+			desired:  v1beta1.RulesetRule{Action: "log", Expression: "true"},
+			observed: cloudflare.RulesetRule{Action: "block", Expression: "true"},
+			want:     false,
+		},
+		"EnabledChanged": {
+			reason:   "Disabling a rule should be detected",
+			desired:  v1beta1.RulesetRule{Action: "block", Expression: "true", Enabled: ptr.To(false)},
+			observed: cloudflare.RulesetRule{Action: "block", Expression: "true", Enabled: ptr.To(true)},
+			want:     false,
+		},
+		"ActionParametersChanged": {
+			reason: "A changed block response should be detected",
+			desired: v1beta1.RulesetRule{
+				Action:     "block",
+				Expression: "true",
+				ActionParameters: &v1beta1.RuleActionParameters{
+					Response: &v1beta1.RuleBlockResponse{StatusCode: 403, ContentType: "text/plain", Content: "no"},
+				},
+			},
+			observed: cloudflare.RulesetRule{
+				Action:     "block",
+				Expression: "true",
+				ActionParameters: &cloudflare.RulesetRuleActionParameters{
+					Response: &cloudflare.RulesetRuleActionParametersBlockResponse{StatusCode: 429, ContentType: "text/plain", Content: "no"},
+				},
+			},
+			want: false,
+		},
+		"SkipRulesChanged": {
+			reason: "A changed set of skipped rules should be detected",
+			desired: v1beta1.RulesetRule{
+				Action:     "skip",
+				Expression: "true",
+				ActionParameters: &v1beta1.RuleActionParameters{
+					Rules: map[string][]string{"rs": {"a"}},
+				},
+			},
+			observed: cloudflare.RulesetRule{
+				Action:     "skip",
+				Expression: "true",
+				ActionParameters: &cloudflare.RulesetRuleActionParameters{
+					Rules: map[string][]string{"rs": {"a"}, "other": {"b"}},
+				},
+			},
+			want: false,
+		},
+		"RateLimitChanged": {
+			reason: "A changed rate limit should be detected",
+			desired: v1beta1.RulesetRule{
+				Action:     "block",
+				Expression: "true",
+				RateLimit: &v1beta1.RuleRateLimit{
+					Characteristics:   []string{"ip.src", "cf.colo.id"},
+					Period:            60,
+					RequestsPerPeriod: ptr.To(100),
+				},
+			},
+			observed: cloudflare.RulesetRule{
+				Action:     "block",
+				Expression: "true",
+				RateLimit: &cloudflare.RulesetRuleRateLimit{
+					Characteristics:   []string{"ip.src", "cf.colo.id"},
+					Period:            60,
+					RequestsPerPeriod: 50,
+				},
+			},
+			want: false,
+		},
+		"DescriptionRemoved": {
+			reason:   "Removing the description of a rule should be detected",
+			desired:  v1beta1.RulesetRule{Action: "block", Expression: "true"},
+			observed: cloudflare.RulesetRule{Action: "block", Expression: "true", Description: "old"},
+			want:     false,
+		},
+		"ActionParameterRemoved": {
+			reason: "Removing an action parameter should be detected",
+			desired: v1beta1.RulesetRule{
+				Action:           "set_config",
+				Expression:       "true",
+				ActionParameters: &v1beta1.RuleActionParameters{Polish: ptr.To("lossy")},
+			},
+			observed: cloudflare.RulesetRule{
+				Action:     "set_config",
+				Expression: "true",
+				ActionParameters: &cloudflare.RulesetRuleActionParameters{
+					Polish:        cloudflare.PolishLossy.IntoRef(),
+					SecurityLevel: cloudflare.SecurityLevelHigh.IntoRef(),
+				},
+			},
+			want: false,
+		},
+		"LoggingDisabled": {
+			reason:   "A rule that no longer logs should be detected when the spec relies on the default",
+			desired:  v1beta1.RulesetRule{Action: "skip", Expression: "true"},
+			observed: cloudflare.RulesetRule{Action: "skip", Expression: "true", Logging: &cloudflare.RulesetRuleLogging{Enabled: ptr.To(false)}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			desired, err := ConvertRule(tc.desired)
+			if err != nil {
+				t.Fatalf("ConvertRule(...): %v", err)
+			}
+			got := RuleUpToDate(desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nRuleUpToDate(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDiffRules(t *testing.T) {
+	rule := func(ref, action string) v1beta1.RulesetRule {
+		return v1beta1.RulesetRule{Ref: ptr.To(ref), Action: action, Expression: "true"}
+	}
+	cfRule := func(id, ref, action string) cloudflare.RulesetRule {
+		return cloudflare.RulesetRule{ID: id, Ref: ref, Action: action, Expression: "true"}
+	}
+
+	type want struct {
+		diff RuleDiff
+		ok   bool
+	}
+
+	cases := map[string]struct {
+		reason   string
+		desired  []v1beta1.RulesetRule
+		observed []cloudflare.RulesetRule
+		want     want
+	}{
+		"NoChanges": {
+			reason:   "Matching rules should produce an empty diff",
+			desired:  []v1beta1.RulesetRule{rule("a", "block"), rule("b", "log")},
+			observed: []cloudflare.RulesetRule{cfRule("1", "a", "block"), cfRule("2", "b", "log")},
+			want:     want{ok: true},
+		},
+		"CreateUpdateDelete": {
+			reason:   "Rules should be created, updated and deleted by ref",
+			desired:  []v1beta1.RulesetRule{rule("new", "log"), rule("a", "challenge"), rule("b", "log")},
+			observed: []cloudflare.RulesetRule{cfRule("1", "a", "block"), cfRule("2", "gone", "block"), cfRule("3", "b", "log")},
+			want: want{
+				ok: true,
+				diff: RuleDiff{
+					Delete: []string{"2"},
+					Update: []cloudflare.RulesetRule{cfRule("1", "a", "challenge")},
+					Create: []PositionedRule{{Rule: cloudflare.RulesetRule{Ref: "new", Action: "log", Expression: "true"}, Index: 1}},
+				},
+			},
+		},
+		"MissingRef": {
+			reason:   "A desired rule without a ref cannot be diffed",
+			desired:  []v1beta1.RulesetRule{{Action: "block", Expression: "true"}},
+			observed: []cloudflare.RulesetRule{cfRule("1", "a", "block")},
+			want:     want{ok: false},
+		},
+		"DuplicateRef": {
+			reason:   "Duplicate refs cannot be diffed",
+			desired:  []v1beta1.RulesetRule{rule("a", "block"), rule("a", "log")},
+			observed: []cloudflare.RulesetRule{},
+			want:     want{ok: false},
+		},
+		"Reordered": {
+			reason:   "Rules that were kept but changed order cannot be diffed",
+			desired:  []v1beta1.RulesetRule{rule("b", "log"), rule("a", "block")},
+			observed: []cloudflare.RulesetRule{cfRule("1", "a", "block"), cfRule("2", "b", "log")},
+			want:     want{ok: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diff, ok := DiffRules(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.ok, ok); diff != "" {
+				t.Errorf("\n%s\nDiffRules(...): -want ok, +got ok:\n%s\n", tc.reason, diff)
+			}
+			if d := cmp.Diff(tc.want.diff, diff); d != "" {
+				t.Errorf("\n%s\nDiffRules(...): -want, +got:\n%s\n", tc.reason, d)
+			}
+		})
+	}
+}
+
+func TestRulesUpToDate(t *testing.T) {
+	desired := []v1beta1.RulesetRule{
+		{Action: "block", Expression: "a"},
+		{Action: "log", Expression: "b"},
+	}
+
+	if !RulesUpToDate(desired, []cloudflare.RulesetRule{
+		{Action: "block", Expression: "a"},
+		{Action: "log", Expression: "b"},
+	}) {
+		t.Errorf("RulesUpToDate(...): matching rules should be up to date")
+	}
+
+	if RulesUpToDate(desired, []cloudflare.RulesetRule{
+		{Action: "log", Expression: "b"},
+		{Action: "block", Expression: "a"},
+	}) {
+		t.Errorf("RulesUpToDate(...): reordered rules should not be up to date")
+	}
+
+	if RulesUpToDate(desired, []cloudflare.RulesetRule{
+		{Action: "block", Expression: "a"},
+	}) {
+		t.Errorf("RulesUpToDate(...): a missing rule should not be up to date")
+	}
+}
+
+func TestConvertRule(t *testing.T) {
+	_, errPolish := cloudflare.PolishFromString("heavy")
+	_, errSecurityLevel := cloudflare.SecurityLevelFromString("paranoid")
+	_, errSSL := cloudflare.SSLFromString("strictest")
+
+	cases := map[string]struct {
+		reason string
+		ap     *v1beta1.RuleActionParameters
+		want   error
+	}{
+		"Valid": {
+			reason: "Known action parameter values should convert",
+			ap:     &v1beta1.RuleActionParameters{Polish: ptr.To("lossy"), SecurityLevel: ptr.To("high"), SSL: ptr.To("strict")},
+		},
+		"InvalidPolish": {
+			reason: "An unknown polish value should be rejected rather than dropped",
+			ap:     &v1beta1.RuleActionParameters{Polish: ptr.To("heavy")},
+			want:   errors.Wrap(errPolish, errInvalidPolish),
+		},
+		"InvalidSecurityLevel": {
+			reason: "An unknown security level should be rejected rather than dropped",
+			ap:     &v1beta1.RuleActionParameters{SecurityLevel: ptr.To("paranoid")},
+			want:   errors.Wrap(errSecurityLevel, errInvalidSecurityLevel),
+		},
+		"InvalidSSL": {
+			reason: "An unknown SSL mode should be rejected rather than dropped",
+			ap:     &v1beta1.RuleActionParameters{SSL: ptr.To("strictest")},
+			want:   errors.Wrap(errSSL, errInvalidSSL),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ConvertRule(v1beta1.RulesetRule{Action: "set_config", Expression: "true", ActionParameters: tc.ap})
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nConvertRule(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
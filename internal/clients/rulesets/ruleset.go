@@ -18,20 +18,32 @@ package ruleset
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/phaselock"
 )
 
 const (
-	errCreateRuleset = "failed to create ruleset"
-	errGetRuleset    = "failed to get ruleset"
-	errUpdateRuleset = "failed to update ruleset"
-	errDeleteRuleset = "failed to delete ruleset"
+	errCreateRuleset  = "failed to create ruleset"
+	errGetRuleset     = "failed to get ruleset"
+	errUpdateRuleset  = "failed to update ruleset"
+	errDeleteRuleset  = "failed to delete ruleset"
+	errCreateRule     = "failed to create ruleset rule"
+	errUpdateRule     = "failed to update ruleset rule"
+	errDeleteRule     = "failed to delete ruleset rule"
+	errDecodeRuleset  = "failed to decode ruleset"
+	errGetEntrypoint  = "failed to get phase entrypoint ruleset"
+	errPutEntrypoint  = "failed to update phase entrypoint ruleset"
+	errLockEntrypoint = "failed to lock phase entrypoint ruleset"
 )
 
 // Client interface for Cloudflare Ruleset operations
@@ -40,6 +52,11 @@ type Client interface {
 	GetRuleset(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
 	UpdateRuleset(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
 	DeleteRuleset(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) error
+	CreateRulesetRule(ctx context.Context, rulesetID string, rule PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+	UpdateRulesetRule(ctx context.Context, rulesetID string, rule PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+	DeleteRulesetRule(ctx context.Context, rulesetID, ruleID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+	GetEntrypointRuleset(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+	UpdateEntrypointRuleset(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
 }
 
 // NewClient creates a new Cloudflare Ruleset client
@@ -57,11 +74,16 @@ type client struct {
 
 // CreateRuleset creates a new Cloudflare ruleset
 func (c *client) CreateRuleset(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	rules, err := convertRulesToCloudflare(params.Rules)
+	if err != nil {
+		return nil, err
+	}
+
 	createParams := cloudflare.CreateRulesetParams{
 		Name:  params.Name,
 		Kind:  params.Kind,
 		Phase: params.Phase,
-		Rules: convertRulesToCloudflare(params.Rules),
+		Rules: rules,
 	}
 
 	if params.Description != nil {
@@ -106,9 +128,14 @@ func (c *client) GetRuleset(ctx context.Context, rulesetID string, params v1beta
 
 // UpdateRuleset updates a Cloudflare ruleset
 func (c *client) UpdateRuleset(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	rules, err := convertRulesToCloudflare(params.Rules)
+	if err != nil {
+		return nil, err
+	}
+
 	updateParams := cloudflare.UpdateRulesetParams{
 		ID:    rulesetID,
-		Rules: convertRulesToCloudflare(params.Rules),
+		Rules: rules,
 	}
 
 	if params.Description != nil {
@@ -151,55 +178,188 @@ func (c *client) DeleteRuleset(ctx context.Context, rulesetID string, params v1b
 	return nil
 }
 
-// IsRulesetNotFound checks if error indicates ruleset not found
-func IsRulesetNotFound(err error) bool {
-	if err == nil {
-		return false
+// GetEntrypointRuleset retrieves the entrypoint ruleset of the phase
+func (c *client) GetEntrypointRuleset(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	rc, err := resourceContainer(params)
+	if err != nil {
+		return nil, err
 	}
-	// Check for Cloudflare API not found errors
-	if cfErr := (*cloudflare.Error)(nil); errors.As(err, &cfErr) {
-		return cfErr.StatusCode == 404
+
+	ruleset, err := c.api.GetEntrypointRuleset(ctx, rc, params.Phase)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetEntrypoint)
 	}
-	return false
+
+	return &ruleset, nil
 }
 
-// convertRulesToCloudflare converts v1beta1 rules to Cloudflare API format
-func convertRulesToCloudflare(rules []v1beta1.RulesetRule) []cloudflare.RulesetRule {
-	var cfRules []cloudflare.RulesetRule
+// UpdateEntrypointRuleset replaces the rules of the entrypoint ruleset of the
+// phase, creating the entrypoint if it does not exist yet
+func (c *client) UpdateEntrypointRuleset(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	rc, err := resourceContainer(params)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, rule := range rules {
-		cfRule := cloudflare.RulesetRule{
-			Action:     rule.Action,
-			Expression: rule.Expression,
-		}
+	rules, err := convertRulesToCloudflare(params.Rules)
+	if err != nil {
+		return nil, err
+	}
 
-		if rule.Description != nil {
-			cfRule.Description = *rule.Description
-		}
+	updateParams := cloudflare.UpdateEntrypointRulesetParams{
+		Phase: params.Phase,
+		Rules: rules,
+	}
 
-		if rule.Enabled != nil {
-			cfRule.Enabled = rule.Enabled
-		}
+	if params.Description != nil {
+		updateParams.Description = *params.Description
+	}
+
+	ruleset, err := c.api.UpdateEntrypointRuleset(ctx, rc, updateParams)
+	if err != nil {
+		return nil, errors.Wrap(err, errPutEntrypoint)
+	}
+
+	return &ruleset, nil
+}
+
+// LockEntrypoint acquires the lock of the entrypoint ruleset of the phase,
+// which every writer of a phase entrypoint must hold so that concurrent
+// reconciles do not overwrite each other's rules. It returns a function
+// that releases the lock.
+func LockEntrypoint(ctx context.Context, params v1beta1.RulesetParameters) (func(), error) {
+	k := phaselock.ZoneKey(ptr.Deref(params.Zone, ""), params.Phase)
+	if params.Zone == nil {
+		k = phaselock.AccountKey(ptr.Deref(params.Account, ""), params.Phase)
+	}
+	unlock, err := phaselock.Lock(ctx, k)
+	return unlock, errors.Wrap(err, errLockEntrypoint)
+}
 
-		cfRules = append(cfRules, cfRule)
+// IsEntrypoint returns true if the ruleset manages the phase entrypoint
+func IsEntrypoint(params v1beta1.RulesetParameters) bool {
+	return params.Mode != nil && *params.Mode == v1beta1.RulesetModeEntrypoint
+}
+
+// rulePosition places a rule within a ruleset. Index is 1-based, Before
+// and After are rule IDs.
+type rulePosition struct {
+	Index  int    `json:"index,omitempty"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// position returns the position of the rule, or nil if it has none.
+func (r PositionedRule) position() *rulePosition {
+	if r.Index <= 0 && r.Before == "" && r.After == "" {
+		return nil
+	}
+	return &rulePosition{Index: r.Index, Before: r.Before, After: r.After}
+}
+
+// ruleRequest is the body of a request to create or update a single rule.
+type ruleRequest struct {
+	cloudflare.RulesetRule
+	Position *rulePosition `json:"position,omitempty"`
+}
+
+// CreateRulesetRule adds a single rule to a Cloudflare ruleset
+func (c *client) CreateRulesetRule(ctx context.Context, rulesetID string, rule PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	body := ruleRequest{RulesetRule: rule.Rule, Position: rule.position()}
+	rs, err := c.rawRuleset(ctx, http.MethodPost, rulesetID, "", body, params)
+	return rs, errors.Wrap(err, errCreateRule)
+}
+
+// UpdateRulesetRule replaces a single rule of a Cloudflare ruleset, moving
+// it if a position is given
+func (c *client) UpdateRulesetRule(ctx context.Context, rulesetID string, rule PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	body := ruleRequest{RulesetRule: rule.Rule, Position: rule.position()}
+	rs, err := c.rawRuleset(ctx, http.MethodPatch, rulesetID, rule.Rule.ID, body, params)
+	return rs, errors.Wrap(err, errUpdateRule)
+}
+
+// DeleteRulesetRule removes a single rule from a Cloudflare ruleset
+func (c *client) DeleteRulesetRule(ctx context.Context, rulesetID, ruleID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	rs, err := c.rawRuleset(ctx, http.MethodDelete, rulesetID, ruleID, nil, params)
+	return rs, errors.Wrap(err, errDeleteRule)
+}
+
+// rawRuleset calls the rules endpoint of a ruleset, which cloudflare-go only
+// partially covers, and decodes the ruleset returned by the API.
+func (c *client) rawRuleset(ctx context.Context, method, rulesetID, ruleID string, body interface{}, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	rc, err := resourceContainer(params)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/%s/rulesets/%s/rules", rc.URLFragment(), rulesetID)
+	if ruleID != "" {
+		endpoint += "/" + ruleID
+	}
+
+	res, err := c.api.Raw(ctx, method, endpoint, body, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleset := &cloudflare.Ruleset{}
+	if err := json.Unmarshal(res.Result, ruleset); err != nil {
+		return nil, errors.Wrap(err, errDecodeRuleset)
 	}
 
-	return cfRules
+	return ruleset, nil
 }
 
+// resourceContainer returns the zone or account the ruleset belongs to.
+func resourceContainer(params v1beta1.RulesetParameters) (*cloudflare.ResourceContainer, error) {
+	switch {
+	case params.Zone != nil:
+		return cloudflare.ZoneIdentifier(*params.Zone), nil
+	case params.Account != nil:
+		return cloudflare.AccountIdentifier(*params.Account), nil
+	default:
+		return nil, errors.New("either zone or account must be specified")
+	}
+}
 
+// convertRulesToCloudflare converts v1beta1 rules to Cloudflare API format
+func convertRulesToCloudflare(rules []v1beta1.RulesetRule) ([]cloudflare.RulesetRule, error) {
+	cfRules := make([]cloudflare.RulesetRule, 0, len(rules))
+	for _, rule := range rules {
+		cf, err := ConvertRule(rule)
+		if err != nil {
+			return nil, err
+		}
+		cfRules = append(cfRules, cf)
+	}
+	return cfRules, nil
+}
 
 // GenerateObservation creates observation from Cloudflare ruleset
 func GenerateObservation(ruleset *cloudflare.Ruleset) v1beta1.RulesetObservation {
 	observation := v1beta1.RulesetObservation{
-		ID: ruleset.ID,
+		ID:          ruleset.ID,
+		Name:        ruleset.Name,
+		Description: ruleset.Description,
+		Kind:        ruleset.Kind,
+		Phase:       ruleset.Phase,
 	}
 
 	if ruleset.Version != nil && *ruleset.Version != "" {
 		observation.Version = *ruleset.Version
 	}
 
+	if ruleset.LastUpdated != nil {
+		observation.LastModified = &metav1.Time{Time: *ruleset.LastUpdated}
+	}
 
+	for _, r := range ruleset.Rules {
+		ro := v1beta1.RulesetRuleObservation{ID: r.ID, Ref: r.Ref}
+		if r.Version != nil {
+			ro.Version = *r.Version
+		}
+		observation.Rules = append(observation.Rules, ro)
+	}
 
 	return observation
 }
@@ -226,11 +386,5 @@ func UpToDate(params *v1beta1.RulesetParameters, ruleset *cloudflare.Ruleset) bo
 		return false
 	}
 
-	// For simplicity, we'll consider rules changed if the count differs
-	// A more sophisticated comparison could be implemented if needed
-	if len(params.Rules) != len(ruleset.Rules) {
-		return false
-	}
-
-	return true
-}
\ No newline at end of file
+	return RulesUpToDate(params.Rules, ruleset.Rules)
+}
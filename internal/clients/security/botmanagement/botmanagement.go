@@ -18,13 +18,12 @@ package botmanagement
 
 import (
 	"context"
-	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 
 	"github.com/rossigee/provider-cloudflare/apis/security/v1beta1"
-	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // BotManagementAPI defines the interface for Bot Management operations
@@ -58,8 +57,8 @@ func (c *CloudflareBotManagementClient) Get(ctx context.Context, zoneID string)
 
 	botManagement, err := c.client.GetBotManagement(ctx, rc)
 	if err != nil {
-		if isNotFound(err) {
-			return nil, clients.NewNotFoundError("bot management configuration not found")
+		if cferrors.IsNotFound(err) {
+			return nil, cferrors.NewNotFound("bot management configuration not found")
 		}
 		return nil, errors.Wrap(err, "cannot get bot management configuration")
 	}
@@ -203,16 +202,3 @@ func convertBotManagementToObservation(botManagement cloudflare.BotManagement) *
 
 	return obs
 }
-
-// isNotFound checks if an error indicates that the bot management configuration was not found.
-func isNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	errStr := strings.ToLower(err.Error())
-	return strings.Contains(errStr, "not found") ||
-		strings.Contains(errStr, "resource not found") ||
-		strings.Contains(errStr, "bot management not found") ||
-		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
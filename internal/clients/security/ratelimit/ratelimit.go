@@ -18,13 +18,12 @@ package ratelimit
 
 import (
 	"context"
-	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 
 	"github.com/rossigee/provider-cloudflare/apis/security/v1beta1"
-	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // RateLimitAPI defines the interface for Rate Limit operations
@@ -55,8 +54,8 @@ func NewClientFromAPI(api *cloudflare.API) *CloudflareRateLimitClient {
 func (c *CloudflareRateLimitClient) Get(ctx context.Context, zoneID, rateLimitID string) (*v1beta1.RateLimitObservation, error) {
 	rateLimit, err := c.client.RateLimit(ctx, zoneID, rateLimitID)
 	if err != nil {
-		if isNotFound(err) {
-			return nil, clients.NewNotFoundError("rate limit not found")
+		if cferrors.IsNotFound(err) {
+			return nil, cferrors.NewNotFound("rate limit not found")
 		}
 		return nil, errors.Wrap(err, "cannot get rate limit")
 	}
@@ -92,7 +91,7 @@ func (c *CloudflareRateLimitClient) Update(ctx context.Context, rateLimitID stri
 // Delete deletes a Rate Limit.
 func (c *CloudflareRateLimitClient) Delete(ctx context.Context, zoneID, rateLimitID string) error {
 	err := c.client.DeleteRateLimit(ctx, zoneID, rateLimitID)
-	if err != nil && !isNotFound(err) {
+	if err != nil && !cferrors.IsNotFound(err) {
 		return errors.Wrap(err, "cannot delete rate limit")
 	}
 	return nil
@@ -342,15 +341,3 @@ func convertCorrelateFromCloudflare(correlate *cloudflare.RateLimitCorrelate) *v
 		By: &correlate.By,
 	}
 }
-
-// isNotFound checks if an error indicates that the rate limit was not found.
-func isNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	errStr := strings.ToLower(err.Error())
-	return strings.Contains(errStr, "not found") ||
-		strings.Contains(errStr, "resource not found") ||
-		strings.Contains(errStr, "rate limit not found")
-}
\ No newline at end of file
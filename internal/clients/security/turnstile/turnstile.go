@@ -18,14 +18,13 @@ package turnstile
 
 import (
 	"context"
-	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/rossigee/provider-cloudflare/apis/security/v1beta1"
-	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // TurnstileAPI defines the interface for Turnstile operations
@@ -78,8 +77,8 @@ func (c *CloudflareTurnstileClient) Get(ctx context.Context, accountID, siteKey
 
 	widget, err := c.client.GetTurnstileWidget(ctx, rc, siteKey)
 	if err != nil {
-		if isNotFound(err) {
-			return nil, clients.NewNotFoundError("turnstile widget not found")
+		if cferrors.IsNotFound(err) {
+			return nil, cferrors.NewNotFound("turnstile widget not found")
 		}
 		return nil, errors.Wrap(err, "cannot get turnstile widget")
 	}
@@ -113,7 +112,7 @@ func (c *CloudflareTurnstileClient) Delete(ctx context.Context, accountID, siteK
 
 	err := c.client.DeleteTurnstileWidget(ctx, rc, siteKey)
 	if err != nil {
-		if isNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			return nil // Already deleted
 		}
 		return errors.Wrap(err, "cannot delete turnstile widget")
@@ -227,19 +226,6 @@ func convertTurnstileToObservation(widget cloudflare.TurnstileWidget) *v1beta1.T
 	return obs
 }
 
-// isNotFound checks if an error indicates that the turnstile widget was not found.
-func isNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	errStr := strings.ToLower(err.Error())
-	return strings.Contains(errStr, "not found") ||
-		strings.Contains(errStr, "resource not found") ||
-		strings.Contains(errStr, "widget not found") ||
-		strings.Contains(errStr, "does not exist")
-}
-
 // equalStringSlices compares two string slices for equality (order doesn't matter).
 func equalStringSlices(a, b []string) bool {
 	if len(a) != len(b) {
@@ -258,4 +244,4 @@ func equalStringSlices(a, b []string) bool {
 	}
 
 	return true
-}
\ No newline at end of file
+}
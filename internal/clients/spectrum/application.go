@@ -29,10 +29,6 @@ import (
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
 )
 
-const (
-	errApplicationNotFound = "Application not found"
-)
-
 // Client is a Cloudflare Spectrum API client
 type Client interface {
 	SpectrumApplication(ctx context.Context, zoneID, applicationID string) (cloudflare.SpectrumApplication, error)
@@ -217,18 +213,6 @@ func (c *client) DeleteSpectrumApplication(ctx context.Context, zoneID, applicat
 	return c.cf.DeleteSpectrumApplication(ctx, zoneID, applicationID)
 }
 
-// IsApplicationNotFound returns true if the error indicates the application was not found
-func IsApplicationNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	// Check for Cloudflare not found error or our specific error message
-	return err.Error() == errApplicationNotFound || 
-		   err.Error() == "404" ||
-		   err.Error() == "Not found" ||
-		   err.Error() == "10006"
-}
-
 // GenerateObservation creates observation data from a Spectrum Application
 func GenerateObservation(app cloudflare.SpectrumApplication) v1beta1.ApplicationObservation {
 	obs := v1beta1.ApplicationObservation{}
@@ -311,4 +295,4 @@ func ConvertIPs(ipStrings []string) ([]net.IP, error) {
 		ips[i] = ip
 	}
 	return ips, nil
-}
\ No newline at end of file
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/rossigee/provider-cloudflare/apis/spectrum/v1beta1"
 	pcv1beta1 "github.com/rossigee/provider-cloudflare/apis/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	"github.com/rossigee/provider-cloudflare/internal/clients/spectrum/fake"
 
 	corev1 "k8s.io/api/core/v1"
@@ -36,6 +37,7 @@ import (
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
@@ -100,7 +102,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	app, err := e.client.SpectrumApplication(ctx, *cr.Spec.ForProvider.Zone, rid)
 	if err != nil {
-		if IsApplicationNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			return managed.ExternalObservation{ResourceExists: false}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errApplicationLookup)
@@ -295,7 +297,7 @@ func TestConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.Application{
 					Spec: v1beta1.ApplicationSpec{
-						ResourceSpec: xpv1.ResourceSpec{},
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{},
 					},
 				},
 			},
@@ -325,8 +327,9 @@ func TestConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.Application{
 					Spec: v1beta1.ApplicationSpec{
-						ResourceSpec: xpv1.ResourceSpec{
-							ProviderConfigReference: &xpv1.Reference{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{
+							ProviderConfigReference: &xpv1.ProviderConfigReference{
+								Kind: "ProviderConfig",
 								Name: "blah",
 							},
 						},
@@ -440,7 +443,7 @@ func TestObserve(t *testing.T) {
 			fields: fields{
 				client: fake.MockClient{
 					MockSpectrumApplication: func(ctx context.Context, zoneID string, ApplicationID string) (cloudflare.SpectrumApplication, error) {
-						return cloudflare.SpectrumApplication{}, errors.New("10006")
+						return cloudflare.SpectrumApplication{}, &cloudflare.Error{StatusCode: 404, ErrorCodes: []int{10006}}
 					},
 				},
 			},
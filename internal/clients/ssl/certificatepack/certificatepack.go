@@ -18,14 +18,13 @@ package certificatepack
 
 import (
 	"context"
-	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/rossigee/provider-cloudflare/apis/ssl/v1beta1"
-	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // CertificatePackAPI defines the interface for Certificate Pack operations
@@ -50,8 +49,8 @@ func NewClient(client CertificatePackAPI) *CloudflareCertificatePackClient {
 func (c *CloudflareCertificatePackClient) Get(ctx context.Context, zoneID, certificatePackID string) (*v1beta1.CertificatePackObservation, error) {
 	pack, err := c.client.CertificatePack(ctx, zoneID, certificatePackID)
 	if err != nil {
-		if isNotFound(err) {
-			return nil, clients.NewNotFoundError("certificate pack not found")
+		if cferrors.IsNotFound(err) {
+			return nil, cferrors.NewNotFound("certificate pack not found")
 		}
 		return nil, errors.Wrap(err, "cannot get certificate pack")
 	}
@@ -75,7 +74,7 @@ func (c *CloudflareCertificatePackClient) Create(ctx context.Context, params v1b
 func (c *CloudflareCertificatePackClient) Delete(ctx context.Context, zoneID, certificatePackID string) error {
 	err := c.client.DeleteCertificatePack(ctx, zoneID, certificatePackID)
 	if err != nil {
-		if isNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			return nil // Already deleted
 		}
 		return errors.Wrap(err, "cannot delete certificate pack")
@@ -203,16 +202,3 @@ func convertCertificatePackToObservation(pack cloudflare.CertificatePack) *v1bet
 
 	return obs
 }
-
-// isNotFound checks if an error indicates that certificate pack was not found.
-func isNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	errStr := strings.ToLower(err.Error())
-	return strings.Contains(errStr, "not found") ||
-		strings.Contains(errStr, "resource not found") ||
-		strings.Contains(errStr, "certificate not found") ||
-		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
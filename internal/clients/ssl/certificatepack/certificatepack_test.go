@@ -30,7 +30,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 
 	"github.com/rossigee/provider-cloudflare/apis/ssl/v1beta1"
-	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // MockCertificatePackAPI implements the CertificatePackAPI interface for testing
@@ -203,7 +203,7 @@ func TestGet(t *testing.T) {
 			fields: fields{
 				client: &MockCertificatePackAPI{
 					MockCertificatePack: func(ctx context.Context, zoneID, certificatePackID string) (cloudflare.CertificatePack, error) {
-						return cloudflare.CertificatePack{}, errors.New("not found")
+						return cloudflare.CertificatePack{}, &cloudflare.NotFoundError{}
 					},
 				},
 			},
@@ -214,7 +214,7 @@ func TestGet(t *testing.T) {
 			},
 			want: want{
 				obs: nil,
-				err: clients.NewNotFoundError("certificate pack not found"),
+				err: cferrors.NewNotFound("certificate pack not found"),
 			},
 		},
 		"GetCertificatePackAPIError": {
@@ -464,7 +464,7 @@ func TestDelete(t *testing.T) {
 			fields: fields{
 				client: &MockCertificatePackAPI{
 					MockDeleteCertificatePack: func(ctx context.Context, zoneID, certificateID string) error {
-						return errors.New("not found")
+						return &cloudflare.NotFoundError{}
 					},
 				},
 			},
@@ -679,91 +679,3 @@ func TestConvertParametersToCertificatePackRequest(t *testing.T) {
 	}
 }
 
-func TestIsNotFound(t *testing.T) {
-	type args struct {
-		err error
-	}
-
-	type want struct {
-		isNotFound bool
-	}
-
-	cases := map[string]struct {
-		reason string
-		args   args
-		want   want
-	}{
-		"NilError": {
-			reason: "isNotFound should return false for nil error",
-			args: args{
-				err: nil,
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-		"NotFoundError": {
-			reason: "isNotFound should return true for 'not found' error",
-			args: args{
-				err: errors.New("not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"ResourceNotFoundError": {
-			reason: "isNotFound should return true for 'resource not found' error",
-			args: args{
-				err: errors.New("resource not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"CertificateNotFoundError": {
-			reason: "isNotFound should return true for 'certificate not found' error",
-			args: args{
-				err: errors.New("certificate not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"DoesNotExistError": {
-			reason: "isNotFound should return true for 'does not exist' error",
-			args: args{
-				err: errors.New("does not exist"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"CaseInsensitiveError": {
-			reason: "isNotFound should be case insensitive",
-			args: args{
-				err: errors.New("CERTIFICATE NOT FOUND"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"OtherError": {
-			reason: "isNotFound should return false for other errors",
-			args: args{
-				err: errors.New("some other error"),
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got := isNotFound(tc.args.err)
-			if diff := cmp.Diff(tc.want.isNotFound, got); diff != "" {
-				t.Errorf("\n%s\nisNotFound(...): -want, +got:\n%s\n", tc.reason, diff)
-			}
-		})
-	}
-}
\ No newline at end of file
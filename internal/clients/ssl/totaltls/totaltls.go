@@ -18,13 +18,12 @@ package totaltls
 
 import (
 	"context"
-	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 
 	"github.com/rossigee/provider-cloudflare/apis/ssl/v1beta1"
-	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // TotalTLSAPI defines the interface for Total TLS operations
@@ -51,8 +50,8 @@ func (c *CloudflareTotalTLSClient) Get(ctx context.Context, zoneID string) (*v1b
 
 	settings, err := c.client.GetTotalTLS(ctx, rc)
 	if err != nil {
-		if isNotFound(err) {
-			return nil, clients.NewNotFoundError("total tls settings not found")
+		if cferrors.IsNotFound(err) {
+			return nil, cferrors.NewNotFound("total tls settings not found")
 		}
 		return nil, errors.Wrap(err, "cannot get total tls settings")
 	}
@@ -123,16 +122,3 @@ func convertTotalTLSToObservation(settings cloudflare.TotalTLS) *v1beta1.TotalTL
 
 	return obs
 }
-
-// isNotFound checks if an error indicates that total tls settings were not found.
-func isNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	errStr := strings.ToLower(err.Error())
-	return strings.Contains(errStr, "not found") ||
-		strings.Contains(errStr, "resource not found") ||
-		strings.Contains(errStr, "tls not found") ||
-		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
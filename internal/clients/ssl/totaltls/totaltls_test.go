@@ -28,7 +28,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 
 	"github.com/rossigee/provider-cloudflare/apis/ssl/v1beta1"
-	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // MockTotalTLSAPI implements the TotalTLSAPI interface for testing
@@ -135,7 +135,7 @@ func TestGet(t *testing.T) {
 			fields: fields{
 				client: &MockTotalTLSAPI{
 					MockGetTotalTLS: func(ctx context.Context, rc *cloudflare.ResourceContainer) (cloudflare.TotalTLS, error) {
-						return cloudflare.TotalTLS{}, errors.New("not found")
+						return cloudflare.TotalTLS{}, &cloudflare.NotFoundError{}
 					},
 				},
 			},
@@ -145,7 +145,7 @@ func TestGet(t *testing.T) {
 			},
 			want: want{
 				obs: nil,
-				err: clients.NewNotFoundError("total tls settings not found"),
+				err: cferrors.NewNotFound("total tls settings not found"),
 			},
 		},
 		"GetTotalTLSAPIError": {
@@ -669,91 +669,3 @@ func TestConvertTotalTLSToObservation(t *testing.T) {
 	}
 }
 
-func TestIsNotFound(t *testing.T) {
-	type args struct {
-		err error
-	}
-
-	type want struct {
-		isNotFound bool
-	}
-
-	cases := map[string]struct {
-		reason string
-		args   args
-		want   want
-	}{
-		"NilError": {
-			reason: "isNotFound should return false for nil error",
-			args: args{
-				err: nil,
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-		"NotFoundError": {
-			reason: "isNotFound should return true for 'not found' error",
-			args: args{
-				err: errors.New("not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"ResourceNotFoundError": {
-			reason: "isNotFound should return true for 'resource not found' error",
-			args: args{
-				err: errors.New("resource not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"TLSNotFoundError": {
-			reason: "isNotFound should return true for 'tls not found' error",
-			args: args{
-				err: errors.New("tls not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"DoesNotExistError": {
-			reason: "isNotFound should return true for 'does not exist' error",
-			args: args{
-				err: errors.New("does not exist"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"CaseInsensitiveError": {
-			reason: "isNotFound should be case insensitive",
-			args: args{
-				err: errors.New("TLS NOT FOUND"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"OtherError": {
-			reason: "isNotFound should return false for other errors",
-			args: args{
-				err: errors.New("some other error"),
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got := isNotFound(tc.args.err)
-			if diff := cmp.Diff(tc.want.isNotFound, got); diff != "" {
-				t.Errorf("\n%s\nisNotFound(...): -want, +got:\n%s\n", tc.reason, diff)
-			}
-		})
-	}
-}
\ No newline at end of file
@@ -18,13 +18,12 @@ package universalssl
 
 import (
 	"context"
-	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 
 	"github.com/rossigee/provider-cloudflare/apis/ssl/v1beta1"
-	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // UniversalSSLAPI defines the interface for Universal SSL operations
@@ -47,8 +46,8 @@ func NewClient(client UniversalSSLAPI) *CloudflareUniversalSSLClient {
 func (c *CloudflareUniversalSSLClient) Get(ctx context.Context, zoneID string) (*v1beta1.UniversalSSLObservation, error) {
 	settings, err := c.client.UniversalSSLSettingDetails(ctx, zoneID)
 	if err != nil {
-		if isNotFound(err) {
-			return nil, clients.NewNotFoundError("universal ssl settings not found")
+		if cferrors.IsNotFound(err) {
+			return nil, cferrors.NewNotFound("universal ssl settings not found")
 		}
 		return nil, errors.Wrap(err, "cannot get universal ssl settings")
 	}
@@ -93,16 +92,3 @@ func convertUniversalSSLToObservation(settings cloudflare.UniversalSSLSetting) *
 
 	return obs
 }
-
-// isNotFound checks if an error indicates that universal ssl settings were not found.
-func isNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	errStr := strings.ToLower(err.Error())
-	return strings.Contains(errStr, "not found") ||
-		strings.Contains(errStr, "resource not found") ||
-		strings.Contains(errStr, "ssl not found") ||
-		strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
@@ -28,7 +28,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 
 	"github.com/rossigee/provider-cloudflare/apis/ssl/v1beta1"
-	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 // MockUniversalSSLAPI implements the UniversalSSLAPI interface for testing
@@ -124,7 +124,7 @@ func TestGet(t *testing.T) {
 			fields: fields{
 				client: &MockUniversalSSLAPI{
 					MockUniversalSSLSettingDetails: func(ctx context.Context, zoneID string) (cloudflare.UniversalSSLSetting, error) {
-						return cloudflare.UniversalSSLSetting{}, errors.New("not found")
+						return cloudflare.UniversalSSLSetting{}, &cloudflare.NotFoundError{}
 					},
 				},
 			},
@@ -134,7 +134,7 @@ func TestGet(t *testing.T) {
 			},
 			want: want{
 				obs: nil,
-				err: clients.NewNotFoundError("universal ssl settings not found"),
+				err: cferrors.NewNotFound("universal ssl settings not found"),
 			},
 		},
 		"GetUniversalSSLAPIError": {
@@ -503,91 +503,3 @@ func TestConvertUniversalSSLToObservation(t *testing.T) {
 	}
 }
 
-func TestIsNotFound(t *testing.T) {
-	type args struct {
-		err error
-	}
-
-	type want struct {
-		isNotFound bool
-	}
-
-	cases := map[string]struct {
-		reason string
-		args   args
-		want   want
-	}{
-		"NilError": {
-			reason: "isNotFound should return false for nil error",
-			args: args{
-				err: nil,
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-		"NotFoundError": {
-			reason: "isNotFound should return true for 'not found' error",
-			args: args{
-				err: errors.New("not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"ResourceNotFoundError": {
-			reason: "isNotFound should return true for 'resource not found' error",
-			args: args{
-				err: errors.New("resource not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"SSLNotFoundError": {
-			reason: "isNotFound should return true for 'ssl not found' error",
-			args: args{
-				err: errors.New("ssl not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"DoesNotExistError": {
-			reason: "isNotFound should return true for 'does not exist' error",
-			args: args{
-				err: errors.New("does not exist"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"CaseInsensitiveError": {
-			reason: "isNotFound should be case insensitive",
-			args: args{
-				err: errors.New("NOT FOUND"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"OtherError": {
-			reason: "isNotFound should return false for other errors",
-			args: args{
-				err: errors.New("some other error"),
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got := isNotFound(tc.args.err)
-			if diff := cmp.Diff(tc.want.isNotFound, got); diff != "" {
-				t.Errorf("\n%s\nisNotFound(...): -want, +got:\n%s\n", tc.reason, diff)
-			}
-		})
-	}
-}
\ No newline at end of file
@@ -21,10 +21,10 @@ import (
 	"net/http"
 
 	"github.com/cloudflare/cloudflare-go"
-	"github.com/pkg/errors"
 
 	"github.com/rossigee/provider-cloudflare/apis/sslsaas/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 )
 
 const (
@@ -61,7 +61,7 @@ func (c *client) CustomHostname(ctx context.Context, zoneID, hostnameID string)
 	}
 
 	if hostname.ID == "" {
-		return cloudflare.CustomHostname{}, errors.New(errCustomHostnameNotFound)
+		return cloudflare.CustomHostname{}, cferrors.NewNotFound(errCustomHostnameNotFound)
 	}
 
 	return hostname, nil
@@ -94,16 +94,6 @@ func (c *client) DeleteCustomHostname(ctx context.Context, zoneID, hostnameID st
 	return err
 }
 
-// IsCustomHostnameNotFound returns true if the error indicates the hostname was not found
-func IsCustomHostnameNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return err.Error() == errCustomHostnameNotFound ||
-		err.Error() == "404" ||
-		err.Error() == "Not found"
-}
-
 // GenerateObservation creates observation data from a Custom Hostname
 func GenerateObservation(hostname cloudflare.CustomHostname) v1beta1.CustomHostnameObservation {
 	obs := v1beta1.CustomHostnameObservation{
@@ -238,4 +228,4 @@ func UpToDate(spec *v1beta1.CustomHostnameParameters, hostname cloudflare.Custom
 	}
 
 	return true
-}
\ No newline at end of file
+}
@@ -30,6 +30,7 @@ import (
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
@@ -265,7 +266,7 @@ func TestConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.CustomHostname{
 					Spec: v1beta1.CustomHostnameSpec{
-						ResourceSpec: xpv1.ResourceSpec{},
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{},
 					},
 				},
 			},
@@ -295,8 +296,9 @@ func TestConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.CustomHostname{
 					Spec: v1beta1.CustomHostnameSpec{
-						ResourceSpec: xpv1.ResourceSpec{
-							ProviderConfigReference: &xpv1.Reference{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{
+							ProviderConfigReference: &xpv1.ProviderConfigReference{
+								Kind: "ProviderConfig",
 								Name: "blah",
 							},
 						},
@@ -26,10 +26,6 @@ import (
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
 )
 
-const (
-	errFallbackOriginNotFound = "Fallback Origin not found"
-)
-
 // Client is a Cloudflare SSL for SaaS Fallback Origin API client
 type Client interface {
 	FallbackOrigin(ctx context.Context, zoneID string) (cloudflare.CustomHostnameFallbackOrigin, error)
@@ -77,17 +73,6 @@ func (c *client) DeleteFallbackOrigin(ctx context.Context, zoneID string) error
 	return err
 }
 
-// IsFallbackOriginNotFound returns true if the error indicates the fallback origin was not found
-func IsFallbackOriginNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return err.Error() == errFallbackOriginNotFound ||
-		err.Error() == "fallback origin not found" ||
-		err.Error() == "404" ||
-		err.Error() == "Not found"
-}
-
 // GenerateObservation creates observation data from Fallback Origin
 func GenerateObservation(origin cloudflare.CustomHostnameFallbackOrigin) v1beta1.FallbackOriginObservation {
 	obs := v1beta1.FallbackOriginObservation{
@@ -118,4 +103,4 @@ func UpToDate(spec *v1beta1.FallbackOriginParameters, origin cloudflare.CustomHo
 
 	// Check if the specified origin matches the observed origin
 	return *spec.Origin == origin.Origin
-}
\ No newline at end of file
+}
@@ -29,6 +29,7 @@ import (
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	rtfake "github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
@@ -37,6 +38,7 @@ import (
 	providerv1beta1 "github.com/rossigee/provider-cloudflare/apis/v1beta1"
 	"github.com/rossigee/provider-cloudflare/apis/sslsaas/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	"github.com/rossigee/provider-cloudflare/internal/clients/sslsaas/fallbackorigin/fake"
 )
 
@@ -92,7 +94,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	_, err := e.client.FallbackOrigin(ctx, *cr.Spec.ForProvider.Zone)
 	if err != nil {
-		if IsFallbackOriginNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			return managed.ExternalObservation{ResourceExists: false}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errFallbackOriginLookup)
@@ -227,7 +229,7 @@ func TestConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.FallbackOrigin{
 					Spec: v1beta1.FallbackOriginSpec{
-						ResourceSpec: xpv1.ResourceSpec{},
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{},
 					},
 				},
 			},
@@ -257,8 +259,9 @@ func TestConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.FallbackOrigin{
 					Spec: v1beta1.FallbackOriginSpec{
-						ResourceSpec: xpv1.ResourceSpec{
-							ProviderConfigReference: &xpv1.Reference{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{
+							ProviderConfigReference: &xpv1.ProviderConfigReference{
+								Kind: "ProviderConfig",
 								Name: "blah",
 							},
 						},
@@ -320,7 +323,7 @@ func TestObserve(t *testing.T) {
 			fields: fields{
 				client: &fake.MockClient{
 					MockFallbackOrigin: func(ctx context.Context, zoneID string) (cloudflare.CustomHostnameFallbackOrigin, error) {
-						return cloudflare.CustomHostnameFallbackOrigin{}, errors.New("fallback origin not found")
+						return cloudflare.CustomHostnameFallbackOrigin{}, &cloudflare.NotFoundError{}
 					},
 				},
 			},
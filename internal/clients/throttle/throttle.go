@@ -0,0 +1,325 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package throttle shares the Cloudflare API request budget of each
+// credential between every client the provider builds.
+//
+// Cloudflare limits requests per user or token across all of their
+// clients, so a budget is kept per credential rather than per client or
+// controller. Requests waiting on a budget are served round-robin by
+// controller, so a controller with thousands of resources cannot starve
+// the others. Responses that report an exhausted budget, either with a
+// 429 status or with ratelimit headers, pause every request using the
+// budget until Cloudflare is ready to accept them again.
+package throttle
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultLimit is the sustained request rate of a budget, matching the
+	// Cloudflare global limit of 1200 requests per five minutes.
+	DefaultLimit = rate.Limit(1200.0 / 300.0)
+
+	// DefaultBurst is the number of requests a budget allows at once.
+	DefaultBurst = 100
+
+	// defaultRetryAfter is how long a budget is paused after a 429
+	// response that does not say when to retry.
+	defaultRetryAfter = 5 * time.Second
+)
+
+// DefaultBudgets are the budgets used by transports that do not set their
+// own.
+var DefaultBudgets = NewBudgets(DefaultLimit, DefaultBurst)
+
+// Budgets holds the request budget of each credential.
+type Budgets struct {
+	limit rate.Limit
+	burst int
+
+	mu      sync.Mutex
+	budgets map[string]*budget
+}
+
+// NewBudgets returns budgets that allow each credential limit requests per
+// second, with bursts of up to burst requests.
+func NewBudgets(limit rate.Limit, burst int) *Budgets {
+	return &Budgets{limit: limit, burst: burst, budgets: make(map[string]*budget)}
+}
+
+func (b *Budgets) get(credential string) *budget {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.budgets[credential]; !ok {
+		b.budgets[credential] = newBudget(b.limit, b.burst)
+	}
+	return b.budgets[credential]
+}
+
+// A Transport is an http.RoundTripper that waits for the budget of the
+// credential a request is made with before sending it.
+type Transport struct {
+	// Controller the requests are made for. Requests of different
+	// controllers take turns when waiting on the same budget.
+	Controller string
+
+	// Base is the RoundTripper used to send requests, defaulting to
+	// http.DefaultTransport.
+	Base http.RoundTripper
+
+	// Budgets the requests are made against, defaulting to DefaultBudgets.
+	Budgets *Budgets
+}
+
+// NewTransport returns a Transport that sends the requests of the supplied
+// controller using base, against DefaultBudgets.
+func NewTransport(controller string, base http.RoundTripper) *Transport {
+	return &Transport{Controller: controller, Base: base}
+}
+
+// RoundTrip waits for the budget of the request's credential, sends the
+// request, and pauses the budget if the response reports it exhausted.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	budgets := t.Budgets
+	if budgets == nil {
+		budgets = DefaultBudgets
+	}
+	b := budgets.get(credential(req))
+
+	if err := b.wait(req.Context(), t.Controller); err != nil {
+		return nil, err
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if d := backoff(resp, time.Now()); d > 0 {
+		b.pause(d)
+	}
+	return resp, nil
+}
+
+// credential returns a key identifying the credential a request is
+// authenticated with, without holding the credential itself.
+func credential(req *http.Request) string {
+	id := req.Header.Get("Authorization")
+	if id == "" {
+		id = req.Header.Get("X-Auth-Email") + "/" + req.Header.Get("X-Auth-Key")
+	}
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:])
+}
+
+// backoff returns how long requests should be paused after the supplied
+// response, or zero if they need not be.
+func backoff(resp *http.Response, now time.Time) time.Duration {
+	remaining, reset, limited := parseRateLimit(resp.Header)
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), now); ok {
+			return d
+		}
+		if limited && reset > 0 {
+			return reset
+		}
+		return defaultRetryAfter
+	}
+
+	if limited && remaining == 0 {
+		return reset
+	}
+	return 0
+}
+
+// parseRetryAfter parses a Retry-After header, which holds either a number
+// of seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(t.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// parseRateLimit parses the remaining requests and the time until they are
+// reset from a RateLimit header. Cloudflare sends structured fields like
+// `"default";r=50;t=30`, while earlier drafts of the header use
+// `limit=100, remaining=50, reset=30`; both are understood.
+func parseRateLimit(h http.Header) (int, time.Duration, bool) {
+	v := h.Get("Ratelimit")
+	if v == "" {
+		return 0, 0, false
+	}
+
+	remaining, reset := -1, -1
+	for _, f := range strings.FieldsFunc(v, func(r rune) bool { return r == ';' || r == ',' }) {
+		k, val, found := strings.Cut(strings.TrimSpace(f), "=")
+		if !found {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(val))
+		if err != nil {
+			continue
+		}
+		switch k {
+		case "r", "remaining":
+			remaining = n
+		case "t", "reset":
+			reset = n
+		}
+	}
+	if remaining < 0 || reset < 0 {
+		return 0, 0, false
+	}
+	return remaining, time.Duration(reset) * time.Second, true
+}
+
+// A budget is a token bucket whose waiters are served round-robin by
+// controller.
+type budget struct {
+	limiter *rate.Limiter
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+	queues      map[string][]*waiter
+	order       []string
+	dispatching bool
+}
+
+type waiter struct {
+	ready     chan struct{}
+	cancelled bool
+}
+
+func newBudget(limit rate.Limit, burst int) *budget {
+	return &budget{limiter: rate.NewLimiter(limit, burst), queues: make(map[string][]*waiter)}
+}
+
+// wait blocks until the budget allows a request of the supplied
+// controller, or the context is done.
+func (b *budget) wait(ctx context.Context, controller string) error {
+	w := &waiter{ready: make(chan struct{})}
+
+	b.mu.Lock()
+	if _, ok := b.queues[controller]; !ok {
+		b.order = append(b.order, controller)
+	}
+	b.queues[controller] = append(b.queues[controller], w)
+	if !b.dispatching {
+		b.dispatching = true
+		go b.dispatch()
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		b.mu.Lock()
+		w.cancelled = true
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// pause stops the budget allowing requests for the supplied duration.
+func (b *budget) pause(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if until := time.Now().Add(d); until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+}
+
+// dispatch hands out requests to waiters for as long as there are any.
+func (b *budget) dispatch() {
+	for {
+		b.mu.Lock()
+		if len(b.order) == 0 {
+			b.dispatching = false
+			b.mu.Unlock()
+			return
+		}
+		paused := time.Until(b.pausedUntil)
+		b.mu.Unlock()
+
+		if paused > 0 {
+			time.Sleep(paused)
+			continue
+		}
+
+		// Wait can only fail if the limiter's burst is zero or the
+		// context is done, neither of which happens here.
+		_ = b.limiter.Wait(context.Background())
+
+		b.mu.Lock()
+		if w := b.next(); w != nil {
+			close(w.ready)
+		}
+		b.mu.Unlock()
+	}
+}
+
+// next removes and returns the first waiter of the next controller in
+// turn, skipping waiters whose context is done. It must be called with the
+// budget locked.
+func (b *budget) next() *waiter {
+	for len(b.order) > 0 {
+		c := b.order[0]
+		b.order = b.order[1:]
+
+		q := b.queues[c]
+		for len(q) > 0 && q[0].cancelled {
+			q = q[1:]
+		}
+		if len(q) == 0 {
+			delete(b.queues, c)
+			continue
+		}
+
+		w := q[0]
+		if q = q[1:]; len(q) > 0 {
+			b.queues[c] = q
+			b.order = append(b.order, c)
+		} else {
+			delete(b.queues, c)
+		}
+		return w
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/time/rate"
+)
+
+func TestBackoff(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		reason string
+		code   int
+		header http.Header
+		want   time.Duration
+	}{
+		"OK": {
+			reason: "A response within the budget should not pause requests",
+			code:   http.StatusOK,
+			header: http.Header{"Ratelimit": {`"default";r=50;t=30`}},
+			want:   0,
+		},
+		"Exhausted": {
+			reason: "A response using the last of the budget should pause requests until it is reset",
+			code:   http.StatusOK,
+			header: http.Header{"Ratelimit": {`"default";r=0;t=30`}},
+			want:   30 * time.Second,
+		},
+		"ExhaustedDraft": {
+			reason: "Earlier drafts of the RateLimit header should be understood",
+			code:   http.StatusOK,
+			header: http.Header{"Ratelimit": {"limit=1200, remaining=0, reset=12"}},
+			want:   12 * time.Second,
+		},
+		"RetryAfterSeconds": {
+			reason: "A 429 response should pause requests for its Retry-After seconds",
+			code:   http.StatusTooManyRequests,
+			header: http.Header{"Retry-After": {"7"}, "Ratelimit": {`"default";r=0;t=30`}},
+			want:   7 * time.Second,
+		},
+		"RetryAfterDate": {
+			reason: "A 429 response should pause requests until its Retry-After date",
+			code:   http.StatusTooManyRequests,
+			header: http.Header{"Retry-After": {now.Add(90 * time.Second).Format(http.TimeFormat)}},
+			want:   90 * time.Second,
+		},
+		"RateLimitReset": {
+			reason: "A 429 response without Retry-After should pause requests until the budget is reset",
+			code:   http.StatusTooManyRequests,
+			header: http.Header{"Ratelimit": {`"default";r=0;t=20`}},
+			want:   20 * time.Second,
+		},
+		"NoHeaders": {
+			reason: "A 429 response without headers should pause requests for a default duration",
+			code:   http.StatusTooManyRequests,
+			header: http.Header{},
+			want:   defaultRetryAfter,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := backoff(&http.Response{StatusCode: tc.code, Header: tc.header}, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nbackoff(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBudgetFairness(t *testing.T) {
+	// Allow a request every 10ms, so they finish in the order allowed.
+	b := newBudget(rate.Limit(100), 1)
+
+	// Pause the budget so every request below is queued before any is
+	// allowed.
+	b.pause(50 * time.Millisecond)
+
+	var mu sync.Mutex
+	var got []string
+	var wg sync.WaitGroup
+	queued := func(controller string) int {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		return len(b.queues[controller])
+	}
+	enqueue := func(controller string) {
+		n := queued(controller)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := b.wait(context.Background(), controller); err != nil {
+				t.Errorf("b.wait(...): %v", err)
+			}
+			mu.Lock()
+			got = append(got, controller)
+			mu.Unlock()
+		}()
+
+		// Wait for the request to be queued, so they queue in order.
+		for queued(controller) == n {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	for range 4 {
+		enqueue("record")
+	}
+	enqueue("zone")
+	wg.Wait()
+
+	// The zone request should not wait behind every record request.
+	if diff := cmp.Diff("zone", got[1]); diff != "" {
+		t.Errorf("b.wait(...): -want second request, +got second request:\n%s\n%v", diff, got)
+	}
+}
+
+func TestBudgetCancelled(t *testing.T) {
+	b := newBudget(rate.Inf, 1)
+	b.pause(time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.wait(ctx, "record"); err != context.DeadlineExceeded {
+		t.Errorf("b.wait(...): want %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestTransport(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests++
+		if r.Header.Get("Authorization") == "Bearer limited" && requests == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	budgets := NewBudgets(rate.Inf, 1)
+	hc := &http.Client{Transport: &Transport{Controller: "record", Budgets: budgets}}
+	do := func(token string) time.Duration {
+		req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		start := time.Now()
+		resp, err := hc.Do(req)
+		if err != nil {
+			t.Fatalf("hc.Do(...): %v", err)
+		}
+		resp.Body.Close()
+		return time.Since(start)
+	}
+
+	// The first request is limited, pausing the budget of its token.
+	do("limited")
+
+	// Other tokens have their own budgets, and should not wait.
+	if d := do("other"); d > 500*time.Millisecond {
+		t.Errorf("hc.Do(...): a request with another token waited %s", d)
+	}
+
+	// Requests with the limited token should wait for the Retry-After.
+	if d := do("limited"); d < 900*time.Millisecond {
+		t.Errorf("hc.Do(...): a request with the limited token waited only %s", d)
+	}
+}
@@ -20,7 +20,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
@@ -28,12 +27,14 @@ import (
 
 	"github.com/rossigee/provider-cloudflare/apis/transform/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/clients/phaselock"
 )
 
 const (
-	// Cloudflare returns this error when a ruleset or rule is not found
-	errRulesetNotFound = "10007"
-	errRuleNotFound    = "10014"
+	errRuleNotFound = "rule not found in ruleset"
+
+	errLockRuleset = "failed to lock phase ruleset"
 )
 
 // Client is a Cloudflare API client that implements methods for working
@@ -62,6 +63,12 @@ func NewClient(cfg clients.Config, hc *http.Client) (Client, error) {
 
 // CreateTransformRule creates a new transform rule in the appropriate ruleset
 func (c *clientImpl) CreateTransformRule(ctx context.Context, zoneID string, spec *v1beta1.RuleParameters) (cloudflare.RulesetRule, error) {
+	unlock, err := phaselock.Lock(ctx, phaselock.ZoneKey(zoneID, spec.Phase))
+	if err != nil {
+		return cloudflare.RulesetRule{}, errors.Wrap(err, errLockRuleset)
+	}
+	defer unlock()
+
 	// Get or create the phase ruleset
 	ruleset, err := c.getOrCreatePhaseRuleset(ctx, zoneID, spec.Phase)
 	if err != nil {
@@ -99,6 +106,12 @@ func (c *clientImpl) CreateTransformRule(ctx context.Context, zoneID string, spe
 
 // UpdateTransformRule updates an existing transform rule
 func (c *clientImpl) UpdateTransformRule(ctx context.Context, zoneID string, ruleID string, spec *v1beta1.RuleParameters) (cloudflare.RulesetRule, error) {
+	unlock, err := phaselock.Lock(ctx, phaselock.ZoneKey(zoneID, spec.Phase))
+	if err != nil {
+		return cloudflare.RulesetRule{}, errors.Wrap(err, errLockRuleset)
+	}
+	defer unlock()
+
 	// Get the phase ruleset
 	ruleset, err := c.getPhaseRuleset(ctx, zoneID, spec.Phase)
 	if err != nil {
@@ -121,7 +134,7 @@ func (c *clientImpl) UpdateTransformRule(ctx context.Context, zoneID string, rul
 	}
 
 	if !ruleFound {
-		return cloudflare.RulesetRule{}, errors.New("rule not found in ruleset")
+		return cloudflare.RulesetRule{}, cferrors.NewNotFound(errRuleNotFound)
 	}
 
 	// Update the ruleset
@@ -155,11 +168,17 @@ func (c *clientImpl) GetTransformRule(ctx context.Context, zoneID string, ruleID
 		}
 	}
 
-	return cloudflare.RulesetRule{}, errors.New("rule not found")
+	return cloudflare.RulesetRule{}, cferrors.NewNotFound(errRuleNotFound)
 }
 
 // DeleteTransformRule deletes a transform rule from the specified phase
 func (c *clientImpl) DeleteTransformRule(ctx context.Context, zoneID string, ruleID string, phase string) error {
+	unlock, err := phaselock.Lock(ctx, phaselock.ZoneKey(zoneID, phase))
+	if err != nil {
+		return errors.Wrap(err, errLockRuleset)
+	}
+	defer unlock()
+
 	// Get the phase ruleset
 	ruleset, err := c.getPhaseRuleset(ctx, zoneID, phase)
 	if err != nil {
@@ -178,7 +197,7 @@ func (c *clientImpl) DeleteTransformRule(ctx context.Context, zoneID string, rul
 	}
 
 	if !ruleFound {
-		return errors.New("rule not found in ruleset")
+		return cferrors.NewNotFound(errRuleNotFound)
 	}
 
 	// Update the ruleset without the deleted rule
@@ -202,7 +221,7 @@ func (c *clientImpl) ListTransformRules(ctx context.Context, zoneID string, phas
 	// Get the phase ruleset
 	ruleset, err := c.getPhaseRuleset(ctx, zoneID, phase)
 	if err != nil {
-		if IsRulesetNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			// No ruleset exists for this phase yet, return empty list
 			return []cloudflare.RulesetRule{}, nil
 		}
@@ -236,7 +255,7 @@ func (c *clientImpl) getOrCreatePhaseRuleset(ctx context.Context, zoneID string,
 	}
 
 	// If ruleset doesn't exist, create it
-	if IsRulesetNotFound(err) {
+	if cferrors.IsNotFound(err) {
 		createParams := cloudflare.CreateRulesetParams{
 			Name:        fmt.Sprintf("Transform Rules - %s", phase),
 			Description: fmt.Sprintf("Transform rules for %s phase", phase),
@@ -338,16 +357,6 @@ func (c *clientImpl) specToRulesetRule(spec *v1beta1.RuleParameters) cloudflare.
 	return rule
 }
 
-// IsRulesetNotFound returns true if the passed error indicates a ruleset was not found
-func IsRulesetNotFound(err error) bool {
-	return strings.Contains(err.Error(), errRulesetNotFound)
-}
-
-// IsRuleNotFound returns true if the passed error indicates a rule was not found
-func IsRuleNotFound(err error) bool {
-	return strings.Contains(err.Error(), errRuleNotFound)
-}
-
 // GenerateObservation creates an observation from a Cloudflare RulesetRule
 func GenerateObservation(rule cloudflare.RulesetRule, rulesetID string) v1beta1.RuleObservation {
 	obs := v1beta1.RuleObservation{
@@ -408,4 +417,4 @@ func UpToDate(spec *v1beta1.RuleParameters, rule cloudflare.RulesetRule) bool {
 	}
 
 	return true
-}
\ No newline at end of file
+}
@@ -17,7 +17,6 @@ limitations under the License.
 package rule
 
 import (
-	"errors"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -217,90 +216,3 @@ func TestGenerateObservation(t *testing.T) {
 	}
 }
 
-func TestIsRulesetNotFound(t *testing.T) {
-	type args struct {
-		err error
-	}
-
-	type want struct {
-		isNotFound bool
-	}
-
-	cases := map[string]struct {
-		reason string
-		args   args
-		want   want
-	}{
-		"NotFoundError": {
-			reason: "IsRulesetNotFound should return true for ruleset not found errors",
-			args: args{
-				err: errors.New("API Error 10007: Ruleset not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"OtherError": {
-			reason: "IsRulesetNotFound should return false for other errors",
-			args: args{
-				err: errors.New("API Error 10001: Invalid request"),
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got := IsRulesetNotFound(tc.args.err)
-			if diff := cmp.Diff(tc.want.isNotFound, got); diff != "" {
-				t.Errorf("\n%s\nIsRulesetNotFound(...): -want, +got:\n%s\n", tc.reason, diff)
-			}
-		})
-	}
-}
-
-func TestIsRuleNotFound(t *testing.T) {
-	type args struct {
-		err error
-	}
-
-	type want struct {
-		isNotFound bool
-	}
-
-	cases := map[string]struct {
-		reason string
-		args   args
-		want   want
-	}{
-		"NotFoundError": {
-			reason: "IsRuleNotFound should return true for rule not found errors",
-			args: args{
-				err: errors.New("API Error 10014: Rule not found"),
-			},
-			want: want{
-				isNotFound: true,
-			},
-		},
-		"OtherError": {
-			reason: "IsRuleNotFound should return false for other errors",
-			args: args{
-				err: errors.New("API Error 10001: Invalid request"),
-			},
-			want: want{
-				isNotFound: false,
-			},
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got := IsRuleNotFound(tc.args.err)
-			if diff := cmp.Diff(tc.want.isNotFound, got); diff != "" {
-				t.Errorf("\n%s\nIsRuleNotFound(...): -want, +got:\n%s\n", tc.reason, diff)
-			}
-		})
-	}
-}
\ No newline at end of file
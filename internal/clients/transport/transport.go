@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package transport sends the requests of Cloudflare API clients with the
+// HTTP transport of the ProviderConfig they are made for. The transport
+// is chosen per request, below any instrumentation and throttling that
+// wraps it, so clients of every ProviderConfig can share those.
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errParseProxy = "cannot parse proxy URL"
+	errSystemCAs  = "cannot load system certificate authorities"
+	errNoCerts    = "CA bundle does not contain any PEM encoded certificates"
+)
+
+type key struct{}
+
+// Base is the http.RoundTripper that finally sends the requests of
+// Cloudflare API clients. Each request is sent with the transport set for
+// it by With, or with http.DefaultTransport if there is none.
+var Base http.RoundTripper = base{}
+
+type base struct{}
+
+func (base) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t, ok := req.Context().Value(key{}).(http.RoundTripper); ok {
+		return t.RoundTrip(req)
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// With returns an http.RoundTripper that sends requests via next, which
+// should eventually send them with Base. Base sends them with t.
+func With(next, t http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = Base
+	}
+	return &with{next: next, t: t}
+}
+
+type with struct {
+	next http.RoundTripper
+	t    http.RoundTripper
+}
+
+func (w *with) RoundTrip(req *http.Request) (*http.Response, error) {
+	return w.next.RoundTrip(req.WithContext(context.WithValue(req.Context(), key{}, w.t)))
+}
+
+// New returns an *http.Transport like http.DefaultTransport that sends
+// requests through the supplied proxy, if any, and that trusts the
+// supplied PEM encoded certificate authorities in addition to those of
+// the system.
+func New(proxy string, caBundle []byte) (*http.Transport, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+
+	if proxy != "" {
+		u, err := url.Parse(proxy)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseProxy)
+		}
+		t.Proxy = http.ProxyURL(u)
+	}
+
+	if len(caBundle) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, errors.Wrap(err, errSystemCAs)
+		}
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return nil, errors.New(errNoCerts)
+		}
+		if t.TLSClientConfig == nil {
+			t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		t.TLSClientConfig.RootCAs = pool
+	}
+
+	return t, nil
+}
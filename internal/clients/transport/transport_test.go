@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transport
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestNew(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer srv.Close()
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	cases := map[string]struct {
+		reason   string
+		proxy    string
+		caBundle []byte
+		err      error
+		sendErr  bool
+	}{
+		"Default": {
+			reason:  "A transport without a CA bundle should not trust the certificate of the test server",
+			sendErr: true,
+		},
+		"CABundle": {
+			reason:   "A transport should trust the certificate authorities of its CA bundle",
+			caBundle: ca,
+		},
+		"ErrNoCerts": {
+			reason:   "An error should be returned if the CA bundle holds no certificates",
+			caBundle: []byte("not a certificate"),
+			err:      errors.New(errNoCerts),
+		},
+		"ErrParseProxy": {
+			reason: "An error should be returned if the proxy URL cannot be parsed",
+			proxy:  "http://[::1",
+			err:    errors.Wrap(errors.New(`parse "http://[::1": missing ']' in host`), errParseProxy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tr, err := New(tc.proxy, tc.caBundle)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nNew(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+			resp, err := tr.RoundTrip(req)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if got := err != nil; got != tc.sendErr {
+				t.Errorf("\n%s\nRoundTrip(...): want error %t, got %v", tc.reason, tc.sendErr, err)
+			}
+		})
+	}
+}
+
+func TestProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	tr, err := New(proxy.URL, nil)
+	if err != nil {
+		t.Fatalf("New(...): %v", err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, "http://api.example.org/client/v4/zones", nil)
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip(...): %v", err)
+	}
+	resp.Body.Close()
+	if diff := cmp.Diff("http://api.example.org/client/v4/zones", proxied); diff != "" {
+		t.Errorf("RoundTrip(...): -want proxied request, +got proxied request:\n%s", diff)
+	}
+}
+
+// recorder is an http.RoundTripper that records that it was used.
+type recorder struct{ used bool }
+
+func (r *recorder) RoundTrip(_ *http.Request) (*http.Response, error) {
+	r.used = true
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+// wrapper is an http.RoundTripper that wraps another, like instrumentation
+// or throttling does.
+type wrapper struct{ next http.RoundTripper }
+
+func (w wrapper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return w.next.RoundTrip(req)
+}
+
+func TestWith(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		next   http.RoundTripper
+	}{
+		"Wrapped": {
+			reason: "Base should send requests with the transport set by With, beneath any wrapping transports",
+			next:   wrapper{next: Base},
+		},
+		"Unwrapped": {
+			reason: "Requests should be sent by Base when With wraps no transport",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &recorder{}
+			req, _ := http.NewRequest(http.MethodGet, "http://api.example.org", nil)
+			resp, err := With(tc.next, r).RoundTrip(req)
+			if err != nil {
+				t.Fatalf("\n%s\nRoundTrip(...): %v", tc.reason, err)
+			}
+			resp.Body.Close()
+			if !r.used {
+				t.Errorf("\n%s\nRoundTrip(...): request was not sent with the transport set by With", tc.reason)
+			}
+		})
+	}
+}
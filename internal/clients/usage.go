@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
+)
+
+// A UsageTracker records that a managed resource uses a ProviderConfig or
+// ClusterProviderConfig by applying a ProviderConfigUsage named for the
+// resource's UID. The usage lives in the namespace of the resource, is
+// labelled with the kind and name of the config it uses, and is garbage
+// collected along with the resource.
+type UsageTracker struct {
+	c resource.Applicator
+}
+
+// NewUsageTracker returns a UsageTracker that applies usages with c.
+func NewUsageTracker(c client.Client) *UsageTracker {
+	return &UsageTracker{c: resource.NewAPIUpdatingApplicator(c)}
+}
+
+// Track that the supplied managed resource uses the ProviderConfig it
+// references. Track should be called before the ProviderConfig is used, so
+// that a resource referencing a misconfigured ProviderConfig still counts
+// as one of its users.
+func (u *UsageTracker) Track(ctx context.Context, mg resource.Managed) error {
+	ref := providerConfigReference(mg)
+	if ref == nil {
+		return errors.New(errPCRef)
+	}
+
+	gvk := mg.GetObjectKind().GroupVersionKind()
+	kind := providerConfigKind(ref)
+
+	pcu := &v1beta1.ProviderConfigUsage{}
+	pcu.SetName(string(mg.GetUID()))
+	pcu.SetNamespace(mg.GetNamespace())
+	pcu.SetLabels(map[string]string{
+		xpv1.LabelKeyProviderKind: kind,
+		xpv1.LabelKeyProviderName: ref.Name,
+	})
+	pcu.SetOwnerReferences([]metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(mg, gvk))})
+	pcu.SetProviderConfigReference(xpv1.ProviderConfigReference{Kind: kind, Name: ref.Name})
+	pcu.SetResourceReference(xpv1.TypedReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       mg.GetName(),
+	})
+
+	err := u.c.Apply(ctx, pcu,
+		resource.MustBeControllableBy(mg.GetUID()),
+		resource.AllowUpdateIf(func(current, _ runtime.Object) bool {
+			cur, ok := current.(*v1beta1.ProviderConfigUsage)
+			return ok && cur.GetProviderConfigReference() != pcu.GetProviderConfigReference()
+		}),
+	)
+	return errors.Wrap(resource.Ignore(resource.IsNotAllowed, err), errTrackPCUsage)
+}
+
+func providerConfigReference(mg resource.Managed) *xpv1.ProviderConfigReference {
+	if r, ok := mg.(interface {
+		GetProviderConfigReference() *xpv1.ProviderConfigReference
+	}); ok {
+		return r.GetProviderConfigReference()
+	}
+	return nil
+}
+
+// providerConfigKind returns the kind of config ref points to. References
+// made before ClusterProviderConfigs existed have no kind, and point to a
+// ProviderConfig.
+func providerConfigKind(ref *xpv1.ProviderConfigReference) string {
+	if ref.Kind == "" {
+		return v1beta1.ProviderConfigKind
+	}
+	return ref.Kind
+}
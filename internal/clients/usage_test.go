@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	rtfake "github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	dnsv1beta1 "github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
+)
+
+func TestUsageTrackerTrack(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		ref    xpv1.ProviderConfigReference
+		want   xpv1.ProviderConfigReference
+	}{
+		"ProviderConfig": {
+			reason: "A usage of a ProviderConfig should record its kind and name.",
+			ref:    xpv1.ProviderConfigReference{Kind: v1beta1.ProviderConfigKind, Name: "default"},
+			want:   xpv1.ProviderConfigReference{Kind: v1beta1.ProviderConfigKind, Name: "default"},
+		},
+		"ClusterProviderConfig": {
+			reason: "A usage of a ClusterProviderConfig should record its kind and name.",
+			ref:    xpv1.ProviderConfigReference{Kind: v1beta1.ClusterProviderConfigKind, Name: "shared"},
+			want:   xpv1.ProviderConfigReference{Kind: v1beta1.ClusterProviderConfigKind, Name: "shared"},
+		},
+		"NoKind": {
+			reason: "A reference without a kind should be recorded as a usage of a ProviderConfig.",
+			ref:    xpv1.ProviderConfigReference{Name: "default"},
+			want:   xpv1.ProviderConfigReference{Kind: v1beta1.ProviderConfigKind, Name: "default"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &dnsv1beta1.Record{}
+			mg.SetGroupVersionKind(dnsv1beta1.RecordGroupVersionKind)
+			mg.SetName("www")
+			mg.SetNamespace("team-a")
+			mg.SetUID("mr-uid")
+			mg.SetProviderConfigReference(&tc.ref)
+
+			var created *v1beta1.ProviderConfigUsage
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				MockCreate: test.NewMockCreateFn(nil, func(obj client.Object) error {
+					created = obj.(*v1beta1.ProviderConfigUsage)
+					return nil
+				}),
+			}
+
+			if err := NewUsageTracker(c).Track(context.Background(), mg); err != nil {
+				t.Fatalf("\n%s\nTrack(...): %v", tc.reason, err)
+			}
+			if created == nil {
+				t.Fatalf("\n%s\nTrack(...): want a ProviderConfigUsage to be created", tc.reason)
+			}
+
+			if created.GetName() != "mr-uid" || created.GetNamespace() != "team-a" {
+				t.Errorf("\n%s\nTrack(...): want usage team-a/mr-uid, got %s/%s", tc.reason, created.GetNamespace(), created.GetName())
+			}
+			wantLabels := map[string]string{
+				xpv1.LabelKeyProviderKind: tc.want.Kind,
+				xpv1.LabelKeyProviderName: tc.want.Name,
+			}
+			if diff := cmp.Diff(wantLabels, created.GetLabels()); diff != "" {
+				t.Errorf("\n%s\nTrack(...): -want labels, +got labels:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, created.GetProviderConfigReference()); diff != "" {
+				t.Errorf("\n%s\nTrack(...): -want provider config reference, +got:\n%s", tc.reason, diff)
+			}
+			want := xpv1.TypedReference{APIVersion: "dns.cloudflare.m.crossplane.io/v1beta1", Kind: "Record", Name: "www"}
+			if diff := cmp.Diff(want, created.GetResourceReference()); diff != "" {
+				t.Errorf("\n%s\nTrack(...): -want resource reference, +got:\n%s", tc.reason, diff)
+			}
+			if owners := created.GetOwnerReferences(); len(owners) != 1 || owners[0].UID != "mr-uid" {
+				t.Errorf("\n%s\nTrack(...): want the usage to be controlled by the managed resource, got %v", tc.reason, owners)
+			}
+		})
+	}
+}
+
+func TestUsageTrackerTrackErrors(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		mg     *testProviderConfigReferencer
+		c      client.Client
+		want   error
+	}{
+		"NoReference": {
+			reason: "An error should be returned if the managed resource does not reference a ProviderConfig.",
+			mg:     &testProviderConfigReferencer{Managed: &rtfake.Managed{}},
+			want:   errors.New(errPCRef),
+		},
+		"ApplyError": {
+			reason: "Errors applying the usage should be wrapped.",
+			mg:     &testProviderConfigReferencer{Managed: &rtfake.Managed{}, Ref: &xpv1.ProviderConfigReference{Kind: v1beta1.ProviderConfigKind, Name: "default"}},
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			want: errors.Wrap(errors.Wrap(errBoom, "cannot get object"), errTrackPCUsage),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewUsageTracker(tc.c).Track(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nTrack(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -17,8 +17,10 @@ limitations under the License.
 package clients
 
 import (
+	"encoding/base64"
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -134,6 +136,31 @@ func (rv *recordValidator) ValidateRecord(recordType, content string, priority *
 		}
 	}
 
+	switch strings.ToUpper(recordType) {
+	case "CNAME", "NS":
+		return rv.validateTarget(strings.ToUpper(recordType), content)
+	case "TXT":
+		return rv.validateTXT(content)
+	case "SPF":
+		return rv.validateSPF(content)
+	case "CAA":
+		return rv.validateCAA(content)
+	case "TLSA", "SMIMEA":
+		return rv.validateTLSA(strings.ToUpper(recordType), content)
+	case "SSHFP":
+		return rv.validateSSHFP(content)
+	case "DS":
+		return rv.validateDS(content)
+	case "DNSKEY":
+		return rv.validateDNSKEY(content)
+	case "CERT":
+		return rv.validateCERT(content)
+	case "NAPTR":
+		return rv.validateNAPTR(content)
+	case "LOC":
+		return rv.validateLOC(content)
+	}
+
 	return nil
 }
 
@@ -171,3 +198,407 @@ func (rv *recordValidator) validateIPv6(ip string) error {
 
 	return nil
 }
+
+const (
+	// Most characters of the content of a TXT record.
+	maxTXTLength = 2048
+
+	// Most characters of each string of a TXT record.
+	maxTXTStringLength = 255
+)
+
+var (
+	// Labels of a target may start with an underscore, such as the
+	// selector._domainkey labels of DKIM keys.
+	targetRegex = regexp.MustCompile(`^(\*\.)?(_?[a-zA-Z0-9]([a-zA-Z0-9\-_]{0,61}[a-zA-Z0-9])?\.)*_?[a-zA-Z0-9]([a-zA-Z0-9\-_]{0,61}[a-zA-Z0-9])?\.?$`)
+	hexRegex    = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+	alnumRegex  = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+
+	// Tags of CAA properties that Cloudflare accepts.
+	caaTags = map[string]bool{"issue": true, "issuewild": true, "iodef": true}
+
+	// Hex characters of the digests of DS records, by digest type.
+	dsDigestLengths = map[int]int{1: 40, 2: 64, 3: 64, 4: 96}
+
+	// Hex characters of the fingerprints of SSHFP records, by fingerprint
+	// type.
+	sshfpFingerprintLengths = map[int]int{1: 40, 2: 64}
+
+	// Hex characters of the certificate association data of TLSA and
+	// SMIMEA records, by matching type. Matching type 0 is the whole
+	// certificate, whose length varies.
+	tlsaDataLengths = map[int]int{1: 64, 2: 128}
+
+	// Mnemonics of CERT record certificate types.
+	certTypes = map[string]bool{
+		"PKIX": true, "SPKI": true, "PGP": true, "IPKIX": true, "ISPKI": true,
+		"IPGP": true, "ACPKIX": true, "IACPKIX": true, "URI": true, "OID": true,
+	}
+)
+
+// validateTarget validates the hostname a CNAME or NS record points to
+func (rv *recordValidator) validateTarget(recordType, content string) error {
+	if content == "@" {
+		return nil
+	}
+	if len(content) > 255 || !targetRegex.MatchString(content) {
+		return errors.Errorf("invalid %s target hostname", recordType)
+	}
+	return nil
+}
+
+// validateTXT validates the length of TXT record content, and the strings
+// it is split into. Content that is not quoted is split by Cloudflare into
+// strings of at most 255 characters. Quoted content must be one or more
+// quoted strings of at most 255 characters each.
+func (rv *recordValidator) validateTXT(content string) error {
+	if len(content) > maxTXTLength {
+		return errors.Errorf("TXT record content must be %d characters or fewer", maxTXTLength)
+	}
+	strs, err := txtStrings(content)
+	if err != nil {
+		return err
+	}
+	for _, s := range strs {
+		if len(s) > maxTXTStringLength {
+			return errors.Errorf("TXT record strings must be %d characters or fewer, split longer content into quoted strings", maxTXTStringLength)
+		}
+	}
+	return nil
+}
+
+// validateSPF validates SPF record content, which is TXT record content
+// that holds an SPF policy
+func (rv *recordValidator) validateSPF(content string) error {
+	if content == "" {
+		return errors.New("SPF record content cannot be empty")
+	}
+	if err := rv.validateTXT(content); err != nil {
+		return err
+	}
+	strs, _ := txtStrings(content)
+	if policy := strings.Join(strs, ""); policy != "v=spf1" && !strings.HasPrefix(policy, "v=spf1 ") {
+		return errors.New("SPF record content must start with v=spf1")
+	}
+	return nil
+}
+
+// txtStrings returns the strings of TXT record content. Content that is
+// not quoted is a single string.
+func txtStrings(content string) ([]string, error) {
+	if !strings.HasPrefix(strings.TrimSpace(content), `"`) {
+		if len(content) <= maxTXTStringLength {
+			return []string{content}, nil
+		}
+		// Cloudflare splits long content that is not quoted itself.
+		var strs []string
+		for len(content) > maxTXTStringLength {
+			strs = append(strs, content[:maxTXTStringLength])
+			content = content[maxTXTStringLength:]
+		}
+		return append(strs, content), nil
+	}
+
+	tokens, err := quotedFields(content)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid TXT record content")
+	}
+	strs := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		if !t.quoted {
+			return nil, errors.New("TXT record content must be either unquoted or only quoted strings")
+		}
+		strs = append(strs, t.value)
+	}
+	return strs, nil
+}
+
+// validateCAA validates CAA record content
+// CAA format: flags tag "value"
+func (rv *recordValidator) validateCAA(content string) error {
+	tokens, err := quotedFields(content)
+	if err != nil {
+		return errors.Wrap(err, "invalid CAA record content")
+	}
+	if len(tokens) != 3 {
+		return errors.New("CAA record must have format: flags tag \"value\"")
+	}
+	if _, err := parseUint(tokens[0].value, 255); err != nil {
+		return errors.New("CAA flags must be between 0 and 255")
+	}
+	tag := tokens[1].value
+	if !alnumRegex.MatchString(tag) || !caaTags[strings.ToLower(tag)] {
+		return errors.New("CAA tag must be one of issue, issuewild or iodef")
+	}
+	value := tokens[2].value
+	if strings.ToLower(tag) == "iodef" && !strings.HasPrefix(value, "mailto:") &&
+		!strings.HasPrefix(value, "https://") && !strings.HasPrefix(value, "http://") {
+		return errors.New("CAA iodef value must be a mailto:, http:// or https:// URL")
+	}
+	return nil
+}
+
+// validateTLSA validates TLSA and SMIMEA record content
+// TLSA format: usage selector matching_type certificate
+func (rv *recordValidator) validateTLSA(recordType, content string) error {
+	parts := strings.Fields(content)
+	if len(parts) != 4 {
+		return errors.Errorf("%s record must have format: usage selector matching_type certificate", recordType)
+	}
+	if _, err := parseUint(parts[0], 3); err != nil {
+		return errors.Errorf("%s usage must be between 0 and 3", recordType)
+	}
+	if _, err := parseUint(parts[1], 1); err != nil {
+		return errors.Errorf("%s selector must be 0 or 1", recordType)
+	}
+	matchingType, err := parseUint(parts[2], 2)
+	if err != nil {
+		return errors.Errorf("%s matching type must be between 0 and 2", recordType)
+	}
+	return validateHex(recordType+" certificate", parts[3], tlsaDataLengths[matchingType])
+}
+
+// validateSSHFP validates SSHFP record content
+// SSHFP format: algorithm type fingerprint
+func (rv *recordValidator) validateSSHFP(content string) error {
+	parts := strings.Fields(content)
+	if len(parts) < 3 {
+		return errors.New("SSHFP record must have format: algorithm type fingerprint")
+	}
+	if algorithm, err := parseUint(parts[0], 6); err != nil || algorithm == 0 || algorithm == 5 {
+		return errors.New("SSHFP algorithm must be 1 (RSA), 2 (DSA), 3 (ECDSA), 4 (Ed25519) or 6 (Ed448)")
+	}
+	fpType, err := parseUint(parts[1], 2)
+	if err != nil || fpType == 0 {
+		return errors.New("SSHFP type must be 1 (SHA-1) or 2 (SHA-256)")
+	}
+	return validateHex("SSHFP fingerprint", strings.Join(parts[2:], ""), sshfpFingerprintLengths[fpType])
+}
+
+// validateDS validates DS record content
+// DS format: key_tag algorithm digest_type digest
+func (rv *recordValidator) validateDS(content string) error {
+	parts := strings.Fields(content)
+	if len(parts) < 4 {
+		return errors.New("DS record must have format: key_tag algorithm digest_type digest")
+	}
+	if _, err := parseUint(parts[0], 65535); err != nil {
+		return errors.New("DS key tag must be between 0 and 65535")
+	}
+	if algorithm, err := parseUint(parts[1], 255); err != nil || algorithm == 0 {
+		return errors.New("DS algorithm must be between 1 and 255")
+	}
+	digestType, err := parseUint(parts[2], 255)
+	if err != nil || dsDigestLengths[digestType] == 0 {
+		return errors.New("DS digest type must be 1 (SHA-1), 2 (SHA-256), 3 (GOST) or 4 (SHA-384)")
+	}
+	return validateHex("DS digest", strings.Join(parts[3:], ""), dsDigestLengths[digestType])
+}
+
+// validateDNSKEY validates DNSKEY record content
+// DNSKEY format: flags protocol algorithm public_key
+func (rv *recordValidator) validateDNSKEY(content string) error {
+	parts := strings.Fields(content)
+	if len(parts) < 4 {
+		return errors.New("DNSKEY record must have format: flags protocol algorithm public_key")
+	}
+	if _, err := parseUint(parts[0], 65535); err != nil {
+		return errors.New("DNSKEY flags must be between 0 and 65535")
+	}
+	if parts[1] != "3" {
+		return errors.New("DNSKEY protocol must be 3")
+	}
+	if algorithm, err := parseUint(parts[2], 255); err != nil || algorithm == 0 {
+		return errors.New("DNSKEY algorithm must be between 1 and 255")
+	}
+	return validateBase64("DNSKEY public key", strings.Join(parts[3:], ""))
+}
+
+// validateCERT validates CERT record content
+// CERT format: type key_tag algorithm certificate
+func (rv *recordValidator) validateCERT(content string) error {
+	parts := strings.Fields(content)
+	if len(parts) < 4 {
+		return errors.New("CERT record must have format: type key_tag algorithm certificate")
+	}
+	if _, err := parseUint(parts[0], 65535); err != nil && !certTypes[strings.ToUpper(parts[0])] {
+		return errors.New("CERT type must be between 0 and 65535, or a mnemonic such as PKIX or PGP")
+	}
+	if _, err := parseUint(parts[1], 65535); err != nil {
+		return errors.New("CERT key tag must be between 0 and 65535")
+	}
+	if _, err := parseUint(parts[2], 255); err != nil {
+		return errors.New("CERT algorithm must be between 0 and 255")
+	}
+	return validateBase64("CERT certificate", strings.Join(parts[3:], ""))
+}
+
+// validateNAPTR validates NAPTR record content
+// NAPTR format: order preference "flags" "service" "regexp" replacement
+func (rv *recordValidator) validateNAPTR(content string) error {
+	tokens, err := quotedFields(content)
+	if err != nil {
+		return errors.Wrap(err, "invalid NAPTR record content")
+	}
+	if len(tokens) != 6 {
+		return errors.New("NAPTR record must have format: order preference \"flags\" \"service\" \"regexp\" replacement")
+	}
+	if _, err := parseUint(tokens[0].value, 65535); err != nil {
+		return errors.New("NAPTR order must be between 0 and 65535")
+	}
+	if _, err := parseUint(tokens[1].value, 65535); err != nil {
+		return errors.New("NAPTR preference must be between 0 and 65535")
+	}
+	if flags := tokens[2].value; flags != "" && !alnumRegex.MatchString(flags) {
+		return errors.New("NAPTR flags must be letters or digits")
+	}
+	regex, replacement := tokens[4].value, tokens[5].value
+	if regex != "" && replacement != "." {
+		return errors.New("NAPTR record must set either a regexp or a replacement, not both")
+	}
+	if replacement != "." && !targetRegex.MatchString(replacement) {
+		return errors.New("invalid NAPTR replacement hostname")
+	}
+	return nil
+}
+
+// validateLOC validates LOC record content
+// LOC format: d1 [m1 [s1]] N|S d2 [m2 [s2]] E|W alt[m] [size[m] [hp[m] [vp[m]]]]
+func (rv *recordValidator) validateLOC(content string) error {
+	parts := strings.Fields(content)
+	rest, err := locCoordinate(parts, "latitude", 90, "N", "S")
+	if err != nil {
+		return err
+	}
+	rest, err = locCoordinate(rest, "longitude", 180, "E", "W")
+	if err != nil {
+		return err
+	}
+	if len(rest) == 0 {
+		return errors.New("LOC record must have an altitude")
+	}
+	if len(rest) > 4 {
+		return errors.New("LOC record must have format: d1 [m1 [s1]] N|S d2 [m2 [s2]] E|W alt[m] [size[m] [hp[m] [vp[m]]]]")
+	}
+	alt, err := strconv.ParseFloat(strings.TrimSuffix(rest[0], "m"), 64)
+	if err != nil || alt < -100000 || alt > 42849672.95 {
+		return errors.New("LOC altitude must be between -100000.00 and 42849672.95 meters")
+	}
+	for i, name := range []string{"size", "horizontal precision", "vertical precision"} {
+		if len(rest) <= i+1 {
+			break
+		}
+		v, err := strconv.ParseFloat(strings.TrimSuffix(rest[i+1], "m"), 64)
+		if err != nil || v < 0 || v > 90000000 {
+			return errors.Errorf("LOC %s must be between 0 and 90000000.00 meters", name)
+		}
+	}
+	return nil
+}
+
+// locCoordinate validates a latitude or longitude of LOC record content,
+// and returns the content that follows it.
+func locCoordinate(parts []string, name string, maxDegrees int, dirs ...string) ([]string, error) {
+	// Degrees, and optionally minutes and seconds, precede the direction.
+	n := 0
+	for n < len(parts) && n < 4 && !slices.Contains(dirs, parts[n]) {
+		n++
+	}
+	if n == 0 || n > 3 || n == len(parts) {
+		return nil, errors.Errorf("LOC %s must have format: degrees [minutes [seconds]] %s", name, strings.Join(dirs, "|"))
+	}
+	degrees, err := parseUint(parts[0], maxDegrees)
+	if err != nil {
+		return nil, errors.Errorf("LOC %s degrees must be between 0 and %d", name, maxDegrees)
+	}
+	if n > 1 {
+		if _, err := parseUint(parts[1], 59); err != nil {
+			return nil, errors.Errorf("LOC %s minutes must be between 0 and 59", name)
+		}
+	}
+	if n > 2 {
+		if s, err := strconv.ParseFloat(parts[2], 64); err != nil || s < 0 || s >= 60 {
+			return nil, errors.Errorf("LOC %s seconds must be between 0 and 59.999", name)
+		}
+	}
+	if degrees == maxDegrees && slices.ContainsFunc(parts[1:n], func(p string) bool {
+		f, _ := strconv.ParseFloat(p, 64)
+		return f != 0
+	}) {
+		return nil, errors.Errorf("LOC %s must not be more than %d degrees", name, maxDegrees)
+	}
+	return parts[n+1:], nil
+}
+
+// A field of record content, which may be quoted.
+type field struct {
+	value  string
+	quoted bool
+}
+
+// quotedFields splits record content into fields separated by whitespace.
+// Quoted fields may contain whitespace and backslash escaped characters.
+func quotedFields(content string) ([]field, error) {
+	var fields []field
+	for i := 0; i < len(content); {
+		switch {
+		case content[i] == ' ' || content[i] == '\t':
+			i++
+		case content[i] == '"':
+			b := strings.Builder{}
+			i++
+			for i < len(content) && content[i] != '"' {
+				if content[i] == '\\' && i+1 < len(content) {
+					i++
+				}
+				b.WriteByte(content[i])
+				i++
+			}
+			if i == len(content) {
+				return nil, errors.New("unterminated quoted string")
+			}
+			i++
+			if i < len(content) && content[i] != ' ' && content[i] != '\t' {
+				return nil, errors.New("quoted strings must be separated by whitespace")
+			}
+			fields = append(fields, field{value: b.String(), quoted: true})
+		default:
+			j := strings.IndexAny(content[i:], " \t")
+			if j < 0 {
+				j = len(content) - i
+			}
+			fields = append(fields, field{value: content[i : i+j]})
+			i += j
+		}
+	}
+	return fields, nil
+}
+
+// parseUint parses a decimal number between 0 and the supplied maximum.
+func parseUint(s string, maxValue int) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || n > maxValue || strings.HasPrefix(s, "+") {
+		return 0, errors.Errorf("%q is not between 0 and %d", s, maxValue)
+	}
+	return n, nil
+}
+
+// validateHex validates hex data, and its length if the supplied length
+// is not zero.
+func validateHex(name, data string, length int) error {
+	if !hexRegex.MatchString(data) || len(data)%2 != 0 {
+		return errors.Errorf("%s must be an even number of hex characters", name)
+	}
+	if length != 0 && len(data) != length {
+		return errors.Errorf("%s must be %d hex characters, got %d", name, length, len(data))
+	}
+	return nil
+}
+
+// validateBase64 validates base64 data.
+func validateBase64(name, data string) error {
+	if _, err := base64.StdEncoding.DecodeString(data); err != nil || data == "" {
+		return errors.Errorf("%s must be base64", name)
+	}
+	return nil
+}
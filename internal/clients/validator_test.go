@@ -17,6 +17,7 @@ limitations under the License.
 package clients
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -335,6 +336,311 @@ func TestValidateRecord(t *testing.T) {
 	}
 }
 
+func TestValidateRecordTypes(t *testing.T) {
+	validator := NewDNSRecordValidator()
+
+	sha1 := "123456789abcdef67890123456789abcdef67890"
+	sha256 := "0b9fa5a59eed715c26c1020c711b4f6ec42d58b0015e14337a39dad301c5afc3"
+	sha384 := sha256 + sha256[:32]
+	sha512 := sha256 + sha256
+	key := "AwEAAagAIKlVZrpC6Ia7gEzahOR+9W29euxhJhVVLOyQbSEW0O8gcCjF"
+
+	type args struct {
+		recordType string
+		content    string
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"ValidCNAMEUnderscores": {
+			reason: "A CNAME record may point to a name with underscore labels",
+			args:   args{recordType: "CNAME", content: "s1._domainkey.example.com"},
+		},
+		"InvalidCNAMETarget": {
+			reason: "A CNAME record must point to a hostname",
+			args:   args{recordType: "CNAME", content: "not a hostname"},
+			want:   want{err: errors.New("invalid CNAME target hostname")},
+		},
+		"InvalidNSTarget": {
+			reason: "An NS record must point to a hostname",
+			args:   args{recordType: "NS", content: "ns1..example.com"},
+			want:   want{err: errors.New("invalid NS target hostname")},
+		},
+		"ValidTXT": {
+			reason: "Unquoted TXT content should pass",
+			args:   args{recordType: "TXT", content: "google-site-verification=abc123"},
+		},
+		"ValidTXTLongUnquoted": {
+			reason: "Unquoted TXT content longer than one string should pass, as Cloudflare splits it",
+			args:   args{recordType: "TXT", content: strings.Repeat("a", 300)},
+		},
+		"ValidTXTQuotedStrings": {
+			reason: "TXT content of quoted strings of at most 255 characters should pass",
+			args:   args{recordType: "TXT", content: `"v=DKIM1; k=rsa; p=` + strings.Repeat("A", 200) + `" "` + strings.Repeat("B", 200) + `"`},
+		},
+		"ValidTXTEscapedQuote": {
+			reason: "Quoted TXT strings may contain escaped quotes",
+			args:   args{recordType: "TXT", content: `"say \"hello\""`},
+		},
+		"TXTTooLong": {
+			reason: "TXT content longer than 2048 characters should fail",
+			args:   args{recordType: "TXT", content: strings.Repeat("a", 2049)},
+			want:   want{err: errors.New("TXT record content must be 2048 characters or fewer")},
+		},
+		"TXTQuotedStringTooLong": {
+			reason: "Quoted TXT strings longer than 255 characters should fail",
+			args:   args{recordType: "TXT", content: `"` + strings.Repeat("a", 256) + `"`},
+			want:   want{err: errors.New("TXT record strings must be 255 characters or fewer, split longer content into quoted strings")},
+		},
+		"TXTUnterminatedQuote": {
+			reason: "TXT content with an unterminated quoted string should fail",
+			args:   args{recordType: "TXT", content: `"v=spf1 -all`},
+			want:   want{err: errors.New("invalid TXT record content: unterminated quoted string")},
+		},
+		"TXTMixedQuoting": {
+			reason: "TXT content that mixes quoted and unquoted strings should fail",
+			args:   args{recordType: "TXT", content: `"v=spf1" -all`},
+			want:   want{err: errors.New("TXT record content must be either unquoted or only quoted strings")},
+		},
+		"ValidSPF": {
+			reason: "SPF content that starts with v=spf1 should pass",
+			args:   args{recordType: "SPF", content: "v=spf1 include:_spf.example.com -all"},
+		},
+		"InvalidSPF": {
+			reason: "SPF content that does not start with v=spf1 should fail",
+			args:   args{recordType: "SPF", content: "include:_spf.example.com -all"},
+			want:   want{err: errors.New("SPF record content must start with v=spf1")},
+		},
+		"ValidCAA": {
+			reason: "A CAA record with flags, tag and quoted value should pass",
+			args:   args{recordType: "CAA", content: `0 issue "letsencrypt.org"`},
+		},
+		"ValidCAAIodef": {
+			reason: "A CAA iodef record with a mailto URL should pass",
+			args:   args{recordType: "CAA", content: `128 iodef "mailto:security@example.com"`},
+		},
+		"CAAInvalidFlags": {
+			reason: "CAA flags above 255 should fail",
+			args:   args{recordType: "CAA", content: `256 issue "letsencrypt.org"`},
+			want:   want{err: errors.New("CAA flags must be between 0 and 255")},
+		},
+		"CAAInvalidTag": {
+			reason: "Unknown CAA tags should fail",
+			args:   args{recordType: "CAA", content: `0 issuer "letsencrypt.org"`},
+			want:   want{err: errors.New("CAA tag must be one of issue, issuewild or iodef")},
+		},
+		"CAAInvalidIodef": {
+			reason: "A CAA iodef value that is not a URL should fail",
+			args:   args{recordType: "CAA", content: `0 iodef "security@example.com"`},
+			want:   want{err: errors.New("CAA iodef value must be a mailto:, http:// or https:// URL")},
+		},
+		"CAAMissingValue": {
+			reason: "A CAA record without a value should fail",
+			args:   args{recordType: "CAA", content: "0 issue"},
+			want:   want{err: errors.New(`CAA record must have format: flags tag "value"`)},
+		},
+		"ValidTLSA": {
+			reason: "A TLSA record with a SHA-256 hash should pass",
+			args:   args{recordType: "TLSA", content: "3 1 1 " + sha256},
+		},
+		"ValidTLSASHA512": {
+			reason: "A TLSA record with a SHA-512 hash should pass",
+			args:   args{recordType: "TLSA", content: "0 0 2 " + sha512},
+		},
+		"TLSAInvalidUsage": {
+			reason: "TLSA usage above 3 should fail",
+			args:   args{recordType: "TLSA", content: "4 1 1 " + sha256},
+			want:   want{err: errors.New("TLSA usage must be between 0 and 3")},
+		},
+		"TLSAInvalidSelector": {
+			reason: "TLSA selector above 1 should fail",
+			args:   args{recordType: "TLSA", content: "3 2 1 " + sha256},
+			want:   want{err: errors.New("TLSA selector must be 0 or 1")},
+		},
+		"TLSAInvalidMatchingType": {
+			reason: "TLSA matching type above 2 should fail",
+			args:   args{recordType: "TLSA", content: "3 1 3 " + sha256},
+			want:   want{err: errors.New("TLSA matching type must be between 0 and 2")},
+		},
+		"TLSAWrongHashLength": {
+			reason: "A TLSA SHA-256 hash must be 64 hex characters",
+			args:   args{recordType: "TLSA", content: "3 1 1 " + sha1},
+			want:   want{err: errors.New("TLSA certificate must be 64 hex characters, got 40")},
+		},
+		"TLSANotHex": {
+			reason: "TLSA certificate data must be hex",
+			args:   args{recordType: "TLSA", content: "3 1 0 xyz0"},
+			want:   want{err: errors.New("TLSA certificate must be an even number of hex characters")},
+		},
+		"SMIMEAInvalidUsage": {
+			reason: "SMIMEA records should be validated as TLSA records are",
+			args:   args{recordType: "SMIMEA", content: "5 0 1 " + sha256},
+			want:   want{err: errors.New("SMIMEA usage must be between 0 and 3")},
+		},
+		"ValidSSHFP": {
+			reason: "An SSHFP record with a SHA-256 fingerprint should pass",
+			args:   args{recordType: "SSHFP", content: "4 2 " + sha256},
+		},
+		"SSHFPInvalidAlgorithm": {
+			reason: "Unknown SSHFP algorithms should fail",
+			args:   args{recordType: "SSHFP", content: "5 2 " + sha256},
+			want:   want{err: errors.New("SSHFP algorithm must be 1 (RSA), 2 (DSA), 3 (ECDSA), 4 (Ed25519) or 6 (Ed448)")},
+		},
+		"SSHFPWrongFingerprintLength": {
+			reason: "An SSHFP SHA-1 fingerprint must be 40 hex characters",
+			args:   args{recordType: "SSHFP", content: "1 1 " + sha256},
+			want:   want{err: errors.New("SSHFP fingerprint must be 40 hex characters, got 64")},
+		},
+		"ValidDS": {
+			reason: "A DS record with a SHA-256 digest should pass",
+			args:   args{recordType: "DS", content: "2371 13 2 " + sha256},
+		},
+		"ValidDSSplitDigest": {
+			reason: "A DS digest may be split by whitespace",
+			args:   args{recordType: "DS", content: "2371 13 4 " + sha384[:48] + " " + sha384[48:]},
+		},
+		"DSWrongDigestLength": {
+			reason: "A DS SHA-384 digest must be 96 hex characters",
+			args:   args{recordType: "DS", content: "2371 13 4 " + sha256},
+			want:   want{err: errors.New("DS digest must be 96 hex characters, got 64")},
+		},
+		"DSInvalidDigestType": {
+			reason: "Unknown DS digest types should fail",
+			args:   args{recordType: "DS", content: "2371 13 5 " + sha256},
+			want:   want{err: errors.New("DS digest type must be 1 (SHA-1), 2 (SHA-256), 3 (GOST) or 4 (SHA-384)")},
+		},
+		"DSInvalidKeyTag": {
+			reason: "DS key tags above 65535 should fail",
+			args:   args{recordType: "DS", content: "65536 13 2 " + sha256},
+			want:   want{err: errors.New("DS key tag must be between 0 and 65535")},
+		},
+		"ValidDNSKEY": {
+			reason: "A DNSKEY record with a base64 public key should pass",
+			args:   args{recordType: "DNSKEY", content: "257 3 13 " + key},
+		},
+		"DNSKEYInvalidProtocol": {
+			reason: "DNSKEY protocol must be 3",
+			args:   args{recordType: "DNSKEY", content: "257 2 13 " + key},
+			want:   want{err: errors.New("DNSKEY protocol must be 3")},
+		},
+		"DNSKEYInvalidKey": {
+			reason: "A DNSKEY public key must be base64",
+			args:   args{recordType: "DNSKEY", content: "257 3 13 not-base64!"},
+			want:   want{err: errors.New("DNSKEY public key must be base64")},
+		},
+		"ValidCERT": {
+			reason: "A CERT record with a mnemonic type should pass",
+			args:   args{recordType: "CERT", content: "PGP 0 0 " + key},
+		},
+		"CERTInvalidType": {
+			reason: "Unknown CERT type mnemonics should fail",
+			args:   args{recordType: "CERT", content: "X509 0 0 " + key},
+			want:   want{err: errors.New("CERT type must be between 0 and 65535, or a mnemonic such as PKIX or PGP")},
+		},
+		"CERTMissingCertificate": {
+			reason: "A CERT record without a certificate should fail",
+			args:   args{recordType: "CERT", content: "1 0 0"},
+			want:   want{err: errors.New("CERT record must have format: type key_tag algorithm certificate")},
+		},
+		"ValidNAPTRRegexp": {
+			reason: "A NAPTR record with a regexp and no replacement should pass",
+			args:   args{recordType: "NAPTR", content: `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .`},
+		},
+		"ValidNAPTRReplacement": {
+			reason: "A NAPTR record with a replacement and no regexp should pass",
+			args:   args{recordType: "NAPTR", content: `100 10 "S" "SIP+D2U" "" _sip._udp.example.com.`},
+		},
+		"NAPTRRegexpAndReplacement": {
+			reason: "A NAPTR record must not set both a regexp and a replacement",
+			args:   args{recordType: "NAPTR", content: `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" sip.example.com`},
+			want:   want{err: errors.New("NAPTR record must set either a regexp or a replacement, not both")},
+		},
+		"NAPTRInvalidOrder": {
+			reason: "NAPTR order above 65535 should fail",
+			args:   args{recordType: "NAPTR", content: `70000 10 "S" "SIP+D2U" "" _sip._udp.example.com.`},
+			want:   want{err: errors.New("NAPTR order must be between 0 and 65535")},
+		},
+		"NAPTRTooFewFields": {
+			reason: "A NAPTR record with too few fields should fail",
+			args:   args{recordType: "NAPTR", content: `100 10 "S" "SIP+D2U"`},
+			want:   want{err: errors.New(`NAPTR record must have format: order preference "flags" "service" "regexp" replacement`)},
+		},
+		"ValidLOC": {
+			reason: "A LOC record with every field should pass",
+			args:   args{recordType: "LOC", content: "51 30 12.748 N 0 7 39.611 W 0.00m 1m 10000m 10m"},
+		},
+		"ValidLOCDegreesOnly": {
+			reason: "A LOC record may omit minutes, seconds, size and precision",
+			args:   args{recordType: "LOC", content: "42 N 71 W -24m"},
+		},
+		"LOCInvalidLatitude": {
+			reason: "LOC latitudes above 90 degrees should fail",
+			args:   args{recordType: "LOC", content: "91 0 0 N 0 0 0 E 0m"},
+			want:   want{err: errors.New("LOC latitude degrees must be between 0 and 90")},
+		},
+		"LOCLatitudeBeyondPole": {
+			reason: "LOC latitudes of 90 degrees must not have minutes or seconds",
+			args:   args{recordType: "LOC", content: "90 30 0 N 0 0 0 E 0m"},
+			want:   want{err: errors.New("LOC latitude must not be more than 90 degrees")},
+		},
+		"LOCInvalidMinutes": {
+			reason: "LOC minutes above 59 should fail",
+			args:   args{recordType: "LOC", content: "51 60 N 0 W 0m"},
+			want:   want{err: errors.New("LOC latitude minutes must be between 0 and 59")},
+		},
+		"LOCInvalidSeconds": {
+			reason: "LOC seconds of 60 or more should fail",
+			args:   args{recordType: "LOC", content: "51 30 60 N 0 W 0m"},
+			want:   want{err: errors.New("LOC latitude seconds must be between 0 and 59.999")},
+		},
+		"LOCMissingDirection": {
+			reason: "A LOC longitude without a direction should fail",
+			args:   args{recordType: "LOC", content: "51 30 N 0 7 0m"},
+			want:   want{err: errors.New("LOC longitude must have format: degrees [minutes [seconds]] E|W")},
+		},
+		"LOCMissingAltitude": {
+			reason: "A LOC record without an altitude should fail",
+			args:   args{recordType: "LOC", content: "51 30 N 0 7 W"},
+			want:   want{err: errors.New("LOC record must have an altitude")},
+		},
+		"LOCInvalidAltitude": {
+			reason: "LOC altitudes below -100000 meters should fail",
+			args:   args{recordType: "LOC", content: "51 30 N 0 7 W -100001m"},
+			want:   want{err: errors.New("LOC altitude must be between -100000.00 and 42849672.95 meters")},
+		},
+		"LOCInvalidPrecision": {
+			reason: "LOC precisions above 90000000 meters should fail",
+			args:   args{recordType: "LOC", content: "51 30 N 0 7 W 0m 1m 90000001m"},
+			want:   want{err: errors.New("LOC horizontal precision must be between 0 and 90000000.00 meters")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validator.ValidateRecord(tc.args.recordType, tc.args.content, nil)
+
+			if tc.want.err == nil {
+				if err != nil {
+					t.Errorf("\n%s\nValidateRecord(%s, %s): expected no error, got %v", tc.reason, tc.args.recordType, tc.args.content, err)
+				}
+			} else {
+				if err == nil {
+					t.Errorf("\n%s\nValidateRecord(%s, %s): expected error %v, got nil", tc.reason, tc.args.recordType, tc.args.content, tc.want.err)
+				} else if err.Error() != tc.want.err.Error() {
+					t.Errorf("\n%s\nValidateRecord(%s, %s): expected error %v, got %v", tc.reason, tc.args.recordType, tc.args.content, tc.want.err, err)
+				}
+			}
+		})
+	}
+}
+
 func TestValidateIPv4(t *testing.T) {
 	validator := &recordValidator{}
 
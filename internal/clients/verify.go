@@ -0,0 +1,169 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+)
+
+// VerifyInterval is how long the result of verifying a credential is
+// trusted before it is verified again.
+const VerifyInterval = 1 * time.Hour
+
+const (
+	errVerifyToken     = "cannot verify API token"
+	errVerifyKey       = "cannot verify API key"
+	errGetTokenGroups  = "cannot get API token permission groups"
+	errNewVerifyClient = "cannot create client to verify credential"
+	errFmtTokenStatus  = "API token is %s"
+
+	tokenActive = "active"
+	effectAllow = "allow"
+)
+
+// A CredentialVerifier can verify a Cloudflare credential. It is satisfied
+// by *cloudflare.API.
+type CredentialVerifier interface {
+	VerifyAPIToken(ctx context.Context) (cloudflare.APITokenVerifyBody, error)
+	GetAPIToken(ctx context.Context, tokenID string) (cloudflare.APIToken, error)
+	UserDetails(ctx context.Context) (cloudflare.User, error)
+}
+
+// VerifyCredentials verifies the credential of the supplied configuration
+// with Cloudflare, and lists the permission groups an API token is allowed.
+// The returned error satisfies cferrors.IsAuth when Cloudflare rejects the
+// credential, in which case what is known about it is returned too.
+func VerifyCredentials(ctx context.Context, api CredentialVerifier, cfg Config) (*v1beta1.CredentialStatus, error) {
+	now := metav1.Now()
+
+	// A global API key has every permission of its user, so there are no
+	// permission groups to list.
+	if cfg.AuthByAPIToken == nil {
+		if _, err := api.UserDetails(ctx); err != nil {
+			return nil, errors.Wrap(err, errVerifyKey)
+		}
+		return &v1beta1.CredentialStatus{LastVerifiedTime: &now}, nil
+	}
+
+	v, err := api.VerifyAPIToken(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errVerifyToken)
+	}
+	cs := &v1beta1.CredentialStatus{
+		TokenID:          v.ID,
+		TokenStatus:      v.Status,
+		LastVerifiedTime: &now,
+	}
+	if !v.ExpiresOn.IsZero() {
+		t := metav1.NewTime(v.ExpiresOn)
+		cs.ExpiresOn = &t
+	}
+	if v.Status != tokenActive {
+		return cs, cferrors.New(cferrors.Auth, fmt.Sprintf(errFmtTokenStatus, v.Status))
+	}
+
+	t, err := api.GetAPIToken(ctx, v.ID)
+	if cferrors.IsAuth(err) || cferrors.IsPermission(err) {
+		// Only tokens allowed API Tokens Read may read their own
+		// permission groups. Others are still valid, but their
+		// permissions are unknown.
+		return cs, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errGetTokenGroups)
+	}
+	cs.PermissionGroups = allowedPermissionGroups(t)
+	return cs, nil
+}
+
+// allowedPermissionGroups returns the names of the permission groups that
+// the policies of the supplied token allow, sorted by name.
+func allowedPermissionGroups(t cloudflare.APIToken) []string {
+	seen := map[string]bool{}
+	groups := []string{}
+	for _, p := range t.Policies {
+		if p.Effect != effectAllow {
+			continue
+		}
+		for _, g := range p.PermissionGroups {
+			if g.Name == "" || seen[g.Name] {
+				continue
+			}
+			seen[g.Name] = true
+			groups = append(groups, g.Name)
+		}
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// A Verifier verifies the credentials of ProviderConfigs and
+// ClusterProviderConfigs. Results are cached by the Session of each config
+// for VerifyInterval, and so are discarded when its credential changes.
+type Verifier struct {
+	kube   client.Client
+	hc     *http.Client
+	newAPI func(cfg Config, hc *http.Client) (CredentialVerifier, error)
+}
+
+// NewVerifier returns a Verifier that reads credentials with c, and makes
+// requests to Cloudflare with hc.
+func NewVerifier(c client.Client, hc *http.Client) *Verifier {
+	return &Verifier{
+		kube: c,
+		hc:   hc,
+		newAPI: func(cfg Config, hc *http.Client) (CredentialVerifier, error) {
+			return NewClient(cfg, hc)
+		},
+	}
+}
+
+// Verify the credential of the supplied ProviderConfig or
+// ClusterProviderConfig.
+func (v *Verifier) Verify(ctx context.Context, pc resource.ProviderConfig) (*v1beta1.CredentialStatus, error) {
+	cfg, err := newConfig(ctx, v.kube, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	verify := func(ctx context.Context) (*v1beta1.CredentialStatus, error) {
+		api, err := v.newAPI(*cfg, v.hc)
+		if err != nil {
+			return nil, errors.Wrap(err, errNewVerifyClient)
+		}
+		return VerifyCredentials(ctx, api, *cfg)
+	}
+
+	if cfg.Session != nil {
+		return cfg.Session.verification(ctx, VerifyInterval, verify)
+	}
+	return verify(ctx)
+}
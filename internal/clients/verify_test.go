@@ -0,0 +1,189 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+)
+
+type fakeVerifier struct {
+	verify   func() (cloudflare.APITokenVerifyBody, error)
+	getToken func(id string) (cloudflare.APIToken, error)
+	user     func() (cloudflare.User, error)
+}
+
+func (f *fakeVerifier) VerifyAPIToken(_ context.Context) (cloudflare.APITokenVerifyBody, error) {
+	return f.verify()
+}
+
+func (f *fakeVerifier) GetAPIToken(_ context.Context, id string) (cloudflare.APIToken, error) {
+	return f.getToken(id)
+}
+
+func (f *fakeVerifier) UserDetails(_ context.Context) (cloudflare.User, error) {
+	return f.user()
+}
+
+func TestVerifyCredentials(t *testing.T) {
+	errBoom := errors.New("boom")
+	errUnauthorized := &cloudflare.Error{StatusCode: http.StatusUnauthorized, ErrorCodes: []int{1000}}
+	errForbidden := &cloudflare.Error{StatusCode: http.StatusForbidden, ErrorCodes: []int{9109}}
+	expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	token := Config{AuthByAPIToken: &AuthByAPIToken{Token: ptr.To("t")}}
+	key := Config{AuthByAPIKey: &AuthByAPIKey{Key: ptr.To("k"), Email: ptr.To("e")}}
+	active := func() (cloudflare.APITokenVerifyBody, error) {
+		return cloudflare.APITokenVerifyBody{ID: "tok", Status: "active", ExpiresOn: expires}, nil
+	}
+
+	type want struct {
+		cs      *v1beta1.CredentialStatus
+		err     error
+		errAuth bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		cfg    Config
+		api    *fakeVerifier
+		want   want
+	}{
+		"APIKey": {
+			reason: "A global API key should be verified by reading its user, and have no permission groups.",
+			cfg:    key,
+			api: &fakeVerifier{user: func() (cloudflare.User, error) {
+				return cloudflare.User{}, nil
+			}},
+			want: want{cs: &v1beta1.CredentialStatus{}},
+		},
+		"APIKeyRejected": {
+			reason: "A global API key Cloudflare rejects should return an auth error.",
+			cfg:    key,
+			api: &fakeVerifier{user: func() (cloudflare.User, error) {
+				return cloudflare.User{}, errUnauthorized
+			}},
+			want: want{err: errors.Wrap(errUnauthorized, errVerifyKey), errAuth: true},
+		},
+		"TokenPermissionGroups": {
+			reason: "The permission groups allowed by a token's policies should be listed once each, sorted by name.",
+			cfg:    token,
+			api: &fakeVerifier{
+				verify: active,
+				getToken: func(id string) (cloudflare.APIToken, error) {
+					return cloudflare.APIToken{ID: id, Policies: []cloudflare.APITokenPolicies{
+						{Effect: "allow", PermissionGroups: []cloudflare.APITokenPermissionGroups{{Name: "Zone Write"}, {Name: "DNS Write"}}},
+						{Effect: "allow", PermissionGroups: []cloudflare.APITokenPermissionGroups{{Name: "DNS Write"}}},
+						{Effect: "deny", PermissionGroups: []cloudflare.APITokenPermissionGroups{{Name: "Logs Write"}}},
+					}}, nil
+				},
+			},
+			want: want{cs: &v1beta1.CredentialStatus{
+				TokenID:          "tok",
+				TokenStatus:      "active",
+				ExpiresOn:        &metav1.Time{Time: expires},
+				PermissionGroups: []string{"DNS Write", "Zone Write"},
+			}},
+		},
+		"TokenCannotReadItself": {
+			reason: "A token that may not read its own details should be verified without permission groups.",
+			cfg:    token,
+			api: &fakeVerifier{
+				verify: active,
+				getToken: func(string) (cloudflare.APIToken, error) {
+					return cloudflare.APIToken{}, errForbidden
+				},
+			},
+			want: want{cs: &v1beta1.CredentialStatus{
+				TokenID:     "tok",
+				TokenStatus: "active",
+				ExpiresOn:   &metav1.Time{Time: expires},
+			}},
+		},
+		"TokenDisabled": {
+			reason: "A token that is not active should be returned with an auth error.",
+			cfg:    token,
+			api: &fakeVerifier{verify: func() (cloudflare.APITokenVerifyBody, error) {
+				return cloudflare.APITokenVerifyBody{ID: "tok", Status: "disabled"}, nil
+			}},
+			want: want{
+				cs:      &v1beta1.CredentialStatus{TokenID: "tok", TokenStatus: "disabled"},
+				err:     cferrors.New(cferrors.Auth, "API token is disabled"),
+				errAuth: true,
+			},
+		},
+		"TokenRejected": {
+			reason: "A token Cloudflare rejects should return an auth error.",
+			cfg:    token,
+			api: &fakeVerifier{verify: func() (cloudflare.APITokenVerifyBody, error) {
+				return cloudflare.APITokenVerifyBody{}, errUnauthorized
+			}},
+			want: want{err: errors.Wrap(errUnauthorized, errVerifyToken), errAuth: true},
+		},
+		"VerifyError": {
+			reason: "Other errors verifying a token should be returned, and not be auth errors.",
+			cfg:    token,
+			api: &fakeVerifier{verify: func() (cloudflare.APITokenVerifyBody, error) {
+				return cloudflare.APITokenVerifyBody{}, errBoom
+			}},
+			want: want{err: errors.Wrap(errBoom, errVerifyToken)},
+		},
+		"GetTokenError": {
+			reason: "Errors other than auth errors listing a token's permission groups should be returned.",
+			cfg:    token,
+			api: &fakeVerifier{
+				verify: active,
+				getToken: func(string) (cloudflare.APIToken, error) {
+					return cloudflare.APIToken{}, errBoom
+				},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetTokenGroups)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cs, err := VerifyCredentials(context.Background(), tc.api, tc.cfg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nVerifyCredentials(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if got := cferrors.IsAuth(err); got != tc.want.errAuth {
+				t.Errorf("\n%s\nVerifyCredentials(...): want cferrors.IsAuth %t, got %t", tc.reason, tc.want.errAuth, got)
+			}
+			if diff := cmp.Diff(tc.want.cs, cs, cmpopts.IgnoreFields(v1beta1.CredentialStatus{}, "LastVerifiedTime")); diff != "" {
+				t.Errorf("\n%s\nVerifyCredentials(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if cs != nil && cs.LastVerifiedTime == nil {
+				t.Errorf("\n%s\nVerifyCredentials(...): want LastVerifiedTime to be set", tc.reason)
+			}
+		})
+	}
+}
@@ -161,14 +161,6 @@ func (c *workerClient) DeleteWorkerSubdomain(ctx context.Context, accountID, sub
 	return errors.New("DeleteWorkerSubdomain not implemented")
 }
 
-// Error checking functions
-func IsDomainNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	return false // Simplified implementation
-}
-
 // Helper functions for controllers
 func GenerateDomainObservation(in interface{}) v1beta1.DomainObservation {
 	// Stub implementation
@@ -178,4 +170,4 @@ func GenerateDomainObservation(in interface{}) v1beta1.DomainObservation {
 func DomainUpToDate(spec *v1beta1.DomainParameters, in interface{}) bool {
 	// Stub implementation
 	return true
-}
\ No newline at end of file
+}
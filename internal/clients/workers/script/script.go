@@ -18,8 +18,6 @@ package script
 
 import (
 	"context"
-	"math"
-	"strings"
 	"sync"
 	"time"
 
@@ -38,15 +36,16 @@ const (
 	errListScripts       = "cannot list worker scripts"
 	errGetScriptSettings = "cannot get worker script settings"
 	
-	// Cache TTL for API responses within the same reconcile cycle
+	// Cache TTL for API responses
 	cacheTimeout = 30 * time.Second
-	
-	// Retry configuration for rate limiting
-	maxRetries = 3
-	baseDelay  = 2 * time.Second
 )
 
-// scriptCache holds cached API responses to avoid duplicate calls within the same reconcile cycle
+// scriptCacheKey is the key under which the scriptCache of an account is
+// stored in a clients.Session.
+type scriptCacheKey string
+
+// scriptCache holds cached API responses to avoid duplicate calls across
+// the reconciles of an account's scripts.
 type scriptCache struct {
 	mu                    sync.RWMutex
 	workerData           map[string]*cachedWorkerData
@@ -76,17 +75,28 @@ type ScriptClient struct {
 	cache     *scriptCache
 }
 
-// NewClient creates a new Worker Script client.
-func NewClient(client clients.ClientInterface) *ScriptClient {
-	return &ScriptClient{
+func newScriptCache() any {
+	return &scriptCache{
+		workerData:     make(map[string]*cachedWorkerData),
+		scriptContent:  make(map[string]*cachedScriptContent),
+		scriptSettings: make(map[string]*cachedScriptSettings),
+	}
+}
+
+// NewClient creates a new Worker Script client. API responses are cached in
+// the supplied Session, if any, so that they are shared by every client made
+// for the same credential and account.
+func NewClient(client clients.ClientInterface, session *clients.Session) *ScriptClient {
+	c := &ScriptClient{
 		client:    client,
 		accountID: "", // Account ID will be retrieved when needed
-		cache: &scriptCache{
-			workerData:     make(map[string]*cachedWorkerData),
-			scriptContent:  make(map[string]*cachedScriptContent),
-			scriptSettings: make(map[string]*cachedScriptSettings),
-		},
 	}
+	if session == nil {
+		c.cache = newScriptCache().(*scriptCache)
+		return c
+	}
+	c.cache = session.Value(scriptCacheKey(client.GetAccountID()), newScriptCache).(*scriptCache)
+	return c
 }
 
 // getAccountID gets the account ID from the Cloudflare API
@@ -148,6 +158,17 @@ func (c *ScriptClient) setScriptContentInCache(scriptName string, content string
 	}
 }
 
+// forget drops every cached response for the named script, so that it is
+// read again after it was changed.
+func (c *ScriptClient) forget(scriptName string) {
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+
+	delete(c.cache.workerData, scriptName)
+	delete(c.cache.scriptContent, scriptName)
+	delete(c.cache.scriptSettings, scriptName)
+}
+
 func (c *ScriptClient) getScriptSettingsFromCache(scriptName string) (*cloudflare.WorkerScriptSettingsResponse, bool) {
 	c.cache.mu.RLock()
 	defer c.cache.mu.RUnlock()
@@ -169,55 +190,6 @@ func (c *ScriptClient) setScriptSettingsInCache(scriptName string, settings clou
 	}
 }
 
-// isRateLimitError checks if an error is due to rate limiting
-func isRateLimitError(err error) bool {
-	if err == nil {
-		return false
-	}
-	errStr := strings.ToLower(err.Error())
-	return strings.Contains(errStr, "rate limit") || 
-		   strings.Contains(errStr, "429") ||
-		   strings.Contains(errStr, "too many requests")
-}
-
-// retryWithBackoff executes a function with exponential backoff on rate limit errors
-func (c *ScriptClient) retryWithBackoff(ctx context.Context, operation func() error) error {
-	var lastErr error
-	
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		if attempt > 0 {
-			// Exponential backoff: baseDelay * 2^(attempt-1) with jitter
-			delay := time.Duration(float64(baseDelay) * math.Pow(2, float64(attempt-1)))
-			// Add 10% jitter to avoid thundering herd
-			jitter := time.Duration(float64(delay) * 0.1 * float64(2*time.Now().UnixNano()%2 - 1) / 1e9)
-			delay += jitter
-			
-			select {
-			case <-time.After(delay):
-			case <-ctx.Done():
-				return ctx.Err()
-			}
-		}
-		
-		lastErr = operation()
-		if lastErr == nil {
-			return nil
-		}
-		
-		// Only retry on rate limit errors
-		if !isRateLimitError(lastErr) {
-			return lastErr
-		}
-		
-		// Don't retry if this was the last attempt
-		if attempt == maxRetries {
-			break
-		}
-	}
-	
-	return errors.Wrap(lastErr, "max retries exceeded")
-}
-
 // convertToCloudflareBindings converts Crossplane bindings to cloudflare-go bindings.
 // Returns error via panic for unsupported bindings (to be caught by caller).
 func convertToCloudflareBindings(bindings []v1beta1.WorkerBinding) map[string]cloudflare.WorkerBinding {
@@ -363,6 +335,7 @@ func (c *ScriptClient) Create(ctx context.Context, params v1beta1.ScriptParamete
 		return nil, errors.New("script content is required")
 	}
 	
+	c.forget(createParams.ScriptName)
 	resp, err := c.client.UploadWorker(ctx, rc, createParams)
 	if err != nil {
 		return nil, errors.Wrap(err, errCreateScript)
@@ -404,10 +377,7 @@ func (c *ScriptClient) Get(ctx context.Context, scriptName string) (*v1beta1.Scr
 	if cachedWorkerData, ok := c.getWorkerDataFromCache(scriptName); ok {
 		scriptResp = *cachedWorkerData
 	} else {
-		err = c.retryWithBackoff(ctx, func() error {
-			scriptResp, err = c.client.GetWorker(ctx, rc, scriptName)
-			return err
-		})
+		scriptResp, err = c.client.GetWorker(ctx, rc, scriptName)
 		if err != nil {
 			return nil, errors.Wrap(err, errGetScript)
 		}
@@ -420,10 +390,7 @@ func (c *ScriptClient) Get(ctx context.Context, scriptName string) (*v1beta1.Scr
 	if cachedSettings, ok := c.getScriptSettingsFromCache(scriptName); ok {
 		settingsResp = *cachedSettings
 	} else {
-		err = c.retryWithBackoff(ctx, func() error {
-			settingsResp, err = c.client.GetWorkersScriptSettings(ctx, rc, scriptName)
-			return err
-		})
+		settingsResp, err = c.client.GetWorkersScriptSettings(ctx, rc, scriptName)
 		if err != nil {
 			return nil, errors.Wrap(err, errGetScriptSettings)
 		}
@@ -452,6 +419,7 @@ func (c *ScriptClient) Update(ctx context.Context, params v1beta1.ScriptParamete
 	rc := cloudflare.AccountIdentifier(accountID)
 	
 	// Use UploadWorker which handles both create and update
+	c.forget(createParams.ScriptName)
 	resp, err := c.client.UploadWorker(ctx, rc, createParams)
 	if err != nil {
 		return nil, errors.Wrap(err, errUpdateScript)
@@ -473,6 +441,7 @@ func (c *ScriptClient) Delete(ctx context.Context, scriptName string) error {
 		ScriptName: scriptName,
 	}
 
+	c.forget(scriptName)
 	err = c.client.DeleteWorker(ctx, rc, deleteParams)
 	if err != nil {
 		return errors.Wrap(err, errDeleteScript)
@@ -518,10 +487,7 @@ func (c *ScriptClient) IsUpToDate(ctx context.Context, params v1beta1.ScriptPara
 		}
 		rc := cloudflare.AccountIdentifier(accountID)
 		
-		err = c.retryWithBackoff(ctx, func() error {
-			currentScript, err = c.client.GetWorkersScriptContent(ctx, rc, params.ScriptName)
-			return err
-		})
+		currentScript, err = c.client.GetWorkersScriptContent(ctx, rc, params.ScriptName)
 		if err != nil {
 			return false, errors.Wrap(err, errGetScript)
 		}
@@ -546,10 +512,7 @@ func (c *ScriptClient) IsUpToDate(ctx context.Context, params v1beta1.ScriptPara
 		}
 		rc := cloudflare.AccountIdentifier(accountID)
 		
-		err = c.retryWithBackoff(ctx, func() error {
-			settingsResp, err = c.client.GetWorkersScriptSettings(ctx, rc, params.ScriptName)
-			return err
-		})
+		settingsResp, err = c.client.GetWorkersScriptSettings(ctx, rc, params.ScriptName)
 		if err != nil {
 			return false, errors.Wrap(err, errGetScriptSettings)
 		}
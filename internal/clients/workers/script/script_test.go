@@ -193,7 +193,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.mockClient())
+			client := NewClient(tc.mockClient(), nil)
 			obs, err := client.Create(context.Background(), tc.args.params)
 
 			if tc.want.err != nil {
@@ -294,7 +294,7 @@ func TestGet(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.mockClient())
+			client := NewClient(tc.mockClient(), nil)
 			obs, err := client.Get(context.Background(), tc.args.scriptName)
 
 			if tc.want.err != nil {
@@ -371,7 +371,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.mockClient())
+			client := NewClient(tc.mockClient(), nil)
 			err := client.Delete(context.Background(), tc.args.scriptName)
 
 			if tc.want.err != nil {
@@ -500,7 +500,7 @@ func TestIsUpToDate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			client := NewClient(tc.mockClient())
+			client := NewClient(tc.mockClient(), nil)
 			isUpToDate, err := client.IsUpToDate(context.Background(), tc.args.params, tc.args.obs)
 
 			if tc.want.err != nil {
@@ -520,4 +520,41 @@ func TestIsUpToDate(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestSessionCache(t *testing.T) {
+	settings := func(id string) clients.ClientInterface {
+		client := clients.NewMockClient()
+		client.On("GetWorkersScriptSettings").Return(cloudflare.WorkerScriptSettingsResponse{
+			WorkerMetaData: cloudflare.WorkerMetaData{ID: id},
+		}, nil)
+		return client
+	}
+	session := clients.NewPool().Session("pc", []byte("token"))
+
+	if _, err := NewClient(settings("old"), session).Get(context.Background(), testScriptName); err != nil {
+		t.Fatalf("Get(): %v", err)
+	}
+
+	// A client made for a later reconcile uses the responses cached by
+	// the first.
+	next := NewClient(settings("new"), session)
+	obs, err := next.Get(context.Background(), testScriptName)
+	if err != nil {
+		t.Fatalf("Get(): %v", err)
+	}
+	if obs.ID != "old" {
+		t.Errorf("Get(): want the cached ID old, got %s", obs.ID)
+	}
+
+	// Changing the script drops its cached responses.
+	if _, err := next.Update(context.Background(), v1beta1.ScriptParameters{ScriptName: testScriptName, Script: testScript}); err != nil {
+		t.Fatalf("Update(): %v", err)
+	}
+	obs, err = next.Get(context.Background(), testScriptName)
+	if err != nil {
+		t.Fatalf("Get(): %v", err)
+	}
+	if obs.ID != "new" {
+		t.Errorf("Get(): want the ID new after an update, got %s", obs.ID)
+	}
+}
@@ -19,7 +19,6 @@ package zones
 import (
 	"context"
 	"net/http"
-	"strings"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -38,20 +37,6 @@ const (
 	errSetPlan        = "error setting plan"
 	errUpdateSettings = "error updating settings"
 
-	// Hardcoded string in cloudflare-go library.
-	// It is used to detect a 'not found' zone
-	// lookup vs. a failed lookup.
-	// REF: https://github.com/cloudflare/cloudflare-go/blob/1dd2d1fe7d044b42d0b64c2f79b9e730c701ab75/cloudflare.go#L162
-	// DO NOT CHANGE THIS
-	errZoneNotFound = "Zone could not be found"
-
-	// String returned by Cloudflare API if making a Zone
-	// request for a Zone ID that doesn't exist.
-	// It is used to detect a 'not found' zone
-	// lookup vs. a failed lookup.
-	// DO NOT CHANGE THIS
-	errZoneInvalidID = "Invalid zone identifier"
-
 	cfsZeroRTT                                  = "0rtt"
 	cfsAdvancedDDOS                             = "advanced_ddos"
 	cfsAlwaysOnline                             = "always_online"
@@ -217,13 +202,6 @@ func toSecurityHeaderSettings(in interface{}) *v1beta1.SecurityHeaderSettings {
 // that represent settings on a Zone.
 type ZoneSettingsMap map[string]interface{}
 
-// IsZoneNotFound returns true if the passed error indicates
-// a Zone was not found.
-func IsZoneNotFound(err error) bool {
-	errStr := err.Error()
-	return errStr == errZoneNotFound || strings.Contains(errStr, errZoneInvalidID)
-}
-
 // Client is a Cloudflare API client that implements methods for working
 // with Zones.
 type Client interface {
@@ -18,16 +18,12 @@ package cache
 
 import (
 	"context"
-	"time"
 
 	"github.com/pkg/errors"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
@@ -35,6 +31,8 @@ import (
 	"github.com/rossigee/provider-cloudflare/apis/cache/v1beta1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
 	"github.com/rossigee/provider-cloudflare/internal/clients/cache"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 	"github.com/rossigee/provider-cloudflare/internal/metrics"
 )
 
@@ -45,34 +43,31 @@ const (
 )
 
 // SetupCacheRule adds a controller that reconciles CacheRule managed resources.
-func SetupCacheRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupCacheRule(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.CacheRuleGroupKind)
 
-	o := controller.Options{
-		RateLimiter: nil, // Use default rate limiter
-		MaxConcurrentReconciles: 5,
-	}
-
 	hc := metrics.NewInstrumentedHTTPClient(name)
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.CacheRuleGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&connector{
 			kube: mgr.GetClient(),
 			newClientFn: func(cfg clients.Config) (cache.CacheRuleClient, error) {
 				return cache.NewCacheRuleClient(cfg, hc)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.CacheRuleGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.CacheRuleGroupVersionKind.GroupKind())).
 		For(&v1beta1.CacheRule{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -128,7 +123,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	rule, ruleset, err := c.service.GetCacheRule(ctx, rulesetID, ruleID, cr.Spec.ForProvider)
 	if err != nil {
-		if cache.IsCacheRuleNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			return managed.ExternalObservation{
 				ResourceExists: false,
 			}, nil
@@ -200,7 +195,7 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	err := c.service.DeleteCacheRule(ctx, rulesetID, ruleID, cr.Spec.ForProvider)
-	if err != nil && !cache.IsCacheRuleNotFound(err) {
+	if err != nil && !cferrors.IsNotFound(err) {
 		return managed.ExternalDelete{}, errors.Wrap(err, "failed to delete cache rule from Cloudflare API")
 	}
 
@@ -17,14 +17,14 @@ limitations under the License.
 package cache
 
 import (
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 // Setup Cache controllers.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func Setup(mgr ctrl.Manager, o options.Options) error {
 	// Setup v1alpha1 controllers (cluster-scoped)
-	return SetupCacheRule(mgr, l, rl)
+	return SetupCacheRule(mgr, o)
 }
\ No newline at end of file
@@ -17,16 +17,27 @@ limitations under the License.
 package controller
 
 import (
-	"k8s.io/client-go/util/workqueue"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
+	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
 	"github.com/rossigee/provider-cloudflare/internal/controller/cache"
-	// "github.com/rossigee/provider-cloudflare/internal/controller/config" // Temporarily disabled
+	"github.com/rossigee/provider-cloudflare/internal/controller/config"
 	record "github.com/rossigee/provider-cloudflare/internal/controller/dns"
 	emailrouting "github.com/rossigee/provider-cloudflare/internal/controller/emailrouting"
+	firewall "github.com/rossigee/provider-cloudflare/internal/controller/firewall"
+	lists "github.com/rossigee/provider-cloudflare/internal/controller/lists"
 	loadbalancing "github.com/rossigee/provider-cloudflare/internal/controller/loadbalancing"
+	logpush "github.com/rossigee/provider-cloudflare/internal/controller/logpush"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 	originssl "github.com/rossigee/provider-cloudflare/internal/controller/originssl"
 	r2 "github.com/rossigee/provider-cloudflare/internal/controller/r2"
 	rulesets "github.com/rossigee/provider-cloudflare/internal/controller/rulesets"
@@ -39,55 +50,155 @@ import (
 	zone "github.com/rossigee/provider-cloudflare/internal/controller/zone"
 )
 
-// Setup creates all CloudFlare controllers with the supplied logger and adds them to
-// the supplied manager.
-func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
-	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.TypedRateLimiter[any]) error{
-		// config.Setup, // Temporarily disabled for v2 compatibility debugging
-		zone.Setup,
-		record.Setup,
-		application.Setup,
-		workers.Setup, // Workers client implementation now complete
-		ssl.Setup,
-		sslsaas.Setup,
-		transform.Setup,
-		rulesets.Setup,
-		security.Setup,
-		loadbalancing.Setup,
-		originssl.Setup,
-		cache.Setup,
-		r2.Setup,
-		emailrouting.Setup,
-	} {
-		if err := setup(mgr, l, wl); err != nil {
-			return err
+const (
+	errUnknownGroups = "unknown controller groups %s; groups are %s"
+	errDetectCRDs    = "cannot detect CRDs of controller group %s"
+)
+
+// A group of controllers. Each is named for the API group of the resources
+// it reconciles, such as dns for dns.cloudflare.m.crossplane.io.
+type group struct {
+	name  string
+	setup func(ctrl.Manager, options.Options) error
+}
+
+var groups = []group{
+	{name: "zone", setup: zone.Setup},
+	{name: "dns", setup: record.Setup},
+	{name: "spectrum", setup: application.Setup},
+	{name: "workers", setup: workers.Setup},
+	{name: "ssl", setup: ssl.Setup},
+	{name: "sslsaas", setup: sslsaas.Setup},
+	{name: "transform", setup: transform.Setup},
+	{name: "rulesets", setup: rulesets.Setup},
+	{name: "firewall", setup: firewall.Setup},
+	{name: "security", setup: security.Setup},
+	{name: "loadbalancing", setup: loadbalancing.Setup},
+	{name: "originssl", setup: originssl.Setup},
+	{name: "cache", setup: cache.Setup},
+	{name: "r2", setup: r2.Setup},
+	{name: "emailrouting", setup: emailrouting.Setup},
+	{name: "logpush", setup: logpush.Setup},
+	{name: "lists", setup: lists.Setup},
+}
+
+// Groups returns the names of the controller groups, in the order they are
+// set up.
+func Groups() []string {
+	names := make([]string, len(groups))
+	for i, g := range groups {
+		names[i] = g.name
+	}
+	return names
+}
+
+// A Selection of controller groups to set up.
+type Selection struct {
+	// Enable only these groups. All groups are enabled if it is empty.
+	Enable []string
+
+	// Disable these groups, even if they are enabled.
+	Disable []string
+
+	// DetectCRDs skips the groups that have a kind whose CRD is not
+	// installed, rather than failing to start their controllers.
+	DetectCRDs bool
+}
+
+// Groups returns the names of the selected groups, in the order they are
+// set up. It returns an error if a group is not known.
+func (s Selection) Groups() ([]string, error) {
+	all := Groups()
+	var unknown []string
+	for _, name := range slices.Concat(s.Enable, s.Disable) {
+		if !slices.Contains(all, name) {
+			unknown = append(unknown, name)
 		}
 	}
-	return nil
+	if len(unknown) > 0 {
+		return nil, errors.Errorf(errUnknownGroups, strings.Join(unknown, ", "), strings.Join(all, ", "))
+	}
+	return slices.DeleteFunc(all, func(name string) bool {
+		return (len(s.Enable) > 0 && !slices.Contains(s.Enable, name)) || slices.Contains(s.Disable, name)
+	}), nil
 }
 
-// SetupMinimal creates minimal controllers with only config, zone, and dns record support.
-func SetupMinimal(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
-	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.TypedRateLimiter[any]) error{
-		// config.Setup, // Temporarily disabled for v2 compatibility debugging
-		zone.Setup,
-		record.Setup,
-		application.Setup,
-		workers.Setup, // Workers client implementation now complete
-		ssl.Setup,
-		sslsaas.Setup,
-		transform.Setup,
-		rulesets.Setup,
-		security.Setup,
-		loadbalancing.Setup,
-		originssl.Setup,
-		cache.Setup,
-		r2.Setup,
-		emailrouting.Setup,
-	} {
-		if err := setup(mgr, l, wl); err != nil {
+// Setup creates the CloudFlare controllers of the selected groups with the
+// supplied options and adds them to the supplied manager. The controllers
+// of ProviderConfigs are always added.
+func Setup(mgr ctrl.Manager, o options.Options, s Selection) error {
+	names, err := s.Groups()
+	if err != nil {
+		return err
+	}
+	if err := config.Setup(mgr, o); err != nil {
+		return err
+	}
+	for _, g := range groups {
+		if !slices.Contains(names, g.name) {
+			continue
+		}
+		if s.DetectCRDs {
+			missing, err := missingKinds(mgr.GetScheme(), mgr.GetRESTMapper(), g.name+"."+v1beta1.Group)
+			if err != nil {
+				return errors.Wrapf(err, errDetectCRDs, g.name)
+			}
+			if len(missing) > 0 {
+				o.Logger.Info("Skipping controller group whose CRDs are not installed", "group", g.name, "missing", missing)
+				continue
+			}
+		}
+		if err := g.setup(mgr, o); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// Kinds returns the managed resource kinds of the supplied scheme, sorted
+// by kind and group.
+func Kinds(s *runtime.Scheme) []schema.GroupKind {
+	var kinds []schema.GroupKind
+	for gvk := range s.AllKnownTypes() {
+		obj, err := s.New(gvk)
+		if err != nil {
+			continue
+		}
+		if _, ok := obj.(resource.Managed); !ok || slices.Contains(kinds, gvk.GroupKind()) {
+			continue
+		}
+		kinds = append(kinds, gvk.GroupKind())
+	}
+	slices.SortFunc(kinds, func(a, b schema.GroupKind) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return kinds
+}
+
+// missingKinds returns the managed resource kinds of the supplied API group
+// that the REST mapper does not know, because their CRDs are not installed.
+func missingKinds(s *runtime.Scheme, m kmeta.RESTMapper, apiGroup string) ([]string, error) {
+	var missing []string
+	for gvk := range s.AllKnownTypes() {
+		if gvk.Group != apiGroup {
+			continue
+		}
+		obj, err := s.New(gvk)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := obj.(resource.Managed); !ok {
+			continue
+		}
+		_, err = m.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if kmeta.IsNoMatchError(err) {
+			missing = append(missing, gvk.Kind)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	slices.Sort(missing)
+	return missing, nil
+}
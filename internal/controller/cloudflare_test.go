@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis"
+	lbv1beta1 "github.com/rossigee/provider-cloudflare/apis/loadbalancing/v1beta1"
+	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
+)
+
+func TestSelectionGroups(t *testing.T) {
+	type want struct {
+		groups []string
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		s      Selection
+		want   want
+	}{
+		"All": {
+			reason: "All groups should be selected by default.",
+			s:      Selection{},
+			want:   want{groups: Groups()},
+		},
+		"Enable": {
+			reason: "Only enabled groups should be selected, in setup order.",
+			s:      Selection{Enable: []string{"dns", "zone"}},
+			want:   want{groups: []string{"zone", "dns"}},
+		},
+		"Disable": {
+			reason: "Disabled groups should not be selected.",
+			s:      Selection{Disable: []string{"workers", "r2", "spectrum"}},
+			want: want{groups: []string{
+				"zone", "dns", "ssl", "sslsaas", "transform", "rulesets", "firewall", "security",
+				"loadbalancing", "originssl", "cache", "emailrouting", "logpush", "lists",
+			}},
+		},
+		"EnableAndDisable": {
+			reason: "A group that is both enabled and disabled should not be selected.",
+			s:      Selection{Enable: []string{"dns", "zone"}, Disable: []string{"zone"}},
+			want:   want{groups: []string{"dns"}},
+		},
+		"Unknown": {
+			reason: "Unknown groups should be an error.",
+			s:      Selection{Enable: []string{"dns", "mx"}, Disable: []string{"tunnels"}},
+			want: want{err: errors.Errorf(errUnknownGroups, "mx, tunnels",
+				"zone, dns, spectrum, workers, ssl, sslsaas, transform, rulesets, firewall, security, loadbalancing, originssl, cache, r2, emailrouting, logpush, lists")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.s.Groups()
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGroups(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.groups, got); diff != "" {
+				t.Errorf("\n%s\nGroups(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestKinds(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string]int{}
+	for _, gk := range Kinds(s) {
+		got[gk.String()]++
+	}
+	pool := schema.GroupKind{Group: lbv1beta1.Group, Kind: lbv1beta1.LoadBalancerPoolKind}.String()
+	if diff := cmp.Diff(1, got[pool]); diff != "" {
+		t.Errorf("\nEach managed kind should be returned once.\n-want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(0, got[v1beta1.ProviderConfigGroupKind]); diff != "" {
+		t.Errorf("\nKinds that are not managed resources should not be returned.\n-want, +got:\n%s", diff)
+	}
+}
+
+func TestMissingKinds(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	mapper := func(kinds ...string) kmeta.RESTMapper {
+		m := kmeta.NewDefaultRESTMapper(nil)
+		for _, k := range kinds {
+			m.Add(lbv1beta1.GroupVersion.WithKind(k), kmeta.RESTScopeNamespace)
+		}
+		return m
+	}
+
+	type want struct {
+		missing []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mapper kmeta.RESTMapper
+		group  string
+		want   want
+	}{
+		"AllInstalled": {
+			reason: "No kinds should be missing when all CRDs of the group are installed.",
+			mapper: mapper(lbv1beta1.LoadBalancerKind, lbv1beta1.LoadBalancerMonitorKind, lbv1beta1.LoadBalancerPoolKind),
+			group:  lbv1beta1.Group,
+		},
+		"SomeInstalled": {
+			reason: "Kinds whose CRDs are not installed should be missing.",
+			mapper: mapper(lbv1beta1.LoadBalancerKind),
+			group:  lbv1beta1.Group,
+			want:   want{missing: []string{lbv1beta1.LoadBalancerMonitorKind, lbv1beta1.LoadBalancerPoolKind}},
+		},
+		"NoneInstalled": {
+			reason: "All managed kinds of the group should be missing when none of its CRDs are installed.",
+			mapper: mapper(),
+			group:  lbv1beta1.Group,
+			want:   want{missing: []string{lbv1beta1.LoadBalancerKind, lbv1beta1.LoadBalancerMonitorKind, lbv1beta1.LoadBalancerPoolKind}},
+		},
+		"UnknownGroup": {
+			reason: "A group with no kinds in the scheme should have no missing kinds.",
+			mapper: mapper(),
+			group:  "example.org",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := missingKinds(s, tc.mapper, tc.group)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nmissingKinds(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.missing, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nmissingKinds(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
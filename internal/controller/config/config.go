@@ -17,37 +17,359 @@ limitations under the License.
 package config
 
 import (
-	"k8s.io/client-go/util/workqueue"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/providerconfig"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
 )
 
-// Setup adds a controller that reconciles ProviderConfigs using v2 patterns.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	name := providerconfig.ControllerName(v1beta1.ProviderConfigGroupKind)
+const (
+	finalizer    = "in-use.crossplane.io"
+	shortWait    = 30 * time.Second
+	rejectedWait = time.Minute
+	timeout      = 2 * time.Minute
 
-	o := controller.Options{
-		RateLimiter: nil, // Use default rate limiter
-	}
+	errGetPC        = "cannot get ProviderConfig"
+	errListPCUs     = "cannot list ProviderConfigUsages"
+	errDeletePCU    = "cannot delete ProviderConfigUsage"
+	errUpdate       = "cannot update ProviderConfig"
+	errUpdateStatus = "cannot update ProviderConfig status"
+	errInUse        = "Blocking deletion while usages still exist"
+	errVerify       = "cannot verify credential"
 
-	of := resource.ProviderConfigKinds{
-		Config:    v1beta1.ProviderConfigGroupVersionKind,
-		UsageList: v1beta1.ProviderConfigUsageListGroupVersionKind,
+	reasonAccount event.Reason = "UsageAccounting"
+	reasonVerify  event.Reason = "VerifyCredentials"
+)
+
+// Setup adds controllers that reconcile ProviderConfigs and
+// ClusterProviderConfigs by accounting for the managed resources that use
+// them.
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	if err := setup(mgr, o, v1beta1.ProviderConfigGroupKind, v1beta1.ProviderConfigKind, &v1beta1.ProviderConfig{}); err != nil {
+		return err
 	}
+	return setup(mgr, o, v1beta1.ClusterProviderConfigGroupKind, v1beta1.ClusterProviderConfigKind, &v1beta1.ClusterProviderConfig{})
+}
+
+func setup(mgr ctrl.Manager, o options.Options, groupKind, kind string, of client.Object) error {
+	name := providerconfig.ControllerName(groupKind)
+
+	r := NewReconciler(mgr.GetClient(), kind,
+		WithVerifier(clients.NewVerifier(mgr.GetClient(), metrics.NewInstrumentedHTTPClient(name))),
+		WithLogger(o.Logger.WithValues("controller", name)),
+		WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
-		For(&v1beta1.ProviderConfig{}).
-		// Removed ProviderConfigUsage watch for v2 compatibility
-		Complete(providerconfig.NewReconciler(mgr, of,
-			providerconfig.WithLogger(l.WithValues("controller", name)),
-			providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+		WithOptions(o.ControllerOptions(schema.GroupKind{Group: v1beta1.Group, Kind: kind})).
+		For(of).
+		Watches(&v1beta1.ProviderConfigUsage{}, handler.EnqueueRequestsFromMapFunc(usageToProviderConfig(kind))).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(secretToProviderConfigs(mgr.GetClient(), kind))).
+		Complete(o.Reconciler(name, r))
+}
+
+// secretToProviderConfigs returns a function that maps a Secret to the
+// configs of the supplied kind whose credential or CA bundle it holds, so
+// that a fixed credential is verified again right away.
+func secretToProviderConfigs(c client.Reader, kind string) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		var reqs []reconcile.Request
+		add := func(pc client.Object, spec v1beta1.ProviderConfigSpec) {
+			if usesSecret(spec, o.GetNamespace(), o.GetName()) {
+				reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(pc)})
+			}
+		}
+
+		if kind == v1beta1.ClusterProviderConfigKind {
+			l := &v1beta1.ClusterProviderConfigList{}
+			if err := c.List(ctx, l); err != nil {
+				return nil
+			}
+			for i := range l.Items {
+				add(&l.Items[i], l.Items[i].Spec)
+			}
+			return reqs
+		}
+
+		l := &v1beta1.ProviderConfigList{}
+		if err := c.List(ctx, l); err != nil {
+			return nil
+		}
+		for i := range l.Items {
+			add(&l.Items[i], l.Items[i].Spec)
+		}
+		return reqs
+	}
+}
+
+// usesSecret returns true if the supplied config reads its credential or
+// CA bundle from the Secret of the supplied namespace and name.
+func usesSecret(spec v1beta1.ProviderConfigSpec, namespace, name string) bool {
+	if ref := spec.Credentials.SecretRef; spec.Credentials.Source == xpv1.CredentialsSourceSecret && ref != nil &&
+		ref.Namespace == namespace && ref.Name == name {
+		return true
+	}
+	if b := spec.CABundle; b != nil && b.SecretRef != nil && b.SecretRef.Namespace == namespace && b.SecretRef.Name == name {
+		return true
+	}
+	return false
+}
+
+// usageToProviderConfig returns a function that maps a ProviderConfigUsage
+// to the config of the supplied kind that it uses, if any. A ProviderConfig
+// is in the namespace of its usages, while a ClusterProviderConfig is not
+// namespaced.
+func usageToProviderConfig(kind string) handler.MapFunc {
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		pcu, ok := o.(*v1beta1.ProviderConfigUsage)
+		if !ok {
+			return nil
+		}
+		ref := pcu.GetProviderConfigReference()
+		if usedKind(ref) != kind {
+			return nil
+		}
+		nn := types.NamespacedName{Name: ref.Name}
+		if kind == v1beta1.ProviderConfigKind {
+			nn.Namespace = pcu.GetNamespace()
+		}
+		return []reconcile.Request{{NamespacedName: nn}}
+	}
+}
+
+// usedKind returns the kind of config a usage refers to. Usages made before
+// ClusterProviderConfigs existed have no kind, and refer to a
+// ProviderConfig.
+func usedKind(ref xpv1.ProviderConfigReference) string {
+	if ref.Kind == "" {
+		return v1beta1.ProviderConfigKind
+	}
+	return ref.Kind
+}
+
+// A Verifier verifies the credential of a ProviderConfig or
+// ClusterProviderConfig. It returns an error satisfying cferrors.IsAuth if
+// Cloudflare rejects the credential.
+type Verifier interface {
+	Verify(ctx context.Context, pc resource.ProviderConfig) (*v1beta1.CredentialStatus, error)
+}
+
+// A VerifierFn is a function that satisfies Verifier.
+type VerifierFn func(ctx context.Context, pc resource.ProviderConfig) (*v1beta1.CredentialStatus, error)
+
+// Verify the credential of the supplied config.
+func (fn VerifierFn) Verify(ctx context.Context, pc resource.ProviderConfig) (*v1beta1.CredentialStatus, error) {
+	return fn(ctx, pc)
+}
+
+// A ReconcilerOption configures a Reconciler.
+type ReconcilerOption func(*Reconciler)
+
+// WithLogger specifies how the Reconciler should log messages.
+func WithLogger(l logging.Logger) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.log = l
+	}
+}
+
+// WithVerifier specifies how the Reconciler should verify credentials.
+func WithVerifier(v Verifier) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.verifier = v
+	}
+}
+
+// WithRecorder specifies how the Reconciler should record events.
+func WithRecorder(er event.Recorder) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.record = er
+	}
+}
+
+// A Reconciler counts the users of a ProviderConfig or
+// ClusterProviderConfig, and blocks its deletion while it has any. Unlike
+// the crossplane-runtime ProviderConfig reconciler it only counts usages in
+// the namespace of a ProviderConfig, so that ProviderConfigs of the same
+// name in different namespaces are accounted for separately. Usages of a
+// ClusterProviderConfig are counted in every namespace.
+type Reconciler struct {
+	client   client.Client
+	kind     string
+	verifier Verifier
+	log      logging.Logger
+	record   event.Recorder
+}
+
+// NewReconciler returns a Reconciler of configs of the supplied kind, which
+// must be either ProviderConfig or ClusterProviderConfig.
+func NewReconciler(c client.Client, kind string, o ...ReconcilerOption) *Reconciler {
+	r := &Reconciler{
+		client:   c,
+		kind:     kind,
+		verifier: clients.NewVerifier(c, nil),
+		log:      logging.NewNopLogger(),
+		record:   event.NewNopRecorder(),
+	}
+	for _, ro := range o {
+		ro(r)
+	}
+	return r
+}
+
+func (r *Reconciler) newConfig() resource.ProviderConfig {
+	if r.kind == v1beta1.ClusterProviderConfigKind {
+		return &v1beta1.ClusterProviderConfig{}
+	}
+	return &v1beta1.ProviderConfig{}
+}
+
+// Reconcile a ProviderConfig or ClusterProviderConfig by accounting for the managed resources that
+// are using it, and ensuring it cannot be deleted until it is no longer in
+// use.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pc := r.newConfig()
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		log.Debug(errGetPC, "error", err)
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+
+	log = log.WithValues(
+		"uid", pc.GetUID(),
+		"version", pc.GetResourceVersion(),
+		"name", pc.GetName(),
+	)
+
+	// A ClusterProviderConfig has no namespace, so its usages are listed in
+	// all of them. Usages are not filtered by kind label, which those made
+	// before ClusterProviderConfigs existed do not have.
+	l := &v1beta1.ProviderConfigUsageList{}
+	if err := r.client.List(ctx, l,
+		client.InNamespace(pc.GetNamespace()),
+		client.MatchingLabels{xpv1.LabelKeyProviderName: pc.GetName()}); err != nil {
+		log.Debug(errListPCUs, "error", err)
+		r.record.Event(pc, event.Warning(reasonAccount, errors.Wrap(err, errListPCUs)))
+		return reconcile.Result{RequeueAfter: shortWait}, nil
+	}
+
+	users := int64(0)
+	for i := range l.Items {
+		pcu := &l.Items[i]
+		if usedKind(pcu.GetProviderConfigReference()) != r.kind {
+			continue
+		}
+		if metav1.GetControllerOf(pcu) != nil {
+			users++
+			continue
+		}
+		// Usages are always controlled by the resource that made them. One
+		// that is not was probably restored from a backup. It is either
+		// stale, or will be made again when its resource next connects.
+		if err := r.client.Delete(ctx, pcu); resource.IgnoreNotFound(err) != nil {
+			log.Debug(errDeletePCU, "error", err)
+			r.record.Event(pc, event.Warning(reasonAccount, errors.Wrap(err, errDeletePCU)))
+			return reconcile.Result{RequeueAfter: shortWait}, nil
+		}
+	}
+
+	log = log.WithValues("usages", users)
+
+	if meta.WasDeleted(pc) {
+		if users > 0 {
+			log.Debug(errInUse)
+			r.record.Event(pc, event.Warning(reasonAccount, errors.New(errInUse)))
+
+			// We watch usages, so will be requeued as they are deleted.
+			pc.SetUsers(users)
+			pc.SetConditions(providerconfig.Terminating().WithMessage(errInUse))
+			return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, pc), errUpdateStatus)
+		}
+
+		clients.DefaultPool.Forget(pc.GetUID())
+
+		meta.RemoveFinalizer(pc, finalizer)
+		if err := r.client.Update(ctx, pc); err != nil {
+			log.Debug(errUpdate, "error", err)
+			return reconcile.Result{RequeueAfter: shortWait}, nil
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if !meta.FinalizerExists(pc, finalizer) {
+		meta.AddFinalizer(pc, finalizer)
+		if err := r.client.Update(ctx, pc); err != nil {
+			log.Debug(errUpdate, "error", err)
+			return reconcile.Result{RequeueAfter: shortWait}, nil
+		}
+	}
+
+	pc.SetUsers(users)
+	result := r.verify(ctx, pc, log)
+	if err := r.client.Status().Update(ctx, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errUpdateStatus)
+	}
+	return result, nil
+}
+
+// verify the credential of the supplied config, and record the result in
+// its status. The credential is verified again once the result is stale,
+// so that expired or revoked tokens are noticed. A rejected credential is
+// checked again sooner; until its Secret changes that finds the result
+// cached with the credential, and costs no request.
+func (r *Reconciler) verify(ctx context.Context, pc resource.ProviderConfig, log logging.Logger) reconcile.Result {
+	cs, err := r.verifier.Verify(ctx, pc)
+	switch {
+	case err == nil:
+		setCredential(pc, cs)
+		pc.SetConditions(v1beta1.CredentialsVerified())
+	case cferrors.IsAuth(err):
+		log.Debug(errVerify, "error", err)
+		r.record.Event(pc, event.Warning(reasonVerify, err))
+		setCredential(pc, cs)
+		pc.SetConditions(v1beta1.CredentialsRejected(err))
+		return reconcile.Result{RequeueAfter: rejectedWait}
+	default:
+		// The credential may be fine, so what was last learned about it
+		// is kept until it can be verified again.
+		log.Debug(errVerify, "error", err)
+		r.record.Event(pc, event.Warning(reasonVerify, errors.Wrap(err, errVerify)))
+		pc.SetConditions(v1beta1.CredentialsUnverified(err))
+		return reconcile.Result{RequeueAfter: shortWait}
+	}
+	return reconcile.Result{RequeueAfter: clients.VerifyInterval}
+}
+
+func setCredential(pc resource.ProviderConfig, cs *v1beta1.CredentialStatus) {
+	switch o := pc.(type) {
+	case *v1beta1.ProviderConfig:
+		o.Status.Credential = cs.DeepCopy()
+	case *v1beta1.ClusterProviderConfig:
+		o.Status.Credential = cs.DeepCopy()
+	}
 }
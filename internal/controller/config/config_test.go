@@ -0,0 +1,485 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis"
+	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cftest"
+)
+
+func usage(kind string, controlled bool) v1beta1.ProviderConfigUsage {
+	u := v1beta1.ProviderConfigUsage{}
+	u.SetProviderConfigReference(xpv1.ProviderConfigReference{Kind: kind, Name: "default"})
+	if controlled {
+		u.SetOwnerReferences([]metav1.OwnerReference{{Controller: ptr.To(true), UID: "mr"}})
+	}
+	return u
+}
+
+func TestReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := metav1.Now()
+	pc := v1beta1.ProviderConfigKind
+	cpc := v1beta1.ClusterProviderConfigKind
+	active := &v1beta1.CredentialStatus{TokenID: "t", TokenStatus: "active", PermissionGroups: []string{"DNS Write"}}
+	expired := &v1beta1.CredentialStatus{TokenID: "t", TokenStatus: "expired"}
+	errRejected := cferrors.New(cferrors.Auth, "API token is expired")
+
+	type state struct {
+		users      int64
+		finalizers []string
+		terminated bool
+		verified   xpv1.ConditionReason
+		credential *v1beta1.CredentialStatus
+	}
+
+	type want struct {
+		result  reconcile.Result
+		err     error
+		state   *state
+		updates int
+	}
+
+	cases := map[string]struct {
+		reason    string
+		kind      string
+		new       bool
+		deleted   bool
+		usages    []v1beta1.ProviderConfigUsage
+		listErr   error
+		verified  *v1beta1.CredentialStatus
+		verifyErr error
+		want      want
+	}{
+		"New": {
+			reason:   "A ProviderConfig should get a finalizer.",
+			kind:     pc,
+			new:      true,
+			verified: active,
+			want: want{
+				result:  reconcile.Result{RequeueAfter: clients.VerifyInterval},
+				state:   &state{finalizers: []string{finalizer}, verified: v1beta1.ReasonVerified, credential: active},
+				updates: 1,
+			},
+		},
+		"InUse": {
+			reason:   "A ProviderConfig that has a finalizer should not be updated, and should report the number of its users.",
+			kind:     pc,
+			verified: active,
+			usages:   []v1beta1.ProviderConfigUsage{usage(pc, true), usage(pc, true)},
+			want: want{
+				result: reconcile.Result{RequeueAfter: clients.VerifyInterval},
+				state:  &state{users: 2, finalizers: []string{finalizer}, verified: v1beta1.ReasonVerified, credential: active},
+			},
+		},
+		"StaleUsage": {
+			reason:   "Usages without a controller should be deleted and not counted.",
+			kind:     pc,
+			verified: active,
+			usages:   []v1beta1.ProviderConfigUsage{usage(pc, true), usage(pc, false)},
+			want: want{
+				result: reconcile.Result{RequeueAfter: clients.VerifyInterval},
+				state:  &state{users: 1, finalizers: []string{finalizer}, verified: v1beta1.ReasonVerified, credential: active},
+			},
+		},
+		"DeletedInUse": {
+			reason:  "Deletion of a ProviderConfig should be blocked while it has users.",
+			kind:    pc,
+			deleted: true,
+			usages:  []v1beta1.ProviderConfigUsage{usage(pc, true)},
+			want: want{
+				state: &state{users: 1, finalizers: []string{finalizer}, terminated: true},
+			},
+		},
+		"DeletedUnused": {
+			reason:  "The finalizer of a deleted ProviderConfig should be removed once it has no users.",
+			kind:    pc,
+			deleted: true,
+			want: want{
+				state:   &state{finalizers: []string{}},
+				updates: 1,
+			},
+		},
+		"ListError": {
+			reason:  "A ProviderConfig should be requeued if its usages cannot be listed.",
+			kind:    pc,
+			listErr: errBoom,
+			want: want{
+				result: reconcile.Result{RequeueAfter: shortWait},
+			},
+		},
+		"LegacyUsage": {
+			reason:   "Usages without a kind should be counted as users of a ProviderConfig.",
+			kind:     pc,
+			verified: active,
+			usages:   []v1beta1.ProviderConfigUsage{usage("", true)},
+			want: want{
+				result: reconcile.Result{RequeueAfter: clients.VerifyInterval},
+				state:  &state{users: 1, finalizers: []string{finalizer}, verified: v1beta1.ReasonVerified, credential: active},
+			},
+		},
+		"OtherKind": {
+			reason:   "Usages of a ClusterProviderConfig of the same name should not be counted as users of a ProviderConfig.",
+			kind:     pc,
+			verified: active,
+			usages:   []v1beta1.ProviderConfigUsage{usage(pc, true), usage(cpc, true), usage(cpc, false)},
+			want: want{
+				result: reconcile.Result{RequeueAfter: clients.VerifyInterval},
+				state:  &state{users: 1, finalizers: []string{finalizer}, verified: v1beta1.ReasonVerified, credential: active},
+			},
+		},
+		"ClusterInUse": {
+			reason:   "A ClusterProviderConfig should report the number of its users in every namespace.",
+			kind:     cpc,
+			verified: active,
+			usages:   []v1beta1.ProviderConfigUsage{usage(cpc, true), usage(cpc, true), usage(pc, true)},
+			want: want{
+				result: reconcile.Result{RequeueAfter: clients.VerifyInterval},
+				state:  &state{users: 2, finalizers: []string{finalizer}, verified: v1beta1.ReasonVerified, credential: active},
+			},
+		},
+		"ClusterDeletedInUse": {
+			reason:  "Deletion of a ClusterProviderConfig should be blocked while it has users.",
+			kind:    cpc,
+			deleted: true,
+			usages:  []v1beta1.ProviderConfigUsage{usage(cpc, true)},
+			want: want{
+				state: &state{users: 1, finalizers: []string{finalizer}, terminated: true},
+			},
+		},
+		"Rejected": {
+			reason:    "A ProviderConfig whose credential Cloudflare rejects should say so, and be verified again soon.",
+			kind:      pc,
+			verified:  expired,
+			verifyErr: errRejected,
+			want: want{
+				result: reconcile.Result{RequeueAfter: rejectedWait},
+				state:  &state{finalizers: []string{finalizer}, verified: v1beta1.ReasonRejected, credential: expired},
+			},
+		},
+		"VerifyError": {
+			reason:    "A ProviderConfig whose credential cannot be verified should be verified again soon.",
+			kind:      cpc,
+			verifyErr: errBoom,
+			want: want{
+				result: reconcile.Result{RequeueAfter: shortWait},
+				state:  &state{finalizers: []string{finalizer}, verified: v1beta1.ReasonVerifyFailed},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// ProviderConfigs are namespaced, ClusterProviderConfigs are not.
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "default"}}
+			if tc.kind == pc {
+				req.Namespace = "team-a"
+			}
+
+			var got *state
+			updates := 0
+			record := func(obj client.Object) error {
+				pc := obj.(resource.ProviderConfig)
+				got = &state{
+					users:      pc.GetUsers(),
+					finalizers: pc.GetFinalizers(),
+					terminated: pc.GetCondition(providerconfig.TypeTerminating).Status == "True",
+					verified:   pc.GetCondition(v1beta1.TypeCredentialsVerified).Reason,
+				}
+				switch o := obj.(type) {
+				case *v1beta1.ProviderConfig:
+					got.credential = o.Status.Credential
+				case *v1beta1.ClusterProviderConfig:
+					got.credential = o.Status.Credential
+				}
+				return nil
+			}
+
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					pc := obj.(resource.ProviderConfig)
+					pc.SetNamespace(req.Namespace)
+					pc.SetName(req.Name)
+					if !tc.new {
+						pc.SetFinalizers([]string{finalizer})
+					}
+					if tc.deleted {
+						pc.SetDeletionTimestamp(&now)
+					}
+					return nil
+				}),
+				MockList: func(_ context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+					lo := &client.ListOptions{}
+					lo.ApplyOptions(opts)
+					if lo.Namespace != req.Namespace {
+						t.Errorf("List(...): want usages listed in namespace %q, got %q", req.Namespace, lo.Namespace)
+					}
+					obj.(*v1beta1.ProviderConfigUsageList).Items = tc.usages
+					return tc.listErr
+				},
+				MockDelete: test.NewMockDeleteFn(nil),
+				MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+					updates++
+					return record(obj)
+				}),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, record),
+			}
+
+			r := NewReconciler(c, tc.kind, WithVerifier(VerifierFn(func(_ context.Context, _ resource.ProviderConfig) (*v1beta1.CredentialStatus, error) {
+				return tc.verified, tc.verifyErr
+			})))
+			result, err := r.Reconcile(context.Background(), req)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want result, +got result:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.state, got, cmp.AllowUnexported(state{}), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want ProviderConfig, +got ProviderConfig:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.updates, updates); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want updates, +got updates:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUsageToProviderConfig(t *testing.T) {
+	pcu := func(kind string) *v1beta1.ProviderConfigUsage {
+		u := &v1beta1.ProviderConfigUsage{}
+		u.SetNamespace("team-a")
+		u.SetProviderConfigReference(xpv1.ProviderConfigReference{Kind: kind, Name: "default"})
+		return u
+	}
+
+	cases := map[string]struct {
+		reason string
+		kind   string
+		pcu    client.Object
+		want   []reconcile.Request
+	}{
+		"ProviderConfig": {
+			reason: "A usage of a ProviderConfig should map to the ProviderConfig in its namespace.",
+			kind:   v1beta1.ProviderConfigKind,
+			pcu:    pcu(v1beta1.ProviderConfigKind),
+			want:   []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "team-a", Name: "default"}}},
+		},
+		"LegacyProviderConfig": {
+			reason: "A usage without a kind should map to the ProviderConfig in its namespace.",
+			kind:   v1beta1.ProviderConfigKind,
+			pcu:    pcu(""),
+			want:   []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "team-a", Name: "default"}}},
+		},
+		"ClusterProviderConfig": {
+			reason: "A usage of a ClusterProviderConfig should map to the ClusterProviderConfig, which has no namespace.",
+			kind:   v1beta1.ClusterProviderConfigKind,
+			pcu:    pcu(v1beta1.ClusterProviderConfigKind),
+			want:   []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "default"}}},
+		},
+		"OtherKind": {
+			reason: "A usage of a ClusterProviderConfig should not map to a ProviderConfig.",
+			kind:   v1beta1.ProviderConfigKind,
+			pcu:    pcu(v1beta1.ClusterProviderConfigKind),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := usageToProviderConfig(tc.kind)(context.Background(), tc.pcu)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nusageToProviderConfig(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSecretToProviderConfigs(t *testing.T) {
+	secretRef := func(name string) *xpv1.SecretKeySelector {
+		return &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: "team-a", Name: name}, Key: "credentials"}
+	}
+	credentials := func(name string) v1beta1.ProviderConfigSpec {
+		return v1beta1.ProviderConfigSpec{Credentials: v1beta1.ProviderCredentials{
+			Source:                    xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef(name)},
+		}}
+	}
+	pcWith := func(name string, spec v1beta1.ProviderConfigSpec) *v1beta1.ProviderConfig {
+		pc := &v1beta1.ProviderConfig{Spec: spec}
+		pc.SetNamespace("team-a")
+		pc.SetName(name)
+		return pc
+	}
+	cpcWith := func(name string, spec v1beta1.ProviderConfigSpec) *v1beta1.ClusterProviderConfig {
+		cpc := &v1beta1.ClusterProviderConfig{Spec: spec}
+		cpc.SetName(name)
+		return cpc
+	}
+	caBundle := credentials("other")
+	caBundle.CABundle = &v1beta1.CABundleSource{SecretRef: secretRef("creds")}
+
+	secret := &corev1.Secret{}
+	secret.SetNamespace("team-a")
+	secret.SetName("creds")
+
+	cases := map[string]struct {
+		reason string
+		kind   string
+		objs   []client.Object
+		want   []reconcile.Request
+	}{
+		"Credentials": {
+			reason: "A Secret should map to the ProviderConfigs whose credential it holds.",
+			kind:   v1beta1.ProviderConfigKind,
+			objs:   []client.Object{pcWith("a", credentials("creds")), pcWith("b", credentials("other"))},
+			want:   []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "team-a", Name: "a"}}},
+		},
+		"CABundle": {
+			reason: "A Secret should map to the ProviderConfigs whose CA bundle it holds.",
+			kind:   v1beta1.ProviderConfigKind,
+			objs:   []client.Object{pcWith("a", caBundle)},
+			want:   []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "team-a", Name: "a"}}},
+		},
+		"ClusterProviderConfig": {
+			reason: "A Secret should map to the ClusterProviderConfigs whose credential it holds.",
+			kind:   v1beta1.ClusterProviderConfigKind,
+			objs:   []client.Object{cpcWith("shared", credentials("creds")), pcWith("a", credentials("creds"))},
+			want:   []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "shared"}}},
+		},
+		"Unused": {
+			reason: "A Secret no config uses should not map to any.",
+			kind:   v1beta1.ProviderConfigKind,
+			objs:   []client.Object{pcWith("a", credentials("other"))},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := apis.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(tc.objs...).Build()
+			got := secretToProviderConfigs(kube, tc.kind)(context.Background(), secret)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nsecretToProviderConfigs(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcileFixedSecret(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	api := cftest.NewServer()
+	defer api.Close()
+
+	token := func(tok string) map[string][]byte {
+		creds, _ := json.Marshal(map[string]string{"token": tok})
+		return map[string][]byte{"credentials": creds}
+	}
+	secret := &corev1.Secret{Data: token("revoked")}
+	secret.SetNamespace("team-a")
+	secret.SetName("creds")
+
+	baseURL := api.URL
+	pc := &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{
+		Credentials: v1beta1.ProviderCredentials{
+			Source: xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: "team-a", Name: "creds"},
+				Key:             "credentials",
+			}},
+		},
+		AccountID: ptr.To(cftest.AccountID),
+		BaseURL:   &baseURL,
+	}}
+	pc.SetNamespace("team-a")
+	pc.SetName("default")
+	pc.SetUID("fixed-secret")
+	defer clients.DefaultPool.Forget(pc.GetUID())
+
+	kube := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(secret, pc).
+		WithStatusSubresource(&v1beta1.ProviderConfig{}).
+		Build()
+	r := NewReconciler(kube, v1beta1.ProviderConfigKind)
+	req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(pc)}
+
+	result, err := r.Reconcile(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(reconcile.Result{RequeueAfter: rejectedWait}, result); diff != "" {
+		t.Errorf("\nA rejected credential should be verified again soon.\n-want, +got:\n%s", diff)
+	}
+	if err := kube.Get(context.Background(), req.NamespacedName, pc); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(v1beta1.ReasonRejected, pc.GetCondition(v1beta1.TypeCredentialsVerified).Reason); diff != "" {
+		t.Errorf("\nA revoked token should be rejected.\n-want, +got:\n%s", diff)
+	}
+
+	secret.Data = token(cftest.Token)
+	if err := kube.Update(context.Background(), secret); err != nil {
+		t.Fatal(err)
+	}
+	reqs := secretToProviderConfigs(kube, v1beta1.ProviderConfigKind)(context.Background(), secret)
+	if diff := cmp.Diff([]reconcile.Request{req}, reqs); diff != "" {
+		t.Fatalf("\nA change to the Secret should requeue the ProviderConfig.\n-want, +got:\n%s", diff)
+	}
+	if _, err := r.Reconcile(context.Background(), reqs[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := kube.Get(context.Background(), req.NamespacedName, pc); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(v1beta1.ReasonVerified, pc.GetCondition(v1beta1.TypeCredentialsVerified).Reason); diff != "" {
+		t.Errorf("\nA fixed token should be verified without waiting for the verification to go stale.\n-want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,363 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/controller/testutils"
+)
+
+func TestRecordEndToEnd(t *testing.T) {
+	e := testutils.NewEnv(t)
+	e.Setup(Setup)
+	zone := e.API.AddZone("example.org")
+
+	cr := &v1beta1.Record{
+		ObjectMeta: metav1.ObjectMeta{Name: "www"},
+		Spec: v1beta1.RecordSpec{ForProvider: v1beta1.RecordParameters{
+			Zone:    &zone,
+			Type:    testutils.StringPtr("A"),
+			Name:    "www.example.org",
+			Content: "192.0.2.1",
+			TTL:     testutils.Int64Ptr(300),
+			Comment: testutils.StringPtr("owned by dns-team"),
+			Tags:    []string{"team:dns"},
+			Settings: &v1beta1.RecordSettings{
+				IPv4Only: testutils.BoolPtr(true),
+			},
+		}},
+	}
+	e.Create(cr)
+	e.Sync(cr)
+
+	if diff := cmp.Diff(corev1.ConditionTrue, cr.GetCondition(xpv1.TypeReady).Status); diff != "" {
+		t.Errorf("\nA created record should be ready.\n-want, +got:\n%s\n%+v", diff, cr.Status.Conditions)
+	}
+	records := e.API.DNSRecords(zone)
+	if len(records) != 1 {
+		t.Fatalf("\nThe record should be created once.\nrecords: %+v", records)
+	}
+	if diff := cmp.Diff(records[0].ID, meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("\nThe external name should be the ID of the record.\n-want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("owned by dns-team", records[0].Comment); diff != "" {
+		t.Errorf("\nThe record should be created with its comment.\n-want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"team:dns"}, cr.Status.AtProvider.Tags); diff != "" {
+		t.Errorf("\nThe tags of the record should be observed.\n-want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(&v1beta1.RecordSettings{IPv4Only: testutils.BoolPtr(true)}, cr.Status.AtProvider.Settings); diff != "" {
+		t.Errorf("\nThe settings of the record should be observed.\n-want, +got:\n%s", diff)
+	}
+
+	cr.Spec.ForProvider.Content = "192.0.2.2"
+	cr.Spec.ForProvider.Tags = []string{"team:dns", "env:prod"}
+	cr.Spec.ForProvider.Settings = &v1beta1.RecordSettings{IPv4Only: testutils.BoolPtr(false), IPv6Only: testutils.BoolPtr(true)}
+	if err := e.Client.Update(t.Context(), cr); err != nil {
+		t.Fatal(err)
+	}
+	e.Sync(cr)
+	if diff := cmp.Diff("192.0.2.2", e.API.DNSRecords(zone)[0].Content); diff != "" {
+		t.Errorf("\nA changed record should be updated.\n-want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"team:dns", "env:prod"}, e.API.DNSRecords(zone)[0].Tags); diff != "" {
+		t.Errorf("\nChanged tags should be updated.\n-want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(&v1beta1.RecordSettings{IPv4Only: testutils.BoolPtr(false), IPv6Only: testutils.BoolPtr(true)}, cr.Status.AtProvider.Settings); diff != "" {
+		t.Errorf("\nChanged settings should be updated.\n-want, +got:\n%s", diff)
+	}
+
+	e.Delete(cr)
+	if diff := cmp.Diff(0, len(e.API.DNSRecords(zone))); diff != "" {
+		t.Errorf("\nA deleted record should be deleted.\n-want, +got:\n%s", diff)
+	}
+}
+
+func TestRecordEndToEndRejected(t *testing.T) {
+	e := testutils.NewEnv(t)
+	e.Setup(Setup)
+	zone := e.API.AddZone("example.org")
+
+	cr := &v1beta1.Record{
+		ObjectMeta: metav1.ObjectMeta{Name: "www"},
+		Spec: v1beta1.RecordSpec{ForProvider: v1beta1.RecordParameters{
+			Zone:    &zone,
+			Type:    testutils.StringPtr("A"),
+			Name:    "www.example.org",
+			Content: "2001:db8::1",
+			TTL:     testutils.Int64Ptr(1),
+		}},
+	}
+	e.Create(cr)
+	e.Sync(cr)
+
+	synced := cr.GetCondition(xpv1.TypeSynced)
+	if synced.Status != corev1.ConditionFalse || !strings.Contains(synced.Message, "Must be a valid IPv4 address") {
+		t.Errorf("\nAn error from Cloudflare should be reported by the Synced condition.\nSynced: %+v", synced)
+	}
+	if diff := cmp.Diff(0, len(e.API.DNSRecords(zone))); diff != "" {
+		t.Errorf("\nA rejected record should not be created.\n-want, +got:\n%s", diff)
+	}
+}
+
+func TestRecordEndToEndOrphan(t *testing.T) {
+	e := testutils.NewEnv(t)
+	e.Setup(Setup)
+	zone := e.API.AddZone("example.org")
+
+	cr := &v1beta1.Record{
+		ObjectMeta: metav1.ObjectMeta{Name: "www"},
+		Spec: v1beta1.RecordSpec{ForProvider: v1beta1.RecordParameters{
+			Zone:    &zone,
+			Type:    testutils.StringPtr("A"),
+			Name:    "www.example.org",
+			Content: "192.0.2.1",
+			TTL:     testutils.Int64Ptr(300),
+		}},
+	}
+	cr.SetManagementPolicies(xpv1.ManagementPolicies{
+		xpv1.ManagementActionObserve,
+		xpv1.ManagementActionCreate,
+		xpv1.ManagementActionUpdate,
+		xpv1.ManagementActionLateInitialize,
+	})
+	e.Create(cr)
+	e.Sync(cr)
+	if diff := cmp.Diff(1, len(e.API.DNSRecords(zone))); diff != "" {
+		t.Fatalf("\nThe record should be created.\n-want, +got:\n%s", diff)
+	}
+
+	e.Delete(cr)
+	if diff := cmp.Diff(1, len(e.API.DNSRecords(zone))); diff != "" {
+		t.Errorf("\nA record whose management policies do not allow deletion should be left in Cloudflare.\n-want, +got:\n%s", diff)
+	}
+}
+
+func TestRecordEndToEndDeletionPolicyOrphan(t *testing.T) {
+	e := testutils.NewEnv(t)
+	e.Setup(Setup)
+	zone := e.API.AddZone("example.org")
+
+	cr := &v1beta1.Record{
+		ObjectMeta: metav1.ObjectMeta{Name: "www"},
+		Spec: v1beta1.RecordSpec{
+			DeletionPolicy: xpv1.DeletionOrphan,
+			ForProvider: v1beta1.RecordParameters{
+				Zone:    &zone,
+				Type:    testutils.StringPtr("A"),
+				Name:    "www.example.org",
+				Content: "192.0.2.1",
+				TTL:     testutils.Int64Ptr(300),
+			},
+		},
+	}
+	e.Create(cr)
+	e.Sync(cr)
+	if diff := cmp.Diff(1, len(e.API.DNSRecords(zone))); diff != "" {
+		t.Fatalf("\nThe record should be created.\n-want, +got:\n%s", diff)
+	}
+
+	e.Delete(cr)
+	if diff := cmp.Diff(1, len(e.API.DNSRecords(zone))); diff != "" {
+		t.Errorf("\nA record with a deletionPolicy of Orphan should be left in Cloudflare.\n-want, +got:\n%s", diff)
+	}
+}
+
+func TestRecordEndToEndData(t *testing.T) {
+	e := testutils.NewEnv(t)
+	e.Setup(Setup)
+	zone := e.API.AddZone("example.org")
+
+	cr := &v1beta1.Record{
+		ObjectMeta: metav1.ObjectMeta{Name: "sip"},
+		Spec: v1beta1.RecordSpec{ForProvider: v1beta1.RecordParameters{
+			Zone: &zone,
+			Type: testutils.StringPtr("SRV"),
+			Name: "_sip._udp.example.org",
+			TTL:  testutils.Int64Ptr(300),
+			Data: &v1beta1.RecordData{SRV: &v1beta1.SRVRecordData{
+				Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.org",
+			}},
+		}},
+	}
+	e.Create(cr)
+	e.Sync(cr)
+
+	if diff := cmp.Diff(corev1.ConditionTrue, cr.GetCondition(xpv1.TypeReady).Status); diff != "" {
+		t.Errorf("\nA record created from data should be ready.\n-want, +got:\n%s\n%+v", diff, cr.Status.Conditions)
+	}
+	if diff := cmp.Diff(cr.Spec.ForProvider.Data, cr.Status.AtProvider.Data); diff != "" {
+		t.Errorf("\nThe data of the record should be observed.\n-want, +got:\n%s", diff)
+	}
+	created := e.API.DNSRecords(zone)[0].ModifiedOn
+
+	e.Sync(cr)
+	if diff := cmp.Diff(created, e.API.DNSRecords(zone)[0].ModifiedOn); diff != "" {
+		t.Errorf("\nA record whose content Cloudflare computes from its data should be up to date.\n-want, +got:\n%s", diff)
+	}
+
+	cr.Spec.ForProvider.Data.SRV.Port = 5061
+	if err := e.Client.Update(t.Context(), cr); err != nil {
+		t.Fatal(err)
+	}
+	e.Sync(cr)
+	if diff := cmp.Diff("5 5061 sip.example.org", e.API.DNSRecords(zone)[0].Content); diff != "" {
+		t.Errorf("\nChanged data should be updated.\n-want, +got:\n%s", diff)
+	}
+}
+
+func TestRecordSetEndToEnd(t *testing.T) {
+	e := testutils.NewEnv(t)
+	e.Setup(Setup)
+	zone := e.API.AddZone("example.org")
+
+	cr := &v1beta1.RecordSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "www"},
+		Spec: v1beta1.RecordSetSpec{ForProvider: v1beta1.RecordSetParameters{
+			Zone: &zone,
+			Type: testutils.StringPtr("A"),
+			Name: "www",
+			TTL:  testutils.Int64Ptr(300),
+			Values: []v1beta1.RecordSetValue{
+				{Content: "192.0.2.1"},
+				{Content: "192.0.2.2"},
+			},
+		}},
+	}
+	e.Create(cr)
+	e.Sync(cr)
+
+	if diff := cmp.Diff(corev1.ConditionTrue, cr.GetCondition(xpv1.TypeReady).Status); diff != "" {
+		t.Errorf("\nA created record set should be ready.\n-want, +got:\n%s\n%+v", diff, cr.Status.Conditions)
+	}
+	if diff := cmp.Diff("www.example.org", meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("\nThe external name should be the FQDN of the records.\n-want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"192.0.2.1", "192.0.2.2"}, recordContents(e.API.DNSRecords(zone))); diff != "" {
+		t.Errorf("\nA record should be created for each value.\n-want, +got:\n%s", diff)
+	}
+	ids := map[string]string{}
+	for _, r := range e.API.DNSRecords(zone) {
+		ids[r.Content] = r.ID
+	}
+	observed := map[string]string{}
+	for _, m := range cr.Status.AtProvider.Records {
+		observed[m.Content] = m.ID
+	}
+	if diff := cmp.Diff(ids, observed); diff != "" {
+		t.Errorf("\nThe ID of each record should be observed.\n-want, +got:\n%s", diff)
+	}
+
+	cr.Spec.ForProvider.Values = []v1beta1.RecordSetValue{
+		{Content: "192.0.2.2"},
+		{Content: "192.0.2.3"},
+		{Content: "192.0.2.4"},
+	}
+	if err := e.Client.Update(t.Context(), cr); err != nil {
+		t.Fatal(err)
+	}
+	e.Sync(cr)
+	records := e.API.DNSRecords(zone)
+	if diff := cmp.Diff([]string{"192.0.2.2", "192.0.2.3", "192.0.2.4"}, recordContents(records)); diff != "" {
+		t.Errorf("\nThe records should converge on changed values.\n-want, +got:\n%s", diff)
+	}
+	for _, r := range records {
+		if r.Content == "192.0.2.2" && r.ID != ids["192.0.2.2"] {
+			t.Errorf("\nA record whose value was kept should not be replaced.\nwant ID %s, got %s", ids["192.0.2.2"], r.ID)
+		}
+	}
+
+	e.Delete(cr)
+	if diff := cmp.Diff(0, len(e.API.DNSRecords(zone))); diff != "" {
+		t.Errorf("\nThe records of a deleted record set should be deleted.\n-want, +got:\n%s", diff)
+	}
+}
+
+// recordContents returns the sorted contents of the supplied records.
+func recordContents(rs []cloudflare.DNSRecord) []string {
+	out := make([]string, len(rs))
+	for i, r := range rs {
+		out[i] = r.Content
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestRecordSetEndToEndAdopt(t *testing.T) {
+	e := testutils.NewEnv(t)
+	e.Setup(Setup)
+	zone := e.API.AddZone("example.org")
+
+	// Leave a record in Cloudflare for the set to adopt.
+	existing := &v1beta1.Record{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing"},
+		Spec: v1beta1.RecordSpec{ForProvider: v1beta1.RecordParameters{
+			Zone:    &zone,
+			Type:    testutils.StringPtr("A"),
+			Name:    "www",
+			Content: "192.0.2.1",
+			TTL:     testutils.Int64Ptr(300),
+		}},
+	}
+	existing.SetManagementPolicies(xpv1.ManagementPolicies{
+		xpv1.ManagementActionObserve,
+		xpv1.ManagementActionCreate,
+	})
+	e.Create(existing)
+	e.Sync(existing)
+	e.Delete(existing)
+	records := e.API.DNSRecords(zone)
+	if len(records) != 1 {
+		t.Fatalf("\nThe existing record should be left in Cloudflare.\nrecords: %+v", records)
+	}
+
+	cr := &v1beta1.RecordSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "www"},
+		Spec: v1beta1.RecordSetSpec{ForProvider: v1beta1.RecordSetParameters{
+			Zone:   &zone,
+			Type:   testutils.StringPtr("A"),
+			Name:   "www",
+			TTL:    testutils.Int64Ptr(300),
+			Values: []v1beta1.RecordSetValue{{Content: "192.0.2.1"}},
+		}},
+	}
+	e.Create(cr)
+	e.Sync(cr)
+
+	if diff := cmp.Diff(corev1.ConditionTrue, cr.GetCondition(xpv1.TypeReady).Status); diff != "" {
+		t.Errorf("\nA record set that adopted its records should be ready.\n-want, +got:\n%s\n%+v", diff, cr.Status.Conditions)
+	}
+	if diff := cmp.Diff("www.example.org", meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("\nThe external name should be the FQDN of the adopted records.\n-want, +got:\n%s", diff)
+	}
+	adopted := e.API.DNSRecords(zone)
+	if len(adopted) != 1 || adopted[0].ID != records[0].ID {
+		t.Errorf("\nA record set with a relative name should adopt the existing record rather than create another.\nrecords: %+v", adopted)
+	}
+}
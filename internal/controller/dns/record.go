@@ -18,20 +18,13 @@ package record
 
 import (
 	"context"
-	"fmt"
-	"strconv"
-	"strings"
-	"time"
 
 	"github.com/pkg/errors"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
@@ -40,7 +33,9 @@ import (
 
 	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	records "github.com/rossigee/provider-cloudflare/internal/clients/records"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
 )
 
@@ -55,41 +50,36 @@ const (
 	errRecordDeletion = "cannot delete record"
 	errRecordNoZone   = "no zone found"
 
-	maxConcurrency = 5
-
 	// recordStatusActive = "active"
 )
 
-// Setup adds a controller that reconciles Record managed resources.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+// SetupRecord adds a controller that reconciles Record managed resources.
+func SetupRecord(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.RecordGroupKind)
 
-	o := controller.Options{
-		RateLimiter: nil, // Use default rate limiter
-		MaxConcurrentReconciles: maxConcurrency,
-	}
-
 	hc := metrics.NewInstrumentedHTTPClient(name)
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.RecordGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&connector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (records.Client, error) {
 				return records.NewClient(cfg, hc)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.RecordGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		// Do not initialize external-name field.
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.RecordGroupVersionKind.GroupKind())).
 		For(&v1beta1.Record{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -148,7 +138,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	if err != nil {
 		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(records.IsRecordNotFound, err), errRecordLookup)
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errRecordLookup)
 	}
 
 	cr.Status.AtProvider = records.GenerateObservation(record)
@@ -189,8 +179,9 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		}
 	}
 
-	// SRV records require priority, weight, and port fields
-	if *cr.Spec.ForProvider.Type == "SRV" {
+	// SRV records require priority, weight, and port fields, unless they
+	// set data.
+	if *cr.Spec.ForProvider.Type == "SRV" && cr.Spec.ForProvider.Data == nil {
 		if cr.Spec.ForProvider.Priority == nil || cr.Spec.ForProvider.Weight == nil || cr.Spec.ForProvider.Port == nil {
 			return managed.ExternalCreation{}, errors.New("SRV records require priority, weight, and port fields")
 		}
@@ -198,49 +189,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	cr.SetConditions(rtv1.Creating())
 
-	ttl := int(*cr.Spec.ForProvider.TTL)
-	var pri *uint16
-	if cr.Spec.ForProvider.Priority != nil {
-		val := uint16(*cr.Spec.ForProvider.Priority)
-		pri = &val
-	}
-
-	rc := cloudflare.ZoneIdentifier(*cr.Spec.ForProvider.Zone)
-	params := cloudflare.CreateDNSRecordParams{
-		Type:    *cr.Spec.ForProvider.Type,
-		Name:    cr.Spec.ForProvider.Name,
-		Content: cr.Spec.ForProvider.Content,
-		TTL:     ttl,
-		Proxied: cr.Spec.ForProvider.Proxied,
-	}
-	if pri != nil {
-		params.Priority = pri
-	}
-
-	// For SRV records, use the Data field instead of Priority/Content
-	if *cr.Spec.ForProvider.Type == "SRV" {
-		srvData := map[string]interface{}{
-			"priority": int(*cr.Spec.ForProvider.Priority),
-			"weight":   int(*cr.Spec.ForProvider.Weight),
-			"port":     int(*cr.Spec.ForProvider.Port),
-			"target":   cr.Spec.ForProvider.Content,
-		}
-		params.Data = srvData
-		params.Priority = nil
-		params.Content = ""
-	}
-
-	// For TLSA records, parse content and use Data field
-	if *cr.Spec.ForProvider.Type == "TLSA" {
-		tlsaData, err := parseTLSAContent(cr.Spec.ForProvider.Content)
-		if err != nil {
-			return managed.ExternalCreation{}, errors.Wrap(err, errRecordCreation)
-		}
-		params.Data = tlsaData
-		params.Content = ""
-	}
-	
-	res, err := e.client.CreateDNSRecord(ctx, rc, params)
+	res, err := records.CreateRecord(ctx, e.client, *cr.Spec.ForProvider.Zone, &cr.Spec.ForProvider)
 
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errRecordCreation)
@@ -304,40 +253,3 @@ func (e *external) Disconnect(ctx context.Context) error {
 	// No persistent connections to clean up
 	return nil
 }
-
-// parseTLSAContent parses a TLSA content string into CloudFlare API format.
-// Input format: "usage selector matching_type certificate"
-// Example: "3 1 1 0b9fa5a59eed715c26c1020c711b4f6ec42d58b0015e14337a39dad301c5afc3"
-func parseTLSAContent(content string) (map[string]interface{}, error) {
-	parts := strings.Fields(content)
-	if len(parts) != 4 {
-		return nil, fmt.Errorf("TLSA content must have 4 space-separated fields (usage selector matching_type certificate), got %d", len(parts))
-	}
-
-	usage, err := strconv.Atoi(parts[0])
-	if err != nil || usage < 0 || usage > 3 {
-		return nil, fmt.Errorf("TLSA usage must be 0-3, got: %s", parts[0])
-	}
-
-	selector, err := strconv.Atoi(parts[1])
-	if err != nil || selector < 0 || selector > 1 {
-		return nil, fmt.Errorf("TLSA selector must be 0-1, got: %s", parts[1])
-	}
-
-	matchingType, err := strconv.Atoi(parts[2])
-	if err != nil || matchingType < 0 || matchingType > 2 {
-		return nil, fmt.Errorf("TLSA matching_type must be 0-2, got: %s", parts[2])
-	}
-
-	certificate := parts[3]
-	if len(certificate) == 0 {
-		return nil, fmt.Errorf("TLSA certificate cannot be empty")
-	}
-
-	return map[string]interface{}{
-		"usage":         usage,
-		"selector":      selector,
-		"matching_type": matchingType,
-		"certificate":   certificate,
-	}, nil
-}
@@ -35,6 +35,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
@@ -114,7 +115,7 @@ func TestConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.Record{
 					Spec: v1beta1.RecordSpec{
-						ResourceSpec: xpv1.ResourceSpec{},
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{},
 					},
 				},
 			},
@@ -144,8 +145,9 @@ func TestConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.Record{
 					Spec: v1beta1.RecordSpec{
-						ResourceSpec: xpv1.ResourceSpec{
-							ProviderConfigReference: &xpv1.Reference{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{
+							ProviderConfigReference: &xpv1.ProviderConfigReference{
+								Kind: "ProviderConfig",
 								Name: "blah",
 							},
 						},
@@ -218,8 +220,8 @@ func TestObserve(t *testing.T) {
 			reason: "We should return an empty observation and an error if the API returned an error",
 			fields: fields{
 				client: &fake.MockClient{
-					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
-						return cloudflare.DNSRecord{}, errBoom
+					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (records.Record, error) {
+						return records.Record{}, errBoom
 					},
 				},
 			},
@@ -238,8 +240,8 @@ func TestObserve(t *testing.T) {
 			reason: "We should return an error if the record does not have a zone",
 			fields: fields{
 				client: &fake.MockClient{
-					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
-						return cloudflare.DNSRecord{}, errBoom
+					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (records.Record, error) {
+						return records.Record{}, errBoom
 					},
 				},
 			},
@@ -257,10 +259,10 @@ func TestObserve(t *testing.T) {
 			reason: "We should return ResourceExists: true and no error when a record is found",
 			fields: fields{
 				client: &fake.MockClient{
-					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
-						return cloudflare.DNSRecord{
+					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (records.Record, error) {
+						return records.Record{DNSRecord: cloudflare.DNSRecord{
 							ID: recordID,
-						}, nil
+						}}, nil
 					},
 				},
 			},
@@ -327,8 +329,8 @@ func TestCreate(t *testing.T) {
 			reason: "We should return any errors during the create process",
 			fields: fields{
 				client: &fake.MockClient{
-					MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
-						return cloudflare.DNSRecord{}, errBoom
+					MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params records.CreateParams) (records.Record, error) {
+						return records.Record{}, errBoom
 					},
 				},
 			},
@@ -349,13 +351,13 @@ func TestCreate(t *testing.T) {
 			reason: "We should return an error if 'Priority' is unset for MX records",
 			fields: fields{
 				client: &fake.MockClient{
-					MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
-						return cloudflare.DNSRecord{
+					MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params records.CreateParams) (records.Record, error) {
+						return records.Record{DNSRecord: cloudflare.DNSRecord{
 							Type:    params.Type,
 							Name:    params.Name,
 							Content: params.Content,
 							TTL:     params.TTL,
-						}, nil
+						}}, nil
 					},
 				},
 			},
@@ -375,13 +377,13 @@ func TestCreate(t *testing.T) {
 			reason: "We should return an error if 'Priority' is unset for SRV records",
 			fields: fields{
 				client: &fake.MockClient{
-					MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
-						return cloudflare.DNSRecord{
+					MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params records.CreateParams) (records.Record, error) {
+						return records.Record{DNSRecord: cloudflare.DNSRecord{
 							Type:    params.Type,
 							Name:    params.Name,
 							Content: params.Content,
 							TTL:     params.TTL,
-						}, nil
+						}}, nil
 					},
 				},
 			},
@@ -401,13 +403,13 @@ func TestCreate(t *testing.T) {
 			reason: "We should return an error if 'Priority' is unset for URI records",
 			fields: fields{
 				client: &fake.MockClient{
-					MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
-						return cloudflare.DNSRecord{
+					MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params records.CreateParams) (records.Record, error) {
+						return records.Record{DNSRecord: cloudflare.DNSRecord{
 							Type:    params.Type,
 							Name:    params.Name,
 							Content: params.Content,
 							TTL:     params.TTL,
-						}, nil
+						}}, nil
 					},
 				},
 			},
@@ -427,13 +429,13 @@ func TestCreate(t *testing.T) {
 			reason: "We should return ExternalNameAssigned: true and no error when a record is created",
 			fields: fields{
 				client: &fake.MockClient{
-					MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.CreateDNSRecordParams) (cloudflare.DNSRecord, error) {
-						return cloudflare.DNSRecord{
+					MockCreateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params records.CreateParams) (records.Record, error) {
+						return records.Record{DNSRecord: cloudflare.DNSRecord{
 							Type:    params.Type,
 							Name:    params.Name,
 							Content: params.Content,
 							TTL:     params.TTL,
-						}, nil
+						}}, nil
 					},
 				},
 			},
@@ -501,8 +503,8 @@ func TestUpdate(t *testing.T) {
 			reason: "We should return an error when no external name is set",
 			fields: fields{
 				client: &fake.MockClient{
-					MockUpdateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
-						return cloudflare.DNSRecord{}, nil
+					MockUpdateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params records.UpdateParams) (records.Record, error) {
+						return records.Record{}, nil
 					},
 				},
 			},
@@ -522,8 +524,8 @@ func TestUpdate(t *testing.T) {
 			reason: "We should return any errors during the update process",
 			fields: fields{
 				client: &fake.MockClient{
-					MockUpdateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
-						return cloudflare.DNSRecord{}, errBoom
+					MockUpdateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params records.UpdateParams) (records.Record, error) {
+						return records.Record{}, errBoom
 					},
 				},
 			},
@@ -544,13 +546,13 @@ func TestUpdate(t *testing.T) {
 			reason: "We should return no error when a zone is updated",
 			fields: fields{
 				client: &fake.MockClient{
-					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (cloudflare.DNSRecord, error) {
-						return cloudflare.DNSRecord{
+					MockGetDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, recordID string) (records.Record, error) {
+						return records.Record{DNSRecord: cloudflare.DNSRecord{
 							ID: rc.Identifier,
-						}, nil
+						}}, nil
 					},
-					MockUpdateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.UpdateDNSRecordParams) (cloudflare.DNSRecord, error) {
-						return cloudflare.DNSRecord{}, nil
+					MockUpdateDNSRecord: func(ctx context.Context, rc *cloudflare.ResourceContainer, params records.UpdateParams) (records.Record, error) {
+						return records.Record{}, nil
 					},
 				},
 			},
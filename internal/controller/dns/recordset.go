@@ -0,0 +1,243 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/cloudflare/cloudflare-go"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	records "github.com/rossigee/provider-cloudflare/internal/clients/records"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+const (
+	errNotRecordSet = "managed resource is not a RecordSet custom resource"
+
+	errRecordSetZone     = "cannot get zone of record set"
+	errRecordSetLookup   = "cannot lookup record set"
+	errRecordSetCreation = "cannot create record set"
+	errRecordSetUpdate   = "cannot update record set"
+	errRecordSetDeletion = "cannot delete record set"
+)
+
+// SetupRecordSet adds a controller that reconciles RecordSet managed
+// resources.
+func SetupRecordSet(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1beta1.RecordSetGroupKind)
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	r := managed.NewReconciler(options.ReportErrors(mgr),
+		resource.ManagedKind(v1beta1.RecordSetGroupVersionKind),
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&recordSetConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (records.Client, error) {
+				return records.NewClient(cfg, hc)
+			},
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.RecordSetGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
+		// The external name is set to the FQDN of the set once it is
+		// created.
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ControllerOptions(v1beta1.RecordSetGroupVersionKind.GroupKind())).
+		For(&v1beta1.RecordSet{}).
+		Complete(o.Reconciler(name, r))
+}
+
+// A recordSetConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type recordSetConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (records.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *recordSetConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, ok := mg.(*v1beta1.RecordSet)
+	if !ok {
+		return nil, errors.New(errNotRecordSet)
+	}
+
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordSetExternal{client: client}, nil
+}
+
+// A recordSetExternal observes, then either creates, updates, or deletes
+// the DNS Records of a set to ensure they reflect the managed resource's
+// desired state.
+type recordSetExternal struct {
+	client records.Client
+}
+
+// list returns the DNS Records of a set. They are listed by the FQDN of
+// the set, since Cloudflare lists records by their fully qualified names.
+// Until the FQDN is known from the external name, the name of the set is
+// qualified with the name of its zone, so that existing records are
+// adopted.
+func (e *recordSetExternal) list(ctx context.Context, cr *v1beta1.RecordSet) ([]cloudflare.DNSRecord, error) {
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		z, err := e.client.ZoneDetails(ctx, *cr.Spec.ForProvider.Zone)
+		if err != nil {
+			return nil, errors.Wrap(err, errRecordSetZone)
+		}
+		name = records.FQDN(cr.Spec.ForProvider.Name, z.Name)
+	}
+	return records.ListRecordSet(ctx, e.client, *cr.Spec.ForProvider.Zone, name, ptr.Deref(cr.Spec.ForProvider.Type, "A"))
+}
+
+func (e *recordSetExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.RecordSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRecordSet)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalObservation{}, errors.New(errRecordNoZone)
+	}
+
+	rs, err := e.list(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRecordSetLookup)
+	}
+	if len(rs) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = records.GenerateRecordSetObservation(rs)
+
+	cr.SetConditions(rtv1.Available())
+
+	// Adopt existing records by their FQDN.
+	li := false
+	if meta.GetExternalName(cr) == "" {
+		meta.SetExternalName(cr, rs[0].Name)
+		li = true
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        records.PlanRecordSet(&cr.Spec.ForProvider, rs).Empty(),
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (e *recordSetExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.RecordSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRecordSet)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalCreation{},
+			errors.Wrap(errors.New(errRecordNoZone), errRecordSetCreation)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	created, err := records.ApplyRecordSetPlan(ctx, e.client, *cr.Spec.ForProvider.Zone, records.PlanRecordSet(&cr.Spec.ForProvider, nil))
+
+	// Update the external name with the FQDN of the new DNS Records, so
+	// that they are found even if the name of the set is relative to its
+	// zone.
+	if len(created) > 0 {
+		meta.SetExternalName(cr, created[0].Name)
+		cr.Status.AtProvider = records.GenerateRecordSetObservation(created)
+	}
+
+	return managed.ExternalCreation{}, errors.Wrap(err, errRecordSetCreation)
+}
+
+func (e *recordSetExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.RecordSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRecordSet)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errRecordNoZone), errRecordSetUpdate)
+	}
+
+	rs, err := e.list(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRecordSetUpdate)
+	}
+
+	_, err = records.ApplyRecordSetPlan(ctx, e.client, *cr.Spec.ForProvider.Zone, records.PlanRecordSet(&cr.Spec.ForProvider, rs))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errRecordSetUpdate)
+}
+
+func (e *recordSetExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.RecordSet)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotRecordSet)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalDelete{}, errors.Wrap(errors.New(errRecordNoZone), errRecordSetDeletion)
+	}
+
+	rs, err := e.list(ctx, cr)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errRecordSetDeletion)
+	}
+
+	rc := cloudflare.ZoneIdentifier(*cr.Spec.ForProvider.Zone)
+	for _, r := range rs {
+		if err := e.client.DeleteDNSRecord(ctx, rc, r.ID); err != nil {
+			return managed.ExternalDelete{}, errors.Wrap(err, errRecordSetDeletion)
+		}
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *recordSetExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -0,0 +1,429 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package record
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	records "github.com/rossigee/provider-cloudflare/internal/clients/records"
+	"github.com/rossigee/provider-cloudflare/internal/clients/records/fake"
+)
+
+type recordSetModifier func(*v1beta1.RecordSet)
+
+func withRecordSetZone(zoneID string) recordSetModifier {
+	return func(r *v1beta1.RecordSet) { r.Spec.ForProvider.Zone = &zoneID }
+}
+
+func withRecordSetExternalName(name string) recordSetModifier {
+	return func(r *v1beta1.RecordSet) { meta.SetExternalName(r, name) }
+}
+
+func recordSet(m ...recordSetModifier) *v1beta1.RecordSet {
+	cr := &v1beta1.RecordSet{}
+	cr.Spec.ForProvider.Type = ptr.To("A")
+	cr.Spec.ForProvider.Name = "www"
+	cr.Spec.ForProvider.TTL = ptr.To[int64](300)
+	cr.Spec.ForProvider.Values = []v1beta1.RecordSetValue{{Content: "192.0.2.1"}, {Content: "192.0.2.2"}}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// listRecords returns a ListDNSRecords mock that returns the supplied
+// records if it is asked for those named name.
+func listRecords(name string, rs ...cloudflare.DNSRecord) func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+	return func(_ context.Context, _ *cloudflare.ResourceContainer, params cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+		if params.Name != name || params.Type != "A" {
+			return nil, &cloudflare.ResultInfo{}, nil
+		}
+		return rs, &cloudflare.ResultInfo{}, nil
+	}
+}
+
+func aRecord(id, content string) cloudflare.DNSRecord {
+	return cloudflare.DNSRecord{ID: id, Type: "A", Name: "www.example.com", Content: content, TTL: 300}
+}
+
+func TestRecordSetObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		o   managed.ExternalObservation
+		obs v1beta1.RecordSetObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client records.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"ErrNotRecordSet": {
+			reason: "An error should be returned if the managed resource is not a *RecordSet",
+			mg:     nil,
+			want: want{
+				err: errors.New(errNotRecordSet),
+			},
+		},
+		"ErrRecordNoZone": {
+			reason: "An error should be returned if the record set does not have a zone",
+			mg:     recordSet(),
+			want: want{
+				err: errors.New(errRecordNoZone),
+			},
+		},
+		"ErrRecordSetLookup": {
+			reason: "An error should be returned if the records cannot be listed",
+			client: &fake.MockClient{
+				MockListDNSRecords: func(_ context.Context, _ *cloudflare.ResourceContainer, _ cloudflare.ListDNSRecordsParams) ([]cloudflare.DNSRecord, *cloudflare.ResultInfo, error) {
+					return nil, nil, errBoom
+				},
+			},
+			mg: recordSet(withRecordSetZone("zone")),
+			want: want{
+				err: errors.Wrap(errBoom, errRecordSetLookup),
+			},
+		},
+		"NotFound": {
+			reason: "The record set should not exist if there are no records with its name and type",
+			client: &fake.MockClient{},
+			mg:     recordSet(withRecordSetZone("zone")),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ErrRecordSetZone": {
+			reason: "An error should be returned if the zone of a record set without an external name cannot be got",
+			client: &fake.MockClient{
+				MockZoneDetails: func(_ context.Context, _ string) (cloudflare.Zone, error) {
+					return cloudflare.Zone{}, errBoom
+				},
+			},
+			mg: recordSet(withRecordSetZone("zone")),
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, errRecordSetZone), errRecordSetLookup),
+			},
+		},
+		"Adopted": {
+			reason: "The records of a set without an external name should be listed by its name qualified with its zone, and adopted",
+			client: &fake.MockClient{
+				MockZoneDetails: func(_ context.Context, _ string) (cloudflare.Zone, error) {
+					return cloudflare.Zone{Name: "example.com"}, nil
+				},
+				MockListDNSRecords: listRecords("www.example.com", aRecord("a", "192.0.2.1"), aRecord("b", "192.0.2.2")),
+			},
+			mg: recordSet(withRecordSetZone("zone")),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				obs: v1beta1.RecordSetObservation{
+					FQDN: "www.example.com",
+					Records: []v1beta1.RecordSetMemberObservation{
+						{ID: "a", Content: "192.0.2.1"},
+						{ID: "b", Content: "192.0.2.2"},
+					},
+				},
+			},
+		},
+		"UpToDate": {
+			reason: "The records should be listed by the FQDN in the external name, and be up to date if they match the values",
+			client: &fake.MockClient{
+				MockListDNSRecords: listRecords("www.example.com", aRecord("a", "192.0.2.1"), aRecord("b", "192.0.2.2")),
+			},
+			mg: recordSet(withRecordSetZone("zone"), withRecordSetExternalName("www.example.com")),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: v1beta1.RecordSetObservation{
+					FQDN: "www.example.com",
+					Records: []v1beta1.RecordSetMemberObservation{
+						{ID: "a", Content: "192.0.2.1"},
+						{ID: "b", Content: "192.0.2.2"},
+					},
+				},
+			},
+		},
+		"NotUpToDate": {
+			reason: "The record set should not be up to date if a value has no record",
+			client: &fake.MockClient{
+				MockListDNSRecords: listRecords("www.example.com", aRecord("a", "192.0.2.1")),
+			},
+			mg: recordSet(withRecordSetZone("zone"), withRecordSetExternalName("www.example.com")),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: v1beta1.RecordSetObservation{
+					FQDN:    "www.example.com",
+					Records: []v1beta1.RecordSetMemberObservation{{ID: "a", Content: "192.0.2.1"}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := recordSetExternal{client: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1beta1.RecordSet); ok {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want atProvider, +got atProvider:\n%s\n", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRecordSetCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client records.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"ErrNotRecordSet": {
+			reason: "An error should be returned if the managed resource is not a *RecordSet",
+			mg:     nil,
+			want: want{
+				err: errors.New(errNotRecordSet),
+			},
+		},
+		"ErrRecordNoZone": {
+			reason: "An error should be returned if the record set does not have a zone",
+			mg:     recordSet(),
+			want: want{
+				err: errors.Wrap(errors.New(errRecordNoZone), errRecordSetCreation),
+			},
+		},
+		"ErrRecordSetCreation": {
+			reason: "An error should be returned if a record cannot be created",
+			client: &fake.MockClient{
+				MockCreateDNSRecord: func(_ context.Context, _ *cloudflare.ResourceContainer, _ records.CreateParams) (records.Record, error) {
+					return records.Record{}, errBoom
+				},
+			},
+			mg: recordSet(withRecordSetZone("zone")),
+			want: want{
+				err: errors.Wrap(errBoom, errRecordSetCreation),
+			},
+		},
+		"Success": {
+			reason: "A record should be created for each value, and the external name set to their FQDN",
+			client: &fake.MockClient{
+				MockCreateDNSRecord: func(_ context.Context, _ *cloudflare.ResourceContainer, params records.CreateParams) (records.Record, error) {
+					return records.Record{DNSRecord: aRecord(params.Content, params.Content)}, nil
+				},
+			},
+			mg: recordSet(withRecordSetZone("zone")),
+			want: want{
+				externalName: "www.example.com",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := recordSetExternal{client: tc.client}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1beta1.RecordSet); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\ne.Create(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRecordSetUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		list   []cloudflare.DNSRecord
+		failed bool
+		mg     resource.Managed
+		want   want
+	}{
+		"ErrNotRecordSet": {
+			reason: "An error should be returned if the managed resource is not a *RecordSet",
+			mg:     nil,
+			want: want{
+				err: errors.New(errNotRecordSet),
+			},
+		},
+		"ErrRecordNoZone": {
+			reason: "An error should be returned if the record set does not have a zone",
+			mg:     recordSet(),
+			want: want{
+				err: errors.Wrap(errors.New(errRecordNoZone), errRecordSetUpdate),
+			},
+		},
+		"ErrRecordSetUpdate": {
+			reason: "An error should be returned if a record cannot be changed",
+			list:   []cloudflare.DNSRecord{aRecord("a", "192.0.2.1"), aRecord("c", "192.0.2.3")},
+			failed: true,
+			mg:     recordSet(withRecordSetZone("zone"), withRecordSetExternalName("www.example.com")),
+			want: want{
+				calls: []string{"update c"},
+				err:   errors.Wrap(errBoom, errRecordSetUpdate),
+			},
+		},
+		"Success": {
+			reason: "The records should be converged on the values of the set",
+			list:   []cloudflare.DNSRecord{aRecord("a", "192.0.2.1"), aRecord("c", "192.0.2.3"), aRecord("d", "192.0.2.4")},
+			mg:     recordSet(withRecordSetZone("zone"), withRecordSetExternalName("www.example.com")),
+			want: want{
+				calls: []string{"update c", "delete d"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			client := &fake.MockClient{
+				MockListDNSRecords: listRecords("www.example.com", tc.list...),
+				MockUpdateDNSRecord: func(_ context.Context, _ *cloudflare.ResourceContainer, params records.UpdateParams) (records.Record, error) {
+					calls = append(calls, "update "+params.ID)
+					if tc.failed {
+						return records.Record{}, errBoom
+					}
+					return records.Record{}, nil
+				},
+				MockCreateDNSRecord: func(_ context.Context, _ *cloudflare.ResourceContainer, params records.CreateParams) (records.Record, error) {
+					calls = append(calls, "create "+params.Content)
+					return records.Record{}, nil
+				},
+				MockDeleteDNSRecord: func(_ context.Context, _ *cloudflare.ResourceContainer, id string) error {
+					calls = append(calls, "delete "+id)
+					return nil
+				},
+			}
+			e := recordSetExternal{client: client}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want calls, +got calls:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRecordSetDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		failed bool
+		mg     resource.Managed
+		want   want
+	}{
+		"ErrNotRecordSet": {
+			reason: "An error should be returned if the managed resource is not a *RecordSet",
+			mg:     nil,
+			want: want{
+				err: errors.New(errNotRecordSet),
+			},
+		},
+		"ErrRecordNoZone": {
+			reason: "An error should be returned if the record set does not have a zone",
+			mg:     recordSet(),
+			want: want{
+				err: errors.Wrap(errors.New(errRecordNoZone), errRecordSetDeletion),
+			},
+		},
+		"ErrRecordSetDeletion": {
+			reason: "An error should be returned if a record cannot be deleted",
+			failed: true,
+			mg:     recordSet(withRecordSetZone("zone"), withRecordSetExternalName("www.example.com")),
+			want: want{
+				deleted: []string{"a"},
+				err:     errors.Wrap(errBoom, errRecordSetDeletion),
+			},
+		},
+		"Success": {
+			reason: "Every record of the set should be deleted",
+			mg:     recordSet(withRecordSetZone("zone"), withRecordSetExternalName("www.example.com")),
+			want: want{
+				deleted: []string{"a", "b"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted []string
+			client := &fake.MockClient{
+				MockListDNSRecords: listRecords("www.example.com", aRecord("a", "192.0.2.1"), aRecord("b", "192.0.2.2")),
+				MockDeleteDNSRecord: func(_ context.Context, _ *cloudflare.ResourceContainer, id string) error {
+					deleted = append(deleted, id)
+					if tc.failed {
+						return errBoom
+					}
+					return nil
+				},
+			}
+			e := recordSetExternal{client: client}
+			_, err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -22,19 +22,19 @@ import (
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/emailrouting/v1beta1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	emailroutingruleclient "github.com/rossigee/provider-cloudflare/internal/clients/emailrouting/rule"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
@@ -48,22 +48,26 @@ const (
 )
 
 // SetupRule adds a controller that reconciles Rule managed resources.
-func SetupRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupRule(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.RuleKind)
 
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.RuleGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&connector{
 			kube:         mgr.GetClient(),
 			newServiceFn: emailroutingruleclient.NewClientFromAPI,
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.RuleGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies())
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
+		WithOptions(o.ControllerOptions(v1beta1.RuleGroupVersionKind.GroupKind())).
 		For(&v1beta1.Rule{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -124,7 +128,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	obs, err := c.service.Get(ctx, cr.Spec.ForProvider.ZoneID, ruleTag)
 	if err != nil {
-		if emailroutingruleclient.IsRuleNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			return managed.ExternalObservation{ResourceExists: false}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetRule)
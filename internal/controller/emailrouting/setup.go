@@ -17,18 +17,17 @@ limitations under the License.
 package emailrouting
 
 import (
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 // Setup Email Routing controllers.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.TypedRateLimiter[any]) error{
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	for _, setup := range []func(ctrl.Manager, options.Options) error{
 		SetupRule,
 	} {
-		if err := setup(mgr, l, rl); err != nil {
+		if err := setup(mgr, o); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,222 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/firewall/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/clients/firewall/filter"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+const (
+	errNotFilter      = "managed resource is not a Filter custom resource"
+	errFilterLookup   = "cannot lookup filter"
+	errFilterCreation = "cannot create filter"
+	errFilterUpdate   = "cannot update filter"
+	errFilterDeletion = "cannot delete filter"
+)
+
+// SetupFilter adds a controller that reconciles Filter managed resources.
+func SetupFilter(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1beta1.FilterGroupKind)
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	r := managed.NewReconciler(options.ReportErrors(mgr),
+		resource.ManagedKind(v1beta1.FilterGroupVersionKind),
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&filterConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (filter.Client, error) {
+				return filter.NewClient(cfg, hc)
+			},
+		}))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.FilterGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
+		// Do not initialize external-name field.
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ControllerOptions(v1beta1.FilterGroupVersionKind.GroupKind())).
+		For(&v1beta1.Filter{}).
+		Complete(o.Reconciler(name, r))
+}
+
+// A filterConnector is expected to produce an ExternalClient when its Connect
+// method is called.
+type filterConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (filter.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *filterConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, ok := mg.(*v1beta1.Filter)
+	if !ok {
+		return nil, errors.New(errNotFilter)
+	}
+
+	// Get client configuration
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filterExternal{client: client}, nil
+}
+
+// A filterExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type filterExternal struct {
+	client filter.Client
+}
+
+func (e *filterExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.Filter)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFilter)
+	}
+
+	// Filter does not exist if we dont have an ID stored in external-name
+	fid := meta.GetExternalName(cr)
+	if fid == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalObservation{}, errors.New(errNoZone)
+	}
+
+	f, err := e.client.Filter(ctx, *cr.Spec.ForProvider.Zone, fid)
+
+	if err != nil {
+		return managed.ExternalObservation{},
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errFilterLookup)
+	}
+
+	cr.Status.AtProvider = filter.GenerateObservation(f)
+
+	cr.Status.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: filter.LateInitialize(&cr.Spec.ForProvider, f),
+		ResourceUpToDate:        filter.UpToDate(&cr.Spec.ForProvider, f),
+	}, nil
+}
+
+func (e *filterExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.Filter)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFilter)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalCreation{}, errors.New(errNoZone)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	nf, err := filter.CreateFilter(ctx, e.client, &cr.Spec.ForProvider)
+
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errFilterCreation)
+	}
+
+	cr.Status.AtProvider = filter.GenerateObservation(*nf)
+
+	// Update the external name with the ID of the new Filter
+	meta.SetExternalName(cr, nf.ID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *filterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.Filter)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFilter)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errNoZone), errFilterUpdate)
+	}
+
+	fid := meta.GetExternalName(cr)
+
+	// Update should never be called on a nonexistent resource
+	if fid == "" {
+		return managed.ExternalUpdate{}, errors.New(errFilterUpdate)
+	}
+
+	return managed.ExternalUpdate{},
+		errors.Wrap(
+			filter.UpdateFilter(ctx, e.client, fid, &cr.Spec.ForProvider),
+			errFilterUpdate,
+		)
+}
+
+func (e *filterExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.Filter)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotFilter)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalDelete{}, errors.Wrap(errors.New(errNoZone), errFilterDeletion)
+	}
+
+	fid := meta.GetExternalName(cr)
+
+	// Delete should never be called on a nonexistent resource
+	if fid == "" {
+		return managed.ExternalDelete{}, errors.New(errFilterDeletion)
+	}
+
+	return managed.ExternalDelete{}, errors.Wrap(
+		resource.Ignore(cferrors.IsNotFound, e.client.DeleteFilter(ctx, *cr.Spec.ForProvider.Zone, fid)),
+		errFilterDeletion)
+}
+
+func (e *filterExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -0,0 +1,667 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/rossigee/provider-cloudflare/apis/firewall/v1beta1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/rossigee/provider-cloudflare/internal/clients/firewall/filter"
+	"github.com/rossigee/provider-cloudflare/internal/clients/firewall/filter/fake"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	rtfake "github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	corev1 "k8s.io/api/core/v1"
+
+	pcv1beta1 "github.com/rossigee/provider-cloudflare/apis/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type filterModifier func(*v1beta1.Filter)
+
+func withFilterExpression(expression string) filterModifier {
+	return func(r *v1beta1.Filter) { r.Spec.ForProvider.Expression = expression }
+}
+
+func withFilterDescription(description string) filterModifier {
+	return func(r *v1beta1.Filter) { r.Spec.ForProvider.Description = &description }
+}
+
+func withFilterPaused(paused bool) filterModifier {
+	return func(r *v1beta1.Filter) { r.Spec.ForProvider.Paused = &paused }
+}
+
+func withFilterZone(zone string) filterModifier {
+	return func(r *v1beta1.Filter) { r.Spec.ForProvider.Zone = &zone }
+}
+
+func withFilterExternalName(filterID string) filterModifier {
+	return func(r *v1beta1.Filter) { meta.SetExternalName(r, filterID) }
+}
+
+func filterBuild(m ...filterModifier) *v1beta1.Filter {
+	cr := &v1beta1.Filter{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+func TestFilterObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type fields struct {
+		client filter.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"ErrNotFilter": {
+			reason: "An error should be returned if the managed resource is not a *Filter",
+			fields: fields{
+				client: &fake.MockClient{},
+			},
+			args: args{
+				mg: nil,
+			},
+			want: want{
+				err: errors.New(errNotFilter),
+			},
+		},
+		"ErrNoFilter": {
+			reason: "We should return ResourceExists: false when no external name is set",
+			fields: fields{
+				client: &fake.MockClient{},
+			},
+			args: args{
+				mg: &v1beta1.Filter{},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ErrFilterLookup": {
+			reason: "We should return an empty observation and an error if the API returned an error",
+			fields: fields{
+				client: &fake.MockClient{
+					MockFilter: func(ctx context.Context, zoneID string, filterID string) (cloudflare.Filter, error) {
+						return cloudflare.Filter{}, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: filterBuild(
+					withFilterExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
+					withFilterZone("Test Zone"),
+				),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Wrap(errBoom, errFilterLookup),
+			},
+		},
+		"ErrFilterNoZone": {
+			reason: "We should return an error if the filter does not have a zone",
+			fields: fields{
+				client: &fake.MockClient{},
+			},
+			args: args{
+				mg: filterBuild(
+					withFilterExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
+				),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.New(errNoZone),
+			},
+		},
+		"Success": {
+			reason: "We should return ResourceExists: true and no error when a filter is found",
+			fields: fields{
+				client: &fake.MockClient{
+					MockCreateFilter: func(ctx context.Context, zoneID string, filter cloudflare.Filter) (*cloudflare.Filter, error) {
+						return &cloudflare.Filter{
+							ID:          "372e67954025e0ba6aaa6d586b9e0b61",
+							Expression:  "http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100",
+							Paused:      false,
+							Description: "Test Description",
+						}, nil
+					},
+					MockFilter: func(ctx context.Context, zoneID string, filterID string) (cloudflare.Filter, error) {
+						return cloudflare.Filter{
+							ID:          "372e67954025e0ba6aaa6d586b9e0b61",
+							Expression:  "http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100",
+							Paused:      false,
+							Description: "Test Description",
+							Ref:         "SQ-100",
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: filterBuild(
+					withFilterExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
+					withFilterExpression("http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100"),
+					withFilterDescription("Test Description"),
+					withFilterPaused(false),
+					withFilterZone("Test Zone"),
+				),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := filterExternal{client: tc.fields.client}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFilterCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type fields struct {
+		client filter.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"ErrNotFilter": {
+			reason: "An error should be returned if the managed resource is not a Filter",
+			args: args{
+				mg: nil,
+			},
+			want: want{
+				err: errors.New(errNotFilter),
+			},
+		},
+		"ErrFilterCreate": {
+			reason: "We should return any errors during the create process",
+			fields: fields{
+				client: &fake.MockClient{
+					MockCreateFilter: func(ctx context.Context, zoneID string, filter cloudflare.Filter) (*cloudflare.Filter, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			args: args{
+				mg: filterBuild(
+					withFilterExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
+					withFilterExpression("http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100"),
+					withFilterDescription("Test Description"),
+					withFilterPaused(false),
+					withFilterZone("Test Zone"),
+				),
+			},
+			want: want{
+				o:   managed.ExternalCreation{},
+				err: errors.Wrap(errBoom, errFilterCreation),
+			},
+		},
+		"Success": {
+			reason: "We should return ExternalNameAssigned: true and no error when a record is created",
+			fields: fields{
+				client: &fake.MockClient{
+					MockCreateFilter: func(ctx context.Context, zoneID string, filter cloudflare.Filter) (*cloudflare.Filter, error) {
+						return &cloudflare.Filter{
+							ID:          "372e67954025e0ba6aaa6d586b9e0b61",
+							Expression:  "http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100",
+							Paused:      false,
+							Description: "Test Description",
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: filterBuild(
+					withFilterExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
+					withFilterExpression("http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100"),
+					withFilterDescription("Test Description"),
+					withFilterPaused(false),
+					withFilterZone("Test Zone"),
+				),
+			},
+			want: want{
+				o: managed.ExternalCreation{},
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := filterExternal{client: tc.fields.client}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFilterConnect(t *testing.T) {
+	mc := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil),
+	}
+
+	_, errGetProviderConfig := clients.GetConfig(context.Background(), mc, &rtfake.Managed{})
+
+	type fields struct {
+		kube      client.Client
+		newClient func(cfg clients.Config, hc *http.Client) (filter.Client, error)
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   error
+	}{
+		"ErrNotFilter": {
+			reason: "An error should be returned if the managed resource is not a Filter",
+			args: args{
+				mg: nil,
+			},
+			want: errors.New(errNotFilter),
+		},
+		"ErrGetConfig": {
+			reason: "Any errors from GetConfig should be wrapped",
+			fields: fields{
+				kube: mc,
+			},
+			args: args{
+				mg: &v1beta1.Filter{
+					Spec: v1beta1.FilterSpec{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{},
+					},
+				},
+			},
+			want: errors.Wrap(errGetProviderConfig, errClientConfig),
+		},
+		"ConnectReturnOK": {
+			reason: "Connect should return no error when passed the correct values",
+			fields: fields{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						switch o := obj.(type) {
+						case *pcv1beta1.ProviderConfig:
+							o.Spec.Credentials.Source = "Secret"
+							o.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{
+								Key: "creds",
+							}
+						case *corev1.Secret:
+							o.Data = map[string][]byte{
+								"creds": []byte("{\"APIKey\":\"foo\",\"Email\":\"foo@bar.com\"}"),
+							}
+						}
+						return nil
+					}),
+				},
+				newClient: filter.NewClient,
+			},
+			args: args{
+				mg: &v1beta1.Filter{
+					Spec: v1beta1.FilterSpec{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{
+							ProviderConfigReference: &xpv1.ProviderConfigReference{
+								Kind: "ProviderConfig",
+								Name: "blah",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			nc := func(cfg clients.Config) (filter.Client, error) {
+				return tc.fields.newClient(cfg, nil)
+			}
+			e := &filterConnector{kube: tc.fields.kube, newCloudflareClientFn: nc}
+			_, err := e.Connect(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Connect(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFilterUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type fields struct {
+		client filter.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"ErrNotFilter": {
+			reason: "An error should be returned if the managed resource is not a Filter",
+			args: args{
+				mg: nil,
+			},
+			want: want{
+				err: errors.New(errNotFilter),
+			},
+		}, "ErrNoFilter": {
+			reason: "We should return an error when no external name is set",
+			fields: fields{
+				client: &fake.MockClient{},
+			},
+			args: args{
+				mg: filterBuild(
+					withFilterExpression("http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100"),
+					withFilterDescription("Test Description"),
+					withFilterPaused(false),
+					withFilterZone("Test Zone"),
+				),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.New(errFilterUpdate),
+			},
+		}, "ErrNoZone": {
+			reason: "We should return an error when no Zone is set",
+			fields: fields{
+				client: &fake.MockClient{},
+			},
+			args: args{
+				mg: filterBuild(
+					withFilterExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
+					withFilterExpression("http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100"),
+					withFilterDescription("Test Description"),
+					withFilterPaused(false),
+				),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.Wrap(errors.New(errNoZone), errFilterUpdate),
+			},
+		}, "ErrFilterUpdate": {
+			reason: "We should return any errors during the update process",
+			fields: fields{
+				client: &fake.MockClient{
+					MockUpdateFilter: func(ctx context.Context, zoneID, filterID string, filter cloudflare.Filter) error {
+						return errBoom
+					},
+					MockFilter: func(ctx context.Context, zoneID string, filterID string) (cloudflare.Filter, error) {
+						return cloudflare.Filter{
+							ID:          "372e67954025e0ba6aaa6d586b9e0b61",
+							Expression:  "http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100",
+							Paused:      false,
+							Description: "Test Description",
+							Ref:         "SQ-100",
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: filterBuild(
+					withFilterExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
+					withFilterExpression("http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100"),
+					withFilterDescription("Test Description"),
+					withFilterPaused(false),
+					withFilterZone("Test Zone"),
+				),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.Wrap(errBoom, errFilterUpdate),
+			},
+		},
+		"Success": {
+			reason: "We should return no error when a filter is updated successfully",
+			fields: fields{
+				client: &fake.MockClient{
+					MockUpdateFilter: func(ctx context.Context, zoneID, filterID string, filter cloudflare.Filter) error {
+						return nil
+					},
+					MockFilter: func(ctx context.Context, zoneID string, filterID string) (cloudflare.Filter, error) {
+						return cloudflare.Filter{
+							ID:          "372e67954025e0ba6aaa6d586b9e0b61",
+							Expression:  "http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100",
+							Paused:      false,
+							Description: "Test Description",
+							Ref:         "SQ-100",
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: filterBuild(
+					withFilterExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
+					withFilterExpression("http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100"),
+					withFilterDescription("Test Description"),
+					withFilterPaused(false),
+					withFilterZone("Test Zone"),
+				),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := filterExternal{client: tc.fields.client}
+			got, err := e.Update(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFilterDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type fields struct {
+		client filter.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"ErrNotFilter": {
+			reason: "An error should be returned if the managed resource is not a Filter",
+			args: args{
+				mg: nil,
+			},
+			want: want{
+				err: errors.New(errNotFilter),
+			},
+		},
+		"ErrNoFilter": {
+			reason: "We should return an error when no external name is set",
+			fields: fields{
+				client: &fake.MockClient{},
+			},
+			args: args{
+				mg: filterBuild(
+					withFilterExpression("http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100"),
+					withFilterDescription("Test Description"),
+					withFilterPaused(false),
+					withFilterZone("Test Zone"),
+				),
+			},
+			want: want{
+				err: errors.New(errFilterDeletion),
+			},
+		},
+		"ErrFilterDelete": {
+			reason: "We should return any errors during the delete process",
+			fields: fields{
+				client: &fake.MockClient{
+					MockDeleteFilter: func(ctx context.Context, zoneID string, firewallFilterID string) error {
+						return errBoom
+					},
+				},
+			},
+			args: args{
+				mg: filterBuild(
+					withFilterExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
+					withFilterExpression("http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100"),
+					withFilterDescription("Test Description"),
+					withFilterPaused(false),
+					withFilterZone("Test Zone"),
+				),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errFilterDeletion),
+			},
+		},
+		"Success": {
+			reason: "We should return no error when a filter is deleted",
+			fields: fields{
+				client: &fake.MockClient{
+					MockDeleteFilter: func(ctx context.Context, zoneID string, firewallFilterID string) error {
+						return nil
+					},
+				},
+			},
+			args: args{
+				mg: filterBuild(
+					withFilterExternalName("372e67954025e0ba6aaa6d586b9e0b61"),
+					withFilterExpression("http.request.uri.path ~ \".*wp-login.php\" or http.request.uri.path ~ \".*xmlrpc.php\") and ip.addr ne 172.16.22.100"),
+					withFilterDescription("Test Description"),
+					withFilterPaused(false),
+					withFilterZone("Test Zone"),
+				),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := filterExternal{client: tc.fields.client}
+			_, err := e.Delete(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
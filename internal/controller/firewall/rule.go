@@ -0,0 +1,227 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/firewall/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/clients/firewall/rule"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+const (
+	errNotRule      = "managed resource is not a Rule custom resource"
+	errRuleLookup   = "cannot lookup firewall rule"
+	errRuleCreation = "cannot create firewall rule"
+	errRuleUpdate   = "cannot update firewall rule"
+	errRuleDeletion = "cannot delete firewall rule"
+	errNoFilter     = "no filter found"
+)
+
+// SetupRule adds a controller that reconciles Rule managed resources.
+func SetupRule(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1beta1.RuleGroupKind)
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	r := managed.NewReconciler(options.ReportErrors(mgr),
+		resource.ManagedKind(v1beta1.RuleGroupVersionKind),
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&ruleConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (rule.Client, error) {
+				return rule.NewClient(cfg, hc)
+			},
+		}))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.RuleGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
+		// Do not initialize external-name field.
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ControllerOptions(v1beta1.RuleGroupVersionKind.GroupKind())).
+		For(&v1beta1.Rule{}).
+		Complete(o.Reconciler(name, r))
+}
+
+// A ruleConnector is expected to produce an ExternalClient when its Connect
+// method is called.
+type ruleConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (rule.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *ruleConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, ok := mg.(*v1beta1.Rule)
+	if !ok {
+		return nil, errors.New(errNotRule)
+	}
+
+	// Get client configuration
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ruleExternal{client: client}, nil
+}
+
+// A ruleExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type ruleExternal struct {
+	client rule.Client
+}
+
+func (e *ruleExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.Rule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRule)
+	}
+
+	// Rule does not exist if we dont have an ID stored in external-name
+	rid := meta.GetExternalName(cr)
+	if rid == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalObservation{}, errors.New(errNoZone)
+	}
+
+	r, err := e.client.FirewallRule(ctx, *cr.Spec.ForProvider.Zone, rid)
+
+	if err != nil {
+		return managed.ExternalObservation{},
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errRuleLookup)
+	}
+
+	cr.Status.AtProvider = rule.GenerateObservation(r)
+
+	cr.Status.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: rule.LateInitialize(&cr.Spec.ForProvider, r),
+		ResourceUpToDate:        rule.UpToDate(&cr.Spec.ForProvider, r),
+	}, nil
+}
+
+func (e *ruleExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.Rule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRule)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalCreation{}, errors.New(errNoZone)
+	}
+
+	if cr.Spec.ForProvider.Filter == nil {
+		return managed.ExternalCreation{}, errors.New(errNoFilter)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	nr, err := rule.CreateRule(ctx, e.client, &cr.Spec.ForProvider)
+
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errRuleCreation)
+	}
+
+	cr.Status.AtProvider = rule.GenerateObservation(*nr)
+
+	// Update the external name with the ID of the new Rule
+	meta.SetExternalName(cr, nr.ID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *ruleExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.Rule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRule)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errNoZone), errRuleUpdate)
+	}
+
+	rid := meta.GetExternalName(cr)
+
+	// Update should never be called on a nonexistent resource
+	if rid == "" {
+		return managed.ExternalUpdate{}, errors.New(errRuleUpdate)
+	}
+
+	return managed.ExternalUpdate{},
+		errors.Wrap(
+			rule.UpdateRule(ctx, e.client, rid, &cr.Spec.ForProvider),
+			errRuleUpdate,
+		)
+}
+
+func (e *ruleExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.Rule)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotRule)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalDelete{}, errors.Wrap(errors.New(errNoZone), errRuleDeletion)
+	}
+
+	rid := meta.GetExternalName(cr)
+
+	// Delete should never be called on a nonexistent resource
+	if rid == "" {
+		return managed.ExternalDelete{}, errors.New(errRuleDeletion)
+	}
+
+	return managed.ExternalDelete{}, errors.Wrap(
+		resource.Ignore(cferrors.IsNotFound, e.client.DeleteFirewallRule(ctx, *cr.Spec.ForProvider.Zone, rid)),
+		errRuleDeletion)
+}
+
+func (e *ruleExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
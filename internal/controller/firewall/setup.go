@@ -0,0 +1,42 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
+)
+
+const (
+	errClientConfig = "error getting client config"
+	errNoZone       = "no zone found"
+)
+
+// Setup Firewall controllers.
+func Setup(mgr ctrl.Manager, o options.Options) error {
+
+	if err := SetupFilter(mgr, o); err != nil {
+		return err
+	}
+
+	if err := SetupRule(mgr, o); err != nil {
+		return err
+	}
+
+	return nil
+}
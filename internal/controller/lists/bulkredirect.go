@@ -0,0 +1,366 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lists
+
+import (
+	"context"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/lists/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/clients/lists"
+	ruleset "github.com/rossigee/provider-cloudflare/internal/clients/rulesets"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+const (
+	errNotBulkRedirect = "managed resource is not a BulkRedirect custom resource"
+
+	errBulkRedirectLookup   = "cannot lookup bulk redirects"
+	errBulkRedirectCreation = "cannot create bulk redirects"
+	errBulkRedirectUpdate   = "cannot update bulk redirects"
+	errBulkRedirectDeletion = "cannot delete bulk redirects"
+	errRedirectRule         = "cannot update bulk redirect rule"
+)
+
+// SetupBulkRedirect adds a controller that reconciles BulkRedirect managed
+// resources.
+func SetupBulkRedirect(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1beta1.BulkRedirectGroupKind)
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	r := managed.NewReconciler(options.ReportErrors(mgr),
+		resource.ManagedKind(v1beta1.BulkRedirectGroupVersionKind),
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&bulkRedirectConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (lists.API, error) {
+				return lists.NewClient(cfg, hc)
+			},
+			newRulesetClientFn: func(cfg clients.Config) (ruleset.Client, error) {
+				return ruleset.NewClient(cfg, hc)
+			},
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.BulkRedirectGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
+		// Do not initialize external-name field.
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ControllerOptions(v1beta1.BulkRedirectGroupVersionKind.GroupKind())).
+		For(&v1beta1.BulkRedirect{}).
+		Complete(o.Reconciler(name, r))
+}
+
+// A bulkRedirectConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type bulkRedirectConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (lists.API, error)
+	newRulesetClientFn    func(cfg clients.Config) (ruleset.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *bulkRedirectConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.BulkRedirect)
+	if !ok {
+		return nil, errors.New(errNotBulkRedirect)
+	}
+
+	// Get client configuration
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	api, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	rulesets, err := c.newRulesetClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := clients.ResolveAccountID(ctx, api, cr.Spec.ForProvider.AccountID, *config)
+	if err != nil {
+		return nil, errors.Wrap(err, errAccount)
+	}
+
+	return &bulkRedirectExternal{kube: c.kube, api: api, rulesets: rulesets, accountID: accountID}, nil
+}
+
+// A bulkRedirectExternal observes, then either creates, updates, or deletes
+// an external resource to ensure it reflects the managed resource's desired
+// state.
+type bulkRedirectExternal struct {
+	kube      client.Client
+	api       lists.API
+	rulesets  ruleset.Client
+	accountID string
+}
+
+func (e *bulkRedirectExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.BulkRedirect)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBulkRedirect)
+	}
+
+	// The redirects do not exist if we dont have a list ID stored in
+	// external-name
+	lid := meta.GetExternalName(cr)
+	if lid == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	l, err := e.api.GetList(ctx, e.rc(), lid)
+	if err != nil {
+		return managed.ExternalObservation{},
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errBulkRedirectLookup)
+	}
+
+	d, err := e.itemsDiff(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errBulkRedirectLookup)
+	}
+
+	rs, err := e.rulesets.GetEntrypointRuleset(ctx, lists.BulkRedirectEntrypoint(e.accountID))
+	if resource.Ignore(cferrors.IsNotFound, err) != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errBulkRedirectLookup)
+	}
+
+	cr.Status.AtProvider = v1beta1.BulkRedirectObservation{ListID: l.ID, NumItems: l.NumItems}
+	var rule *cloudflare.RulesetRule
+	if rs != nil {
+		cr.Status.AtProvider.RulesetID = rs.ID
+		if rule = lists.FindBulkRedirectRule(cr.Spec.ForProvider, rs); rule != nil {
+			cr.Status.AtProvider.RuleID = rule.ID
+		}
+	}
+
+	cr.Status.SetConditions(rtv1.Available())
+
+	desired, err := ruleset.ConvertRule(lists.BulkRedirectRule(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRedirectRule)
+	}
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: ptr.Deref(cr.Spec.ForProvider.Description, "") == l.Description &&
+			d.Empty() &&
+			rule != nil && ruleset.RuleUpToDate(desired, *rule),
+	}, nil
+}
+
+func (e *bulkRedirectExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.BulkRedirect)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBulkRedirect)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	// The redirects and their rule are added by the Update that follows the
+	// next observation.
+	l, err := e.api.CreateList(ctx, e.rc(), cloudflare.ListCreateParams{
+		Name:        cr.Spec.ForProvider.Name,
+		Description: ptr.Deref(cr.Spec.ForProvider.Description, ""),
+		Kind:        v1beta1.ListKindRedirect,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errBulkRedirectCreation)
+	}
+
+	cr.Status.AtProvider.ListID = l.ID
+
+	// Update the external name with the ID of the new redirect list
+	meta.SetExternalName(cr, l.ID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *bulkRedirectExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.BulkRedirect)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBulkRedirect)
+	}
+
+	lid := meta.GetExternalName(cr)
+
+	// Update should never be called on a nonexistent resource
+	if lid == "" {
+		return managed.ExternalUpdate{}, errors.New(errBulkRedirectUpdate)
+	}
+
+	if _, err := e.api.UpdateList(ctx, e.rc(), cloudflare.ListUpdateParams{
+		ID:          lid,
+		Description: ptr.Deref(cr.Spec.ForProvider.Description, ""),
+	}); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errBulkRedirectUpdate)
+	}
+
+	d, err := e.itemsDiff(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errBulkRedirectUpdate)
+	}
+
+	if err := lists.ApplyItems(ctx, e.api, e.rc(), lid, d); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errBulkRedirectUpdate)
+	}
+
+	// The rule is written once the list holds the redirects, so that
+	// enabling a new list never redirects against a partial one.
+	return managed.ExternalUpdate{}, errors.Wrap(e.writeRule(ctx, cr), errBulkRedirectUpdate)
+}
+
+func (e *bulkRedirectExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.BulkRedirect)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBulkRedirect)
+	}
+
+	lid := meta.GetExternalName(cr)
+
+	// Delete should never be called on a nonexistent resource
+	if lid == "" {
+		return managed.ExternalDelete{}, errors.New(errBulkRedirectDeletion)
+	}
+
+	// The rule goes first, as a list cannot be deleted while a rule uses it.
+	if err := e.deleteRule(ctx, cr); err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errBulkRedirectDeletion)
+	}
+
+	_, err := e.api.DeleteList(ctx, e.rc(), lid)
+	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errBulkRedirectDeletion)
+}
+
+func (e *bulkRedirectExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
+
+func (e *bulkRedirectExternal) rc() *cloudflare.ResourceContainer {
+	return cloudflare.AccountIdentifier(e.accountID)
+}
+
+// itemsDiff returns the changes needed to bring the redirect list to the
+// desired state.
+func (e *bulkRedirectExternal) itemsDiff(ctx context.Context, cr *v1beta1.BulkRedirect) (lists.ItemsDiff, error) {
+	redirects := append([]v1beta1.Redirect{}, cr.Spec.ForProvider.Redirects...)
+	if src := cr.Spec.ForProvider.RedirectsFrom; src != nil {
+		data, err := configMapData(ctx, e.kube, cr.GetNamespace(), src.ConfigMapKeyRef)
+		if err != nil {
+			return lists.ItemsDiff{}, errors.Wrap(err, errDesiredItems)
+		}
+		fromConfigMap, err := lists.ParseRedirects(data)
+		if err != nil {
+			return lists.ItemsDiff{}, errors.Wrap(err, errDesiredItems)
+		}
+		redirects = append(redirects, fromConfigMap...)
+	}
+
+	current, err := e.api.ListListItems(ctx, e.rc(), cloudflare.ListListItemsParams{ID: meta.GetExternalName(cr)})
+	if err != nil {
+		return lists.ItemsDiff{}, errors.Wrap(err, errListItems)
+	}
+
+	items := lists.BulkRedirectItems(cr.Spec.ForProvider, redirects)
+	d, err := lists.DiffItems(v1beta1.ListKindRedirect, items, current)
+	return d, errors.Wrap(err, errDesiredItems)
+}
+
+// writeRule creates or updates the rule enabling the redirect list, leaving
+// the other rules of the phase alone.
+func (e *bulkRedirectExternal) writeRule(ctx context.Context, cr *v1beta1.BulkRedirect) error {
+	params := lists.BulkRedirectEntrypoint(e.accountID)
+	unlock, err := ruleset.LockEntrypoint(ctx, params)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	desired := lists.BulkRedirectRule(cr.Spec.ForProvider)
+
+	rs, err := e.rulesets.GetEntrypointRuleset(ctx, params)
+	if cferrors.IsNotFound(err) {
+		params.Rules = append(params.Rules, desired)
+		_, err = e.rulesets.UpdateEntrypointRuleset(ctx, params)
+		return errors.Wrap(err, errRedirectRule)
+	}
+	if err != nil {
+		return errors.Wrap(err, errRedirectRule)
+	}
+
+	cf, err := ruleset.ConvertRule(desired)
+	if err != nil {
+		return errors.Wrap(err, errRedirectRule)
+	}
+
+	pr := ruleset.PositionedRule{Rule: cf}
+	existing := lists.FindBulkRedirectRule(cr.Spec.ForProvider, rs)
+	switch {
+	case existing == nil:
+		_, err = e.rulesets.CreateRulesetRule(ctx, rs.ID, pr, params)
+	case !ruleset.RuleUpToDate(pr.Rule, *existing):
+		pr.Rule.ID = existing.ID
+		_, err = e.rulesets.UpdateRulesetRule(ctx, rs.ID, pr, params)
+	}
+	return errors.Wrap(err, errRedirectRule)
+}
+
+// deleteRule deletes the rule enabling the redirect list, if there is one.
+func (e *bulkRedirectExternal) deleteRule(ctx context.Context, cr *v1beta1.BulkRedirect) error {
+	params := lists.BulkRedirectEntrypoint(e.accountID)
+	unlock, err := ruleset.LockEntrypoint(ctx, params)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	rs, err := e.rulesets.GetEntrypointRuleset(ctx, params)
+	if err != nil {
+		return resource.Ignore(cferrors.IsNotFound, err)
+	}
+
+	rule := lists.FindBulkRedirectRule(cr.Spec.ForProvider, rs)
+	if rule == nil {
+		return nil
+	}
+
+	_, err = e.rulesets.DeleteRulesetRule(ctx, rs.ID, rule.ID, params)
+	return resource.Ignore(cferrors.IsNotFound, err)
+}
@@ -0,0 +1,394 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lists
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/lists/v1beta1"
+	rulesetsv1beta1 "github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/lists"
+	ruleset "github.com/rossigee/provider-cloudflare/internal/clients/rulesets"
+)
+
+// mockRulesetClient is a ruleset.Client whose phase entrypoint methods are
+// replaced by its fields.
+type mockRulesetClient struct {
+	ruleset.Client
+
+	MockGetEntrypointRuleset    func(ctx context.Context, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+	MockUpdateEntrypointRuleset func(ctx context.Context, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+	MockCreateRulesetRule       func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+	MockUpdateRulesetRule       func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+	MockDeleteRulesetRule       func(ctx context.Context, rulesetID, ruleID string, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+}
+
+func (m *mockRulesetClient) GetEntrypointRuleset(ctx context.Context, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	return m.MockGetEntrypointRuleset(ctx, params)
+}
+
+func (m *mockRulesetClient) UpdateEntrypointRuleset(ctx context.Context, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	return m.MockUpdateEntrypointRuleset(ctx, params)
+}
+
+func (m *mockRulesetClient) CreateRulesetRule(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	return m.MockCreateRulesetRule(ctx, rulesetID, rule, params)
+}
+
+func (m *mockRulesetClient) UpdateRulesetRule(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	return m.MockUpdateRulesetRule(ctx, rulesetID, rule, params)
+}
+
+func (m *mockRulesetClient) DeleteRulesetRule(ctx context.Context, rulesetID, ruleID string, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	return m.MockDeleteRulesetRule(ctx, rulesetID, ruleID, params)
+}
+
+type bulkRedirectModifier func(*v1beta1.BulkRedirect)
+
+func withRedirects(r ...v1beta1.Redirect) bulkRedirectModifier {
+	return func(b *v1beta1.BulkRedirect) { b.Spec.ForProvider.Redirects = r }
+}
+
+func withRedirectsFrom(name, key string) bulkRedirectModifier {
+	return func(b *v1beta1.BulkRedirect) {
+		b.Spec.ForProvider.RedirectsFrom = &v1beta1.ItemsSource{
+			ConfigMapKeyRef: v1beta1.ConfigMapKeySelector{Name: name, Key: key},
+		}
+	}
+}
+
+func withStatusCode(c int) bulkRedirectModifier {
+	return func(b *v1beta1.BulkRedirect) { b.Spec.ForProvider.StatusCode = ptr.To(c) }
+}
+
+func bulkRedirectCR(m ...bulkRedirectModifier) *v1beta1.BulkRedirect {
+	b := &v1beta1.BulkRedirect{
+		Spec: v1beta1.BulkRedirectSpec{
+			ForProvider: v1beta1.BulkRedirectParameters{
+				Name:        "moved",
+				Description: ptr.To("Moved pages"),
+			},
+		},
+	}
+	b.SetNamespace("default")
+	meta.SetExternalName(b, "l1")
+	for _, f := range m {
+		f(b)
+	}
+	return b
+}
+
+func movedList(ctx context.Context, rc *cloudflare.ResourceContainer, listID string) (cloudflare.List, error) {
+	return cloudflare.List{ID: listID, Name: "moved", Kind: v1beta1.ListKindRedirect, Description: "Moved pages", NumItems: 1}, nil
+}
+
+func movedItems(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListListItemsParams) ([]cloudflare.ListItem, error) {
+	return []cloudflare.ListItem{{ID: "i1", Redirect: &cloudflare.Redirect{
+		SourceUrl: "example.com/a", TargetUrl: "https://example.com/b", StatusCode: ptr.To(301),
+	}}}, nil
+}
+
+// redirectRule returns the ruleset rule of a bulk redirect. Bulk redirect
+// rules have no action parameters that can fail to convert.
+func redirectRule(p v1beta1.BulkRedirectParameters) cloudflare.RulesetRule {
+	r, _ := ruleset.ConvertRule(lists.BulkRedirectRule(p))
+	return r
+}
+
+func movedRule() cloudflare.RulesetRule {
+	r := redirectRule(v1beta1.BulkRedirectParameters{Name: "moved"})
+	r.ID = "r1"
+	return r
+}
+
+func entrypoint(rules ...cloudflare.RulesetRule) func(ctx context.Context, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	return func(ctx context.Context, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+		return &cloudflare.Ruleset{ID: "rs1", Phase: lists.PhaseRedirect, Rules: rules}, nil
+	}
+}
+
+func noEntrypoint(ctx context.Context, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	return nil, &cloudflare.NotFoundError{}
+}
+
+func TestBulkRedirectObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	redirect := v1beta1.Redirect{SourceURL: "example.com/a", TargetURL: "https://example.com/b"}
+
+	type want struct {
+		o   managed.ExternalObservation
+		obs v1beta1.BulkRedirectObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		kube     client.Client
+		api      *mockAPI
+		rulesets *mockRulesetClient
+		mg       *v1beta1.BulkRedirect
+		want     want
+	}{
+		"NotFound": {
+			reason: "Redirects whose list is gone should not exist",
+			api: &mockAPI{
+				MockGetList: func(ctx context.Context, rc *cloudflare.ResourceContainer, listID string) (cloudflare.List, error) {
+					return cloudflare.List{}, &cloudflare.NotFoundError{}
+				},
+			},
+			mg:   bulkRedirectCR(),
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"UpToDate": {
+			reason: "Redirects whose items and rule match should be up to date",
+			api:    &mockAPI{MockGetList: movedList, MockListListItems: movedItems},
+			rulesets: &mockRulesetClient{
+				MockGetEntrypointRuleset: entrypoint(cloudflare.RulesetRule{ID: "other", Ref: "other"}, movedRule()),
+			},
+			mg: bulkRedirectCR(withRedirects(redirect)),
+			want: want{
+				o:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: v1beta1.BulkRedirectObservation{ListID: "l1", NumItems: 1, RulesetID: "rs1", RuleID: "r1"},
+			},
+		},
+		"DefaultStatusCodeChanged": {
+			reason: "Changing the default status code should change the items",
+			api:    &mockAPI{MockGetList: movedList, MockListListItems: movedItems},
+			rulesets: &mockRulesetClient{
+				MockGetEntrypointRuleset: entrypoint(movedRule()),
+			},
+			mg: bulkRedirectCR(withRedirects(redirect), withStatusCode(308)),
+			want: want{
+				o:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: v1beta1.BulkRedirectObservation{ListID: "l1", NumItems: 1, RulesetID: "rs1", RuleID: "r1"},
+			},
+		},
+		"RedirectsFromConfigMap": {
+			reason: "Redirects read from a ConfigMap should be added to the inline redirects",
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*corev1.ConfigMap).Data = map[string]string{"redirects": "example.com/c https://example.com/d\n"}
+					return nil
+				}),
+			},
+			api: &mockAPI{MockGetList: movedList, MockListListItems: movedItems},
+			rulesets: &mockRulesetClient{
+				MockGetEntrypointRuleset: entrypoint(movedRule()),
+			},
+			mg: bulkRedirectCR(withRedirects(redirect), withRedirectsFrom("moved", "redirects")),
+			want: want{
+				o:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: v1beta1.BulkRedirectObservation{ListID: "l1", NumItems: 1, RulesetID: "rs1", RuleID: "r1"},
+			},
+		},
+		"NoEntrypoint": {
+			reason: "Redirects without a phase entrypoint should need their rule written",
+			api:    &mockAPI{MockGetList: movedList, MockListListItems: movedItems},
+			rulesets: &mockRulesetClient{
+				MockGetEntrypointRuleset: noEntrypoint,
+			},
+			mg: bulkRedirectCR(withRedirects(redirect)),
+			want: want{
+				o:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: v1beta1.BulkRedirectObservation{ListID: "l1", NumItems: 1},
+			},
+		},
+		"ErrGetEntrypoint": {
+			reason: "Errors looking up the phase entrypoint should be returned",
+			api:    &mockAPI{MockGetList: movedList, MockListListItems: movedItems},
+			rulesets: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return nil, errBoom
+				},
+			},
+			mg:   bulkRedirectCR(withRedirects(redirect)),
+			want: want{err: errors.Wrap(errBoom, errBulkRedirectLookup)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &bulkRedirectExternal{kube: tc.kube, api: tc.api, rulesets: tc.rulesets, accountID: "a"}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, tc.mg.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBulkRedirectCreate(t *testing.T) {
+	api := &mockAPI{
+		MockCreateList: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListCreateParams) (cloudflare.List, error) {
+			if params.Name != "moved" || params.Kind != v1beta1.ListKindRedirect {
+				return cloudflare.List{}, errors.Errorf("unexpected params %+v", params)
+			}
+			return cloudflare.List{ID: "l2"}, nil
+		},
+	}
+	cr := bulkRedirectCR()
+	meta.SetExternalName(cr, "")
+
+	e := &bulkRedirectExternal{api: api, accountID: "a"}
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if diff := cmp.Diff("l2", meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("e.Create(...): -want external name, +got external name:\n%s", diff)
+	}
+}
+
+func TestBulkRedirectUpdate(t *testing.T) {
+	redirect := v1beta1.Redirect{SourceURL: "example.com/a", TargetURL: "https://example.com/b"}
+
+	type want struct {
+		created []cloudflare.ListItemCreateRequest
+		call    string
+		rule    cloudflare.RulesetRule
+	}
+
+	cases := map[string]struct {
+		reason     string
+		mg         *v1beta1.BulkRedirect
+		entrypoint func(ctx context.Context, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+		want       want
+	}{
+		"CreateEntrypoint": {
+			reason:     "A missing phase entrypoint should be created holding the rule",
+			mg:         bulkRedirectCR(withRedirects(redirect)),
+			entrypoint: noEntrypoint,
+			want:       want{call: "UpdateEntrypointRuleset", rule: redirectRule(v1beta1.BulkRedirectParameters{Name: "moved"})},
+		},
+		"CreateRule": {
+			reason:     "A missing rule should be added alongside the other rules of the phase",
+			mg:         bulkRedirectCR(withRedirects(redirect)),
+			entrypoint: entrypoint(cloudflare.RulesetRule{ID: "other", Ref: "other"}),
+			want:       want{call: "CreateRulesetRule", rule: redirectRule(v1beta1.BulkRedirectParameters{Name: "moved"})},
+		},
+		"UpdateItemsAndRule": {
+			reason: "Changed items should be replaced and a stale rule updated in place",
+			mg: bulkRedirectCR(withRedirects(redirect), withStatusCode(308), func(b *v1beta1.BulkRedirect) {
+				b.Spec.ForProvider.Enabled = ptr.To(false)
+			}),
+			entrypoint: entrypoint(movedRule()),
+			want: want{
+				created: []cloudflare.ListItemCreateRequest{{Redirect: &cloudflare.Redirect{
+					SourceUrl: "example.com/a", TargetUrl: "https://example.com/b", StatusCode: ptr.To(308),
+				}}},
+				call: "UpdateRulesetRule",
+				rule: func() cloudflare.RulesetRule {
+					r := redirectRule(v1beta1.BulkRedirectParameters{Name: "moved", Enabled: ptr.To(false)})
+					r.ID = "r1"
+					return r
+				}(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			api := &mockAPI{
+				MockUpdateList: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListUpdateParams) (cloudflare.List, error) {
+					return cloudflare.List{ID: params.ID}, nil
+				},
+				MockListListItems: movedItems,
+				MockDeleteListItems: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDeleteItemsParams) ([]cloudflare.ListItem, error) {
+					return nil, nil
+				},
+				MockCreateListItems: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListCreateItemsParams) ([]cloudflare.ListItem, error) {
+					got.created = params.Items
+					return nil, nil
+				},
+			}
+			rulesets := &mockRulesetClient{
+				MockGetEntrypointRuleset: tc.entrypoint,
+				MockUpdateEntrypointRuleset: func(ctx context.Context, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					got.call = "UpdateEntrypointRuleset"
+					got.rule, _ = ruleset.ConvertRule(params.Rules[0])
+					return &cloudflare.Ruleset{}, nil
+				},
+				MockCreateRulesetRule: func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					got.call = "CreateRulesetRule"
+					got.rule = rule.Rule
+					return &cloudflare.Ruleset{}, nil
+				},
+				MockUpdateRulesetRule: func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					got.call = "UpdateRulesetRule"
+					got.rule = rule.Rule
+					return &cloudflare.Ruleset{}, nil
+				},
+			}
+			e := &bulkRedirectExternal{api: api, rulesets: rulesets, accountID: "a"}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.created, got.created); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want created items, +got created items:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.call, got.call); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want call, +got call:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.rule, got.rule); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want rule, +got rule:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBulkRedirectDelete(t *testing.T) {
+	var deleted []string
+	api := &mockAPI{
+		MockDeleteList: func(ctx context.Context, rc *cloudflare.ResourceContainer, listID string) (cloudflare.ListDeleteResponse, error) {
+			deleted = append(deleted, "list "+listID)
+			return cloudflare.ListDeleteResponse{}, &cloudflare.NotFoundError{}
+		},
+	}
+	rulesets := &mockRulesetClient{
+		MockGetEntrypointRuleset: entrypoint(cloudflare.RulesetRule{ID: "other", Ref: "other"}, movedRule()),
+		MockDeleteRulesetRule: func(ctx context.Context, rulesetID, ruleID string, params rulesetsv1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+			deleted = append(deleted, "rule "+ruleID)
+			return &cloudflare.Ruleset{}, nil
+		},
+	}
+
+	e := &bulkRedirectExternal{api: api, rulesets: rulesets, accountID: "a"}
+	if _, err := e.Delete(context.Background(), bulkRedirectCR()); err != nil {
+		t.Fatalf("e.Delete(...): %v", err)
+	}
+	if diff := cmp.Diff([]string{"rule r1", "list l1"}, deleted); diff != "" {
+		t.Errorf("e.Delete(...): -want deleted, +got deleted:\n%s", diff)
+	}
+}
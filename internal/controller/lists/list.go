@@ -0,0 +1,299 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lists
+
+import (
+	"context"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/lists/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/clients/lists"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+const (
+	errNotList = "managed resource is not a List custom resource"
+
+	errListLookup   = "cannot lookup list"
+	errListCreation = "cannot create list"
+	errListUpdate   = "cannot update list"
+	errListDeletion = "cannot delete list"
+	errListItems    = "cannot lookup list items"
+	errDesiredItems = "cannot determine desired list items"
+	errGetConfigMap = "cannot get items ConfigMap"
+	errConfigMapKey = "items ConfigMap has no key %q"
+)
+
+// SetupList adds a controller that reconciles List managed resources.
+func SetupList(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1beta1.ListGroupKind)
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	r := managed.NewReconciler(options.ReportErrors(mgr),
+		resource.ManagedKind(v1beta1.ListGroupVersionKind),
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&listConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (lists.API, error) {
+				return lists.NewClient(cfg, hc)
+			},
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.ListGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
+		// Do not initialize external-name field.
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ControllerOptions(v1beta1.ListGroupVersionKind.GroupKind())).
+		For(&v1beta1.List{}).
+		Complete(o.Reconciler(name, r))
+}
+
+// A listConnector is expected to produce an ExternalClient when its Connect
+// method is called.
+type listConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (lists.API, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *listConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.List)
+	if !ok {
+		return nil, errors.New(errNotList)
+	}
+
+	// Get client configuration
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	api, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := clients.ResolveAccountID(ctx, api, cr.Spec.ForProvider.AccountID, *config)
+	if err != nil {
+		return nil, errors.Wrap(err, errAccount)
+	}
+
+	return &listExternal{kube: c.kube, api: api, rc: cloudflare.AccountIdentifier(accountID)}, nil
+}
+
+// A listExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type listExternal struct {
+	kube client.Client
+	api  lists.API
+	rc   *cloudflare.ResourceContainer
+}
+
+func (e *listExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.List)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotList)
+	}
+
+	// List does not exist if we dont have an ID stored in external-name
+	lid := meta.GetExternalName(cr)
+	if lid == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	l, err := e.api.GetList(ctx, e.rc, lid)
+	if err != nil {
+		return managed.ExternalObservation{},
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errListLookup)
+	}
+
+	cr.Status.AtProvider = lists.GenerateObservation(l)
+	cr.Status.SetConditions(rtv1.Available())
+
+	lateInit := lists.LateInitialize(&cr.Spec.ForProvider, l)
+	upToDate := lists.UpToDate(&cr.Spec.ForProvider, l)
+	if upToDate && lists.ManagesItems(&cr.Spec.ForProvider) {
+		d, err := e.itemsDiff(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = d.Empty()
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: lateInit,
+		ResourceUpToDate:        upToDate,
+	}, nil
+}
+
+func (e *listExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.List)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotList)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	// Items are added by the Update that follows the next observation.
+	l, err := e.api.CreateList(ctx, e.rc, cloudflare.ListCreateParams{
+		Name:        cr.Spec.ForProvider.Name,
+		Description: ptr.Deref(cr.Spec.ForProvider.Description, ""),
+		Kind:        cr.Spec.ForProvider.Kind,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errListCreation)
+	}
+
+	cr.Status.AtProvider = lists.GenerateObservation(l)
+
+	// Update the external name with the ID of the new List
+	meta.SetExternalName(cr, l.ID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *listExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.List)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotList)
+	}
+
+	lid := meta.GetExternalName(cr)
+
+	// Update should never be called on a nonexistent resource
+	if lid == "" {
+		return managed.ExternalUpdate{}, errors.New(errListUpdate)
+	}
+
+	if _, err := e.api.UpdateList(ctx, e.rc, cloudflare.ListUpdateParams{
+		ID:          lid,
+		Description: ptr.Deref(cr.Spec.ForProvider.Description, ""),
+	}); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListUpdate)
+	}
+
+	if !lists.ManagesItems(&cr.Spec.ForProvider) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	d, err := e.itemsDiff(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListUpdate)
+	}
+
+	return managed.ExternalUpdate{},
+		errors.Wrap(lists.ApplyItems(ctx, e.api, e.rc, lid, d), errListUpdate)
+}
+
+func (e *listExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.List)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotList)
+	}
+
+	lid := meta.GetExternalName(cr)
+
+	// Delete should never be called on a nonexistent resource
+	if lid == "" {
+		return managed.ExternalDelete{}, errors.New(errListDeletion)
+	}
+
+	_, err := e.api.DeleteList(ctx, e.rc, lid)
+	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errListDeletion)
+}
+
+func (e *listExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
+
+// itemsDiff returns the changes needed to bring the items of a List to the
+// desired state.
+func (e *listExternal) itemsDiff(ctx context.Context, cr *v1beta1.List) (lists.ItemsDiff, error) {
+	desired, err := e.desiredItems(ctx, cr)
+	if err != nil {
+		return lists.ItemsDiff{}, errors.Wrap(err, errDesiredItems)
+	}
+
+	current, err := e.api.ListListItems(ctx, e.rc, cloudflare.ListListItemsParams{ID: meta.GetExternalName(cr)})
+	if err != nil {
+		return lists.ItemsDiff{}, errors.Wrap(err, errListItems)
+	}
+
+	d, err := lists.DiffItems(cr.Spec.ForProvider.Kind, desired, current)
+	return d, errors.Wrap(err, errDesiredItems)
+}
+
+// desiredItems returns the inline items of a List followed by those read
+// from its ConfigMap.
+func (e *listExternal) desiredItems(ctx context.Context, cr *v1beta1.List) ([]v1beta1.Item, error) {
+	items := append([]v1beta1.Item{}, cr.Spec.ForProvider.Items...)
+
+	src := cr.Spec.ForProvider.ItemsFrom
+	if src == nil {
+		return items, nil
+	}
+
+	data, err := configMapData(ctx, e.kube, cr.GetNamespace(), src.ConfigMapKeyRef)
+	if err != nil {
+		return nil, err
+	}
+
+	fromConfigMap, err := lists.ParseItems(data)
+	if err != nil {
+		return nil, err
+	}
+	return append(items, fromConfigMap...), nil
+}
+
+// configMapData returns the value of the selected key of a ConfigMap in the
+// supplied namespace.
+func configMapData(ctx context.Context, kube client.Client, namespace string, sel v1beta1.ConfigMapKeySelector) (string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: namespace, Name: sel.Name}, cm); err != nil {
+		return "", errors.Wrap(err, errGetConfigMap)
+	}
+
+	data, ok := cm.Data[sel.Key]
+	if !ok {
+		return "", errors.Errorf(errConfigMapKey, sel.Key)
+	}
+	return data, nil
+}
@@ -0,0 +1,343 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lists
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/lists/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/lists"
+)
+
+// mockAPI is a lists.API whose methods are replaced by its fields.
+type mockAPI struct {
+	MockAccounts        func(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error)
+	MockCreateList      func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListCreateParams) (cloudflare.List, error)
+	MockGetList         func(ctx context.Context, rc *cloudflare.ResourceContainer, listID string) (cloudflare.List, error)
+	MockUpdateList      func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListUpdateParams) (cloudflare.List, error)
+	MockDeleteList      func(ctx context.Context, rc *cloudflare.ResourceContainer, listID string) (cloudflare.ListDeleteResponse, error)
+	MockListListItems   func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListListItemsParams) ([]cloudflare.ListItem, error)
+	MockGetListItem     func(ctx context.Context, rc *cloudflare.ResourceContainer, listID, itemID string) (cloudflare.ListItem, error)
+	MockCreateListItems func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListCreateItemsParams) ([]cloudflare.ListItem, error)
+	MockDeleteListItems func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDeleteItemsParams) ([]cloudflare.ListItem, error)
+}
+
+var _ lists.API = &mockAPI{}
+
+func (m *mockAPI) Accounts(ctx context.Context, params cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+	return m.MockAccounts(ctx, params)
+}
+
+func (m *mockAPI) CreateList(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListCreateParams) (cloudflare.List, error) {
+	return m.MockCreateList(ctx, rc, params)
+}
+
+func (m *mockAPI) GetList(ctx context.Context, rc *cloudflare.ResourceContainer, listID string) (cloudflare.List, error) {
+	return m.MockGetList(ctx, rc, listID)
+}
+
+func (m *mockAPI) UpdateList(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListUpdateParams) (cloudflare.List, error) {
+	return m.MockUpdateList(ctx, rc, params)
+}
+
+func (m *mockAPI) DeleteList(ctx context.Context, rc *cloudflare.ResourceContainer, listID string) (cloudflare.ListDeleteResponse, error) {
+	return m.MockDeleteList(ctx, rc, listID)
+}
+
+func (m *mockAPI) ListListItems(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListListItemsParams) ([]cloudflare.ListItem, error) {
+	return m.MockListListItems(ctx, rc, params)
+}
+
+func (m *mockAPI) GetListItem(ctx context.Context, rc *cloudflare.ResourceContainer, listID, itemID string) (cloudflare.ListItem, error) {
+	return m.MockGetListItem(ctx, rc, listID, itemID)
+}
+
+func (m *mockAPI) CreateListItems(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListCreateItemsParams) ([]cloudflare.ListItem, error) {
+	return m.MockCreateListItems(ctx, rc, params)
+}
+
+func (m *mockAPI) DeleteListItems(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDeleteItemsParams) ([]cloudflare.ListItem, error) {
+	return m.MockDeleteListItems(ctx, rc, params)
+}
+
+type listModifier func(*v1beta1.List)
+
+func withItems(i ...v1beta1.Item) listModifier {
+	return func(l *v1beta1.List) { l.Spec.ForProvider.Items = i }
+}
+
+func withItemsFrom(name, key string) listModifier {
+	return func(l *v1beta1.List) {
+		l.Spec.ForProvider.ItemsFrom = &v1beta1.ItemsSource{
+			ConfigMapKeyRef: v1beta1.ConfigMapKeySelector{Name: name, Key: key},
+		}
+	}
+}
+
+func withListID(id string) listModifier {
+	return func(l *v1beta1.List) { meta.SetExternalName(l, id) }
+}
+
+func listCR(m ...listModifier) *v1beta1.List {
+	l := &v1beta1.List{
+		Spec: v1beta1.ListSpec{
+			ForProvider: v1beta1.ListParameters{
+				Name:        "office_ips",
+				Kind:        v1beta1.ListKindIP,
+				Description: ptr.To("Office ranges"),
+			},
+		},
+	}
+	l.SetNamespace("default")
+	for _, f := range m {
+		f(l)
+	}
+	return l
+}
+
+func officeList(ctx context.Context, rc *cloudflare.ResourceContainer, listID string) (cloudflare.List, error) {
+	return cloudflare.List{ID: listID, Name: "office_ips", Kind: v1beta1.ListKindIP, Description: "Office ranges", NumItems: 1}, nil
+}
+
+func officeItems(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListListItemsParams) ([]cloudflare.ListItem, error) {
+	return []cloudflare.ListItem{{ID: "i1", IP: ptr.To("192.0.2.0/24")}}, nil
+}
+
+func TestListObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		api    *mockAPI
+		mg     *v1beta1.List
+		want   managed.ExternalObservation
+		err    error
+	}{
+		"NoExternalName": {
+			reason: "A List without an external name should not exist",
+			api:    &mockAPI{},
+			mg:     listCR(),
+			want:   managed.ExternalObservation{ResourceExists: false},
+		},
+		"NotFound": {
+			reason: "A List that is gone should not exist",
+			api: &mockAPI{
+				MockGetList: func(ctx context.Context, rc *cloudflare.ResourceContainer, listID string) (cloudflare.List, error) {
+					return cloudflare.List{}, &cloudflare.NotFoundError{}
+				},
+			},
+			mg:   listCR(withListID("l1")),
+			want: managed.ExternalObservation{ResourceExists: false},
+		},
+		"ItemsUnmanaged": {
+			reason: "A List without items should not look at the items",
+			api:    &mockAPI{MockGetList: officeList},
+			mg:     listCR(withListID("l1")),
+			want:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		"ItemsUpToDate": {
+			reason: "A List whose items match should be up to date",
+			api:    &mockAPI{MockGetList: officeList, MockListListItems: officeItems},
+			mg:     listCR(withListID("l1"), withItems(v1beta1.Item{Value: ptr.To("192.0.2.0/24")})),
+			want:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		"ItemsFromConfigMap": {
+			reason: "Items read from a ConfigMap should be added to the inline items",
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*corev1.ConfigMap).Data = map[string]string{"ips": "198.51.100.0/24\n"}
+					return nil
+				}),
+			},
+			api: &mockAPI{MockGetList: officeList, MockListListItems: officeItems},
+			mg: listCR(withListID("l1"), withItems(v1beta1.Item{Value: ptr.To("192.0.2.0/24")}),
+				withItemsFrom("office", "ips")),
+			want: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+		},
+		"ErrConfigMapKey": {
+			reason: "A missing ConfigMap key should be reported",
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			api:  &mockAPI{MockGetList: officeList},
+			mg:   listCR(withListID("l1"), withItemsFrom("office", "ips")),
+			want: managed.ExternalObservation{},
+			err:  errors.Wrap(errors.Errorf(errConfigMapKey, "ips"), errDesiredItems),
+		},
+		"ErrGetList": {
+			reason: "Errors looking up the List should be returned",
+			api: &mockAPI{
+				MockGetList: func(ctx context.Context, rc *cloudflare.ResourceContainer, listID string) (cloudflare.List, error) {
+					return cloudflare.List{}, errBoom
+				},
+			},
+			mg:  listCR(withListID("l1")),
+			err: errors.Wrap(errBoom, errListLookup),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &listExternal{kube: tc.kube, api: tc.api, rc: cloudflare.AccountIdentifier("a")}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestListCreate(t *testing.T) {
+	api := &mockAPI{
+		MockCreateList: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListCreateParams) (cloudflare.List, error) {
+			if params.Name != "office_ips" || params.Kind != v1beta1.ListKindIP {
+				return cloudflare.List{}, errors.Errorf("unexpected params %+v", params)
+			}
+			return cloudflare.List{ID: "l1"}, nil
+		},
+	}
+	cr := listCR()
+
+	e := &listExternal{api: api, rc: cloudflare.AccountIdentifier("a")}
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): %v", err)
+	}
+	if diff := cmp.Diff("l1", meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("e.Create(...): -want external name, +got external name:\n%s", diff)
+	}
+}
+
+func TestListUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	update := func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListUpdateParams) (cloudflare.List, error) {
+		return cloudflare.List{ID: params.ID}, nil
+	}
+
+	type want struct {
+		deleted []cloudflare.ListItemDeleteItemRequest
+		created []cloudflare.ListItemCreateRequest
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1beta1.List
+		create error
+		want   want
+	}{
+		"ItemsUnmanaged": {
+			reason: "A List without items should only update its description",
+			mg:     listCR(withListID("l1")),
+		},
+		"Items": {
+			reason: "Items should be changed in bulk, deleting before creating",
+			mg: listCR(withListID("l1"), withItems(
+				v1beta1.Item{Value: ptr.To("192.0.2.0/24"), Comment: ptr.To("London")},
+			)),
+			want: want{
+				deleted: []cloudflare.ListItemDeleteItemRequest{{ID: "i1"}},
+				created: []cloudflare.ListItemCreateRequest{{IP: ptr.To("192.0.2.0/24"), Comment: "London"}},
+			},
+		},
+		"ErrCreateItems": {
+			reason: "Errors creating items should be returned",
+			mg:     listCR(withListID("l1"), withItems(v1beta1.Item{Value: ptr.To("203.0.113.1")})),
+			create: errBoom,
+			want: want{
+				deleted: []cloudflare.ListItemDeleteItemRequest{{ID: "i1"}},
+				created: []cloudflare.ListItemCreateRequest{{IP: ptr.To("203.0.113.1")}},
+				err:     errors.Wrap(errors.Wrap(errBoom, "cannot create list items"), errListUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			api := &mockAPI{
+				MockUpdateList:    update,
+				MockListListItems: officeItems,
+				MockDeleteListItems: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDeleteItemsParams) ([]cloudflare.ListItem, error) {
+					got.deleted = params.Items.Items
+					return nil, nil
+				},
+				MockCreateListItems: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListCreateItemsParams) ([]cloudflare.ListItem, error) {
+					got.created = params.Items
+					return nil, tc.create
+				},
+			}
+			e := &listExternal{api: api, rc: cloudflare.AccountIdentifier("a")}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, got.deleted); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted items, +got deleted items:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.created, got.created); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want created items, +got created items:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestListDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   error
+	}{
+		"Deleted": {
+			reason: "Deleting a List should succeed",
+		},
+		"AlreadyGone": {
+			reason: "A List that is already gone should be treated as deleted",
+			err:    &cloudflare.NotFoundError{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &mockAPI{
+				MockDeleteList: func(ctx context.Context, rc *cloudflare.ResourceContainer, listID string) (cloudflare.ListDeleteResponse, error) {
+					return cloudflare.ListDeleteResponse{}, tc.err
+				},
+			}
+			e := &listExternal{api: api, rc: cloudflare.AccountIdentifier("a")}
+			_, err := e.Delete(context.Background(), listCR(withListID("l1")))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lists
+
+import (
+	"context"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/lists/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/clients/lists"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+const (
+	errNotListItem = "managed resource is not a ListItem custom resource"
+
+	errListItemLookup   = "cannot lookup list item"
+	errListItemCreation = "cannot create list item"
+	errListItemUpdate   = "cannot update list item"
+	errListItemDeletion = "cannot delete list item"
+	errNoListID         = "no list ID specified"
+	errListItemNotFound = "list item not found after creation"
+)
+
+// SetupListItem adds a controller that reconciles ListItem managed
+// resources.
+func SetupListItem(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1beta1.ListItemGroupKind)
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	r := managed.NewReconciler(options.ReportErrors(mgr),
+		resource.ManagedKind(v1beta1.ListItemGroupVersionKind),
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&listItemConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (lists.API, error) {
+				return lists.NewClient(cfg, hc)
+			},
+		}))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.ListItemGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
+		// Do not initialize external-name field.
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ControllerOptions(v1beta1.ListItemGroupVersionKind.GroupKind())).
+		For(&v1beta1.ListItem{}).
+		Complete(o.Reconciler(name, r))
+}
+
+// A listItemConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type listItemConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (lists.API, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *listItemConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.ListItem)
+	if !ok {
+		return nil, errors.New(errNotListItem)
+	}
+
+	// Get client configuration
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	api, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := clients.ResolveAccountID(ctx, api, cr.Spec.ForProvider.AccountID, *config)
+	if err != nil {
+		return nil, errors.Wrap(err, errAccount)
+	}
+
+	return &listItemExternal{api: api, rc: cloudflare.AccountIdentifier(accountID)}, nil
+}
+
+// A listItemExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type listItemExternal struct {
+	api lists.API
+	rc  *cloudflare.ResourceContainer
+}
+
+func (e *listItemExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.ListItem)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotListItem)
+	}
+
+	// Item does not exist if we dont have an ID stored in external-name
+	iid := meta.GetExternalName(cr)
+	if iid == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if cr.Spec.ForProvider.ListID == nil {
+		return managed.ExternalObservation{}, errors.New(errNoListID)
+	}
+
+	i, err := e.api.GetListItem(ctx, e.rc, *cr.Spec.ForProvider.ListID, iid)
+	if err != nil {
+		return managed.ExternalObservation{},
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errListItemLookup)
+	}
+
+	r, err := lists.CreateRequest(lists.ItemKind(i), cr.Spec.ForProvider.Item)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListItemLookup)
+	}
+
+	cr.Status.AtProvider = lists.GenerateItemObservation(i)
+	cr.Status.SetConditions(rtv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: lists.ItemUpToDate(r, i),
+	}, nil
+}
+
+func (e *listItemExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.ListItem)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotListItem)
+	}
+
+	if cr.Spec.ForProvider.ListID == nil {
+		return managed.ExternalCreation{}, errors.New(errNoListID)
+	}
+	lid := *cr.Spec.ForProvider.ListID
+
+	cr.SetConditions(rtv1.Creating())
+
+	r, err := e.createRequest(ctx, lid, cr.Spec.ForProvider.Item)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errListItemCreation)
+	}
+
+	// An item with the same key may already exist, for example after an
+	// update replaced it. It is adopted, and updated if it differs.
+	items, err := e.api.ListListItems(ctx, e.rc, cloudflare.ListListItemsParams{ID: lid})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errListItemCreation)
+	}
+
+	i := lists.FindItem(r, items)
+	if i == nil {
+		items, err = e.api.CreateListItems(ctx, e.rc, cloudflare.ListCreateItemsParams{
+			ID:    lid,
+			Items: []cloudflare.ListItemCreateRequest{r},
+		})
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errListItemCreation)
+		}
+		if i = lists.FindItem(r, items); i == nil {
+			return managed.ExternalCreation{}, errors.Wrap(errors.New(errListItemNotFound), errListItemCreation)
+		}
+	}
+
+	cr.Status.AtProvider = lists.GenerateItemObservation(*i)
+
+	// Update the external name with the ID of the item
+	meta.SetExternalName(cr, i.ID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *listItemExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.ListItem)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotListItem)
+	}
+
+	iid := meta.GetExternalName(cr)
+
+	// Update should never be called on a nonexistent resource
+	if iid == "" {
+		return managed.ExternalUpdate{}, errors.New(errListItemUpdate)
+	}
+
+	if cr.Spec.ForProvider.ListID == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errNoListID), errListItemUpdate)
+	}
+	lid := *cr.Spec.ForProvider.ListID
+
+	r, err := e.createRequest(ctx, lid, cr.Spec.ForProvider.Item)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListItemUpdate)
+	}
+
+	// Cloudflare cannot update a single item, so it is replaced. The
+	// replacement has a new ID, which is adopted by Create once the next
+	// observation finds the old one gone.
+	d := lists.ItemsDiff{
+		Delete: []cloudflare.ListItemDeleteItemRequest{{ID: iid}},
+		Create: []cloudflare.ListItemCreateRequest{r},
+	}
+	return managed.ExternalUpdate{},
+		errors.Wrap(lists.ApplyItems(ctx, e.api, e.rc, lid, d), errListItemUpdate)
+}
+
+func (e *listItemExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.ListItem)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotListItem)
+	}
+
+	iid := meta.GetExternalName(cr)
+
+	// Delete should never be called on a nonexistent resource
+	if iid == "" {
+		return managed.ExternalDelete{}, errors.New(errListItemDeletion)
+	}
+
+	if cr.Spec.ForProvider.ListID == nil {
+		return managed.ExternalDelete{}, errors.Wrap(errors.New(errNoListID), errListItemDeletion)
+	}
+
+	d := lists.ItemsDiff{Delete: []cloudflare.ListItemDeleteItemRequest{{ID: iid}}}
+	return managed.ExternalDelete{}, errors.Wrap(
+		resource.Ignore(cferrors.IsNotFound, lists.ApplyItems(ctx, e.api, e.rc, *cr.Spec.ForProvider.ListID, d)),
+		errListItemDeletion)
+}
+
+func (e *listItemExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
+
+// createRequest converts the desired item into a create request for the
+// list it belongs to.
+func (e *listItemExternal) createRequest(ctx context.Context, listID string, item v1beta1.Item) (cloudflare.ListItemCreateRequest, error) {
+	l, err := e.api.GetList(ctx, e.rc, listID)
+	if err != nil {
+		return cloudflare.ListItemCreateRequest{}, err
+	}
+	return lists.CreateRequest(l.Kind, item)
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lists
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/lists/v1beta1"
+)
+
+func listItemCR(id string, value string) *v1beta1.ListItem {
+	i := &v1beta1.ListItem{
+		Spec: v1beta1.ListItemSpec{
+			ForProvider: v1beta1.ListItemParameters{
+				ListID: ptr.To("l1"),
+				Item:   v1beta1.Item{Value: ptr.To(value)},
+			},
+		},
+	}
+	if id != "" {
+		meta.SetExternalName(i, id)
+	}
+	return i
+}
+
+func TestListItemObserve(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		mg     *v1beta1.ListItem
+		item   cloudflare.ListItem
+		err    error
+		want   managed.ExternalObservation
+	}{
+		"NotFound": {
+			reason: "An item that is gone should not exist",
+			mg:     listItemCR("i1", "192.0.2.1"),
+			err:    &cloudflare.NotFoundError{},
+			want:   managed.ExternalObservation{ResourceExists: false},
+		},
+		"UpToDate": {
+			reason: "An item matching its spec should be up to date",
+			mg:     listItemCR("i1", "192.0.2.1/32"),
+			item:   cloudflare.ListItem{ID: "i1", IP: ptr.To("192.0.2.1")},
+			want:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		"ValueChanged": {
+			reason: "An item whose value changed should need an update",
+			mg:     listItemCR("i1", "192.0.2.2"),
+			item:   cloudflare.ListItem{ID: "i1", IP: ptr.To("192.0.2.1")},
+			want:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &mockAPI{
+				MockGetListItem: func(ctx context.Context, rc *cloudflare.ResourceContainer, listID, itemID string) (cloudflare.ListItem, error) {
+					return tc.item, tc.err
+				},
+			}
+			e := &listItemExternal{api: api, rc: cloudflare.AccountIdentifier("a")}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestListItemCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		created      bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		items  []cloudflare.ListItem
+		want   want
+	}{
+		"Created": {
+			reason: "A new item should be created and its ID recorded",
+			items:  []cloudflare.ListItem{{ID: "other", IP: ptr.To("198.51.100.1")}},
+			want:   want{externalName: "new", created: true},
+		},
+		"Adopted": {
+			reason: "An existing item with the same value should be adopted",
+			items:  []cloudflare.ListItem{{ID: "existing", IP: ptr.To("192.0.2.1")}},
+			want:   want{externalName: "existing"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			created := false
+			api := &mockAPI{
+				MockGetList: officeList,
+				MockListListItems: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListListItemsParams) ([]cloudflare.ListItem, error) {
+					return tc.items, nil
+				},
+				MockCreateListItems: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListCreateItemsParams) ([]cloudflare.ListItem, error) {
+					created = true
+					return append(tc.items, cloudflare.ListItem{ID: "new", IP: params.Items[0].IP}), nil
+				},
+			}
+			cr := listItemCR("", "192.0.2.1")
+			e := &listItemExternal{api: api, rc: cloudflare.AccountIdentifier("a")}
+			if _, err := e.Create(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\ne.Create(...): %v\n", tc.reason, err)
+			}
+			got := want{externalName: meta.GetExternalName(cr), created: created}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestListItemUpdate(t *testing.T) {
+	var deleted []cloudflare.ListItemDeleteItemRequest
+	var created []cloudflare.ListItemCreateRequest
+	api := &mockAPI{
+		MockGetList: officeList,
+		MockDeleteListItems: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDeleteItemsParams) ([]cloudflare.ListItem, error) {
+			deleted = params.Items.Items
+			return nil, nil
+		},
+		MockCreateListItems: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListCreateItemsParams) ([]cloudflare.ListItem, error) {
+			created = params.Items
+			return nil, nil
+		},
+	}
+
+	e := &listItemExternal{api: api, rc: cloudflare.AccountIdentifier("a")}
+	if _, err := e.Update(context.Background(), listItemCR("i1", "192.0.2.2")); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if diff := cmp.Diff([]cloudflare.ListItemDeleteItemRequest{{ID: "i1"}}, deleted); diff != "" {
+		t.Errorf("e.Update(...): -want deleted items, +got deleted items:\n%s", diff)
+	}
+	if diff := cmp.Diff([]cloudflare.ListItemCreateRequest{{IP: ptr.To("192.0.2.2")}}, created); diff != "" {
+		t.Errorf("e.Update(...): -want created items, +got created items:\n%s", diff)
+	}
+}
+
+func TestListItemDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   error
+	}{
+		"AlreadyGone": {
+			reason: "An item on a list that is gone should be treated as deleted",
+			err:    &cloudflare.NotFoundError{},
+		},
+		"Error": {
+			reason: "Other errors should be returned",
+			err:    errBoom,
+			want:   errors.Wrap(errors.Wrap(errBoom, "cannot delete list items"), errListItemDeletion),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &mockAPI{
+				MockDeleteListItems: func(ctx context.Context, rc *cloudflare.ResourceContainer, params cloudflare.ListDeleteItemsParams) ([]cloudflare.ListItem, error) {
+					return nil, tc.err
+				},
+			}
+			e := &listItemExternal{api: api, rc: cloudflare.AccountIdentifier("a")}
+			_, err := e.Delete(context.Background(), listItemCR("i1", "192.0.2.1"))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
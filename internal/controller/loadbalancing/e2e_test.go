@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancing
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	"github.com/rossigee/provider-cloudflare/apis/loadbalancing/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cftest"
+	"github.com/rossigee/provider-cloudflare/internal/controller/testutils"
+)
+
+func TestLoadBalancerEndToEnd(t *testing.T) {
+	e := testutils.NewEnv(t)
+	e.Setup(Setup)
+	zone := e.API.AddZone("example.org")
+
+	monitor := &v1beta1.LoadBalancerMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "http"},
+		Spec: v1beta1.LoadBalancerMonitorSpec{ForProvider: v1beta1.LoadBalancerMonitorParameters{
+			Account: testutils.StringPtr(cftest.AccountID),
+			Type:    "http",
+			Path:    testutils.StringPtr("/healthz"),
+		}},
+	}
+	e.Create(monitor)
+	e.Sync(monitor)
+	if diff := cmp.Diff(corev1.ConditionTrue, monitor.GetCondition(xpv1.TypeReady).Status); diff != "" {
+		t.Fatalf("\nA created monitor should be ready.\n-want, +got:\n%s\n%+v", diff, monitor.Status.Conditions)
+	}
+
+	pool := &v1beta1.LoadBalancerPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "origins"},
+		Spec: v1beta1.LoadBalancerPoolSpec{ForProvider: v1beta1.LoadBalancerPoolParameters{
+			Name:    testutils.StringPtr("origins"),
+			Monitor: &monitor.Status.AtProvider.ID,
+			Origins: []v1beta1.LoadBalancerOrigin{{Name: "a", Address: "192.0.2.1"}},
+		}},
+	}
+	e.Create(pool)
+	e.Sync(pool)
+	if diff := cmp.Diff(corev1.ConditionTrue, pool.GetCondition(xpv1.TypeReady).Status); diff != "" {
+		t.Fatalf("\nA created pool should be ready.\n-want, +got:\n%s\n%+v", diff, pool.Status.Conditions)
+	}
+
+	lb := &v1beta1.LoadBalancer{
+		ObjectMeta: metav1.ObjectMeta{Name: "www"},
+		Spec: v1beta1.LoadBalancerSpec{ForProvider: v1beta1.LoadBalancerParameters{
+			Zone:         zone,
+			Name:         testutils.StringPtr("www.example.org"),
+			FallbackPool: &pool.Status.AtProvider.ID,
+			DefaultPools: []string{pool.Status.AtProvider.ID},
+		}},
+	}
+	e.Create(lb)
+	e.Sync(lb)
+	if diff := cmp.Diff(corev1.ConditionTrue, lb.GetCondition(xpv1.TypeReady).Status); diff != "" {
+		t.Fatalf("\nA created load balancer should be ready.\n-want, +got:\n%s\n%+v", diff, lb.Status.Conditions)
+	}
+
+	if diff := cmp.Diff("/healthz", e.API.LoadBalancerMonitors()[0].Path); diff != "" {
+		t.Errorf("\nThe monitor should be created as asked.\n-want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(monitor.Status.AtProvider.ID, e.API.LoadBalancerPools()[0].Monitor); diff != "" {
+		t.Errorf("\nThe pool should be created with its monitor.\n-want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{pool.Status.AtProvider.ID}, e.API.LoadBalancers(zone)[0].DefaultPools); diff != "" {
+		t.Errorf("\nThe load balancer should be created with its pools.\n-want, +got:\n%s", diff)
+	}
+
+	e.Delete(lb)
+	e.Delete(pool)
+	e.Delete(monitor)
+	got := len(e.API.LoadBalancers(zone)) + len(e.API.LoadBalancerPools()) + len(e.API.LoadBalancerMonitors())
+	if diff := cmp.Diff(0, got); diff != "" {
+		t.Errorf("\nDeleted load balancing objects should be deleted.\n-want, +got:\n%s", diff)
+	}
+}
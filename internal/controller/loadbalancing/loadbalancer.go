@@ -20,62 +20,57 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
+	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/loadbalancing/v1beta1"
-	apisv1beta1 "github.com/rossigee/provider-cloudflare/apis/v1beta1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	"github.com/rossigee/provider-cloudflare/internal/clients/loadbalancing"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
 	errNotLoadBalancer    = "managed resource is not a LoadBalancer custom resource"
-	errTrackPCUsage       = "cannot track ProviderConfig usage"
-	errGetPC              = "cannot get ProviderConfig"
 	errGetCreds           = "cannot get credentials"
 	errNewClient          = "cannot create new Service"
 )
 
 // SetupLoadBalancer adds a controller that reconciles LoadBalancer managed resources.
-func SetupLoadBalancer(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupLoadBalancer(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.LoadBalancerGroupKind)
 
-	o := controller.Options{
-		MaxConcurrentReconciles: 5,
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.LoadBalancerGroupVersionKind),
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&connector{
 			kube: mgr.GetClient(),
 			newServiceFn: func(cfg clients.Config, httpClient *http.Client) (loadbalancing.LoadBalancerClient, error) {
 				return loadbalancing.NewLoadBalancerClient(cfg, httpClient)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.LoadBalancerGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.LoadBalancerGroupVersionKind.GroupKind())).
 		For(&v1beta1.LoadBalancer{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -86,22 +81,15 @@ type connector struct {
 }
 
 // Connect typically produces an ExternalClient by:
-// 1. Tracking that the managed resource is using a ProviderConfig.
-// 2. Getting the managed resource's ProviderConfig.
-// 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+// 1. Getting the managed resource's ProviderConfig or ClusterProviderConfig.
+// 2. Getting the credentials specified by it.
+// 3. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*v1beta1.LoadBalancer)
+	_, ok := mg.(*v1beta1.LoadBalancer)
 	if !ok {
 		return nil, errors.New(errNotLoadBalancer)
 	}
 
-
-	pc := &apisv1beta1.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPC)
-	}
-
 	// Get client configuration
 	config, err := clients.GetConfig(ctx, c.kube, mg)
 	if err != nil {
@@ -129,7 +117,9 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotLoadBalancer)
 	}
 
-	if cr.Status.AtProvider.ID == "" {
+	// The ID is kept in the external name: status does not survive
+	// the update that records a successful create.
+	if meta.GetExternalName(cr) == "" {
 		return managed.ExternalObservation{
 			ResourceExists: false,
 		}, nil
@@ -140,9 +130,9 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, err
 	}
 
-	lb, err := c.service.GetLoadBalancer(ctx, cr.Status.AtProvider.ID, cr.Spec.ForProvider)
+	lb, err := c.service.GetLoadBalancer(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
 	if err != nil {
-		if loadbalancing.IsLoadBalancerNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			return managed.ExternalObservation{
 				ResourceExists: false,
 			}, nil
@@ -151,6 +141,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	cr.Status.AtProvider = loadbalancing.GenerateLoadBalancerObservation(lb)
+	cr.SetConditions(rtv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
@@ -177,6 +168,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	cr.Status.AtProvider = loadbalancing.GenerateLoadBalancerObservation(lb)
+	meta.SetExternalName(cr, lb.ID)
 
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -194,7 +186,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, err
 	}
 
-	_, err := c.service.UpdateLoadBalancer(ctx, cr.Status.AtProvider.ID, cr.Spec.ForProvider)
+	_, err := c.service.UpdateLoadBalancer(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "failed to update load balancer in Cloudflare API")
 	}
@@ -210,8 +202,8 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, errors.New(errNotLoadBalancer)
 	}
 
-	err := c.service.DeleteLoadBalancer(ctx, cr.Status.AtProvider.ID, cr.Spec.ForProvider)
-	if err != nil && !loadbalancing.IsLoadBalancerNotFound(err) {
+	err := c.service.DeleteLoadBalancer(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil && !cferrors.IsNotFound(err) {
 		return managed.ExternalDelete{}, errors.Wrap(err, "failed to delete load balancer from Cloudflare API")
 	}
 
@@ -35,6 +35,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
@@ -66,7 +68,7 @@ func withName(name string) loadbalancerModifier {
 }
 
 func withID(id string) loadbalancerModifier {
-	return func(lb *v1beta1.LoadBalancer) { lb.Status.AtProvider.ID = id }
+	return func(lb *v1beta1.LoadBalancer) { meta.SetExternalName(lb, id) }
 }
 
 func loadbalancer(m ...loadbalancerModifier) *v1beta1.LoadBalancer {
@@ -115,8 +117,9 @@ func TestConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.LoadBalancer{
 					Spec: v1beta1.LoadBalancerSpec{
-						ResourceSpec: xpv1.ResourceSpec{
-							ProviderConfigReference: &xpv1.Reference{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{
+							ProviderConfigReference: &xpv1.ProviderConfigReference{
+								Kind: "ProviderConfig",
 								Name: "test-config",
 							},
 						},
@@ -152,8 +155,9 @@ func TestConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.LoadBalancer{
 					Spec: v1beta1.LoadBalancerSpec{
-						ResourceSpec: xpv1.ResourceSpec{
-							ProviderConfigReference: &xpv1.Reference{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{
+							ProviderConfigReference: &xpv1.ProviderConfigReference{
+								Kind: "ProviderConfig",
 								Name: "blah",
 							},
 						},
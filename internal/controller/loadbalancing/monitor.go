@@ -19,61 +19,56 @@ package loadbalancing
 import (
 	"context"
 	"net/http"
-	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/loadbalancing/v1beta1"
-	apisv1beta1 "github.com/rossigee/provider-cloudflare/apis/v1beta1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	"github.com/rossigee/provider-cloudflare/internal/clients/loadbalancing"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
 	errNotMonitor       = "managed resource is not a LoadBalancerMonitor custom resource"
-	errGetMonitorPC     = "cannot get ProviderConfig"
 	errGetMonitorCreds  = "cannot get credentials"
 	errNewMonitorClient = "cannot create new Service"
 )
 
 // SetupMonitor adds a controller that reconciles LoadBalancerMonitor managed resources.
-func SetupMonitor(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupMonitor(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.LoadBalancerMonitorGroupKind)
 
-	o := controller.Options{
-		MaxConcurrentReconciles: 5,
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.LoadBalancerMonitorGroupVersionKind),
-		managed.WithExternalConnecter(&monitorConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&monitorConnector{
 			kube: mgr.GetClient(),
 			newServiceFn: func(cfg clients.Config, httpClient *http.Client) (loadbalancing.MonitorClient, error) {
 				return loadbalancing.NewMonitorClient(cfg, httpClient)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.LoadBalancerMonitorGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.LoadBalancerMonitorGroupVersionKind.GroupKind())).
 		For(&v1beta1.LoadBalancerMonitor{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A monitorConnector is expected to produce an ExternalClient when its Connect method
@@ -84,20 +79,15 @@ type monitorConnector struct {
 }
 
 // Connect typically produces an ExternalClient by:
-// 1. Getting the managed resource's ProviderConfig.
-// 2. Getting the credentials specified by the ProviderConfig.
+// 1. Getting the managed resource's ProviderConfig or ClusterProviderConfig.
+// 2. Getting the credentials specified by it.
 // 3. Using the credentials to form a client.
 func (c *monitorConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*v1beta1.LoadBalancerMonitor)
+	_, ok := mg.(*v1beta1.LoadBalancerMonitor)
 	if !ok {
 		return nil, errors.New(errNotMonitor)
 	}
 
-	pc := &apisv1beta1.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetMonitorPC)
-	}
-
 	// Get client configuration
 	config, err := clients.GetConfig(ctx, c.kube, mg)
 	if err != nil {
@@ -124,15 +114,17 @@ func (c *monitorExternal) Observe(ctx context.Context, mg resource.Managed) (man
 		return managed.ExternalObservation{}, errors.New(errNotMonitor)
 	}
 
-	if cr.Status.AtProvider.ID == "" {
+	// The ID is kept in the external name: status does not survive
+	// the update that records a successful create.
+	if meta.GetExternalName(cr) == "" {
 		return managed.ExternalObservation{
 			ResourceExists: false,
 		}, nil
 	}
 
-	monitor, err := c.service.GetMonitor(ctx, cr.Status.AtProvider.ID, cr.Spec.ForProvider)
+	monitor, err := c.service.GetMonitor(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
 	if err != nil {
-		if loadbalancing.IsMonitorNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			return managed.ExternalObservation{
 				ResourceExists: false,
 			}, nil
@@ -141,6 +133,7 @@ func (c *monitorExternal) Observe(ctx context.Context, mg resource.Managed) (man
 	}
 
 	cr.Status.AtProvider = loadbalancing.GenerateMonitorObservation(monitor)
+	cr.SetConditions(rtv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
@@ -162,6 +155,7 @@ func (c *monitorExternal) Create(ctx context.Context, mg resource.Managed) (mana
 	}
 
 	cr.Status.AtProvider = loadbalancing.GenerateMonitorObservation(monitor)
+	meta.SetExternalName(cr, monitor.ID)
 
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -174,7 +168,7 @@ func (c *monitorExternal) Update(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalUpdate{}, errors.New(errNotMonitor)
 	}
 
-	_, err := c.service.UpdateMonitor(ctx, cr.Status.AtProvider.ID, cr.Spec.ForProvider)
+	_, err := c.service.UpdateMonitor(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "failed to update load balancer monitor in Cloudflare API")
 	}
@@ -190,8 +184,8 @@ func (c *monitorExternal) Delete(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalDelete{}, errors.New(errNotMonitor)
 	}
 
-	err := c.service.DeleteMonitor(ctx, cr.Status.AtProvider.ID, cr.Spec.ForProvider)
-	if err != nil && !loadbalancing.IsMonitorNotFound(err) {
+	err := c.service.DeleteMonitor(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil && !cferrors.IsNotFound(err) {
 		return managed.ExternalDelete{}, errors.Wrap(err, "failed to delete load balancer monitor from Cloudflare API")
 	}
 
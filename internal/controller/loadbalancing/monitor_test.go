@@ -35,6 +35,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
@@ -52,7 +54,7 @@ func withMonitorType(monitorType string) monitorModifier {
 }
 
 func withMonitorID(id string) monitorModifier {
-	return func(monitor *v1beta1.LoadBalancerMonitor) { monitor.Status.AtProvider.ID = id }
+	return func(monitor *v1beta1.LoadBalancerMonitor) { meta.SetExternalName(monitor, id) }
 }
 
 func monitor(m ...monitorModifier) *v1beta1.LoadBalancerMonitor {
@@ -99,8 +101,9 @@ func TestMonitorConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.LoadBalancerMonitor{
 					Spec: v1beta1.LoadBalancerMonitorSpec{
-						ResourceSpec: xpv1.ResourceSpec{
-							ProviderConfigReference: &xpv1.Reference{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{
+							ProviderConfigReference: &xpv1.ProviderConfigReference{
+								Kind: "ProviderConfig",
 								Name: "test-config",
 							},
 						},
@@ -135,8 +138,9 @@ func TestMonitorConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.LoadBalancerMonitor{
 					Spec: v1beta1.LoadBalancerMonitorSpec{
-						ResourceSpec: xpv1.ResourceSpec{
-							ProviderConfigReference: &xpv1.Reference{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{
+							ProviderConfigReference: &xpv1.ProviderConfigReference{
+								Kind: "ProviderConfig",
 								Name: "blah",
 							},
 						},
@@ -19,61 +19,57 @@ package loadbalancing
 import (
 	"context"
 	"net/http"
-	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/loadbalancing/v1beta1"
-	apisv1beta1 "github.com/rossigee/provider-cloudflare/apis/v1beta1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	"github.com/rossigee/provider-cloudflare/internal/clients/loadbalancing"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
 	errNotPool       = "managed resource is not a LoadBalancerPool custom resource"
-	errGetPoolPC     = "cannot get ProviderConfig"
 	errGetPoolCreds  = "cannot get credentials"
 	errNewPoolClient = "cannot create new Service"
 )
 
 // SetupPool adds a controller that reconciles LoadBalancerPool managed resources.
-func SetupPool(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupPool(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.LoadBalancerPoolKind)
 
-	o := controller.Options{
-		MaxConcurrentReconciles: 5,
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.LoadBalancerPoolGroupVersionKind),
-		managed.WithExternalConnecter(&poolConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&poolConnector{
 			kube: mgr.GetClient(),
 			newServiceFn: func(cfg clients.Config, httpClient *http.Client) (loadbalancing.PoolClient, error) {
 				return loadbalancing.NewPoolClient(cfg, httpClient)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.LoadBalancerPoolGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.LoadBalancerPoolGroupVersionKind.GroupKind())).
 		For(&v1beta1.LoadBalancerPool{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A poolConnector is expected to produce an ExternalClient when its Connect method
@@ -84,20 +80,15 @@ type poolConnector struct {
 }
 
 // Connect typically produces an ExternalClient by:
-// 1. Getting the managed resource's ProviderConfig.
-// 2. Getting the credentials specified by the ProviderConfig.
+// 1. Getting the managed resource's ProviderConfig or ClusterProviderConfig.
+// 2. Getting the credentials specified by it.
 // 3. Using the credentials to form a client.
 func (c *poolConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*v1beta1.LoadBalancerPool)
+	_, ok := mg.(*v1beta1.LoadBalancerPool)
 	if !ok {
 		return nil, errors.New(errNotPool)
 	}
 
-	pc := &apisv1beta1.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPoolPC)
-	}
-
 	// Get client configuration
 	config, err := clients.GetConfig(ctx, c.kube, mg)
 	if err != nil {
@@ -125,7 +116,9 @@ func (c *poolExternal) Observe(ctx context.Context, mg resource.Managed) (manage
 		return managed.ExternalObservation{}, errors.New(errNotPool)
 	}
 
-	if cr.Status.AtProvider.ID == "" {
+	// The ID is kept in the external name: status does not survive
+	// the update that records a successful create.
+	if meta.GetExternalName(cr) == "" {
 		return managed.ExternalObservation{
 			ResourceExists: false,
 		}, nil
@@ -136,9 +129,9 @@ func (c *poolExternal) Observe(ctx context.Context, mg resource.Managed) (manage
 		return managed.ExternalObservation{}, err
 	}
 
-	pool, err := c.service.GetPool(ctx, cr.Status.AtProvider.ID, cr.Spec.ForProvider)
+	pool, err := c.service.GetPool(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
 	if err != nil {
-		if loadbalancing.IsPoolNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			return managed.ExternalObservation{
 				ResourceExists: false,
 			}, nil
@@ -147,6 +140,7 @@ func (c *poolExternal) Observe(ctx context.Context, mg resource.Managed) (manage
 	}
 
 	cr.Status.AtProvider = loadbalancing.GeneratePoolObservation(pool)
+	cr.SetConditions(rtv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
@@ -173,6 +167,7 @@ func (c *poolExternal) Create(ctx context.Context, mg resource.Managed) (managed
 	}
 
 	cr.Status.AtProvider = loadbalancing.GeneratePoolObservation(pool)
+	meta.SetExternalName(cr, pool.ID)
 
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -190,7 +185,7 @@ func (c *poolExternal) Update(ctx context.Context, mg resource.Managed) (managed
 		return managed.ExternalUpdate{}, err
 	}
 
-	_, err := c.service.UpdatePool(ctx, cr.Status.AtProvider.ID, cr.Spec.ForProvider)
+	_, err := c.service.UpdatePool(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "failed to update load balancer pool in Cloudflare API")
 	}
@@ -206,8 +201,8 @@ func (c *poolExternal) Delete(ctx context.Context, mg resource.Managed) (managed
 		return managed.ExternalDelete{}, errors.New(errNotPool)
 	}
 
-	err := c.service.DeletePool(ctx, cr.Status.AtProvider.ID, cr.Spec.ForProvider)
-	if err != nil && !loadbalancing.IsPoolNotFound(err) {
+	err := c.service.DeletePool(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil && !cferrors.IsNotFound(err) {
 		return managed.ExternalDelete{}, errors.Wrap(err, "failed to delete load balancer pool from Cloudflare API")
 	}
 
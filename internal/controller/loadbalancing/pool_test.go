@@ -35,6 +35,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
@@ -47,7 +49,7 @@ func withPoolName(name string) poolModifier {
 }
 
 func withPoolID(id string) poolModifier {
-	return func(pool *v1beta1.LoadBalancerPool) { pool.Status.AtProvider.ID = id }
+	return func(pool *v1beta1.LoadBalancerPool) { meta.SetExternalName(pool, id) }
 }
 
 func pool(m ...poolModifier) *v1beta1.LoadBalancerPool {
@@ -94,8 +96,9 @@ func TestPoolConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.LoadBalancerPool{
 					Spec: v1beta1.LoadBalancerPoolSpec{
-						ResourceSpec: xpv1.ResourceSpec{
-							ProviderConfigReference: &xpv1.Reference{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{
+							ProviderConfigReference: &xpv1.ProviderConfigReference{
+								Kind: "ProviderConfig",
 								Name: "test-config",
 							},
 						},
@@ -130,8 +133,9 @@ func TestPoolConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.LoadBalancerPool{
 					Spec: v1beta1.LoadBalancerPoolSpec{
-						ResourceSpec: xpv1.ResourceSpec{
-							ProviderConfigReference: &xpv1.Reference{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{
+							ProviderConfigReference: &xpv1.ProviderConfigReference{
+								Kind: "ProviderConfig",
 								Name: "blah",
 							},
 						},
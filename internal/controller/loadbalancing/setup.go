@@ -17,27 +17,25 @@ limitations under the License.
 package loadbalancing
 
 import (
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 // Setup Load Balancer controllers.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func Setup(mgr ctrl.Manager, o options.Options) error {
 
-	if err := SetupLoadBalancer(mgr, l, rl); err != nil {
+	if err := SetupLoadBalancer(mgr, o); err != nil {
 		return err
 	}
 
-	if err := SetupMonitor(mgr, l, rl); err != nil {
+	if err := SetupMonitor(mgr, o); err != nil {
 		return err
 	}
 
-	if err := SetupPool(mgr, l, rl); err != nil {
+	if err := SetupPool(mgr, o); err != nil {
 		return err
 	}
 
 	return nil
 }
-
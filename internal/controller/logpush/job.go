@@ -0,0 +1,241 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logpush
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/logpush/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	jobclient "github.com/rossigee/provider-cloudflare/internal/clients/logpush/job"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+const (
+	errNotJob = "managed resource is not a Logpush Job custom resource"
+
+	errJobClientConfig = "error getting logpush job client config"
+	errJobAccount      = "cannot resolve account ID"
+
+	errJobID       = "cannot parse Logpush Job ID from external name"
+	errJobLookup   = "cannot lookup Logpush Job"
+	errJobCreation = "cannot create Logpush Job"
+	errJobUpdate   = "cannot update Logpush Job"
+	errJobDeletion = "cannot delete Logpush Job"
+	errJobNoID     = "Logpush Job was created without an ID"
+
+	jobMaxConcurrency = 5
+)
+
+// SetupJob adds a controller that reconciles Logpush Job managed resources.
+func SetupJob(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1beta1.JobKind)
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	r := managed.NewReconciler(options.ReportErrors(mgr),
+		resource.ManagedKind(v1beta1.JobGroupVersionKind),
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&jobConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (jobclient.LogpushJobAPI, error) {
+				return clients.NewClient(cfg, hc)
+			},
+		}))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.JobGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
+		// Do not initialize external-name field.
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ControllerOptions(v1beta1.JobGroupVersionKind.GroupKind())).
+		For(&v1beta1.Job{}).
+		Complete(o.Reconciler(name, r))
+}
+
+// A jobConnector is expected to produce an ExternalClient when its Connect
+// method is called.
+type jobConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (jobclient.LogpushJobAPI, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *jobConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.Job)
+	if !ok {
+		return nil, errors.New(errNotJob)
+	}
+
+	// Get client configuration
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errJobClientConfig)
+	}
+
+	api, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Jobs with a zone are zone-scoped; all others live on an account.
+	if cr.Spec.ForProvider.Zone != nil && *cr.Spec.ForProvider.Zone != "" {
+		return &jobExternal{client: jobclient.NewZoneClient(api, *cr.Spec.ForProvider.Zone)}, nil
+	}
+
+	accountID, err := clients.ResolveAccountID(ctx, api, cr.Spec.ForProvider.AccountID, *config)
+	if err != nil {
+		return nil, errors.Wrap(err, errJobAccount)
+	}
+	return &jobExternal{client: jobclient.NewClient(api, accountID)}, nil
+}
+
+// A jobExternal observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type jobExternal struct {
+	client *jobclient.JobClient
+}
+
+func (e *jobExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.Job)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotJob)
+	}
+
+	// Job does not exist if we don't have an ID stored in external-name
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	jobID, err := jobclient.ParseJobID(id)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errJobID)
+	}
+
+	observation, err := e.client.Get(ctx, jobID)
+	if err != nil {
+		return managed.ExternalObservation{},
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errJobLookup)
+	}
+
+	cr.Status.AtProvider = *observation
+	cr.SetConditions(rtv1.Available())
+
+	li := jobclient.LateInitialize(&cr.Spec.ForProvider, *observation)
+
+	upToDate, err := e.client.IsUpToDate(ctx, cr.Spec.ForProvider, *observation)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errJobLookup)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: li,
+		ResourceUpToDate:        upToDate,
+	}, nil
+}
+
+func (e *jobExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.Job)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotJob)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	observation, err := e.client.Create(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errJobCreation)
+	}
+
+	if observation.ID == nil {
+		return managed.ExternalCreation{}, errors.New(errJobNoID)
+	}
+
+	// Update the external name with the ID of the new Job
+	meta.SetExternalName(cr, strconv.Itoa(*observation.ID))
+	cr.Status.AtProvider = *observation
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *jobExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.Job)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotJob)
+	}
+
+	jobID, err := jobclient.ParseJobID(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errJobID)
+	}
+
+	observation, err := e.client.Update(ctx, jobID, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errJobUpdate)
+	}
+
+	cr.Status.AtProvider = *observation
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *jobExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.Job)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotJob)
+	}
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		// Nothing to delete if no external name is set
+		return managed.ExternalDelete{}, nil
+	}
+
+	jobID, err := jobclient.ParseJobID(id)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errJobID)
+	}
+
+	cr.SetConditions(rtv1.Deleting())
+
+	return managed.ExternalDelete{}, errors.Wrap(e.client.Delete(ctx, jobID), errJobDeletion)
+}
+
+func (e *jobExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
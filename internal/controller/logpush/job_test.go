@@ -0,0 +1,290 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logpush
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/logpush/v1beta1"
+	jobclient "github.com/rossigee/provider-cloudflare/internal/clients/logpush/job"
+)
+
+// fakeLogpushAPI records the resource container of every call so tests can
+// tell zone-scoped jobs from account-scoped ones.
+type fakeLogpushAPI struct {
+	job    cloudflare.LogpushJob
+	getErr error
+	rcs    []*cloudflare.ResourceContainer
+}
+
+func (f *fakeLogpushAPI) Accounts(_ context.Context, _ cloudflare.AccountsListParams) ([]cloudflare.Account, cloudflare.ResultInfo, error) {
+	return []cloudflare.Account{{ID: "discovered-account"}}, cloudflare.ResultInfo{}, nil
+}
+
+func (f *fakeLogpushAPI) CreateLogpushJob(_ context.Context, rc *cloudflare.ResourceContainer, p cloudflare.CreateLogpushJobParams) (*cloudflare.LogpushJob, error) {
+	f.rcs = append(f.rcs, rc)
+	j := f.job
+	j.Name = p.Name
+	j.Dataset = p.Dataset
+	j.DestinationConf = p.DestinationConf
+	return &j, nil
+}
+
+func (f *fakeLogpushAPI) GetLogpushJob(_ context.Context, rc *cloudflare.ResourceContainer, _ int) (cloudflare.LogpushJob, error) {
+	f.rcs = append(f.rcs, rc)
+	return f.job, f.getErr
+}
+
+func (f *fakeLogpushAPI) UpdateLogpushJob(_ context.Context, rc *cloudflare.ResourceContainer, _ cloudflare.UpdateLogpushJobParams) error {
+	f.rcs = append(f.rcs, rc)
+	return nil
+}
+
+func (f *fakeLogpushAPI) DeleteLogpushJob(_ context.Context, rc *cloudflare.ResourceContainer, _ int) error {
+	f.rcs = append(f.rcs, rc)
+	return nil
+}
+
+func (f *fakeLogpushAPI) ListLogpushJobs(_ context.Context, rc *cloudflare.ResourceContainer, _ cloudflare.ListLogpushJobsParams) ([]cloudflare.LogpushJob, error) {
+	f.rcs = append(f.rcs, rc)
+	return []cloudflare.LogpushJob{f.job}, nil
+}
+
+type jobModifier func(*v1beta1.Job)
+
+func withExternalName(name string) jobModifier {
+	return func(j *v1beta1.Job) { meta.SetExternalName(j, name) }
+}
+
+func withDestination(dest string) jobModifier {
+	return func(j *v1beta1.Job) { j.Spec.ForProvider.DestinationConf = dest }
+}
+
+func job(m ...jobModifier) *v1beta1.Job {
+	cr := &v1beta1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-job"},
+		Spec: v1beta1.JobSpec{
+			ForProvider: v1beta1.JobParameters{
+				Dataset:         "http_requests",
+				Name:            "example",
+				DestinationConf: "s3://bucket/logs?region=us-east-1",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func remoteJob() cloudflare.LogpushJob {
+	return cloudflare.LogpushJob{
+		ID:              42,
+		Dataset:         "http_requests",
+		Name:            "example",
+		DestinationConf: "s3://bucket/logs?region=us-east-1",
+		Enabled:         true,
+		Frequency:       "high",
+	}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		o              managed.ExternalObservation
+		err            error
+		enabled        *bool
+		frequency      *string
+		observedID     *int
+		observedExists bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		api    *fakeLogpushAPI
+		mg     resource.Managed
+		want   want
+	}{
+		"ErrNotJob": {
+			reason: "An error should be returned if the managed resource is not a *Job",
+			api:    &fakeLogpushAPI{},
+			mg:     nil,
+			want:   want{err: errors.New(errNotJob)},
+		},
+		"NoExternalName": {
+			reason: "A Job without an external name does not exist yet",
+			api:    &fakeLogpushAPI{},
+			mg:     job(),
+			want:   want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"ErrBadExternalName": {
+			reason: "A non-numeric external name cannot be a Logpush Job ID",
+			api:    &fakeLogpushAPI{},
+			mg:     job(withExternalName("not-a-number")),
+			want: want{err: errors.Wrap(func() error {
+				_, err := jobclient.ParseJobID("not-a-number")
+				return err
+			}(), errJobID)},
+		},
+		"NotFound": {
+			reason: "A Job that Cloudflare no longer knows about does not exist",
+			api:    &fakeLogpushAPI{getErr: &cloudflare.NotFoundError{}},
+			mg:     job(withExternalName("42")),
+			want:   want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"ErrLookup": {
+			reason: "Other lookup errors should be returned",
+			api:    &fakeLogpushAPI{getErr: errBoom},
+			mg:     job(withExternalName("42")),
+			want:   want{err: errors.Wrap(errors.Wrap(errBoom, "cannot get logpush job"), errJobLookup)},
+		},
+		"LateInitializedAndUpToDate": {
+			reason: "Unset optional fields should be late-initialized from Cloudflare",
+			api:    &fakeLogpushAPI{job: remoteJob()},
+			mg:     job(withExternalName("42")),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceLateInitialized: true,
+					ResourceUpToDate:        true,
+				},
+				enabled:        ptr.To(true),
+				frequency:      ptr.To("high"),
+				observedID:     ptr.To(42),
+				observedExists: true,
+			},
+		},
+		"NotUpToDate": {
+			reason: "A changed destination should be reported as drift",
+			api:    &fakeLogpushAPI{job: remoteJob()},
+			mg:     job(withExternalName("42"), withDestination("r2://other")),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceLateInitialized: true,
+					ResourceUpToDate:        false,
+				},
+				enabled:        ptr.To(true),
+				frequency:      ptr.To("high"),
+				observedID:     ptr.To(42),
+				observedExists: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &jobExternal{client: jobclient.NewClient(tc.api, "test-account")}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if !tc.want.observedExists {
+				return
+			}
+			cr := tc.mg.(*v1beta1.Job)
+			if diff := cmp.Diff(tc.want.enabled, cr.Spec.ForProvider.Enabled); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want enabled, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.frequency, cr.Spec.ForProvider.Frequency); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want frequency, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.observedID, cr.Status.AtProvider.ID); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want status ID, +got:\n%s\n", tc.reason, diff)
+			}
+			if c := cr.GetCondition(xpv1.TypeReady); c.Reason != xpv1.ReasonAvailable {
+				t.Errorf("\n%s\ne.Observe(...): want Available condition, got %q", tc.reason, c.Reason)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	api := &fakeLogpushAPI{job: remoteJob()}
+	e := &jobExternal{client: jobclient.NewClient(api, "test-account")}
+	cr := job()
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("e.Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("42", meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("e.Create(...): -want external name, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(ptr.To(42), cr.Status.AtProvider.ID); diff != "" {
+		t.Errorf("e.Create(...): -want status ID, +got:\n%s", diff)
+	}
+}
+
+func TestScope(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		client func(api jobclient.LogpushJobAPI) *jobclient.JobClient
+		want   *cloudflare.ResourceContainer
+	}{
+		"AccountScoped": {
+			reason: "Jobs without a zone should be managed on the configured account",
+			client: func(api jobclient.LogpushJobAPI) *jobclient.JobClient {
+				return jobclient.NewClient(api, "test-account")
+			},
+			want: cloudflare.AccountIdentifier("test-account"),
+		},
+		"ZoneScoped": {
+			reason: "Jobs with a zone should be managed on that zone",
+			client: func(api jobclient.LogpushJobAPI) *jobclient.JobClient {
+				return jobclient.NewZoneClient(api, "test-zone")
+			},
+			want: cloudflare.ZoneIdentifier("test-zone"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeLogpushAPI{job: remoteJob()}
+			e := &jobExternal{client: tc.client(api)}
+			cr := job(withExternalName("42"))
+
+			if _, err := e.Update(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): unexpected error: %v", tc.reason, err)
+			}
+			if _, err := e.Delete(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\ne.Delete(...): unexpected error: %v", tc.reason, err)
+			}
+			for _, rc := range api.rcs {
+				if diff := cmp.Diff(tc.want, rc); diff != "" {
+					t.Errorf("\n%s\nresource container: -want, +got:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
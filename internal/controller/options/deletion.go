@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// OrphanOnDelete wraps the supplied connector so that a deleted managed
+// resource whose deprecated deletionPolicy is Orphan keeps its external
+// resource. The managed reconciler only honors management policies for
+// namespaced managed resources, so without this a resource created with
+// deletionPolicy Orphan before management policies replaced it would
+// delete its external resource.
+func OrphanOnDelete(c managed.ExternalConnector) managed.ExternalConnector {
+	return &orphaningConnector{connector: c}
+}
+
+type orphaningConnector struct {
+	connector managed.ExternalConnector
+}
+
+// Connect returns an external client that reports the external resource
+// of a deleted managed resource with a deletionPolicy of Orphan as gone,
+// without connecting to the API, so that the managed reconciler removes
+// its finalizer. It connects as usual otherwise.
+func (c *orphaningConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if meta.WasDeleted(mg) && deletionPolicy(mg) == xpv1.DeletionOrphan {
+		return orphanedExternal{}, nil
+	}
+	return c.connector.Connect(ctx, mg)
+}
+
+// deletionPolicy returns the deprecated deletionPolicy of the supplied
+// managed resource, or an empty policy if it has none.
+func deletionPolicy(mg resource.Managed) xpv1.DeletionPolicy {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return ""
+	}
+	p, _, _ := unstructured.NestedString(u, "spec", "deletionPolicy")
+	return xpv1.DeletionPolicy(p)
+}
+
+// An orphanedExternal is the external client of a managed resource whose
+// external resource is orphaned.
+type orphanedExternal struct{}
+
+func (orphanedExternal) Observe(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+	return managed.ExternalObservation{ResourceExists: false}, nil
+}
+
+func (orphanedExternal) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (orphanedExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (orphanedExternal) Delete(_ context.Context, _ resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+func (orphanedExternal) Disconnect(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+)
+
+func TestOrphanOnDelete(t *testing.T) {
+	now := metav1.Now()
+
+	type want struct {
+		connected bool
+		exists    bool
+	}
+
+	cases := map[string]struct {
+		reason  string
+		deleted bool
+		policy  xpv1.DeletionPolicy
+		want    want
+	}{
+		"DeletedOrphan": {
+			reason:  "A deleted resource with a deletionPolicy of Orphan should report its external resource as gone without connecting",
+			deleted: true,
+			policy:  xpv1.DeletionOrphan,
+			want:    want{connected: false, exists: false},
+		},
+		"DeletedDelete": {
+			reason:  "A deleted resource with a deletionPolicy of Delete should connect",
+			deleted: true,
+			policy:  xpv1.DeletionDelete,
+			want:    want{connected: true, exists: true},
+		},
+		"DeletedNoPolicy": {
+			reason:  "A deleted resource without a deletionPolicy should connect",
+			deleted: true,
+			want:    want{connected: true, exists: true},
+		},
+		"NotDeletedOrphan": {
+			reason: "A resource with a deletionPolicy of Orphan that is not deleted should connect",
+			policy: xpv1.DeletionOrphan,
+			want:   want{connected: true, exists: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1beta1.Record{}
+			cr.Spec.DeletionPolicy = tc.policy
+			if tc.deleted {
+				cr.SetDeletionTimestamp(&now)
+			}
+
+			connected := false
+			c := OrphanOnDelete(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				connected = true
+				return &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{ResourceExists: true}, nil
+					},
+				}, nil
+			}))
+
+			ec, err := c.Connect(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\nc.Connect(...): %v", tc.reason, err)
+			}
+			o, err := ec.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("\n%s\nec.Observe(...): %v", tc.reason, err)
+			}
+			got := want{connected: connected, exists: o.ResourceExists}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nOrphanOnDelete(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+)
+
+// Reasons the Synced condition of a managed resource reports when a request
+// to the Cloudflare API failed with an error of a known category.
+const (
+	ReasonRateLimited xpv1.ConditionReason = "RateLimited"
+	ReasonConflict    xpv1.ConditionReason = "Conflict"
+	ReasonAuth        xpv1.ConditionReason = "AuthenticationFailed"
+	ReasonPermission  xpv1.ConditionReason = "PermissionDenied"
+	ReasonValidation  xpv1.ConditionReason = "InvalidRequest"
+	ReasonTransient   xpv1.ConditionReason = "TransientFailure"
+)
+
+const (
+	// RateLimitedRequeue is how long a managed resource waits after its
+	// requests were rate limited. Cloudflare limits requests per five
+	// minute window, so retrying sooner only spends the next window.
+	RateLimitedRequeue = time.Minute
+
+	// RejectedRequeue is how long a managed resource waits after a request
+	// was rejected in a way that will not change until the resource or its
+	// credentials do. Changes to the resource are reconciled right away.
+	RejectedRequeue = 5 * time.Minute
+)
+
+// An ErrorHandling says how a reconcile that failed with an error of a
+// category is reported and retried.
+type ErrorHandling struct {
+	// Reason reported by the Synced condition.
+	Reason xpv1.ConditionReason
+
+	// RequeueAfter is how long to wait before the next reconcile, or 0 to
+	// retry with the exponential backoff of the controller.
+	RequeueAfter time.Duration
+}
+
+// HandlingFor returns how a reconcile that failed with an error of the
+// supplied category is reported and retried, and false if the error is
+// left to the managed reconciler.
+func HandlingFor(c cferrors.Category) (ErrorHandling, bool) {
+	switch c {
+	case cferrors.RateLimited:
+		return ErrorHandling{Reason: ReasonRateLimited, RequeueAfter: RateLimitedRequeue}, true
+	case cferrors.Conflict:
+		return ErrorHandling{Reason: ReasonConflict}, true
+	case cferrors.Transient:
+		return ErrorHandling{Reason: ReasonTransient}, true
+	case cferrors.Auth:
+		return ErrorHandling{Reason: ReasonAuth, RequeueAfter: RejectedRequeue}, true
+	case cferrors.Permission:
+		return ErrorHandling{Reason: ReasonPermission, RequeueAfter: RejectedRequeue}, true
+	case cferrors.Validation:
+		return ErrorHandling{Reason: ReasonValidation, RequeueAfter: RejectedRequeue}, true
+	case cferrors.Unknown, cferrors.NotFound:
+	}
+	return ErrorHandling{}, false
+}
+
+// A failure records the last error an external client returned during a
+// reconcile.
+type failure struct {
+	category cferrors.Category
+	mg       resource.Managed
+}
+
+type failureKey struct{}
+
+// record records the category of the supplied error in the failure of the
+// reconcile, if any, and returns the error.
+func record(ctx context.Context, mg resource.Managed, err error) error {
+	if err == nil {
+		return nil
+	}
+	if f, ok := ctx.Value(failureKey{}).(*failure); ok {
+		f.category, f.mg = cferrors.Classify(err), mg
+	}
+	return err
+}
+
+// ClassifyErrors wraps the supplied connector so that the errors it and
+// its external clients return are classified. A managed reconciler built
+// with a manager returned by ReportErrors, and wrapped by
+// Options.Reconciler, then reports the category of the error in the
+// Synced condition of the managed resource, and retries as the category
+// calls for.
+func ClassifyErrors(c managed.ExternalConnector) managed.ExternalConnector {
+	return &classifyingConnector{connector: c}
+}
+
+type classifyingConnector struct {
+	connector managed.ExternalConnector
+}
+
+func (c *classifyingConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connector.Connect(ctx, mg)
+	if err != nil {
+		return nil, record(ctx, mg, err)
+	}
+	return &classifyingExternal{ExternalClient: ec}, nil
+}
+
+type classifyingExternal struct {
+	managed.ExternalClient
+}
+
+func (e *classifyingExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	return o, record(ctx, mg, err)
+}
+
+func (e *classifyingExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	return c, record(ctx, mg, err)
+}
+
+func (e *classifyingExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.ExternalClient.Update(ctx, mg)
+	return u, record(ctx, mg, err)
+}
+
+func (e *classifyingExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	d, err := e.ExternalClient.Delete(ctx, mg)
+	return d, record(ctx, mg, err)
+}
+
+// ReportErrors wraps the supplied manager so that a managed reconciler built
+// with it reports the category of the errors classified by ClassifyErrors
+// in the Synced condition it writes, rather than ReconcileError. A failure
+// that is already reported is not written again, so that the write does
+// not trigger another reconcile before the category calls for one.
+func ReportErrors(mgr manager.Manager) manager.Manager {
+	return &reportingManager{Manager: mgr}
+}
+
+type reportingManager struct {
+	manager.Manager
+}
+
+func (m *reportingManager) GetClient() client.Client {
+	return &reportingClient{Client: m.Manager.GetClient()}
+}
+
+type reportingClient struct {
+	client.Client
+}
+
+func (c *reportingClient) Status() client.SubResourceWriter {
+	return &reportingStatusWriter{SubResourceWriter: c.Client.Status(), reader: c.Client}
+}
+
+type reportingStatusWriter struct {
+	client.SubResourceWriter
+	reader client.Reader
+}
+
+// Update updates the status of the supplied object. If it is the managed
+// resource of a reconcile that failed with an error of a known category it
+// replaces the ReconcileError reason of the Synced condition with the
+// category, and skips the update if the status is already as written.
+func (w *reportingStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	f, ok := ctx.Value(failureKey{}).(*failure)
+	if !ok || f.mg == nil || f.mg != obj {
+		return w.SubResourceWriter.Update(ctx, obj, opts...)
+	}
+	h, ok := HandlingFor(f.category)
+	c := f.mg.GetCondition(xpv1.TypeSynced)
+	if !ok || c.Reason != xpv1.ReasonReconcileError {
+		return w.SubResourceWriter.Update(ctx, obj, opts...)
+	}
+	c.Reason = h.Reason
+
+	current, _ := f.mg.DeepCopyObject().(resource.Managed)
+	if err := w.reader.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+		f.mg.SetConditions(c)
+		return w.SubResourceWriter.Update(ctx, obj, opts...)
+	}
+
+	// Keep the transition time of a failure that is already reported.
+	if cc := current.GetCondition(xpv1.TypeSynced); cc.Equal(c) {
+		c = cc
+	}
+	f.mg.SetConditions(c)
+	if sameStatus(current, f.mg) {
+		return nil
+	}
+	return w.SubResourceWriter.Update(ctx, obj, opts...)
+}
+
+// sameStatus returns true if the supplied objects are the same but for
+// their metadata.
+func sameStatus(a, b runtime.Object) bool {
+	ua, err := runtime.DefaultUnstructuredConverter.ToUnstructured(a)
+	if err != nil {
+		return false
+	}
+	ub, err := runtime.DefaultUnstructuredConverter.ToUnstructured(b)
+	if err != nil {
+		return false
+	}
+	delete(ua, "metadata")
+	delete(ub, "metadata")
+	return equality.Semantic.DeepEqual(ua, ub)
+}
+
+// A classifyingReconciler retries the errors classified by the connectors
+// returned by ClassifyErrors.
+type classifyingReconciler struct {
+	reconcile.Reconciler
+}
+
+// Reconcile runs the wrapped reconciler. If it failed with an error of a
+// known category it requeues as the category calls for.
+func (r *classifyingReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	f := &failure{}
+	res, err := r.Reconciler.Reconcile(context.WithValue(ctx, failureKey{}, f), req)
+	if err != nil || f.mg == nil || !res.Requeue { //nolint:staticcheck // The managed reconciler requeues failures with Requeue.
+		return res, err
+	}
+
+	h, ok := HandlingFor(f.category)
+	if !ok || h.RequeueAfter == 0 {
+		return res, nil
+	}
+	return reconcile.Result{RequeueAfter: h.RequeueAfter}, nil
+}
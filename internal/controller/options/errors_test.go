@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestClassifyErrors(t *testing.T) {
+	type want struct {
+		result  reconcile.Result
+		reason  xpv1.ConditionReason
+		updates int
+	}
+
+	cases := map[string]struct {
+		reason     string
+		connectErr error
+		observeErr error
+		reconciles int
+		want       want
+	}{
+		"Success": {
+			reason: "A reconcile without errors should be left alone",
+			want:   want{result: reconcile.Result{}},
+		},
+		"Unknown": {
+			reason:     "An error of no known category should be left to the managed reconciler",
+			observeErr: errors.New("boom"),
+			want:       want{result: reconcile.Result{Requeue: true}, reason: xpv1.ReasonReconcileError, updates: 1},
+		},
+		"RateLimited": {
+			reason:     "A rate limited request should be reported and retried after the rate limit window",
+			observeErr: errors.Wrap(&cloudflare.Error{StatusCode: http.StatusTooManyRequests}, "cannot observe"),
+			want:       want{result: reconcile.Result{RequeueAfter: RateLimitedRequeue}, reason: ReasonRateLimited, updates: 1},
+		},
+		"RateLimitedAgain": {
+			reason:     "A rate limited request that is already reported should not be written again",
+			observeErr: errors.Wrap(&cloudflare.Error{StatusCode: http.StatusTooManyRequests}, "cannot observe"),
+			reconciles: 2,
+			want:       want{result: reconcile.Result{RequeueAfter: RateLimitedRequeue}, reason: ReasonRateLimited, updates: 1},
+		},
+		"Transient": {
+			reason:     "A transient failure should be reported and retried with the default backoff",
+			observeErr: &cloudflare.Error{StatusCode: http.StatusServiceUnavailable},
+			want:       want{result: reconcile.Result{Requeue: true}, reason: ReasonTransient, updates: 1},
+		},
+		"Permission": {
+			reason:     "A request the credentials may not make should be reported and retried rarely",
+			connectErr: &cloudflare.Error{StatusCode: http.StatusForbidden},
+			want:       want{result: reconcile.Result{RequeueAfter: RejectedRequeue}, reason: ReasonPermission, updates: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stored := &fake.Managed{}
+			updates := 0
+			kube := &reportingClient{Client: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					*obj.(*fake.Managed) = *stored.DeepCopyObject().(*fake.Managed)
+					return nil
+				},
+				MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					updates++
+					stored = obj.DeepCopyObject().(*fake.Managed)
+					return nil
+				},
+			}}
+			c := ClassifyErrors(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				if tc.connectErr != nil {
+					return nil, tc.connectErr
+				}
+				return &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, tc.observeErr
+					},
+				}, nil
+			}))
+
+			// Fail like the managed reconciler does.
+			r := &classifyingReconciler{Reconciler: reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+				mg := &fake.Managed{}
+				if err := kube.Get(ctx, req.NamespacedName, mg); err != nil {
+					return reconcile.Result{}, err
+				}
+				ec, err := c.Connect(ctx, mg)
+				if err == nil {
+					_, err = ec.Observe(ctx, mg)
+				}
+				if err != nil {
+					mg.SetConditions(xpv1.ReconcileError(err))
+					return reconcile.Result{Requeue: true}, kube.Status().Update(ctx, mg)
+				}
+				return reconcile.Result{}, nil
+			})}
+
+			var got reconcile.Result
+			for range max(tc.reconciles, 1) {
+				var err error
+				got, err = r.Reconcile(context.Background(), reconcile.Request{})
+				if err != nil {
+					t.Fatalf("\n%s\nr.Reconcile(...): %v", tc.reason, err)
+				}
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want result, +got result:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.reason, stored.GetCondition(xpv1.TypeSynced).Reason); diff != "" && tc.want.reason != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want reason, +got reason:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.updates, updates); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want status updates, +got status updates:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package options configures the controllers of the provider.
+package options
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// AnnotationPollInterval overrides the poll interval of the managed resource
+// it is set on, for example "30s" or "1h".
+const AnnotationPollInterval = "cloudflare.crossplane.io/poll-interval"
+
+const (
+	errOverrideFormat  = "override %q is not of the form <group, kind or kind.group>=<value>"
+	errPollInterval    = "poll interval of %q must be a positive duration"
+	errConcurrency     = "concurrency of %q must be a positive integer"
+	errAmbiguousKind   = "kind %q is in more than one API group; use one of %s"
+	errUnknownOverride = "%q is not an API group or managed resource kind of the provider"
+)
+
+// Options configure the controllers added by each Setup function.
+type Options struct {
+	controller.Options
+
+	// PollIntervals override PollInterval for some controllers. They are
+	// keyed by the lowercase kind and group of a managed resource, as
+	// returned by GroupKind.String, such as
+	// loadbalancerpool.loadbalancing.cloudflare.m.crossplane.io, or by the
+	// first label of its API group, such as loadbalancing. A kind takes
+	// precedence over its group.
+	PollIntervals map[string]time.Duration
+
+	// Concurrency overrides MaxConcurrentReconciles for some controllers,
+	// keyed like PollIntervals.
+	Concurrency map[string]int
+}
+
+// PollIntervalFor returns the poll interval of the controller of the
+// supplied kind.
+func (o Options) PollIntervalFor(gk schema.GroupKind) time.Duration {
+	if d, ok := lookup(o.PollIntervals, gk); ok {
+		return d
+	}
+	return o.PollInterval
+}
+
+// ControllerOptions returns the controller-runtime options of the controller
+// of the supplied kind.
+func (o Options) ControllerOptions(gk schema.GroupKind) ctrlcontroller.Options {
+	if n, ok := lookup(o.Concurrency, gk); ok {
+		o.MaxConcurrentReconciles = n
+	}
+	return o.ForControllerRuntime()
+}
+
+// Reconciler wraps the supplied reconciler so that it reports and retries
+// the errors classified by ClassifyErrors, and is subject to the
+// GlobalRateLimiter, if any.
+func (o Options) Reconciler(name string, r reconcile.Reconciler) reconcile.Reconciler {
+	r = &classifyingReconciler{Reconciler: r}
+	if o.GlobalRateLimiter == nil {
+		return r
+	}
+	return ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)
+}
+
+// PollIntervalHook returns the poll interval set by AnnotationPollInterval
+// on the supplied managed resource, or the supplied poll interval if it has
+// none. An invalid annotation is ignored.
+func PollIntervalHook(mg resource.Managed, pollInterval time.Duration) time.Duration {
+	v, ok := mg.GetAnnotations()[AnnotationPollInterval]
+	if !ok {
+		return pollInterval
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return pollInterval
+	}
+	return d
+}
+
+// ParsePollIntervals parses overrides of the poll interval, such as
+// "zone=1h" or "LoadBalancerPool=1m", into the keys of PollIntervals. The
+// supplied managed resource kinds are those that may be overridden.
+func ParsePollIntervals(overrides []string, kinds []schema.GroupKind) (map[string]time.Duration, error) {
+	return parse(overrides, kinds, func(key, v string) (time.Duration, error) {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, errors.Errorf(errPollInterval, key)
+		}
+		return d, nil
+	})
+}
+
+// ParseConcurrency parses overrides of the maximum concurrent reconciles,
+// such as "dns=10", into the keys of Concurrency, like ParsePollIntervals.
+func ParseConcurrency(overrides []string, kinds []schema.GroupKind) (map[string]int, error) {
+	return parse(overrides, kinds, func(key, v string) (int, error) {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return 0, errors.Errorf(errConcurrency, key)
+		}
+		return n, nil
+	})
+}
+
+func parse[T any](overrides []string, kinds []schema.GroupKind, value func(key, v string) (T, error)) (map[string]T, error) {
+	out := make(map[string]T, len(overrides))
+	for _, o := range overrides {
+		key, v, ok := strings.Cut(o, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		if !ok || key == "" {
+			return nil, errors.Errorf(errOverrideFormat, o)
+		}
+		t, err := value(key, strings.TrimSpace(v))
+		if err != nil {
+			return nil, err
+		}
+		k, err := overrideKey(key, kinds)
+		if err != nil {
+			return nil, err
+		}
+		out[k] = t
+	}
+	return out, nil
+}
+
+// overrideKey returns the key of the override of the supplied lowercase
+// kind.group, kind or group. A kind without its group is only accepted if
+// exactly one of the supplied kinds has it, so that an override of a kind
+// such as rule does not apply to the kinds of that name in other groups.
+func overrideKey(key string, kinds []schema.GroupKind) (string, error) {
+	var matches []string
+	groups := map[string]bool{}
+	for _, gk := range kinds {
+		kindGroup := strings.ToLower(gk.String())
+		if key == kindGroup {
+			return kindGroup, nil
+		}
+		if key == strings.ToLower(gk.Kind) {
+			matches = append(matches, kindGroup)
+		}
+		group, _, _ := strings.Cut(gk.Group, ".")
+		groups[group] = true
+	}
+	switch {
+	case len(matches) == 1:
+		return matches[0], nil
+	case len(matches) > 1:
+		slices.Sort(matches)
+		return "", errors.Errorf(errAmbiguousKind, key, strings.Join(matches, ", "))
+	case groups[key]:
+		return key, nil
+	}
+	return "", errors.Errorf(errUnknownOverride, key)
+}
+
+// lookup returns the override of the supplied kind, falling back to that of
+// its group.
+func lookup[T any](m map[string]T, gk schema.GroupKind) (T, bool) {
+	if v, ok := m[strings.ToLower(gk.String())]; ok {
+		return v, true
+	}
+	group, _, _ := strings.Cut(gk.Group, ".")
+	v, ok := m[group]
+	return v, ok
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+var (
+	pool = schema.GroupKind{Group: "loadbalancing.cloudflare.m.crossplane.io", Kind: "LoadBalancerPool"}
+	lb   = schema.GroupKind{Group: "loadbalancing.cloudflare.m.crossplane.io", Kind: "LoadBalancer"}
+	zone = schema.GroupKind{Group: "zone.cloudflare.m.crossplane.io", Kind: "Zone"}
+
+	firewallRule  = schema.GroupKind{Group: "firewall.cloudflare.m.crossplane.io", Kind: "Rule"}
+	transformRule = schema.GroupKind{Group: "transform.cloudflare.m.crossplane.io", Kind: "Rule"}
+
+	kinds = []schema.GroupKind{pool, lb, zone, firewallRule, transformRule}
+)
+
+func TestOverrides(t *testing.T) {
+	o := Options{
+		Options: controller.Options{PollInterval: 5 * time.Minute, MaxConcurrentReconciles: 5},
+		PollIntervals: map[string]time.Duration{
+			"loadbalancing": 10 * time.Minute,
+			"loadbalancerpool.loadbalancing.cloudflare.m.crossplane.io": time.Minute,
+			"rule.firewall.cloudflare.m.crossplane.io":                  30 * time.Minute,
+		},
+		Concurrency: map[string]int{"loadbalancing": 2},
+	}
+
+	type want struct {
+		PollInterval time.Duration
+		Concurrency  int
+	}
+
+	cases := map[string]struct {
+		reason string
+		gk     schema.GroupKind
+		want   want
+	}{
+		"Kind": {
+			reason: "An override of a kind should take precedence over that of its group.",
+			gk:     pool,
+			want:   want{PollInterval: time.Minute, Concurrency: 2},
+		},
+		"Group": {
+			reason: "An override of a group should apply to each of its kinds.",
+			gk:     lb,
+			want:   want{PollInterval: 10 * time.Minute, Concurrency: 2},
+		},
+		"Default": {
+			reason: "A kind without overrides should use the defaults.",
+			gk:     zone,
+			want:   want{PollInterval: 5 * time.Minute, Concurrency: 5},
+		},
+		"SameKindOtherGroup": {
+			reason: "An override of a kind should not apply to the kind of that name in another group.",
+			gk:     transformRule,
+			want:   want{PollInterval: 5 * time.Minute, Concurrency: 5},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{
+				PollInterval: o.PollIntervalFor(tc.gk),
+				Concurrency:  o.ControllerOptions(tc.gk).MaxConcurrentReconciles,
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\n-want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPollIntervalHook(t *testing.T) {
+	cases := map[string]struct {
+		reason      string
+		annotations map[string]string
+		want        time.Duration
+	}{
+		"NoAnnotation": {
+			reason: "A resource without the annotation should use the poll interval of its controller.",
+			want:   5 * time.Minute,
+		},
+		"Annotation": {
+			reason:      "A resource with the annotation should use its poll interval.",
+			annotations: map[string]string{AnnotationPollInterval: "1h"},
+			want:        time.Hour,
+		},
+		"Invalid": {
+			reason:      "An invalid annotation should be ignored.",
+			annotations: map[string]string{AnnotationPollInterval: "hourly"},
+			want:        5 * time.Minute,
+		},
+		"NotPositive": {
+			reason:      "An annotation that is not positive should be ignored.",
+			annotations: map[string]string{AnnotationPollInterval: "0s"},
+			want:        5 * time.Minute,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			got := PollIntervalHook(mg, 5*time.Minute)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nPollIntervalHook(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestParsePollIntervals(t *testing.T) {
+	type want struct {
+		out map[string]time.Duration
+		err error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		overrides []string
+		want      want
+	}{
+		"Valid": {
+			reason:    "Overrides of a kind should be keyed by its lowercase kind and group, and those of a group by the group.",
+			overrides: []string{"loadbalancing=1h", "LoadBalancerPool = 1m", "Rule.firewall.cloudflare.m.crossplane.io=10m"},
+			want: want{out: map[string]time.Duration{
+				"loadbalancing": time.Hour,
+				"loadbalancerpool.loadbalancing.cloudflare.m.crossplane.io": time.Minute,
+				"rule.firewall.cloudflare.m.crossplane.io":                  10 * time.Minute,
+			}},
+		},
+		"AmbiguousKind": {
+			reason:    "A kind that is in more than one group should be rejected without its group.",
+			overrides: []string{"rule=10m"},
+			want: want{err: errors.Errorf(errAmbiguousKind, "rule",
+				"rule.firewall.cloudflare.m.crossplane.io, rule.transform.cloudflare.m.crossplane.io")},
+		},
+		"Unknown": {
+			reason:    "An override of an unknown group or kind should be rejected.",
+			overrides: []string{"zones=1h"},
+			want:      want{err: errors.Errorf(errUnknownOverride, "zones")},
+		},
+		"NoValue": {
+			reason:    "An override without a value should be rejected.",
+			overrides: []string{"zone"},
+			want:      want{err: errors.Errorf(errOverrideFormat, "zone")},
+		},
+		"NoKey": {
+			reason:    "An override without a group or kind should be rejected.",
+			overrides: []string{"=1h"},
+			want:      want{err: errors.Errorf(errOverrideFormat, "=1h")},
+		},
+		"InvalidDuration": {
+			reason:    "An override that is not a duration should be rejected.",
+			overrides: []string{"zone=hourly"},
+			want:      want{err: errors.Errorf(errPollInterval, "zone")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParsePollIntervals(tc.overrides, kinds)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nParsePollIntervals(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.out, got); diff != "" {
+				t.Errorf("\n%s\nParsePollIntervals(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestParseConcurrency(t *testing.T) {
+	type want struct {
+		out map[string]int
+		err error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		overrides []string
+		want      want
+	}{
+		"Valid": {
+			reason:    "Overrides should be keyed by their lowercase group or kind.",
+			overrides: []string{"LoadBalancing=10"},
+			want:      want{out: map[string]int{"loadbalancing": 10}},
+		},
+		"NotPositive": {
+			reason:    "An override that is not positive should be rejected.",
+			overrides: []string{"loadbalancing=0"},
+			want:      want{err: errors.Errorf(errConcurrency, "loadbalancing")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseConcurrency(tc.overrides, kinds)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nParseConcurrency(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.out, got); diff != "" {
+				t.Errorf("\n%s\nParseConcurrency(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -18,94 +18,75 @@ package originssl
 
 import (
 	"context"
-	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	originsslv1beta1 "github.com/rossigee/provider-cloudflare/apis/originssl/v1beta1"
-	v1beta1 "github.com/rossigee/provider-cloudflare/apis/v1beta1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	certificate "github.com/rossigee/provider-cloudflare/internal/clients/originssl/certificate"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
 	errNotCertificate    = "managed resource is not a Certificate custom resource"
-	errTrackPCUsage      = "cannot track ProviderConfig usage"
-	errGetPC             = "cannot get ProviderConfig"
 	errGetCreds          = "cannot get credentials"
 	errNewCertClient     = "cannot create new Certificate client"
 )
 
 // SetupCertificate adds a controller that reconciles Certificate managed resources.
-func SetupCertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupCertificate(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(originsslv1beta1.CertificateKind)
 
-	o := controller.Options{
-		MaxConcurrentReconciles: 5,
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(originsslv1beta1.CertificateGroupVersionKind),
-		managed.WithExternalConnecter(&certificateConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&certificateConnector{
 			kube: mgr.GetClient(),
 			newServiceFn: func(api *cloudflare.API) *certificate.CloudflareOriginCertificateClient {
 				return certificate.NewClientFromAPI(api)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(originsslv1beta1.CertificateGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(originsslv1beta1.CertificateGroupVersionKind.GroupKind())).
 		For(&originsslv1beta1.Certificate{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A certificateConnector is expected to produce an ExternalClient when its Connect method
 // is called.
 type certificateConnector struct {
 	kube         client.Client
-	usage        resource.Tracker
 	newServiceFn func(*cloudflare.API) *certificate.CloudflareOriginCertificateClient
 }
 
 // Connect typically produces an ExternalClient by:
-// 1. Tracking that the managed resource is using a ProviderConfig.
-// 2. Getting the managed resource's ProviderConfig.
-// 3. Getting the credentials specified by the ProviderConfig.
-// 4. Using the credentials to form a client.
+// 1. Getting the managed resource's ProviderConfig or ClusterProviderConfig.
+// 2. Getting the credentials specified by it.
+// 3. Using the credentials to form a client.
 func (c *certificateConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*originsslv1beta1.Certificate)
+	_, ok := mg.(*originsslv1beta1.Certificate)
 	if !ok {
 		return nil, errors.New(errNotCertificate)
 	}
 
-	if err := c.usage.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsage)
-	}
-
-	pc := &v1beta1.ProviderConfig{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, errGetPC)
-	}
-
 	// Get client configuration
 	config, err := clients.GetConfig(ctx, c.kube, mg)
 	if err != nil {
@@ -142,7 +123,7 @@ func (c *certificateExternal) Observe(ctx context.Context, mg resource.Managed)
 	obs, err := c.service.Get(ctx, meta.GetExternalName(cr))
 	if err != nil {
 		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(clients.IsNotFound, err), "cannot get external resource")
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), "cannot get external resource")
 	}
 
 	cr.Status.AtProvider = *obs
@@ -213,6 +194,6 @@ func (c *certificateExternal) Disconnect(ctx context.Context) error {
 }
 
 // Setup adds controllers for Origin SSL resources.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	return SetupCertificate(mgr, l, rl)
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	return SetupCertificate(mgr, o)
 }
\ No newline at end of file
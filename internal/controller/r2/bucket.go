@@ -18,25 +18,23 @@ package r2
 
 import (
 	"context"
-	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/r2/v1beta1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	bucketclient "github.com/rossigee/provider-cloudflare/internal/clients/r2/bucket"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
 )
 
@@ -44,6 +42,7 @@ const (
 	errNotBucket = "managed resource is not a Bucket custom resource"
 
 	errBucketClientConfig = "error getting bucket client config"
+	errBucketAccount      = "cannot resolve account ID"
 
 	errBucketLookup   = "cannot lookup Bucket"
 	errBucketCreation = "cannot create Bucket"
@@ -54,35 +53,32 @@ const (
 )
 
 // SetupBucket adds a controller that reconciles Bucket managed resources.
-func SetupBucket(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupBucket(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.BucketKind)
 
-	o := controller.Options{
-		RateLimiter: nil, // Use default rate limiter
-		MaxConcurrentReconciles: bucketMaxConcurrency,
-	}
-
 	hc := metrics.NewInstrumentedHTTPClient(name)
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.BucketGroupVersionKind),
-		managed.WithExternalConnecter(&bucketConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&bucketConnector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (*cloudflare.API, error) {
 				return clients.NewClient(cfg, hc)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.BucketGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		// Do not initialize external-name field.
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.BucketGroupVersionKind.GroupKind())).
 		For(&v1beta1.Bucket{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A bucketConnector is expected to produce an ExternalClient when its Connect method
@@ -95,7 +91,7 @@ type bucketConnector struct {
 // Connect produces a valid configuration for a Cloudflare API
 // instance, and returns it as an external client.
 func (c *bucketConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	_, ok := mg.(*v1beta1.Bucket)
+	cr, ok := mg.(*v1beta1.Bucket)
 	if !ok {
 		return nil, errors.New(errNotBucket)
 	}
@@ -111,8 +107,13 @@ func (c *bucketConnector) Connect(ctx context.Context, mg resource.Managed) (man
 		return nil, err
 	}
 
+	accountID, err := clients.ResolveAccountID(ctx, client, cr.Spec.ForProvider.AccountID, *config)
+	if err != nil {
+		return nil, errors.Wrap(err, errBucketAccount)
+	}
+
 	// Create the bucket client wrapper
-	bucketClient := bucketclient.NewClient(client)
+	bucketClient := bucketclient.NewClient(client, accountID)
 
 	return &bucketExternal{client: bucketClient}, nil
 }
@@ -138,7 +139,7 @@ func (c *bucketExternal) Observe(ctx context.Context, mg resource.Managed) (mana
 	observation, err := c.client.Get(ctx, bucketName)
 	if err != nil {
 		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(bucketclient.IsBucketNotFound, err), errBucketLookup)
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errBucketLookup)
 	}
 
 	cr.Status.AtProvider = *observation
@@ -194,7 +195,7 @@ func (c *bucketExternal) Delete(ctx context.Context, mg resource.Managed) (manag
 	}
 
 	err := c.client.Delete(ctx, bucketName)
-	if err != nil && !bucketclient.IsBucketNotFound(err) {
+	if err != nil && !cferrors.IsNotFound(err) {
 		return managed.ExternalDelete{}, errors.Wrap(err, errBucketDeletion)
 	}
 
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package r2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	"github.com/rossigee/provider-cloudflare/apis/r2/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/controller/testutils"
+)
+
+func TestBucketEndToEnd(t *testing.T) {
+	e := testutils.NewEnv(t)
+	e.Setup(Setup)
+
+	cr := &v1beta1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{Name: "assets"},
+		Spec: v1beta1.BucketSpec{ForProvider: v1beta1.BucketParameters{
+			Name:         "assets",
+			LocationHint: testutils.StringPtr("weur"),
+		}},
+	}
+	e.Create(cr)
+	e.Sync(cr)
+
+	if diff := cmp.Diff(corev1.ConditionTrue, cr.GetCondition(xpv1.TypeReady).Status); diff != "" {
+		t.Errorf("\nA created bucket should be ready.\n-want, +got:\n%s\n%+v", diff, cr.Status.Conditions)
+	}
+	buckets := e.API.R2Buckets()
+	if len(buckets) != 1 {
+		t.Fatalf("\nThe bucket should be created once.\nbuckets: %+v", buckets)
+	}
+	if diff := cmp.Diff("weur", buckets[0].Location); diff != "" {
+		t.Errorf("\nThe bucket should be created where it was asked to be.\n-want, +got:\n%s", diff)
+	}
+
+	e.Delete(cr)
+	if diff := cmp.Diff(0, len(e.API.R2Buckets())); diff != "" {
+		t.Errorf("\nA deleted bucket should be deleted.\n-want, +got:\n%s", diff)
+	}
+}
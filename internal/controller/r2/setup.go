@@ -17,17 +17,17 @@ limitations under the License.
 package r2
 
 import (
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 // Setup creates all R2 controllers with the supplied logger and adds them to
 // the supplied manager.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func Setup(mgr ctrl.Manager, o options.Options) error {
 	// Setup Bucket controller
-	if err := SetupBucket(mgr, l, rl); err != nil {
+	if err := SetupBucket(mgr, o); err != nil {
 		return err
 	}
 
@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rulesets
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/controller/testutils"
+)
+
+func TestRulesetEndToEnd(t *testing.T) {
+	e := testutils.NewEnv(t)
+	e.Setup(SetupRuleset)
+	zone := e.API.AddZone("example.org")
+
+	cr := &v1beta1.Ruleset{
+		ObjectMeta: metav1.ObjectMeta{Name: "block"},
+		Spec: v1beta1.RulesetSpec{ForProvider: v1beta1.RulesetParameters{
+			Zone:  &zone,
+			Name:  "block",
+			Kind:  "custom",
+			Phase: "http_request_firewall_custom",
+			Rules: []v1beta1.RulesetRule{{
+				Action:     "block",
+				Expression: `ip.src eq 192.0.2.1`,
+			}},
+		}},
+	}
+	e.Create(cr)
+	e.Sync(cr)
+
+	if diff := cmp.Diff(corev1.ConditionTrue, cr.GetCondition(xpv1.TypeReady).Status); diff != "" {
+		t.Errorf("\nA created ruleset should be ready.\n-want, +got:\n%s\n%+v", diff, cr.Status.Conditions)
+	}
+	rulesets := e.API.Rulesets(zone)
+	if len(rulesets) != 1 {
+		t.Fatalf("\nThe ruleset should be created once.\nrulesets: %+v", rulesets)
+	}
+	if diff := cmp.Diff(rulesets[0].ID, meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("\nThe external name should be the ID of the ruleset.\n-want, +got:\n%s", diff)
+	}
+
+	cr.Spec.ForProvider.Rules[0].Expression = `ip.src eq 192.0.2.2`
+	if err := e.Client.Update(t.Context(), cr); err != nil {
+		t.Fatal(err)
+	}
+	e.Sync(cr)
+	if diff := cmp.Diff(`ip.src eq 192.0.2.2`, e.API.Rulesets(zone)[0].Rules[0].Expression); diff != "" {
+		t.Errorf("\nA changed rule should be updated.\n-want, +got:\n%s", diff)
+	}
+
+	e.Delete(cr)
+	if diff := cmp.Diff(0, len(e.API.Rulesets(zone))); diff != "" {
+		t.Errorf("\nA deleted ruleset should be deleted.\n-want, +got:\n%s", diff)
+	}
+}
+
+func TestRulesetEndToEndEntrypoint(t *testing.T) {
+	e := testutils.NewEnv(t)
+	e.Setup(SetupRuleset)
+	zone := e.API.AddZone("example.org")
+
+	entrypoint := func(name, ref string) *v1beta1.Ruleset {
+		return &v1beta1.Ruleset{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1beta1.RulesetSpec{ForProvider: v1beta1.RulesetParameters{
+				Zone:  &zone,
+				Phase: "http_response_headers_transform",
+				Mode:  testutils.StringPtr("entrypoint"),
+				Rules: []v1beta1.RulesetRule{{
+					Ref:        testutils.StringPtr(ref),
+					Action:     "rewrite",
+					Expression: "true",
+				}},
+			}},
+		}
+	}
+
+	cr := entrypoint("headers", "headers")
+	e.Create(cr)
+	e.Sync(cr)
+
+	if diff := cmp.Diff(corev1.ConditionTrue, cr.GetCondition(xpv1.TypeReady).Status); diff != "" {
+		t.Errorf("\nAn adopted entrypoint should be ready.\n-want, +got:\n%s\n%+v", diff, cr.Status.Conditions)
+	}
+	rulesets := e.API.Rulesets(zone)
+	if len(rulesets) != 1 || len(rulesets[0].Rules) != 1 {
+		t.Fatalf("\nThe entrypoint should be created with its rule.\nrulesets: %+v", rulesets)
+	}
+
+	other := entrypoint("other", "other")
+	e.Create(other)
+	e.Sync(other)
+	e.Sync(cr)
+
+	rulesets = e.API.Rulesets(zone)
+	if len(rulesets) != 1 || len(rulesets[0].Rules) != 2 {
+		t.Fatalf("\nA second ruleset should add its rule to the entrypoint.\nrulesets: %+v", rulesets)
+	}
+	if diff := cmp.Diff(corev1.ConditionTrue, cr.GetCondition(xpv1.TypeSynced).Status); diff != "" {
+		t.Errorf("\nThe rule of another ruleset should not put the first out of sync.\n-want, +got:\n%s\n%+v", diff, cr.Status.Conditions)
+	}
+
+	e.Delete(cr)
+	rulesets = e.API.Rulesets(zone)
+	if len(rulesets) != 1 {
+		t.Fatalf("\nA deleted entrypoint should be kept.\nrulesets: %+v", rulesets)
+	}
+	if len(rulesets[0].Rules) != 1 || rulesets[0].Rules[0].Ref != "other" {
+		t.Errorf("\nOnly the rules of the deleted ruleset should be removed.\nrules: %+v", rulesets[0].Rules)
+	}
+
+	e.Delete(other)
+	rulesets = e.API.Rulesets(zone)
+	if len(rulesets) != 1 || len(rulesets[0].Rules) != 0 {
+		t.Errorf("\nDeleting the last ruleset should empty the entrypoint rather than delete it.\nrulesets: %+v", rulesets)
+	}
+}
+
+func TestManagedRulesetDeploymentEndToEnd(t *testing.T) {
+	e := testutils.NewEnv(t)
+	e.Setup(SetupManagedRulesetDeployment)
+	zone := e.API.AddZone("example.org")
+
+	cr := &v1beta1.ManagedRulesetDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "waf"},
+		Spec: v1beta1.ManagedRulesetDeploymentSpec{ForProvider: v1beta1.ManagedRulesetDeploymentParameters{
+			Zone:             &zone,
+			ManagedRulesetID: "efb7b8c949ac4650a09736fc376e9aee",
+		}},
+	}
+	e.Create(cr)
+	e.Sync(cr)
+
+	if diff := cmp.Diff(corev1.ConditionTrue, cr.GetCondition(xpv1.TypeReady).Status); diff != "" {
+		t.Errorf("\nA deployed managed ruleset should be ready.\n-want, +got:\n%s\n%+v", diff, cr.Status.Conditions)
+	}
+	rulesets := e.API.Rulesets(zone)
+	if len(rulesets) != 1 || len(rulesets[0].Rules) != 1 {
+		t.Fatalf("\nThe managed ruleset should be deployed by one execute rule.\nrulesets: %+v", rulesets)
+	}
+	version := rulesets[0].Version
+
+	e.Reconcile(cr)
+	if diff := cmp.Diff(version, e.API.Rulesets(zone)[0].Version); diff != "" {
+		t.Errorf("\nA deployment that runs the latest version of its managed ruleset should be up to date.\n-want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(corev1.ConditionTrue, cr.GetCondition(xpv1.TypeSynced).Status); diff != "" {
+		t.Errorf("\nAn up to date deployment should be synced.\n-want, +got:\n%s\n%+v", diff, cr.Status.Conditions)
+	}
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rulesets
+
+import (
+	"context"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	ruleset "github.com/rossigee/provider-cloudflare/internal/clients/rulesets"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+const (
+	errNotDeployment = "managed resource is not a ManagedRulesetDeployment custom resource"
+
+	errDeploymentLookup   = "cannot lookup managed ruleset deployment"
+	errDeploymentCreation = "cannot create managed ruleset deployment"
+	errDeploymentUpdate   = "cannot update managed ruleset deployment"
+	errDeploymentDeletion = "cannot delete managed ruleset deployment"
+	errDeploymentNoScope  = "cannot deploy managed ruleset: no zone or account specified"
+)
+
+// SetupManagedRulesetDeployment adds a controller that reconciles
+// ManagedRulesetDeployment managed resources.
+func SetupManagedRulesetDeployment(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1beta1.ManagedRulesetDeploymentGroupKind)
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	r := managed.NewReconciler(options.ReportErrors(mgr),
+		resource.ManagedKind(v1beta1.ManagedRulesetDeploymentGroupVersionKind),
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&deploymentConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (ruleset.Client, error) {
+				return ruleset.NewClient(cfg, hc)
+			},
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.ManagedRulesetDeploymentGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
+		// Do not initialize external-name field.
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ControllerOptions(v1beta1.ManagedRulesetDeploymentGroupVersionKind.GroupKind())).
+		For(&v1beta1.ManagedRulesetDeployment{}).
+		Complete(o.Reconciler(name, r))
+}
+
+// A deploymentConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type deploymentConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (ruleset.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *deploymentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, ok := mg.(*v1beta1.ManagedRulesetDeployment)
+	if !ok {
+		return nil, errors.New(errNotDeployment)
+	}
+
+	// Get client configuration
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deploymentExternal{client: client}, nil
+}
+
+// A deploymentExternal observes, then either creates, updates, or deletes the
+// rules of a managed ruleset deployment within its phase entrypoint ruleset.
+type deploymentExternal struct {
+	client ruleset.Client
+}
+
+func (e *deploymentExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.ManagedRulesetDeployment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDeployment)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil && cr.Spec.ForProvider.Account == nil {
+		return managed.ExternalObservation{}, errors.New(errDeploymentNoScope)
+	}
+
+	rs, err := e.client.GetEntrypointRuleset(ctx, ruleset.DeploymentEntrypoint(cr.Spec.ForProvider))
+	if cferrors.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDeploymentLookup)
+	}
+
+	// The deployment exists as long as any of its rules remain in the
+	// entrypoint.
+	if owned, _ := ruleset.OwnedRules(cr.Spec.ForProvider, rs.Rules); len(owned) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = ruleset.GenerateDeploymentObservation(cr.Spec.ForProvider, rs)
+	cr.Status.SetConditions(rtv1.Available())
+
+	li := false
+	if id := cr.Status.AtProvider.RuleID; id != "" && meta.GetExternalName(cr) != id {
+		meta.SetExternalName(cr, id)
+		li = true
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ruleset.DeploymentUpToDate(cr.Spec.ForProvider, rs),
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (e *deploymentExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.ManagedRulesetDeployment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDeployment)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil && cr.Spec.ForProvider.Account == nil {
+		return managed.ExternalCreation{}, errors.New(errDeploymentNoScope)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	if err := e.deploy(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errDeploymentCreation)
+	}
+
+	// Update the external name with the ID of the execute rule
+	meta.SetExternalName(cr, cr.Status.AtProvider.RuleID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *deploymentExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.ManagedRulesetDeployment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDeployment)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil && cr.Spec.ForProvider.Account == nil {
+		return managed.ExternalUpdate{}, errors.New(errDeploymentNoScope)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(e.deploy(ctx, cr), errDeploymentUpdate)
+}
+
+func (e *deploymentExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.ManagedRulesetDeployment)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotDeployment)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil && cr.Spec.ForProvider.Account == nil {
+		return managed.ExternalDelete{}, errors.New(errDeploymentNoScope)
+	}
+
+	params := ruleset.DeploymentEntrypoint(cr.Spec.ForProvider)
+	unlock, err := ruleset.LockEntrypoint(ctx, params)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errDeploymentDeletion)
+	}
+	defer unlock()
+
+	// Only the rules of this deployment are removed; the entrypoint and the
+	// rules of other deployments are left alone.
+	err = ruleset.DeleteEntrypointRules(ctx, e.client, params, func(r cloudflare.RulesetRule) bool {
+		return ruleset.DeploymentOwns(cr.Spec.ForProvider, r)
+	})
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeploymentDeletion)
+}
+
+func (e *deploymentExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
+
+// deploy brings the rules of the deployment in the entrypoint ruleset in line
+// with the desired skip exceptions and execute rule, rule by rule, keeping
+// them together in the position of the first of them.
+func (e *deploymentExternal) deploy(ctx context.Context, cr *v1beta1.ManagedRulesetDeployment) error {
+	p := cr.Spec.ForProvider
+	params := ruleset.DeploymentEntrypoint(p)
+
+	unlock, err := ruleset.LockEntrypoint(ctx, params)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	rs, err := ruleset.SyncEntrypointRules(ctx, e.client, params, ruleset.DeploymentRules(p), func(r cloudflare.RulesetRule) bool {
+		return ruleset.DeploymentOwns(p, r)
+	})
+	if err != nil {
+		return err
+	}
+
+	cr.Status.AtProvider = ruleset.GenerateDeploymentObservation(p, rs)
+	return nil
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rulesets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+	ruleset "github.com/rossigee/provider-cloudflare/internal/clients/rulesets"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+type deploymentModifier func(*v1beta1.ManagedRulesetDeployment)
+
+func withSkipException(name string) deploymentModifier {
+	return func(d *v1beta1.ManagedRulesetDeployment) {
+		d.Spec.ForProvider.SkipExceptions = append(d.Spec.ForProvider.SkipExceptions,
+			v1beta1.ManagedRulesetSkipException{Name: name, Expression: "true"})
+	}
+}
+
+func deploymentCR(m ...deploymentModifier) *v1beta1.ManagedRulesetDeployment {
+	d := &v1beta1.ManagedRulesetDeployment{
+		Spec: v1beta1.ManagedRulesetDeploymentSpec{
+			ForProvider: v1beta1.ManagedRulesetDeploymentParameters{
+				Zone:             ptr.To("test-zone-id"),
+				ManagedRulesetID: "managed",
+			},
+		},
+	}
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+func executeRule(id string) cloudflare.RulesetRule {
+	return cloudflare.RulesetRule{
+		ID:               id,
+		Ref:              "managed",
+		Action:           "execute",
+		Expression:       "true",
+		ActionParameters: &cloudflare.RulesetRuleActionParameters{ID: "managed"},
+	}
+}
+
+func skipRule(id, name string) cloudflare.RulesetRule {
+	return cloudflare.RulesetRule{
+		ID:               id,
+		Ref:              "managed_skip_" + name,
+		Action:           "skip",
+		Expression:       "true",
+		ActionParameters: &cloudflare.RulesetRuleActionParameters{Rulesets: []string{"managed"}},
+	}
+}
+
+func TestDeploymentObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client ruleset.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"ErrNotDeployment": {
+			reason: "Should return an error if the managed resource is not a ManagedRulesetDeployment",
+			mg:     nil,
+			want:   want{err: errors.New(errNotDeployment)},
+		},
+		"ErrNoScope": {
+			reason: "Should return an error if neither zone nor account is specified",
+			mg: deploymentCR(func(d *v1beta1.ManagedRulesetDeployment) {
+				d.Spec.ForProvider.Zone = nil
+			}),
+			want: want{err: errors.New(errDeploymentNoScope)},
+		},
+		"ErrGetEntrypoint": {
+			reason: "Should return any error encountered getting the entrypoint",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return nil, errors.New("boom")
+				},
+			},
+			mg:   deploymentCR(),
+			want: want{err: errors.Wrap(errors.New("boom"), errDeploymentLookup)},
+		},
+		"NoEntrypoint": {
+			reason: "Should report that the deployment does not exist if the phase has no entrypoint",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return nil, &cloudflare.NotFoundError{}
+				},
+			},
+			mg:   deploymentCR(),
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"NotDeployed": {
+			reason: "Should report that the deployment does not exist if none of its rules are in the entrypoint",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{{ID: "x", Ref: "other"}}}, nil
+				},
+			},
+			mg:   deploymentCR(),
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"DeployedAndUpToDate": {
+			reason: "Should report that the deployment exists and record the execute rule as its external name",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					if params.Phase != ruleset.PhaseFirewallManaged {
+						return nil, errors.New("wrong phase")
+					}
+					return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{{ID: "x", Ref: "other"}, executeRule("exec")}}, nil
+				},
+			},
+			mg: deploymentCR(),
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:          true,
+				ResourceUpToDate:        true,
+				ResourceLateInitialized: true,
+			}},
+		},
+		"MissingSkipException": {
+			reason: "Should report that the deployment is out of date if a skip exception is missing",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{executeRule("exec")}}, nil
+				},
+			},
+			mg: deploymentCR(withSkipException("office")),
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:          true,
+				ResourceUpToDate:        false,
+				ResourceLateInitialized: true,
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &deploymentExternal{client: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("%s\ne.Observe(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("%s\ne.Observe(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDeploymentCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client ruleset.Client
+		mg     *v1beta1.ManagedRulesetDeployment
+		want   want
+	}{
+		"NoEntrypoint": {
+			reason: "Should create the entrypoint holding only the deployment rules if the phase has none",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return nil, &cloudflare.NotFoundError{}
+				},
+				MockUpdateEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					if len(params.Rules) != 2 {
+						return nil, errors.New("want skip and execute rules")
+					}
+					return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{skipRule("skip", "office"), executeRule("exec")}}, nil
+				},
+			},
+			mg:   deploymentCR(withSkipException("office")),
+			want: want{externalName: "exec"},
+		},
+		"AppendToEntrypoint": {
+			reason: "Should append the deployment rules to an existing entrypoint in order",
+			client: func() ruleset.Client {
+				rs := &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{{ID: "x", Ref: "other"}}}
+				return &mockRulesetClient{
+					MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return rs, nil
+					},
+					MockCreateRulesetRule: func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						// Rules are appended in order when none exist yet.
+						if rule.Index != 0 {
+							return nil, errors.Errorf("rule %s: want no index, got %d", rule.Rule.Ref, rule.Index)
+						}
+						rule.Rule.ID = rule.Rule.Ref + "-id"
+						rs = &cloudflare.Ruleset{ID: "ep", Rules: append(append([]cloudflare.RulesetRule{}, rs.Rules...), rule.Rule)}
+						return rs, nil
+					},
+				}
+			}(),
+			mg:   deploymentCR(withSkipException("office")),
+			want: want{externalName: "managed-id"},
+		},
+		"ErrCreateRule": {
+			reason: "Should return any error encountered creating a rule",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return &cloudflare.Ruleset{ID: "ep"}, nil
+				},
+				MockCreateRulesetRule: func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return nil, errors.New("boom")
+				},
+			},
+			mg:   deploymentCR(),
+			want: want{err: errors.Wrap(errors.New("boom"), errDeploymentCreation)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &deploymentExternal{client: tc.client}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("%s\ne.Create(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.externalName, tc.mg.GetAnnotations()["crossplane.io/external-name"]); diff != "" {
+				t.Errorf("%s\ne.Create(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDeploymentUpdate(t *testing.T) {
+	var calls []string
+	client := &mockRulesetClient{
+		MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+			exec := executeRule("exec")
+			exec.Expression = "false"
+			return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{
+				{ID: "x", Ref: "other"},
+				skipRule("stale", "old"),
+				exec,
+			}}, nil
+		},
+		MockDeleteRulesetRule: func(ctx context.Context, rulesetID, ruleID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+			calls = append(calls, "delete "+ruleID)
+			return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{{ID: "x", Ref: "other"}, executeRule("exec")}}, nil
+		},
+		MockUpdateRulesetRule: func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+			calls = append(calls, "update "+rule.Rule.ID)
+			return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{{ID: "x", Ref: "other"}, executeRule("exec")}}, nil
+		},
+		MockCreateRulesetRule: func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+			calls = append(calls, errors.Errorf("create %s at %d", rule.Rule.Ref, rule.Index).Error())
+			return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{{ID: "x", Ref: "other"}, skipRule("new", "office"), executeRule("exec")}}, nil
+		},
+	}
+
+	e := &deploymentExternal{client: client}
+	if _, err := e.Update(context.Background(), deploymentCR(withSkipException("office"))); err != nil {
+		t.Fatalf("e.Update(...): unexpected error: %v", err)
+	}
+
+	want := []string{"delete stale", "update exec", "create managed_skip_office at 2"}
+	if diff := cmp.Diff(want, calls); diff != "" {
+		t.Errorf("e.Update(...): -want calls, +got calls:\n%s", diff)
+	}
+}
+
+func TestDeploymentDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		client ruleset.Client
+		want   error
+	}{
+		"NoEntrypoint": {
+			reason: "Should succeed if the phase has no entrypoint",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return nil, &cloudflare.NotFoundError{}
+				},
+			},
+		},
+		"OwnedRulesOnly": {
+			reason: "Should delete only the rules of the deployment",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{{ID: "x", Ref: "other"}, skipRule("skip", "office"), executeRule("exec")}}, nil
+				},
+				MockDeleteRulesetRule: func(ctx context.Context, rulesetID, ruleID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					if ruleID == "x" {
+						return nil, errors.New("deleted a rule of another deployment")
+					}
+					return &cloudflare.Ruleset{ID: "ep"}, nil
+				},
+			},
+		},
+		"ErrDeleteRule": {
+			reason: "Should return any error encountered deleting a rule",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{executeRule("exec")}}, nil
+				},
+				MockDeleteRulesetRule: func(ctx context.Context, rulesetID, ruleID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return nil, errors.New("boom")
+				},
+			},
+			want: errors.Wrap(errors.New("boom"), errDeploymentDeletion),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &deploymentExternal{client: tc.client}
+			_, err := e.Delete(context.Background(), deploymentCR(withSkipException("office")))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("%s\ne.Delete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rulesets
+
+import (
+	"context"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	ruleset "github.com/rossigee/provider-cloudflare/internal/clients/rulesets"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
+	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
+)
+
+const (
+	errNotPhaseRule = "managed resource is not a PhaseRule custom resource"
+
+	errPhaseRuleLookup   = "cannot lookup phase rule"
+	errPhaseRuleCreation = "cannot create phase rule"
+	errPhaseRuleUpdate   = "cannot update phase rule"
+	errPhaseRuleDeletion = "cannot delete phase rule"
+	errPhaseRuleNoScope  = "cannot manage phase rule: no zone or account specified"
+	errPhaseRuleNotFound = "phase rule not found in the phase entrypoint"
+)
+
+// SetupPhaseRule adds a controller that reconciles PhaseRule managed
+// resources.
+func SetupPhaseRule(mgr ctrl.Manager, o options.Options) error {
+	name := managed.ControllerName(v1beta1.PhaseRuleGroupKind)
+
+	hc := metrics.NewInstrumentedHTTPClient(name)
+	r := managed.NewReconciler(options.ReportErrors(mgr),
+		resource.ManagedKind(v1beta1.PhaseRuleGroupVersionKind),
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&phaseRuleConnector{
+			kube: mgr.GetClient(),
+			newCloudflareClientFn: func(cfg clients.Config) (ruleset.Client, error) {
+				return ruleset.NewClient(cfg, hc)
+			},
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.PhaseRuleGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
+		// Do not initialize external-name field.
+		managed.WithInitializers(),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ControllerOptions(v1beta1.PhaseRuleGroupVersionKind.GroupKind())).
+		For(&v1beta1.PhaseRule{}).
+		Complete(o.Reconciler(name, r))
+}
+
+// A phaseRuleConnector is expected to produce an ExternalClient when its
+// Connect method is called.
+type phaseRuleConnector struct {
+	kube                  client.Client
+	newCloudflareClientFn func(cfg clients.Config) (ruleset.Client, error)
+}
+
+// Connect produces a valid configuration for a Cloudflare API
+// instance, and returns it as an external client.
+func (c *phaseRuleConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, ok := mg.(*v1beta1.PhaseRule)
+	if !ok {
+		return nil, errors.New(errNotPhaseRule)
+	}
+
+	// Get client configuration
+	config, err := clients.GetConfig(ctx, c.kube, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errClientConfig)
+	}
+
+	client, err := c.newCloudflareClientFn(*config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &phaseRuleExternal{client: client}, nil
+}
+
+// A phaseRuleExternal observes, then either creates, updates, or deletes a
+// single rule of a phase entrypoint ruleset.
+type phaseRuleExternal struct {
+	client ruleset.Client
+}
+
+func (e *phaseRuleExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.PhaseRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPhaseRule)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil && cr.Spec.ForProvider.Account == nil {
+		return managed.ExternalObservation{}, errors.New(errPhaseRuleNoScope)
+	}
+
+	rs, err := e.client.GetEntrypointRuleset(ctx, ruleset.PhaseRuleEntrypoint(cr.Spec.ForProvider))
+	if cferrors.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errPhaseRuleLookup)
+	}
+
+	// Rules are found by ID, or adopted by ref before they have one.
+	rule, index := ruleset.FindPhaseRule(cr.Spec.ForProvider, meta.GetExternalName(cr), rs)
+	if rule == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = ruleset.GeneratePhaseRuleObservation(rs, *rule, index)
+	cr.Status.SetConditions(rtv1.Available())
+
+	li := false
+	if meta.GetExternalName(cr) != rule.ID {
+		meta.SetExternalName(cr, rule.ID)
+		li = true
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ruleset.PhaseRuleUpToDate(cr.Spec.ForProvider, rs, *rule, index),
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (e *phaseRuleExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.PhaseRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPhaseRule)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil && cr.Spec.ForProvider.Account == nil {
+		return managed.ExternalCreation{}, errors.New(errPhaseRuleNoScope)
+	}
+
+	cr.SetConditions(rtv1.Creating())
+
+	params := ruleset.PhaseRuleEntrypoint(cr.Spec.ForProvider)
+	unlock, err := ruleset.LockEntrypoint(ctx, params)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPhaseRuleCreation)
+	}
+	defer unlock()
+
+	current, err := e.client.GetEntrypointRuleset(ctx, params)
+	if resource.Ignore(cferrors.IsNotFound, err) != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPhaseRuleCreation)
+	}
+
+	var rs *cloudflare.Ruleset
+	if err != nil {
+		// The phase has no entrypoint yet, so it is created holding only
+		// this rule.
+		current = &cloudflare.Ruleset{}
+		params.Rules = []v1beta1.RulesetRule{cr.Spec.ForProvider.RulesetRule}
+		rs, err = e.client.UpdateEntrypointRuleset(ctx, params)
+	} else {
+		var pr ruleset.PositionedRule
+		if pr, err = ruleset.PositionPhaseRule(cr.Spec.ForProvider, current); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errPhaseRuleCreation)
+		}
+		rs, err = e.client.CreateRulesetRule(ctx, current.ID, pr, params)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPhaseRuleCreation)
+	}
+
+	rule, index := ruleset.AddedRule(current, rs)
+	if rule == nil {
+		return managed.ExternalCreation{}, errors.Wrap(errors.New(errPhaseRuleNotFound), errPhaseRuleCreation)
+	}
+
+	cr.Status.AtProvider = ruleset.GeneratePhaseRuleObservation(rs, *rule, index)
+
+	// Update the external name with the ID of the new rule
+	meta.SetExternalName(cr, rule.ID)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *phaseRuleExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.PhaseRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPhaseRule)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil && cr.Spec.ForProvider.Account == nil {
+		return managed.ExternalUpdate{}, errors.New(errPhaseRuleNoScope)
+	}
+
+	params := ruleset.PhaseRuleEntrypoint(cr.Spec.ForProvider)
+	unlock, err := ruleset.LockEntrypoint(ctx, params)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPhaseRuleUpdate)
+	}
+	defer unlock()
+
+	current, err := e.client.GetEntrypointRuleset(ctx, params)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPhaseRuleUpdate)
+	}
+
+	rule, index := ruleset.FindPhaseRule(cr.Spec.ForProvider, meta.GetExternalName(cr), current)
+	if rule == nil {
+		return managed.ExternalUpdate{}, errors.Wrap(errors.New(errPhaseRuleNotFound), errPhaseRuleUpdate)
+	}
+
+	pr, err := ruleset.PositionPhaseRule(cr.Spec.ForProvider, current)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPhaseRuleUpdate)
+	}
+	pr.Rule.ID = rule.ID
+
+	// Only move the rule when it is out of place.
+	if ruleset.PositionUpToDate(cr.Spec.ForProvider, current, index) {
+		pr = ruleset.PositionedRule{Rule: pr.Rule}
+	}
+
+	rs, err := e.client.UpdateRulesetRule(ctx, current.ID, pr, params)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPhaseRuleUpdate)
+	}
+
+	if rule, index := ruleset.FindPhaseRule(cr.Spec.ForProvider, pr.Rule.ID, rs); rule != nil {
+		cr.Status.AtProvider = ruleset.GeneratePhaseRuleObservation(rs, *rule, index)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *phaseRuleExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1beta1.PhaseRule)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotPhaseRule)
+	}
+
+	if cr.Spec.ForProvider.Zone == nil && cr.Spec.ForProvider.Account == nil {
+		return managed.ExternalDelete{}, errors.New(errPhaseRuleNoScope)
+	}
+
+	params := ruleset.PhaseRuleEntrypoint(cr.Spec.ForProvider)
+	unlock, err := ruleset.LockEntrypoint(ctx, params)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, errPhaseRuleDeletion)
+	}
+	defer unlock()
+
+	rs, err := e.client.GetEntrypointRuleset(ctx, params)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errPhaseRuleDeletion)
+	}
+
+	rule, _ := ruleset.FindPhaseRule(cr.Spec.ForProvider, meta.GetExternalName(cr), rs)
+	if rule == nil {
+		return managed.ExternalDelete{}, nil
+	}
+
+	_, err = e.client.DeleteRulesetRule(ctx, rs.ID, rule.ID, params)
+	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errPhaseRuleDeletion)
+}
+
+func (e *phaseRuleExternal) Disconnect(ctx context.Context) error {
+	// No persistent connections to clean up
+	return nil
+}
@@ -0,0 +1,397 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rulesets
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+	ruleset "github.com/rossigee/provider-cloudflare/internal/clients/rulesets"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+type phaseRuleModifier func(*v1beta1.PhaseRule)
+
+func withPosition(p v1beta1.PhaseRulePosition) phaseRuleModifier {
+	return func(r *v1beta1.PhaseRule) { r.Spec.ForProvider.Position = &p }
+}
+
+func withPhaseRuleID(id string) phaseRuleModifier {
+	return func(r *v1beta1.PhaseRule) { meta.SetExternalName(r, id) }
+}
+
+func phaseRuleCR(m ...phaseRuleModifier) *v1beta1.PhaseRule {
+	r := &v1beta1.PhaseRule{
+		Spec: v1beta1.PhaseRuleSpec{
+			ForProvider: v1beta1.PhaseRuleParameters{
+				Zone:  ptr.To("test-zone-id"),
+				Phase: "http_request_origin",
+				RulesetRule: v1beta1.RulesetRule{
+					Ref:        ptr.To("mine"),
+					Action:     "route",
+					Expression: "true",
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+// phaseEntrypoint returns an entrypoint holding the rule of phaseRuleCR
+// between two rules owned by others.
+func phaseEntrypoint() *cloudflare.Ruleset {
+	return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{
+		{ID: "1", Ref: "first", Action: "route", Expression: "true"},
+		{ID: "2", Ref: "mine", Action: "route", Expression: "true"},
+		{ID: "3", Ref: "last", Action: "route", Expression: "true"},
+	}}
+}
+
+func TestPhaseRuleObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client ruleset.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"ErrNotPhaseRule": {
+			reason: "Should return an error if the managed resource is not a PhaseRule",
+			mg:     nil,
+			want:   want{err: errors.New(errNotPhaseRule)},
+		},
+		"ErrNoScope": {
+			reason: "Should return an error if neither zone nor account is specified",
+			mg:     phaseRuleCR(func(r *v1beta1.PhaseRule) { r.Spec.ForProvider.Zone = nil }),
+			want:   want{err: errors.New(errPhaseRuleNoScope)},
+		},
+		"ErrGetEntrypoint": {
+			reason: "Should return any error encountered getting the entrypoint",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return nil, errors.New("boom")
+				},
+			},
+			mg:   phaseRuleCR(),
+			want: want{err: errors.Wrap(errors.New("boom"), errPhaseRuleLookup)},
+		},
+		"NoEntrypoint": {
+			reason: "Should report that the rule does not exist if the phase has no entrypoint",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return nil, &cloudflare.NotFoundError{}
+				},
+			},
+			mg:   phaseRuleCR(),
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"AdoptedByRef": {
+			reason: "Should adopt a rule with the same ref and record its ID",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return phaseEntrypoint(), nil
+				},
+			},
+			mg: phaseRuleCR(),
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:          true,
+				ResourceUpToDate:        true,
+				ResourceLateInitialized: true,
+			}},
+		},
+		"OutOfPlace": {
+			reason: "Should report a rule that is not in its requested position as out of date",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return phaseEntrypoint(), nil
+				},
+			},
+			mg: phaseRuleCR(withPhaseRuleID("2"), withPosition(v1beta1.PhaseRulePosition{After: ptr.To("last")})),
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: false,
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &phaseRuleExternal{client: tc.client}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("%s\ne.Observe(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("%s\ne.Observe(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPhaseRuleCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client ruleset.Client
+		mg     *v1beta1.PhaseRule
+		want   want
+	}{
+		"NoEntrypoint": {
+			reason: "Should create the entrypoint holding only the rule if the phase has none",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return nil, &cloudflare.NotFoundError{}
+				},
+				MockUpdateEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{{ID: "new", Ref: "mine"}}}, nil
+				},
+			},
+			mg:   phaseRuleCR(),
+			want: want{externalName: "new"},
+		},
+		"Positioned": {
+			reason: "Should create the rule before the rule with the requested ref",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{{ID: "1", Ref: "first"}}}, nil
+				},
+				MockCreateRulesetRule: func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					if rule.Before != "1" {
+						return nil, errors.Errorf("want rule before 1, got %+v", rule)
+					}
+					return &cloudflare.Ruleset{ID: "ep", Rules: []cloudflare.RulesetRule{{ID: "new", Ref: "mine"}, {ID: "1", Ref: "first"}}}, nil
+				},
+			},
+			mg:   phaseRuleCR(withPosition(v1beta1.PhaseRulePosition{Before: ptr.To("first")})),
+			want: want{externalName: "new"},
+		},
+		"ErrMissingAnchor": {
+			reason: "Should return an error if the rule to position against does not exist",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return &cloudflare.Ruleset{ID: "ep"}, nil
+				},
+			},
+			mg:   phaseRuleCR(withPosition(v1beta1.PhaseRulePosition{After: ptr.To("first")})),
+			want: want{err: errors.Wrap(errors.Errorf("no rule with ref %q in the phase entrypoint", "first"), errPhaseRuleCreation)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &phaseRuleExternal{client: tc.client}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("%s\ne.Create(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.mg)); diff != "" {
+				t.Errorf("%s\ne.Create(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPhaseRuleCreateConcurrent(t *testing.T) {
+	// The fake entrypoint is read slowly so that two PhaseRules racing to
+	// create a missing entrypoint would each PUT it and drop the other's rule.
+	var (
+		mu sync.Mutex
+		ep *cloudflare.Ruleset
+	)
+	snapshot := func() *cloudflare.Ruleset {
+		if ep == nil {
+			return nil
+		}
+		rs := *ep
+		rs.Rules = append([]cloudflare.RulesetRule(nil), ep.Rules...)
+		return &rs
+	}
+	client := &mockRulesetClient{
+		MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+			mu.Lock()
+			rs := snapshot()
+			mu.Unlock()
+			time.Sleep(5 * time.Millisecond)
+			if rs == nil {
+				return nil, &cloudflare.NotFoundError{}
+			}
+			return rs, nil
+		},
+		MockUpdateEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			ep = &cloudflare.Ruleset{ID: "ep"}
+			for _, r := range params.Rules {
+				ep.Rules = append(ep.Rules, cloudflare.RulesetRule{ID: *r.Ref, Ref: *r.Ref})
+			}
+			return snapshot(), nil
+		},
+		MockCreateRulesetRule: func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			ep.Rules = append(ep.Rules, cloudflare.RulesetRule{ID: rule.Rule.Ref, Ref: rule.Rule.Ref})
+			return snapshot(), nil
+		},
+	}
+
+	const n = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cr := phaseRuleCR(func(r *v1beta1.PhaseRule) {
+				r.Spec.ForProvider.Ref = ptr.To(fmt.Sprintf("rule%d", i))
+			})
+			_, err := (&phaseRuleExternal{client: client}).Create(context.Background(), cr)
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("e.Create(...): %v", err)
+		}
+	}
+	if got := len(ep.Rules); got != n {
+		t.Errorf("e.Create(...): want %d rules after parallel creates, got %d", n, got)
+	}
+}
+
+func TestPhaseRuleUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		mg     *v1beta1.PhaseRule
+		want   ruleset.PositionedRule
+	}{
+		"InPlace": {
+			reason: "Should not move a rule that is already in place",
+			mg:     phaseRuleCR(withPhaseRuleID("2"), withPosition(v1beta1.PhaseRulePosition{Index: ptr.To(2)})),
+			want:   ruleset.PositionedRule{},
+		},
+		"Move": {
+			reason: "Should move a rule that is out of place",
+			mg:     phaseRuleCR(withPhaseRuleID("2"), withPosition(v1beta1.PhaseRulePosition{After: ptr.To("last")})),
+			want:   ruleset.PositionedRule{After: "3"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got ruleset.PositionedRule
+			e := &phaseRuleExternal{client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return phaseEntrypoint(), nil
+				},
+				MockUpdateRulesetRule: func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					if rule.Rule.ID != "2" {
+						return nil, errors.Errorf("want rule 2, got %s", rule.Rule.ID)
+					}
+					got = rule
+					return phaseEntrypoint(), nil
+				},
+			}}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("%s\ne.Update(...): unexpected error: %v", tc.reason, err)
+			}
+			got.Rule = cloudflare.RulesetRule{}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("%s\ne.Update(...): -want position, +got position:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPhaseRuleDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		client ruleset.Client
+		want   error
+	}{
+		"NoEntrypoint": {
+			reason: "Should succeed if the phase has no entrypoint",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return nil, &cloudflare.NotFoundError{}
+				},
+			},
+		},
+		"OwnRuleOnly": {
+			reason: "Should delete only the rule of the resource",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return phaseEntrypoint(), nil
+				},
+				MockDeleteRulesetRule: func(ctx context.Context, rulesetID, ruleID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					if ruleID != "2" {
+						return nil, errors.Errorf("deleted rule %s", ruleID)
+					}
+					return &cloudflare.Ruleset{ID: "ep"}, nil
+				},
+			},
+		},
+		"ErrDeleteRule": {
+			reason: "Should return any error encountered deleting the rule",
+			client: &mockRulesetClient{
+				MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return phaseEntrypoint(), nil
+				},
+				MockDeleteRulesetRule: func(ctx context.Context, rulesetID, ruleID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+					return nil, errors.New("boom")
+				},
+			},
+			want: errors.Wrap(errors.New("boom"), errPhaseRuleDeletion),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &phaseRuleExternal{client: tc.client}
+			_, err := e.Delete(context.Background(), phaseRuleCR(withPhaseRuleID("2")))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("%s\ne.Delete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
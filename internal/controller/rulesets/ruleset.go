@@ -18,78 +18,65 @@ package rulesets
 
 import (
 	"context"
-	"time"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	ruleset "github.com/rossigee/provider-cloudflare/internal/clients/rulesets"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
 )
 
 const (
 	errNotRuleset = "managed resource is not a Ruleset custom resource"
 
-	errClientConfig = "error getting client config"
-
 	errRulesetLookup   = "cannot lookup ruleset"
 	errRulesetCreation = "cannot create ruleset"
 	errRulesetUpdate   = "cannot update ruleset"
 	errRulesetDeletion = "cannot delete ruleset"
 	errRulesetNoScope  = "cannot create ruleset: no zone or account specified"
+	errEntrypointRef   = "every rule of a ruleset in entrypoint mode must have a ref"
 )
 
-const (
-	maxConcurrency = 5
-)
-
-// Setup adds a controller that reconciles Ruleset managed resources.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	return SetupRuleset(mgr, l, rl)
-}
-
 // SetupRuleset adds a controller that reconciles Ruleset managed resources.
-func SetupRuleset(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupRuleset(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.RulesetGroupKind)
 
-	o := controller.Options{
-		RateLimiter: nil, // Use default rate limiter
-		MaxConcurrentReconciles: maxConcurrency,
-	}
-
 	hc := metrics.NewInstrumentedHTTPClient(name)
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.RulesetGroupVersionKind),
-		managed.WithExternalConnecter(&rulesetConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&rulesetConnector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (ruleset.Client, error) {
 				return ruleset.NewClient(cfg, hc)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.RulesetGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		// Initialize external-name field.
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.RulesetGroupVersionKind.GroupKind())).
 		For(&v1beta1.Ruleset{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -138,6 +125,10 @@ func (e *rulesetExternal) Observe(ctx context.Context, mg resource.Managed) (man
 		return managed.ExternalObservation{}, errors.New(errRulesetNoScope)
 	}
 
+	if ruleset.IsEntrypoint(cr.Spec.ForProvider) {
+		return e.observeEntrypoint(ctx, cr)
+	}
+
 	// Ruleset does not exist if we dont have an ID stored in external-name
 	rulesetID := meta.GetExternalName(cr)
 	if rulesetID == "" {
@@ -147,7 +138,7 @@ func (e *rulesetExternal) Observe(ctx context.Context, mg resource.Managed) (man
 	rs, err := e.client.GetRuleset(ctx, rulesetID, cr.Spec.ForProvider)
 
 	if err != nil {
-		if ruleset.IsRulesetNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			return managed.ExternalObservation{ResourceExists: false}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errRulesetLookup)
@@ -177,6 +168,14 @@ func (e *rulesetExternal) Create(ctx context.Context, mg resource.Managed) (mana
 
 	cr.SetConditions(rtv1.Creating())
 
+	if ruleset.IsEntrypoint(cr.Spec.ForProvider) {
+		if err := e.syncEntrypoint(ctx, cr); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errRulesetCreation)
+		}
+		meta.SetExternalName(cr, cr.Status.AtProvider.ID)
+		return managed.ExternalCreation{}, nil
+	}
+
 	rs, err := e.client.CreateRuleset(ctx, cr.Spec.ForProvider)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errRulesetCreation)
@@ -206,7 +205,16 @@ func (e *rulesetExternal) Update(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalUpdate{}, errors.New(errRulesetUpdate)
 	}
 
-	rs, err := e.client.UpdateRuleset(ctx, rulesetID, cr.Spec.ForProvider)
+	if ruleset.IsEntrypoint(cr.Spec.ForProvider) {
+		return managed.ExternalUpdate{}, errors.Wrap(e.syncEntrypoint(ctx, cr), errRulesetUpdate)
+	}
+
+	current, err := e.client.GetRuleset(ctx, rulesetID, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRulesetUpdate)
+	}
+
+	rs, err := e.updateRules(ctx, rulesetID, cr.Spec.ForProvider, current)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errRulesetUpdate)
 	}
@@ -216,6 +224,35 @@ func (e *rulesetExternal) Update(ctx context.Context, mg resource.Managed) (mana
 	return managed.ExternalUpdate{}, nil
 }
 
+// updateRules applies only the rules that changed when every rule can be
+// matched by ref, and otherwise replaces the whole ruleset.
+func (e *rulesetExternal) updateRules(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters, current *cloudflare.Ruleset) (*cloudflare.Ruleset, error) {
+	diff, ok := ruleset.DiffRules(params.Rules, current.Rules)
+	if !ok || ptr.Deref(params.Description, "") != current.Description {
+		return e.client.UpdateRuleset(ctx, rulesetID, params)
+	}
+
+	rs := current
+	var err error
+	for _, id := range diff.Delete {
+		if rs, err = e.client.DeleteRulesetRule(ctx, rulesetID, id, params); err != nil {
+			return nil, err
+		}
+	}
+	for _, r := range diff.Update {
+		if rs, err = e.client.UpdateRulesetRule(ctx, rulesetID, ruleset.PositionedRule{Rule: r}, params); err != nil {
+			return nil, err
+		}
+	}
+	for _, r := range diff.Create {
+		if rs, err = e.client.CreateRulesetRule(ctx, rulesetID, r, params); err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
 func (e *rulesetExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
 	cr, ok := mg.(*v1beta1.Ruleset)
 	if !ok {
@@ -234,10 +271,95 @@ func (e *rulesetExternal) Delete(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalDelete{}, errors.New(errRulesetDeletion)
 	}
 
+	// Entrypoint rulesets belong to the zone or account and hold the rules
+	// of other resources too, so only the rules of this ruleset are deleted.
+	if ruleset.IsEntrypoint(cr.Spec.ForProvider) {
+		unlock, err := ruleset.LockEntrypoint(ctx, cr.Spec.ForProvider)
+		if err != nil {
+			return managed.ExternalDelete{}, errors.Wrap(err, errRulesetDeletion)
+		}
+		defer unlock()
+
+		err = ruleset.DeleteEntrypointRules(ctx, e.client, cr.Spec.ForProvider, e.owns(cr))
+		return managed.ExternalDelete{}, errors.Wrap(err, errRulesetDeletion)
+	}
+
 	err := e.client.DeleteRuleset(ctx, rulesetID, cr.Spec.ForProvider)
 	return managed.ExternalDelete{}, errors.Wrap(err, errRulesetDeletion)
 }
 
+// observeEntrypoint observes the phase entrypoint ruleset, which is looked up
+// by phase rather than by ID and recorded as the external name once adopted.
+func (e *rulesetExternal) observeEntrypoint(ctx context.Context, cr *v1beta1.Ruleset) (managed.ExternalObservation, error) {
+	rs, err := e.client.GetEntrypointRuleset(ctx, cr.Spec.ForProvider)
+	if cferrors.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRulesetLookup)
+	}
+
+	// The entrypoint is never deleted, so an entrypoint without any of the
+	// rules of this ruleset means the deletion is complete.
+	if meta.WasDeleted(cr) && len(ruleset.EntrypointRules(cr.Spec.ForProvider, cr.Status.AtProvider, rs)) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// The observation is generated from the previous one, which records
+	// the rules this ruleset owns, so up to date is decided first.
+	upToDate := ruleset.EntrypointUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider, rs)
+	cr.Status.AtProvider = ruleset.GenerateEntrypointObservation(cr.Spec.ForProvider, cr.Status.AtProvider, rs)
+	cr.Status.SetConditions(rtv1.Available())
+
+	li := false
+	if meta.GetExternalName(cr) != rs.ID {
+		meta.SetExternalName(cr, rs.ID)
+		li = true
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+// syncEntrypoint brings the rules of the phase entrypoint that belong to the
+// ruleset in line with its desired rules, leaving the rules of other
+// resources in the entrypoint alone.
+func (e *rulesetExternal) syncEntrypoint(ctx context.Context, cr *v1beta1.Ruleset) error {
+	// Rules are owned by ref, so a rule without one could not be told
+	// apart from the rules of other resources once created.
+	for _, r := range cr.Spec.ForProvider.Rules {
+		if ptr.Deref(r.Ref, "") == "" {
+			return errors.New(errEntrypointRef)
+		}
+	}
+
+	unlock, err := ruleset.LockEntrypoint(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	rs, err := ruleset.SyncEntrypointRules(ctx, e.client, cr.Spec.ForProvider, cr.Spec.ForProvider.Rules, e.owns(cr))
+	if err != nil {
+		return err
+	}
+
+	cr.Status.AtProvider = ruleset.GenerateEntrypointObservation(cr.Spec.ForProvider, cr.Status.AtProvider, rs)
+	return nil
+}
+
+// owns returns a function that reports whether a rule of the phase
+// entrypoint belongs to the ruleset.
+func (e *rulesetExternal) owns(cr *v1beta1.Ruleset) func(cloudflare.RulesetRule) bool {
+	observed := cr.Status.AtProvider
+	return func(r cloudflare.RulesetRule) bool {
+		return ruleset.EntrypointOwns(cr.Spec.ForProvider, observed, r)
+	}
+}
+
 func (e *rulesetExternal) Disconnect(ctx context.Context) error {
 	// No persistent connections to clean up
 	return nil
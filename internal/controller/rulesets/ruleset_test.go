@@ -19,6 +19,7 @@ package rulesets
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/google/go-cmp/cmp"
@@ -28,8 +29,10 @@ import (
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
 	ruleset "github.com/rossigee/provider-cloudflare/internal/clients/rulesets"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
@@ -48,6 +51,13 @@ type mockRulesetClient struct {
 	MockGetRuleset    func(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
 	MockUpdateRuleset func(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
 	MockDeleteRuleset func(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) error
+
+	MockCreateRulesetRule func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+	MockUpdateRulesetRule func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+	MockDeleteRulesetRule func(ctx context.Context, rulesetID, ruleID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+
+	MockGetEntrypointRuleset    func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
+	MockUpdateEntrypointRuleset func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error)
 }
 
 func (m *mockRulesetClient) CreateRuleset(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
@@ -66,6 +76,26 @@ func (m *mockRulesetClient) DeleteRuleset(ctx context.Context, rulesetID string,
 	return m.MockDeleteRuleset(ctx, rulesetID, params)
 }
 
+func (m *mockRulesetClient) CreateRulesetRule(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	return m.MockCreateRulesetRule(ctx, rulesetID, rule, params)
+}
+
+func (m *mockRulesetClient) UpdateRulesetRule(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	return m.MockUpdateRulesetRule(ctx, rulesetID, rule, params)
+}
+
+func (m *mockRulesetClient) DeleteRulesetRule(ctx context.Context, rulesetID, ruleID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	return m.MockDeleteRulesetRule(ctx, rulesetID, ruleID, params)
+}
+
+func (m *mockRulesetClient) GetEntrypointRuleset(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	return m.MockGetEntrypointRuleset(ctx, params)
+}
+
+func (m *mockRulesetClient) UpdateEntrypointRuleset(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+	return m.MockUpdateEntrypointRuleset(ctx, params)
+}
+
 type rulesetModifier func(*v1beta1.Ruleset)
 
 func withZone(zone string) rulesetModifier {
@@ -77,6 +107,34 @@ func withRulesetID(id string) rulesetModifier {
 	return func(rs *v1beta1.Ruleset) { rs.Status.AtProvider.ID = id }
 }
 
+func withEntrypoint() rulesetModifier {
+	return func(rs *v1beta1.Ruleset) { rs.Spec.ForProvider.Mode = stringPtr(v1beta1.RulesetModeEntrypoint) }
+}
+
+func withRules(r ...v1beta1.RulesetRule) rulesetModifier {
+	return func(rs *v1beta1.Ruleset) { rs.Spec.ForProvider.Rules = r }
+}
+
+func withObservedRules(ids ...string) rulesetModifier {
+	return func(rs *v1beta1.Ruleset) {
+		for _, id := range ids {
+			rs.Status.AtProvider.Rules = append(rs.Status.AtProvider.Rules, v1beta1.RulesetRuleObservation{ID: id})
+		}
+	}
+}
+
+func ownRule() v1beta1.RulesetRule {
+	return v1beta1.RulesetRule{Ref: stringPtr("own"), Action: "block", Expression: "true"}
+}
+
+func withExternalName(id string) rulesetModifier {
+	return func(rs *v1beta1.Ruleset) { meta.SetExternalName(rs, id) }
+}
+
+func withDeletionTimestamp() rulesetModifier {
+	return func(rs *v1beta1.Ruleset) { rs.SetDeletionTimestamp(&metav1.Time{Time: time.Now()}) }
+}
+
 func rulesetCR(m ...rulesetModifier) *v1beta1.Ruleset {
 	rs := &v1beta1.Ruleset{
 		Spec: v1beta1.RulesetSpec{
@@ -295,6 +353,126 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"EntrypointAdopted": {
+			reason: "Should adopt an existing entrypoint ruleset and record its ID as the external name",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{
+							ID:          "entrypoint-id",
+							Description: "Test ruleset",
+							Kind:        "zone",
+							Phase:       "http_request_firewall_custom",
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(withZone("test-zone-id"), withEntrypoint()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"EntrypointIgnoresOtherRules": {
+			reason: "Rules of other resources in the entrypoint should not make the ruleset out of date",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{
+							ID: "entrypoint-id",
+							Rules: []cloudflare.RulesetRule{
+								{ID: "other-rule", Ref: "other", Action: "log", Expression: "true"},
+								{ID: "own-rule", Ref: "own", Action: "block", Expression: "true"},
+							},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(withZone("test-zone-id"), withEntrypoint(), withExternalName("entrypoint-id"), withRules(ownRule())),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"EntrypointRemovedRule": {
+			reason: "A rule this ruleset owned that left the spec should make the ruleset out of date",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{
+							ID: "entrypoint-id",
+							Rules: []cloudflare.RulesetRule{
+								{ID: "own-rule", Ref: "own", Action: "block", Expression: "true"},
+								{ID: "removed-rule", Ref: "removed", Action: "block", Expression: "true"},
+							},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(withZone("test-zone-id"), withEntrypoint(), withExternalName("entrypoint-id"), withRules(ownRule()),
+					withObservedRules("own-rule", "removed-rule")),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"EntrypointNotFound": {
+			reason: "Should report that the entrypoint does not exist if the phase has none yet",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return nil, &cloudflare.NotFoundError{}
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(withZone("test-zone-id"), withEntrypoint()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"EntrypointEmptiedOnDelete": {
+			reason: "Should report that the ruleset is gone once its rules have left the entrypoint during deletion",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{
+							ID:    "entrypoint-id",
+							Rules: []cloudflare.RulesetRule{{ID: "other-rule", Ref: "other", Action: "log", Expression: "true"}},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(
+					withZone("test-zone-id"),
+					withEntrypoint(),
+					withExternalName("entrypoint-id"),
+					withDeletionTimestamp(),
+				),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -394,12 +572,68 @@ func TestCreate(t *testing.T) {
 				o: managed.ExternalCreation{},
 			},
 		},
+		"EntrypointPut": {
+			reason: "Should PUT the entrypoint ruleset if the phase has none yet rather than create a new ruleset",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return nil, &cloudflare.NotFoundError{}
+					},
+					MockUpdateEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{ID: "entrypoint-id"}, nil
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(withZone("test-zone-id"), withEntrypoint(), withRules(ownRule())),
+			},
+			want: want{
+				o: managed.ExternalCreation{},
+			},
+		},
+		"EntrypointAddRules": {
+			reason: "Should add the rules to an existing entrypoint without replacing the rules of other resources",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{
+							ID:    "entrypoint-id",
+							Rules: []cloudflare.RulesetRule{{ID: "other-rule", Ref: "other", Action: "log", Expression: "true"}},
+						}, nil
+					},
+					MockUpdateEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return nil, errors.New("entrypoint should not be replaced")
+					},
+					MockCreateRulesetRule: func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						if rulesetID != "entrypoint-id" || rule.Rule.Ref != "own" || rule.Index != 0 {
+							return nil, errors.Errorf("unexpected create of %s at %d", rule.Rule.Ref, rule.Index)
+						}
+						return &cloudflare.Ruleset{ID: rulesetID}, nil
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(withZone("test-zone-id"), withEntrypoint(), withRules(ownRule())),
+			},
+			want: want{
+				o: managed.ExternalCreation{},
+			},
+		},
+		"EntrypointRuleWithoutRef": {
+			reason: "Should refuse to write a rule without a ref to an entrypoint",
+			args: args{
+				mg: rulesetCR(withZone("test-zone-id"), withEntrypoint(), withRules(v1beta1.RulesetRule{Action: "block", Expression: "true"})),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(errEntrypointRef), errRulesetCreation),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			e := &rulesetExternal{client: tc.fields.client}
-			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			got, err := e.Create(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("%s\ne.Create(...): -want error, +got error:\n%s", tc.reason, diff)
 			}
@@ -464,10 +698,36 @@ func TestUpdate(t *testing.T) {
 				err: errors.New(errRulesetUpdate),
 			},
 		},
+		"ErrGetRuleset": {
+			reason: "Should return any error encountered reading the current ruleset",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetRuleset: func(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return nil, errors.New("boom")
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(
+					withZone("test-zone-id"),
+					func(rs *v1beta1.Ruleset) {
+						rs.SetAnnotations(map[string]string{
+							"crossplane.io/external-name": "test-ruleset-id",
+						})
+					},
+				),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("boom"), errRulesetUpdate),
+			},
+		},
 		"ErrUpdateRuleset": {
 			reason: "Should return any error encountered updating the ruleset",
 			fields: fields{
 				client: &mockRulesetClient{
+					MockGetRuleset: func(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{ID: "test-ruleset-id", Description: "Old description"}, nil
+					},
 					MockUpdateRuleset: func(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
 						return nil, errors.New("boom")
 					},
@@ -491,6 +751,9 @@ func TestUpdate(t *testing.T) {
 			reason: "Should return no error when ruleset is updated successfully",
 			fields: fields{
 				client: &mockRulesetClient{
+					MockGetRuleset: func(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{ID: "test-ruleset-id", Description: "Old description"}, nil
+					},
 					MockUpdateRuleset: func(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
 						return &cloudflare.Ruleset{
 							ID:          "test-ruleset-id",
@@ -516,12 +779,64 @@ func TestUpdate(t *testing.T) {
 				o: managed.ExternalUpdate{},
 			},
 		},
+		"RuleByRule": {
+			reason: "Rules matched by ref should be changed one by one rather than replacing the ruleset",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetRuleset: func(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{
+							ID:          "test-ruleset-id",
+							Description: "Test ruleset",
+							Rules: []cloudflare.RulesetRule{
+								{ID: "rule-1", Ref: "keep", Action: "block", Expression: "true"},
+								{ID: "rule-2", Ref: "gone", Action: "block", Expression: "true"},
+							},
+						}, nil
+					},
+					MockUpdateRuleset: func(ctx context.Context, rulesetID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return nil, errors.New("ruleset should not be replaced")
+					},
+					MockDeleteRulesetRule: func(ctx context.Context, rulesetID, ruleID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						if ruleID != "rule-2" {
+							return nil, errors.Errorf("unexpected delete of %s", ruleID)
+						}
+						return &cloudflare.Ruleset{ID: rulesetID}, nil
+					},
+					MockUpdateRulesetRule: func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return nil, errors.New("unchanged rule should not be updated")
+					},
+					MockCreateRulesetRule: func(ctx context.Context, rulesetID string, rule ruleset.PositionedRule, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						if rule.Rule.Ref != "new" || rule.Index != 2 {
+							return nil, errors.Errorf("unexpected create of %s at %d", rule.Rule.Ref, rule.Index)
+						}
+						return &cloudflare.Ruleset{ID: rulesetID}, nil
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(
+					withZone("test-zone-id"),
+					func(rs *v1beta1.Ruleset) {
+						rs.SetAnnotations(map[string]string{
+							"crossplane.io/external-name": "test-ruleset-id",
+						})
+						rs.Spec.ForProvider.Rules = []v1beta1.RulesetRule{
+							{Ref: stringPtr("keep"), Action: "block", Expression: "true"},
+							{Ref: stringPtr("new"), Action: "log", Expression: "true"},
+						}
+					},
+				),
+			},
+			want: want{
+				o: managed.ExternalUpdate{},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			e := &rulesetExternal{client: tc.fields.client}
-			got, err := e.Update(tc.args.ctx, tc.args.mg)
+			got, err := e.Update(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("%s\ne.Update(...): -want error, +got error:\n%s", tc.reason, diff)
 			}
@@ -608,6 +923,42 @@ func TestDelete(t *testing.T) {
 				err: errors.Wrap(errors.New("boom"), errRulesetDeletion),
 			},
 		},
+		"EntrypointRulesDeleted": {
+			reason: "Should delete only the rules of the ruleset from an entrypoint rather than delete or empty it",
+			fields: fields{
+				client: &mockRulesetClient{
+					MockGetEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return &cloudflare.Ruleset{
+							ID: "entrypoint-id",
+							Rules: []cloudflare.RulesetRule{
+								{ID: "other-rule", Ref: "other", Action: "log", Expression: "true"},
+								{ID: "own-rule", Ref: "own", Action: "block", Expression: "true"},
+							},
+						}, nil
+					},
+					MockUpdateEntrypointRuleset: func(ctx context.Context, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						return nil, errors.New("entrypoint should not be replaced")
+					},
+					MockDeleteRulesetRule: func(ctx context.Context, rulesetID, ruleID string, params v1beta1.RulesetParameters) (*cloudflare.Ruleset, error) {
+						if ruleID != "own-rule" {
+							return nil, errors.Errorf("unexpected delete of %s", ruleID)
+						}
+						return &cloudflare.Ruleset{ID: rulesetID}, nil
+					},
+				},
+			},
+			args: args{
+				mg: rulesetCR(
+					withZone("test-zone-id"),
+					withEntrypoint(),
+					withExternalName("entrypoint-id"),
+					withRules(ownRule()),
+				),
+			},
+			want: want{
+				err: nil,
+			},
+		},
 		"Success": {
 			reason: "Should return no error when ruleset is deleted successfully",
 			fields: fields{
@@ -636,7 +987,7 @@ func TestDelete(t *testing.T) {
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			e := &rulesetExternal{client: tc.fields.client}
-			_, err := e.Delete(tc.args.ctx, tc.args.mg)
+			_, err := e.Delete(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("%s\ne.Delete(...): -want error, +got error:\n%s", tc.reason, diff)
 			}
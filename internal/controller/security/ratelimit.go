@@ -18,27 +18,25 @@ package security
 
 import (
 	"context"
-	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	securityv1beta1 "github.com/rossigee/provider-cloudflare/apis/security/v1beta1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	botmanagement "github.com/rossigee/provider-cloudflare/internal/clients/security/botmanagement"
 	ratelimit "github.com/rossigee/provider-cloudflare/internal/clients/security/ratelimit"
 	turnstile "github.com/rossigee/provider-cloudflare/internal/clients/security/turnstile"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
@@ -52,33 +50,30 @@ const (
 )
 
 // SetupRateLimit adds a controller that reconciles RateLimit managed resources.
-func SetupRateLimit(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupRateLimit(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(securityv1beta1.RateLimitKind)
 
-	o := controller.Options{
-		RateLimiter: nil, // Use default rate limiter
-		MaxConcurrentReconciles: 5,
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(securityv1beta1.RateLimitGroupVersionKind),
-		managed.WithExternalConnecter(&rateLimitConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&rateLimitConnector{
 			kube: mgr.GetClient(),
 			newServiceFn: func(api *cloudflare.API) *ratelimit.CloudflareRateLimitClient {
 				return ratelimit.NewClientFromAPI(api)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(securityv1beta1.RateLimitGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(securityv1beta1.RateLimitGroupVersionKind.GroupKind())).
 		For(&securityv1beta1.RateLimit{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A rateLimitConnector is expected to produce an ExternalClient when its Connect method
@@ -135,7 +130,7 @@ func (c *rateLimitExternal) Observe(ctx context.Context, mg resource.Managed) (m
 	obs, err := c.service.Get(ctx, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr))
 	if err != nil {
 		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(clients.IsNotFound, err), "cannot get external resource")
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), "cannot get external resource")
 	}
 
 	cr.Status.AtProvider = *obs
@@ -205,33 +200,30 @@ func (c *rateLimitExternal) Disconnect(ctx context.Context) error {
 }
 
 // SetupBotManagement adds a controller that reconciles BotManagement managed resources.
-func SetupBotManagement(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupBotManagement(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(securityv1beta1.BotManagementKind)
 
-	o := controller.Options{
-		RateLimiter: nil, // Use default rate limiter
-		MaxConcurrentReconciles: 5,
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(securityv1beta1.BotManagementGroupVersionKind),
-		managed.WithExternalConnecter(&botManagementConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&botManagementConnector{
 			kube: mgr.GetClient(),
 			newServiceFn: func(api *cloudflare.API) *botmanagement.CloudflareBotManagementClient {
 				return botmanagement.NewClientFromAPI(api)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(securityv1beta1.BotManagementGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(securityv1beta1.BotManagementGroupVersionKind.GroupKind())).
 		For(&securityv1beta1.BotManagement{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A botManagementConnector is expected to produce an ExternalClient when its Connect method
@@ -284,7 +276,7 @@ func (c *botManagementExternal) Observe(ctx context.Context, mg resource.Managed
 	obs, err := c.service.Get(ctx, cr.Spec.ForProvider.Zone)
 	if err != nil {
 		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(clients.IsNotFound, err), "cannot get external resource")
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), "cannot get external resource")
 	}
 
 	cr.Status.AtProvider = *obs
@@ -360,33 +352,30 @@ func (c *botManagementExternal) Disconnect(ctx context.Context) error {
 }
 
 // SetupTurnstile adds a controller that reconciles Turnstile managed resources.
-func SetupTurnstile(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupTurnstile(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(securityv1beta1.TurnstileKind)
 
-	o := controller.Options{
-		RateLimiter: nil, // Use default rate limiter
-		MaxConcurrentReconciles: 5,
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(securityv1beta1.TurnstileGroupVersionKind),
-		managed.WithExternalConnecter(&turnstileConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&turnstileConnector{
 			kube: mgr.GetClient(),
 			newServiceFn: func(api *cloudflare.API) *turnstile.CloudflareTurnstileClient {
 				return turnstile.NewClientFromAPI(api)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(securityv1beta1.TurnstileGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(securityv1beta1.TurnstileGroupVersionKind.GroupKind())).
 		For(&securityv1beta1.Turnstile{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A turnstileConnector is expected to produce an ExternalClient when its Connect method
@@ -443,7 +432,7 @@ func (c *turnstileExternal) Observe(ctx context.Context, mg resource.Managed) (m
 	obs, err := c.service.Get(ctx, cr.Spec.ForProvider.AccountID, meta.GetExternalName(cr))
 	if err != nil {
 		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(clients.IsNotFound, err), "cannot get external resource")
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), "cannot get external resource")
 	}
 
 	cr.Status.AtProvider = *obs
@@ -515,12 +504,12 @@ func (c *turnstileExternal) Disconnect(ctx context.Context) error {
 }
 
 // Setup adds controllers for Security resources.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	if err := SetupRateLimit(mgr, l, rl); err != nil {
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	if err := SetupRateLimit(mgr, o); err != nil {
 		return err
 	}
-	if err := SetupBotManagement(mgr, l, rl); err != nil {
+	if err := SetupBotManagement(mgr, o); err != nil {
 		return err
 	}
-	return SetupTurnstile(mgr, l, rl)
+	return SetupTurnstile(mgr, o)
 }
\ No newline at end of file
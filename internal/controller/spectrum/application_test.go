@@ -225,7 +225,7 @@ func TestObserve(t *testing.T) {
 			fields: fields{
 				client: &mockApplicationClient{
 					MockSpectrumApplication: func(ctx context.Context, zoneID, applicationID string) (cloudflare.SpectrumApplication, error) {
-						return cloudflare.SpectrumApplication{}, errors.New("Application not found")
+						return cloudflare.SpectrumApplication{}, &cloudflare.NotFoundError{}
 					},
 				},
 			},
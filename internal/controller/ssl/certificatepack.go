@@ -18,25 +18,23 @@ package ssl
 
 import (
 	"context"
-	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/ssl/v1beta1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	"github.com/rossigee/provider-cloudflare/internal/clients/ssl/certificatepack"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
@@ -48,32 +46,30 @@ const (
 )
 
 // SetupCertificatePackController adds a controller that reconciles Certificate Pack managed resources.
-func SetupCertificatePackController(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupCertificatePackController(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.CertificatePackKind)
 
-	o := controller.Options{
-		RateLimiter: nil, // Use default rate limiter
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.CertificatePackGroupVersionKind),
-		managed.WithExternalConnecter(&certificatePackConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&certificatePackConnector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (*cloudflare.API, error) {
 				return clients.NewClient(cfg, nil)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.CertificatePackGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.CertificatePackGroupVersionKind.GroupKind())).
 		For(&v1beta1.CertificatePack{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A certificatePackConnector is expected to produce an ExternalClient when its Connect method
@@ -131,7 +127,7 @@ func (c *certificatePackExternal) Observe(ctx context.Context, mg resource.Manag
 
 	observation, err := c.service.Get(ctx, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr))
 	if err != nil {
-		if clients.IsNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			return managed.ExternalObservation{ResourceExists: false}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, "failed to get Certificate Pack")
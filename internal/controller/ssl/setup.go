@@ -17,19 +17,19 @@ limitations under the License.
 package ssl
 
 import (
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 // Setup creates all SSL controllers and adds them to the supplied manager.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
-	if err := SetupUniversalSSLController(mgr, l, rl); err != nil {
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	if err := SetupUniversalSSLController(mgr, o); err != nil {
 		return err
 	}
-	if err := SetupTotalTLSController(mgr, l, rl); err != nil {
+	if err := SetupTotalTLSController(mgr, o); err != nil {
 		return err
 	}
-	return SetupCertificatePackController(mgr, l, rl)
+	return SetupCertificatePackController(mgr, o)
 }
\ No newline at end of file
@@ -18,24 +18,22 @@ package ssl
 
 import (
 	"context"
-	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/ssl/v1beta1"
 	"github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	"github.com/rossigee/provider-cloudflare/internal/clients/ssl/totaltls"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
@@ -47,32 +45,30 @@ const (
 )
 
 // SetupTotalTLSController adds a controller that reconciles Total TLS managed resources.
-func SetupTotalTLSController(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupTotalTLSController(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.TotalTLSKind)
 
-	o := controller.Options{
-		RateLimiter: nil, // Use default rate limiter
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.TotalTLSGroupVersionKind),
-		managed.WithExternalConnecter(&totalTLSConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&totalTLSConnector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (*cloudflare.API, error) {
 				return clients.NewClient(cfg, nil)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.TotalTLSGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.TotalTLSGroupVersionKind.GroupKind())).
 		For(&v1beta1.TotalTLS{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A totalTLSConnector is expected to produce an ExternalClient when its Connect method
@@ -126,7 +122,7 @@ func (c *totalTLSExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 	// We only observe and update the configuration
 	observation, err := c.service.Get(ctx, cr.Spec.ForProvider.Zone)
 	if err != nil {
-		if clients.IsNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			return managed.ExternalObservation{ResourceExists: false}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, "failed to get Total TLS settings")
@@ -18,24 +18,22 @@ package sslsaas
 
 import (
 	"context"
-	"time"
 
 	"github.com/pkg/errors"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/sslsaas/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	customhostname "github.com/rossigee/provider-cloudflare/internal/clients/sslsaas/customhostname"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 	metrics "github.com/rossigee/provider-cloudflare/internal/metrics"
 )
 
@@ -53,40 +51,35 @@ const (
 
 const (
 	customHostnameStatusActive = "active"
-
-	maxConcurrency = 5
 )
 
 // SetupCustomHostname adds a controller that reconciles CustomHostname managed resources.
-func SetupCustomHostname(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupCustomHostname(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.CustomHostnameGroupKind)
 
-	o := controller.Options{
-		RateLimiter: nil, // Use default rate limiter
-		MaxConcurrentReconciles: maxConcurrency,
-	}
-
 	hc := metrics.NewInstrumentedHTTPClient(name)
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.CustomHostnameGroupVersionKind),
-		managed.WithExternalConnecter(&customHostnameConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&customHostnameConnector{
 			kube: mgr.GetClient(),
 			newCloudflareClientFn: func(cfg clients.Config) (customhostname.Client, error) {
 				return customhostname.NewClient(cfg, hc)
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.CustomHostnameGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		// Do not initialize external-name field.
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.CustomHostnameGroupVersionKind.GroupKind())).
 		For(&v1beta1.CustomHostname{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -143,7 +136,7 @@ func (e *customHostnameExternal) Observe(ctx context.Context, mg resource.Manage
 	ch, err := e.client.CustomHostname(ctx, *cr.Spec.ForProvider.Zone, chid)
 
 	if err != nil {
-		if customhostname.IsCustomHostnameNotFound(err) {
+		if cferrors.IsNotFound(err) {
 			return managed.ExternalObservation{ResourceExists: false}, nil
 		}
 		return managed.ExternalObservation{}, errors.Wrap(err, errCustomHostnameLookup)
@@ -36,6 +36,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
@@ -147,7 +148,7 @@ func TestCustomHostnameConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.CustomHostname{
 					Spec: v1beta1.CustomHostnameSpec{
-						ResourceSpec: xpv1.ResourceSpec{},
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{},
 					},
 				},
 			},
@@ -177,8 +178,9 @@ func TestCustomHostnameConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.CustomHostname{
 					Spec: v1beta1.CustomHostnameSpec{
-						ResourceSpec: xpv1.ResourceSpec{
-							ProviderConfigReference: &xpv1.Reference{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{
+							ProviderConfigReference: &xpv1.ProviderConfigReference{
+								Kind: "ProviderConfig",
 								Name: "blah",
 							},
 						},
@@ -298,7 +300,7 @@ func TestCustomHostnameObserve(t *testing.T) {
 			fields: fields{
 				client: &fake.MockClient{
 					MockCustomHostname: func(ctx context.Context, zoneID, hostnameID string) (cloudflare.CustomHostname, error) {
-						return cloudflare.CustomHostname{}, errors.New("Custom Hostname not found")
+						return cloudflare.CustomHostname{}, &cloudflare.NotFoundError{}
 					},
 				},
 			},
@@ -202,7 +202,7 @@ func TestFallbackOriginObserve(t *testing.T) {
 			fields: fields{
 				client: &mockFallbackOriginClient{
 					MockFallbackOrigin: func(ctx context.Context, zoneID string) (cloudflare.CustomHostnameFallbackOrigin, error) {
-						return cloudflare.CustomHostnameFallbackOrigin{}, errors.New("Fallback Origin not found")
+						return cloudflare.CustomHostnameFallbackOrigin{}, &cloudflare.NotFoundError{}
 					},
 				},
 			},
@@ -17,20 +17,19 @@ limitations under the License.
 package sslsaas
 
 import (
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 // Setup creates all SSL for SaaS controllers with the supplied logger and adds them to
 // the supplied manager.
-func Setup(mgr ctrl.Manager, l logging.Logger, wl workqueue.TypedRateLimiter[any]) error {
-	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.TypedRateLimiter[any]) error{
+func Setup(mgr ctrl.Manager, o options.Options) error {
+	for _, setup := range []func(ctrl.Manager, options.Options) error{
 		SetupCustomHostname,
 		SetupFallbackOrigin,
 	} {
-		if err := setup(mgr, l, wl); err != nil {
+		if err := setup(mgr, o); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,336 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutils
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/rossigee/provider-cloudflare/apis"
+	"github.com/rossigee/provider-cloudflare/apis/v1beta1"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cftest"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
+)
+
+// Namespace of the objects of an Env.
+const Namespace = "default"
+
+// Name of the ProviderConfig of an Env, and of the Secret holding its
+// credential.
+const ProviderConfigName = "cftest"
+
+// Most times Sync and Delete reconcile an object before giving up.
+const maxReconciles = 10
+
+// A SetupFn adds controllers to a manager, like the Setup functions of
+// each controller package.
+type SetupFn func(mgr ctrl.Manager, o options.Options) error
+
+// An Env runs the real controllers of managed resources against a fake
+// Cloudflare API, with objects kept by a fake Kubernetes API. Controllers
+// are not started; objects are reconciled when a test asks, so tests are
+// deterministic.
+//
+// Each Env has a ProviderConfig in Namespace whose base URL is that of its
+// fake API. Objects created through the Env without a providerConfigRef
+// use it.
+type Env struct {
+	// API is the fake Cloudflare API the controllers call.
+	API *cftest.Server
+
+	// Client reads and writes the objects of the Env.
+	Client client.Client
+
+	t   testing.TB
+	mgr *fakeManager
+}
+
+// NewEnv returns an Env, which is torn down when the test ends.
+func NewEnv(t testing.TB) *Env {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	api := cftest.NewServer()
+	t.Cleanup(api.Close)
+
+	kube := fake.NewClientBuilder().
+		WithScheme(s).
+		WithStatusSubresource(statusObjects(t, s)...).
+		Build()
+
+	e := &Env{
+		API:    api,
+		Client: kube,
+		t:      t,
+		mgr:    &fakeManager{client: kube, scheme: s},
+	}
+	e.providerConfig()
+	return e
+}
+
+// providerConfig creates the ProviderConfig of the Env and its Secret.
+func (e *Env) providerConfig() {
+	e.t.Helper()
+	token := cftest.Token
+	creds, _ := json.Marshal(map[string]*string{"token": &token})
+	e.create(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: ProviderConfigName},
+		Data:       map[string][]byte{"credentials": creds},
+	})
+
+	accountID, baseURL := cftest.AccountID, e.API.URL
+	e.create(&v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: ProviderConfigName},
+		Spec: v1beta1.ProviderConfigSpec{
+			Credentials: v1beta1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Namespace: Namespace, Name: ProviderConfigName},
+						Key:             "credentials",
+					},
+				},
+			},
+			AccountID: &accountID,
+			BaseURL:   &baseURL,
+		},
+	})
+}
+
+// Setup adds the controllers of each SetupFn to the Env.
+func (e *Env) Setup(fns ...SetupFn) {
+	e.t.Helper()
+	for _, fn := range fns {
+		if err := fn(e.mgr, e.options()); err != nil {
+			e.t.Fatal(err)
+		}
+	}
+}
+
+// options returns the options of the controllers of the Env. Reconciles are
+// not rate limited, since tests run them one at a time.
+func (e *Env) options() options.Options {
+	return options.Options{Options: controller.Options{
+		Logger:                  logging.NewNopLogger(),
+		PollInterval:            time.Minute,
+		MaxConcurrentReconciles: 1,
+	}}
+}
+
+// Create creates obj in Namespace, unless it already has a namespace.
+// Managed resources without a providerConfigRef use the ProviderConfig of
+// the Env.
+func (e *Env) Create(obj client.Object) {
+	e.t.Helper()
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(Namespace)
+	}
+	if mg, ok := obj.(interface {
+		GetProviderConfigReference() *xpv1.ProviderConfigReference
+		SetProviderConfigReference(r *xpv1.ProviderConfigReference)
+	}); ok && mg.GetProviderConfigReference() == nil {
+		mg.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: v1beta1.ProviderConfigKind, Name: ProviderConfigName})
+	}
+	// The API server defaults the management policies of a managed
+	// resource to full control; the fake Kubernetes API does not.
+	if mg, ok := obj.(resource.Managed); ok && len(mg.GetManagementPolicies()) == 0 {
+		mg.SetManagementPolicies(xpv1.ManagementPolicies{xpv1.ManagementActionAll})
+	}
+	e.create(obj)
+}
+
+// create creates obj, giving it a UID as the API server would; the fake
+// Kubernetes API does not.
+func (e *Env) create(obj client.Object) {
+	e.t.Helper()
+	if obj.GetUID() == "" {
+		obj.SetUID(uuid.NewUUID())
+	}
+	if err := e.Client.Create(context.Background(), obj); err != nil {
+		e.t.Fatal(err)
+	}
+}
+
+// Get refreshes obj from the Env. It returns false if obj does not exist.
+func (e *Env) Get(obj client.Object) bool {
+	e.t.Helper()
+	err := e.Client.Get(context.Background(), client.ObjectKeyFromObject(obj), obj)
+	if kerrors.IsNotFound(err) {
+		return false
+	}
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	return true
+}
+
+// Reconcile runs every controller of the Env once for the supplied object,
+// as if each had been told it changed. Controllers of other kinds of
+// object ignore it. It returns whether any controller asked to reconcile
+// it again right away.
+func (e *Env) Reconcile(obj client.Object) bool {
+	e.t.Helper()
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}}
+	again := false
+	for _, r := range e.mgr.reconcilers {
+		res, err := r.Reconcile(context.Background(), req)
+		if err != nil || res.Requeue { //nolint:staticcheck // Managed reconcilers still ask to be requeued this way.
+			again = true
+		}
+	}
+	return again
+}
+
+// Sync reconciles obj until it settles, then refreshes obj. It has settled
+// once reconciling it does not change it. Until then the watch of its
+// controller would have seen it change, and reconciled it again.
+func (e *Env) Sync(obj client.Object) {
+	e.t.Helper()
+	for range maxReconciles {
+		e.Get(obj)
+		version := obj.GetResourceVersion()
+		e.Reconcile(obj)
+		e.Get(obj)
+		if obj.GetResourceVersion() == version {
+			return
+		}
+	}
+}
+
+// Delete deletes obj, and reconciles it until its controller has deleted
+// what it manages and removed its finalizer. It fails the test if obj is
+// not gone by then.
+func (e *Env) Delete(obj client.Object) {
+	e.t.Helper()
+	// Managed reconcilers distrust a missing external resource for a grace
+	// period after creating it. Age the creation so they don't wait it out.
+	if mg, ok := obj.(resource.Managed); ok && e.Get(obj) && !meta.GetExternalCreateSucceeded(mg).IsZero() {
+		meta.SetExternalCreatePending(mg, time.Now().Add(-time.Hour))
+		meta.SetExternalCreateSucceeded(mg, time.Now().Add(-time.Hour))
+		if err := e.Client.Update(context.Background(), obj); err != nil {
+			e.t.Fatal(err)
+		}
+	}
+	if err := e.Client.Delete(context.Background(), obj); err != nil {
+		e.t.Fatal(err)
+	}
+	for range maxReconciles {
+		if !e.Get(obj) {
+			return
+		}
+		e.Reconcile(obj)
+	}
+	if e.Get(obj) {
+		e.t.Fatalf("%T %s was not deleted", obj, obj.GetName())
+	}
+}
+
+// statusObjects returns an object of every kind in s that has a status, so
+// that the fake Kubernetes API serves their status subresource as a real
+// one would.
+func statusObjects(t testing.TB, s *runtime.Scheme) []client.Object {
+	t.Helper()
+	objs := []client.Object{}
+	for gvk := range s.AllKnownTypes() {
+		if gvk.Group == corev1.GroupName {
+			continue
+		}
+		o, err := s.New(gvk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := o.(interface {
+			GetCondition(xpv1.ConditionType) xpv1.Condition
+		}); !ok {
+			continue
+		}
+		if co, ok := o.(client.Object); ok {
+			objs = append(objs, co)
+		}
+	}
+	return objs
+}
+
+// A fakeManager is a manager that is never started. It keeps the
+// controllers added to it, so that an Env can reconcile objects with them.
+type fakeManager struct {
+	manager.Manager
+
+	client      client.Client
+	scheme      *runtime.Scheme
+	cache       informertest.FakeInformers
+	reconcilers []reconcile.Reconciler
+}
+
+func (m *fakeManager) GetClient() client.Client        { return m.client }
+func (m *fakeManager) GetAPIReader() client.Reader     { return m.client }
+func (m *fakeManager) GetScheme() *runtime.Scheme      { return m.scheme }
+func (m *fakeManager) GetCache() cache.Cache           { return &m.cache }
+func (m *fakeManager) GetLogger() logr.Logger          { return logr.Discard() }
+func (m *fakeManager) GetRESTMapper() kmeta.RESTMapper { return m.client.RESTMapper() }
+
+func (m *fakeManager) GetEventRecorderFor(string) record.EventRecorder {
+	return &record.FakeRecorder{}
+}
+
+// GetControllerOptions skips checking that controller names are unique,
+// since every Env adds the same controllers.
+func (m *fakeManager) GetControllerOptions() config.Controller {
+	skip := true
+	return config.Controller{SkipNameValidation: &skip}
+}
+
+// Add keeps controllers, and ignores other runnables.
+func (m *fakeManager) Add(r manager.Runnable) error {
+	if rec, ok := r.(reconcile.Reconciler); ok {
+		m.reconcilers = append(m.reconcilers, rec)
+	}
+	return nil
+}
@@ -37,6 +37,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
@@ -133,7 +134,7 @@ func TestConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.Rule{
 					Spec: v1beta1.RuleSpec{
-						ResourceSpec: xpv1.ResourceSpec{},
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{},
 					},
 				},
 			},
@@ -163,8 +164,9 @@ func TestConnect(t *testing.T) {
 			args: args{
 				mg: &v1beta1.Rule{
 					Spec: v1beta1.RuleSpec{
-						ResourceSpec: xpv1.ResourceSpec{
-							ProviderConfigReference: &xpv1.Reference{
+						ManagedResourceSpec: xpv2.ManagedResourceSpec{
+							ProviderConfigReference: &xpv1.ProviderConfigReference{
+								Kind: "ProviderConfig",
 								Name: "blah",
 							},
 						},
@@ -284,7 +286,7 @@ func TestObserve(t *testing.T) {
 			fields: fields{
 				client: &fake.MockClient{
 					MockGetTransformRule: func(ctx context.Context, zoneID, ruleID, phase string) (cloudflare.RulesetRule, error) {
-						return cloudflare.RulesetRule{}, errors.New("API Error 10014: Rule not found")
+						return cloudflare.RulesetRule{}, &cloudflare.Error{StatusCode: 404, ErrorCodes: []int{10014}}
 					},
 				},
 			},
@@ -18,27 +18,24 @@ package workers
 
 import (
 	"context"
-	"strings"
-	"time"
 
 	"github.com/pkg/errors"
 
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/workers/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	workersclient "github.com/rossigee/provider-cloudflare/internal/clients/workers"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
@@ -52,33 +49,30 @@ const (
 )
 
 // SetupCronTrigger adds a controller that reconciles Worker CronTrigger managed resources.
-func SetupCronTrigger(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupCronTrigger(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.CronTriggerKind)
 
-	o := controller.Options{
-		RateLimiter:             nil, // Use default rate limiter
-		MaxConcurrentReconciles: 5,
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.CronTriggerGroupVersionKind),
-		managed.WithExternalConnecter(&cronTriggerConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&cronTriggerConnector{
 			kube: mgr.GetClient(),
 			newWorkersClientFn: func(client clients.ClientInterface) workersclient.Client {
 				return &stubWorkersClient{mainClient: client}
 			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(10*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.CronTriggerGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.CronTriggerGroupVersionKind.GroupKind())).
 		For(&v1beta1.CronTrigger{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A cronTriggerConnector is expected to produce an ExternalClient when its Connect method
@@ -91,7 +85,7 @@ type cronTriggerConnector struct {
 // Connect produces a valid configuration for a Cloudflare API
 // instance, and returns it as an external client.
 func (c *cronTriggerConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	_, ok := mg.(*v1beta1.CronTrigger)
+	cr, ok := mg.(*v1beta1.CronTrigger)
 	if !ok {
 		return nil, errors.New(errNotCronTrigger)
 	}
@@ -108,8 +102,13 @@ func (c *cronTriggerConnector) Connect(ctx context.Context, mg resource.Managed)
 		return nil, errors.Wrap(err, "failed to create cloudflare client")
 	}
 
+	accountID, err := clients.ResolveAccountID(ctx, api, cr.Spec.ForProvider.AccountID, *config)
+	if err != nil {
+		return nil, errors.Wrap(err, errResolveAccount)
+	}
+
 	// Wrap with adapter to implement ClientInterface
-	adapter := clients.NewCloudflareAPIAdapter(api)
+	adapter := clients.NewCloudflareAPIAdapter(api, accountID)
 	workersClient := c.newWorkersClientFn(adapter)
 	return &cronTriggerExternal{client: workersClient}, nil
 }
@@ -137,7 +136,7 @@ func (e *cronTriggerExternal) Observe(ctx context.Context,
 	cronTriggerObs, err := e.client.WorkerCronTrigger(ctx, scriptName)
 	if err != nil {
 		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(isCronTriggerNotFound, err), errCronTriggerLookup)
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errCronTriggerLookup)
 	}
 
 	// Convert the cron trigger observation
@@ -208,17 +207,6 @@ func (e *cronTriggerExternal) Disconnect(ctx context.Context) error {
 
 // Helper functions
 
-// isCronTriggerNotFound checks if an error indicates that a cron trigger was not found.
-func isCronTriggerNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	errStr := err.Error()
-	return strings.Contains(errStr, "not found") ||
-		   strings.Contains(errStr, "404") ||
-		   strings.Contains(errStr, "does not exist")
-}
-
 // generateCronTriggerObservation converts API response to observation
 func generateCronTriggerObservation(in interface{}) v1beta1.CronTriggerObservation {
 	if response, ok := in.(map[string]interface{}); ok {
@@ -345,4 +333,4 @@ func (c *stubWorkersClient) UpdateWorkerSubdomain(ctx context.Context, accountID
 
 func (c *stubWorkersClient) DeleteWorkerSubdomain(ctx context.Context, accountID, subdomainName string) error {
 	return errors.New("not implemented")
-}
\ No newline at end of file
+}
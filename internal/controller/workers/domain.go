@@ -18,26 +18,22 @@ package workers
 
 import (
 	"context"
-	"strings"
-	"time"
 
 	"github.com/pkg/errors"
 
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
-
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/workers/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
@@ -51,29 +47,27 @@ const (
 )
 
 // SetupDomain adds a controller that reconciles Worker Domain managed resources.
-func SetupDomain(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupDomain(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.DomainKind)
 
-	o := controller.Options{
-		MaxConcurrentReconciles: 5,
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.DomainGroupVersionKind),
-		managed.WithExternalConnecter(&domainConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&domainConnector{
 			kube: mgr.GetClient(),
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(10*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.DomainGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.DomainGroupVersionKind.GroupKind())).
 		For(&v1beta1.Domain{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A domainConnector is expected to produce an ExternalClient when its Connect method
@@ -85,7 +79,7 @@ type domainConnector struct {
 // Connect produces a valid configuration for a Cloudflare API
 // instance, and returns it as an external client.
 func (c *domainConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	_, ok := mg.(*v1beta1.Domain)
+	cr, ok := mg.(*v1beta1.Domain)
 	if !ok {
 		return nil, errors.New(errNotDomain)
 	}
@@ -102,8 +96,13 @@ func (c *domainConnector) Connect(ctx context.Context, mg resource.Managed) (man
 		return nil, errors.Wrap(err, "failed to create cloudflare client")
 	}
 
+	accountID, err := clients.ResolveAccountID(ctx, api, &cr.Spec.ForProvider.AccountID, *config)
+	if err != nil {
+		return nil, errors.Wrap(err, errResolveAccount)
+	}
+
 	// Wrap with adapter to implement ClientInterface
-	adapter := clients.NewCloudflareAPIAdapter(api)
+	adapter := clients.NewCloudflareAPIAdapter(api, accountID)
 	return &domainExternal{client: adapter}, nil
 }
 
@@ -130,7 +129,7 @@ func (e *domainExternal) Observe(ctx context.Context,
 	domainObs, err := e.client.WorkerDomain(ctx, cr.Spec.ForProvider.AccountID, cr.Spec.ForProvider.ZoneID, domainID)
 	if err != nil {
 		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(isDomainNotFound, err), errDomainLookup)
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errDomainLookup)
 	}
 
 	// Convert the domain observation
@@ -201,17 +200,6 @@ func (e *domainExternal) Disconnect(ctx context.Context) error {
 
 // Helper functions
 
-// isDomainNotFound checks if an error indicates that a domain was not found.
-func isDomainNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	errStr := err.Error()
-	return strings.Contains(errStr, "not found") ||
-		   strings.Contains(errStr, "404") ||
-		   strings.Contains(errStr, "does not exist")
-}
-
 // generateDomainObservation converts API response to observation
 func generateDomainObservation(in interface{}) v1beta1.DomainObservation {
 	return v1beta1.DomainObservation{}
@@ -220,4 +208,4 @@ func generateDomainObservation(in interface{}) v1beta1.DomainObservation {
 // isDomainUpToDate checks if the domain matches the desired state
 func isDomainUpToDate(spec v1beta1.DomainParameters, obs v1beta1.DomainObservation) bool {
 	return true
-}
\ No newline at end of file
+}
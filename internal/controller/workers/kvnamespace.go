@@ -18,26 +18,22 @@ package workers
 
 import (
 	"context"
-	"strings"
-	"time"
 
 	"github.com/pkg/errors"
 
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
-
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/workers/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
@@ -51,29 +47,27 @@ const (
 )
 
 // SetupKVNamespace adds a controller that reconciles Worker KVNamespace managed resources.
-func SetupKVNamespace(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupKVNamespace(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.KVNamespaceKind)
 
-	o := controller.Options{
-		MaxConcurrentReconciles: 5,
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.KVNamespaceGroupVersionKind),
-		managed.WithExternalConnecter(&kvNamespaceConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&kvNamespaceConnector{
 			kube: mgr.GetClient(),
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(10*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.KVNamespaceGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.KVNamespaceGroupVersionKind.GroupKind())).
 		For(&v1beta1.KVNamespace{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A kvNamespaceConnector is expected to produce an ExternalClient when its Connect method
@@ -85,7 +79,7 @@ type kvNamespaceConnector struct {
 // Connect produces a valid configuration for a Cloudflare API
 // instance, and returns it as an external client.
 func (c *kvNamespaceConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	_, ok := mg.(*v1beta1.KVNamespace)
+	cr, ok := mg.(*v1beta1.KVNamespace)
 	if !ok {
 		return nil, errors.New(errNotKVNamespace)
 	}
@@ -102,8 +96,13 @@ func (c *kvNamespaceConnector) Connect(ctx context.Context, mg resource.Managed)
 		return nil, errors.Wrap(err, "failed to create cloudflare client")
 	}
 
+	accountID, err := clients.ResolveAccountID(ctx, api, cr.Spec.ForProvider.AccountID, *config)
+	if err != nil {
+		return nil, errors.Wrap(err, errResolveAccount)
+	}
+
 	// Wrap with adapter to implement ClientInterface
-	adapter := clients.NewCloudflareAPIAdapter(api)
+	adapter := clients.NewCloudflareAPIAdapter(api, accountID)
 	return &kvNamespaceExternal{client: adapter}, nil
 }
 
@@ -130,7 +129,7 @@ func (e *kvNamespaceExternal) Observe(ctx context.Context,
 	kvObs, err := e.client.WorkerKVNamespace(ctx, kvID)
 	if err != nil {
 		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(isKVNamespaceNotFound, err), errKVNamespaceLookup)
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errKVNamespaceLookup)
 	}
 
 	// Convert the kv namespace observation
@@ -201,17 +200,6 @@ func (e *kvNamespaceExternal) Disconnect(ctx context.Context) error {
 
 // Helper functions
 
-// isKVNamespaceNotFound checks if an error indicates that a kv namespace was not found.
-func isKVNamespaceNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	errStr := err.Error()
-	return strings.Contains(errStr, "not found") ||
-		   strings.Contains(errStr, "404") ||
-		   strings.Contains(errStr, "does not exist")
-}
-
 // generateKVNamespaceObservation converts API response to observation
 func generateKVNamespaceObservation(in interface{}) v1beta1.KVNamespaceObservation {
 	return v1beta1.KVNamespaceObservation{}
@@ -220,4 +208,4 @@ func generateKVNamespaceObservation(in interface{}) v1beta1.KVNamespaceObservati
 // isKVNamespaceUpToDate checks if the kv namespace matches the desired state
 func isKVNamespaceUpToDate(spec v1beta1.KVNamespaceParameters, obs v1beta1.KVNamespaceObservation) bool {
 	return true
-}
\ No newline at end of file
+}
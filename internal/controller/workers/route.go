@@ -18,27 +18,24 @@ package workers
 
 import (
 	"context"
-	"strings"
-	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/pkg/errors"
 
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/workers/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
@@ -53,29 +50,27 @@ const (
 )
 
 // SetupRoute adds a controller that reconciles Worker Route managed resources.
-func SetupRoute(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupRoute(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.RouteKind)
 
-	o := controller.Options{
-		MaxConcurrentReconciles: 5,
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.RouteGroupVersionKind),
-		managed.WithExternalConnecter(&routeConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&routeConnector{
 			kube: mgr.GetClient(),
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(10*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.RouteGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.RouteGroupVersionKind.GroupKind())).
 		For(&v1beta1.Route{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A routeConnector is expected to produce an ExternalClient when its Connect method
@@ -104,8 +99,9 @@ func (c *routeConnector) Connect(ctx context.Context, mg resource.Managed) (mana
 		return nil, errors.Wrap(err, "failed to create cloudflare client")
 	}
 
-	// Wrap with adapter to implement ClientInterface
-	adapter := clients.NewCloudflareAPIAdapter(api)
+	// Wrap with adapter to implement ClientInterface. Routes are zone
+	// scoped, so there is no account to resolve.
+	adapter := clients.NewCloudflareAPIAdapter(api, clients.AccountID(nil, *config))
 	return &routeExternal{client: adapter, kube: c.kube}, nil
 }
 
@@ -144,7 +140,7 @@ func (e *routeExternal) Observe(ctx context.Context,
 	routesResp, err := e.client.ListWorkerRoutes(ctx, rc, params)
 	if err != nil {
 		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(isRouteNotFound, err), errRouteLookup)
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errRouteLookup)
 	}
 
 	// Find the route with matching pattern
@@ -313,17 +309,6 @@ func (e *routeExternal) Disconnect(ctx context.Context) error {
 
 // Helper functions
 
-// isRouteNotFound checks if an error indicates that a route was not found.
-func isRouteNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	errStr := err.Error()
-	return strings.Contains(errStr, "not found") ||
-		   strings.Contains(errStr, "404") ||
-		   strings.Contains(errStr, "does not exist")
-}
-
 // generateRouteObservation converts API response to observation
 func generateRouteObservation(route *cloudflare.WorkerRoute) v1beta1.RouteObservation {
 	// RouteObservation is currently empty in the API
@@ -333,4 +318,4 @@ func generateRouteObservation(route *cloudflare.WorkerRoute) v1beta1.RouteObserv
 // isRouteUpToDate checks if the route matches the desired state
 func isRouteUpToDate(spec v1beta1.RouteParameters, obs v1beta1.RouteObservation) bool {
 	return true
-}
\ No newline at end of file
+}
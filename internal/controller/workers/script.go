@@ -18,84 +18,75 @@ package workers
 
 import (
 	"context"
-	"strings"
-	"time"
 
 	"github.com/pkg/errors"
 
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/workers/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
 	workerscript "github.com/rossigee/provider-cloudflare/internal/clients/workers/script"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
 	errNotScript = "managed resource is not a Worker Script custom resource"
 
-	errClientConfig = "error getting client config"
+	errClientConfig   = "error getting client config"
+	errResolveAccount = "cannot resolve account ID"
 
 	errScriptLookup      = "cannot lookup script"
 	errScriptObservation = "cannot observe script"
 	errScriptCreation    = "cannot create script"
 	errScriptUpdate      = "cannot update script"
 	errScriptDeletion    = "cannot delete script"
-
-	maxConcurrency = 5
 )
 
 // SetupScript adds a controller that reconciles Worker Script managed resources.
-func SetupScript(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupScript(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.ScriptKind)
 
-	o := controller.Options{
-		RateLimiter: nil, // Use default rate limiter
-		MaxConcurrentReconciles: maxConcurrency,
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.ScriptGroupVersionKind),
-		managed.WithExternalConnecter(&scriptConnector{
-			kube: mgr.GetClient(),
-			newCloudflareClientFn: func(client clients.ClientInterface) *workerscript.ScriptClient {
-				return workerscript.NewClient(client)
-			},
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&scriptConnector{
+			kube:                  mgr.GetClient(),
+			newCloudflareClientFn: workerscript.NewClient,
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(5*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.ScriptGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		// Do not initialize external-name field.
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.ScriptGroupVersionKind.GroupKind())).
 		For(&v1beta1.Script{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A scriptConnector is expected to produce an ExternalClient when its Connect method
 // is called.
 type scriptConnector struct {
 	kube                  client.Client
-	newCloudflareClientFn func(client clients.ClientInterface) *workerscript.ScriptClient
+	newCloudflareClientFn func(client clients.ClientInterface, session *clients.Session) *workerscript.ScriptClient
 }
 
 // Connect produces a valid configuration for a Cloudflare API
 // instance, and returns it as an external client.
 func (c *scriptConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	_, ok := mg.(*v1beta1.Script)
+	cr, ok := mg.(*v1beta1.Script)
 	if !ok {
 		return nil, errors.New(errNotScript)
 	}
@@ -112,9 +103,14 @@ func (c *scriptConnector) Connect(ctx context.Context, mg resource.Managed) (man
 		return nil, errors.Wrap(err, "failed to create cloudflare client")
 	}
 
+	accountID, err := clients.ResolveAccountID(ctx, api, cr.Spec.ForProvider.AccountID, *config)
+	if err != nil {
+		return nil, errors.Wrap(err, errResolveAccount)
+	}
+
 	// Wrap with adapter to implement ClientInterface
-	adapter := clients.NewCloudflareAPIAdapter(api)
-	client := c.newCloudflareClientFn(adapter)
+	adapter := clients.NewCloudflareAPIAdapter(api, accountID)
+	client := c.newCloudflareClientFn(adapter, config.Session)
 	return &scriptExternal{client: client}, nil
 }
 
@@ -141,7 +137,7 @@ func (e *scriptExternal) Observe(ctx context.Context,
 	scriptObs, err := e.client.Get(ctx, scriptName)
 	if err != nil {
 		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(isScriptNotFound, err), errScriptLookup)
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errScriptLookup)
 	}
 
 	cr.Status.AtProvider = *scriptObs
@@ -213,14 +209,3 @@ func (e *scriptExternal) Disconnect(ctx context.Context) error {
 	// No persistent connections to clean up
 	return nil
 }
-
-// isScriptNotFound checks if an error indicates that a script was not found.
-func isScriptNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	errStr := err.Error()
-	return strings.Contains(errStr, "not found") ||
-		   strings.Contains(errStr, "404") ||
-		   strings.Contains(errStr, "does not exist")
-}
\ No newline at end of file
@@ -17,42 +17,42 @@ limitations under the License.
 package workers
 
 import (
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 // Setup creates all Workers controllers with the supplied logger and adds them to
 // the supplied manager.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func Setup(mgr ctrl.Manager, o options.Options) error {
 	// Setup Script controller - the primary Workers resource
-	if err := SetupScript(mgr, l, rl); err != nil {
+	if err := SetupScript(mgr, o); err != nil {
 		return err
 	}
 
 	// Setup Route controller
-	if err := SetupRoute(mgr, l, rl); err != nil {
+	if err := SetupRoute(mgr, o); err != nil {
 		return err
 	}
 
 	// Setup CronTrigger controller
-	if err := SetupCronTrigger(mgr, l, rl); err != nil {
+	if err := SetupCronTrigger(mgr, o); err != nil {
 		return err
 	}
 
 	// Setup Domain controller
-	if err := SetupDomain(mgr, l, rl); err != nil {
+	if err := SetupDomain(mgr, o); err != nil {
 		return err
 	}
 
 	// Setup KVNamespace controller
-	if err := SetupKVNamespace(mgr, l, rl); err != nil {
+	if err := SetupKVNamespace(mgr, o); err != nil {
 		return err
 	}
 
 	// Setup Subdomain controller
-	if err := SetupSubdomain(mgr, l, rl); err != nil {
+	if err := SetupSubdomain(mgr, o); err != nil {
 		return err
 	}
 
@@ -18,26 +18,22 @@ package workers
 
 import (
 	"context"
-	"strings"
-	"time"
 
 	"github.com/pkg/errors"
 
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
-
 
 	rtv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
-	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
 	"github.com/rossigee/provider-cloudflare/apis/workers/v1beta1"
 	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/cferrors"
+	"github.com/rossigee/provider-cloudflare/internal/controller/options"
 )
 
 const (
@@ -51,29 +47,27 @@ const (
 )
 
 // SetupSubdomain adds a controller that reconciles Worker Subdomain managed resources.
-func SetupSubdomain(mgr ctrl.Manager, l logging.Logger, rl workqueue.TypedRateLimiter[any]) error {
+func SetupSubdomain(mgr ctrl.Manager, o options.Options) error {
 	name := managed.ControllerName(v1beta1.SubdomainKind)
 
-	o := controller.Options{
-		MaxConcurrentReconciles: 5,
-	}
-
-	r := managed.NewReconciler(mgr,
+	r := managed.NewReconciler(options.ReportErrors(mgr),
 		resource.ManagedKind(v1beta1.SubdomainGroupVersionKind),
-		managed.WithExternalConnecter(&subdomainConnector{
+		managed.WithExternalConnecter(options.ClassifyErrors(options.OrphanOnDelete(&subdomainConnector{
 			kube: mgr.GetClient(),
-		}),
-		managed.WithLogger(l.WithValues("controller", name)),
+		}))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithPollInterval(10*time.Minute),
+		managed.WithPollInterval(o.PollIntervalFor(v1beta1.SubdomainGroupVersionKind.GroupKind())),
+		managed.WithPollIntervalHook(options.PollIntervalHook),
+		managed.WithManagementPolicies(),
 		managed.WithInitializers(),
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o).
+		WithOptions(o.ControllerOptions(v1beta1.SubdomainGroupVersionKind.GroupKind())).
 		For(&v1beta1.Subdomain{}).
-		Complete(r)
+		Complete(o.Reconciler(name, r))
 }
 
 // A subdomainConnector is expected to produce an ExternalClient when its Connect method
@@ -85,7 +79,7 @@ type subdomainConnector struct {
 // Connect produces a valid configuration for a Cloudflare API
 // instance, and returns it as an external client.
 func (c *subdomainConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	_, ok := mg.(*v1beta1.Subdomain)
+	cr, ok := mg.(*v1beta1.Subdomain)
 	if !ok {
 		return nil, errors.New(errNotSubdomain)
 	}
@@ -102,8 +96,13 @@ func (c *subdomainConnector) Connect(ctx context.Context, mg resource.Managed) (
 		return nil, errors.Wrap(err, "failed to create cloudflare client")
 	}
 
+	accountID, err := clients.ResolveAccountID(ctx, api, &cr.Spec.ForProvider.AccountID, *config)
+	if err != nil {
+		return nil, errors.Wrap(err, errResolveAccount)
+	}
+
 	// Wrap with adapter to implement ClientInterface
-	adapter := clients.NewCloudflareAPIAdapter(api)
+	adapter := clients.NewCloudflareAPIAdapter(api, accountID)
 	return &subdomainExternal{client: adapter}, nil
 }
 
@@ -130,7 +129,7 @@ func (e *subdomainExternal) Observe(ctx context.Context,
 	subdomainObs, err := e.client.WorkerSubdomain(ctx, cr.Spec.ForProvider.AccountID, subdomainName)
 	if err != nil {
 		return managed.ExternalObservation{},
-			errors.Wrap(resource.Ignore(isSubdomainNotFound, err), errSubdomainLookup)
+			errors.Wrap(resource.Ignore(cferrors.IsNotFound, err), errSubdomainLookup)
 	}
 
 	// Convert the subdomain observation
@@ -201,17 +200,6 @@ func (e *subdomainExternal) Disconnect(ctx context.Context) error {
 
 // Helper functions
 
-// isSubdomainNotFound checks if an error indicates that a subdomain was not found.
-func isSubdomainNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	errStr := err.Error()
-	return strings.Contains(errStr, "not found") ||
-		   strings.Contains(errStr, "404") ||
-		   strings.Contains(errStr, "does not exist")
-}
-
 // generateSubdomainObservation converts API response to observation
 func generateSubdomainObservation(in interface{}) v1beta1.SubdomainObservation {
 	return v1beta1.SubdomainObservation{}
@@ -220,4 +208,4 @@ func generateSubdomainObservation(in interface{}) v1beta1.SubdomainObservation {
 // isSubdomainUpToDate checks if the subdomain matches the desired state
 func isSubdomainUpToDate(spec v1beta1.SubdomainParameters, obs v1beta1.SubdomainObservation) bool {
 	return true
-}
\ No newline at end of file
+}
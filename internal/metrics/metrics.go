@@ -22,6 +22,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/rossigee/provider-cloudflare/internal/clients/throttle"
+	"github.com/rossigee/provider-cloudflare/internal/clients/transport"
 )
 
 var (
@@ -69,14 +72,19 @@ func init() {
 }
 
 // NewInstrumentedHTTPClient returns a *http.Client that has
-// been instrumented to track request latencies, types and statuses.
+// been instrumented to track request latencies, types and statuses,
+// and that shares the request budget of each credential with every
+// other controller.
 func NewInstrumentedHTTPClient(n string) *http.Client {
 	c := http.Client{}
 	InstrumentHTTPClient(&c, n)
 	return &c
 }
 
-// InstrumentHTTPClient instruments an existing *http.Client.
+// InstrumentHTTPClient instruments an existing *http.Client. Requests
+// wait for their credential's budget before they are instrumented, so
+// that the metrics track requests actually sent to Cloudflare. They are
+// sent with the transport of the ProviderConfig they are made for.
 func InstrumentHTTPClient(hc *http.Client, n string) {
 	l := prometheus.Labels{"controller": n}
 
@@ -109,10 +117,12 @@ func InstrumentHTTPClient(hc *http.Client, n string) {
 		},
 	}
 
-	hc.Transport = promhttp.InstrumentRoundTripperInFlight(rif,
-		promhttp.InstrumentRoundTripperCounter(rt,
-			promhttp.InstrumentRoundTripperTrace(trace,
-				promhttp.InstrumentRoundTripperDuration(rl, http.DefaultTransport),
+	hc.Transport = throttle.NewTransport(n,
+		promhttp.InstrumentRoundTripperInFlight(rif,
+			promhttp.InstrumentRoundTripperCounter(rt,
+				promhttp.InstrumentRoundTripperTrace(trace,
+					promhttp.InstrumentRoundTripperDuration(rl, transport.Base),
+				),
 			),
 		),
 	)
@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/rossigee/provider-cloudflare/apis/loadbalancing/v1beta1"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-loadbalancing-cloudflare-m-crossplane-io-v1beta1-loadbalancerpool,mutating=false,failurePolicy=fail,sideEffects=None,groups=loadbalancing.cloudflare.m.crossplane.io,resources=loadbalancerpools,versions=v1beta1,name=loadbalancerpools.loadbalancing.cloudflare.m.crossplane.io,admissionReviewVersions=v1
+
+// SetupLoadBalancerPool adds a webhook that validates LoadBalancerPools.
+func SetupLoadBalancerPool(mgr ctrl.Manager) error {
+	return setup(mgr, &v1beta1.LoadBalancerPool{}, validateLoadBalancerPool)
+}
+
+// validateLoadBalancerPool validates the origins of a LoadBalancerPool.
+// Each origin must have a unique name, an address that is an IP address or
+// hostname, and a weight between 0 and 1.
+func validateLoadBalancerPool(_ context.Context, cr *v1beta1.LoadBalancerPool) (admission.Warnings, error) {
+	var errs field.ErrorList
+	path := field.NewPath("spec", "forProvider", "origins")
+	if len(cr.Spec.ForProvider.Origins) == 0 {
+		errs = append(errs, field.Required(path, "a pool must have at least one origin"))
+	}
+
+	names := map[string]bool{}
+	for i, o := range cr.Spec.ForProvider.Origins {
+		if names[o.Name] {
+			errs = append(errs, field.Duplicate(path.Index(i).Child("name"), o.Name))
+		}
+		names[o.Name] = true
+
+		if msg := validateOriginAddress(o.Address); msg != "" {
+			errs = append(errs, field.Invalid(path.Index(i).Child("address"), o.Address, msg))
+		}
+
+		if o.Weight != nil && (*o.Weight < 0 || *o.Weight > 1) {
+			errs = append(errs, field.Invalid(path.Index(i).Child("weight"), *o.Weight, "must be between 0 and 1"))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, kerrors.NewInvalid(v1beta1.LoadBalancerPoolGroupVersionKind.GroupKind(), cr.GetName(), errs)
+	}
+	return nil, nil
+}
+
+// validateOriginAddress returns why the supplied origin address is invalid,
+// or an empty string if it is valid.
+func validateOriginAddress(address string) string {
+	switch {
+	case address == "":
+		return "must be an IP address or hostname"
+	case net.ParseIP(address) != nil:
+		return ""
+	case strings.Contains(address, "://"):
+		return "must be an IP address or hostname, without a scheme"
+	case strings.ContainsAny(address, ":/"):
+		return "must be an IP address or hostname, without a port or path"
+	}
+	if msgs := validation.IsDNS1123Subdomain(strings.ToLower(strings.TrimSuffix(address, "."))); len(msgs) > 0 {
+		return "must be an IP address or hostname: " + strings.Join(msgs, ", ")
+	}
+	return ""
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/loadbalancing/v1beta1"
+)
+
+func TestValidateLoadBalancerPool(t *testing.T) {
+	pool := func(origins ...v1beta1.LoadBalancerOrigin) *v1beta1.LoadBalancerPool {
+		cr := &v1beta1.LoadBalancerPool{}
+		cr.SetName("example")
+		cr.Spec.ForProvider.Origins = origins
+		return cr
+	}
+	path := field.NewPath("spec", "forProvider", "origins")
+	invalid := func(errs ...*field.Error) error {
+		return kerrors.NewInvalid(v1beta1.LoadBalancerPoolGroupVersionKind.GroupKind(), "example", errs)
+	}
+
+	cases := map[string]struct {
+		reason string
+		cr     *v1beta1.LoadBalancerPool
+		want   error
+	}{
+		"Valid": {
+			reason: "Origins with IP addresses, hostnames and weights between 0 and 1 should be accepted.",
+			cr: pool(
+				v1beta1.LoadBalancerOrigin{Name: "ipv4", Address: "192.0.2.1", Weight: ptr.To(0.5)},
+				v1beta1.LoadBalancerOrigin{Name: "ipv6", Address: "2001:db8::1", Weight: ptr.To(1.0)},
+				v1beta1.LoadBalancerOrigin{Name: "host", Address: "origin.example.com", Weight: ptr.To(0.0)},
+			),
+		},
+		"NoOrigins": {
+			reason: "A pool without origins should be rejected.",
+			cr:     pool(),
+			want:   invalid(field.Required(path, "a pool must have at least one origin")),
+		},
+		"DuplicateName": {
+			reason: "Origins should have unique names.",
+			cr: pool(
+				v1beta1.LoadBalancerOrigin{Name: "origin", Address: "192.0.2.1"},
+				v1beta1.LoadBalancerOrigin{Name: "origin", Address: "192.0.2.2"},
+			),
+			want: invalid(field.Duplicate(path.Index(1).Child("name"), "origin")),
+		},
+		"InvalidAddresses": {
+			reason: "Origin addresses should be IP addresses or hostnames without a scheme, port or path.",
+			cr: pool(
+				v1beta1.LoadBalancerOrigin{Name: "empty"},
+				v1beta1.LoadBalancerOrigin{Name: "scheme", Address: "https://origin.example.com"},
+				v1beta1.LoadBalancerOrigin{Name: "port", Address: "origin.example.com:8080"},
+				v1beta1.LoadBalancerOrigin{Name: "path", Address: "origin.example.com/health"},
+			),
+			want: invalid(
+				field.Invalid(path.Index(0).Child("address"), "", "must be an IP address or hostname"),
+				field.Invalid(path.Index(1).Child("address"), "https://origin.example.com", "must be an IP address or hostname, without a scheme"),
+				field.Invalid(path.Index(2).Child("address"), "origin.example.com:8080", "must be an IP address or hostname, without a port or path"),
+				field.Invalid(path.Index(3).Child("address"), "origin.example.com/health", "must be an IP address or hostname, without a port or path"),
+			),
+		},
+		"InvalidWeight": {
+			reason: "Origin weights should be between 0 and 1.",
+			cr: pool(
+				v1beta1.LoadBalancerOrigin{Name: "origin", Address: "192.0.2.1", Weight: ptr.To(1.5)},
+			),
+			want: invalid(field.Invalid(path.Index(0).Child("weight"), 1.5, "must be between 0 and 1")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := validateLoadBalancerPool(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nvalidateLoadBalancerPool(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
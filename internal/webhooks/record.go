@@ -0,0 +1,329 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	zonev1beta1 "github.com/rossigee/provider-cloudflare/apis/zone/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/records"
+)
+
+const (
+	errGetZone        = "cannot get zone of record"
+	errListRecords    = "cannot list records"
+	errListRecordSets = "cannot list record sets"
+	errListZones      = "cannot list zones"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-dns-cloudflare-m-crossplane-io-v1beta1-record,mutating=false,failurePolicy=fail,sideEffects=None,groups=dns.cloudflare.m.crossplane.io,resources=records,versions=v1beta1,name=records.dns.cloudflare.m.crossplane.io,admissionReviewVersions=v1
+
+// SetupRecord adds a webhook that validates DNS Records.
+func SetupRecord(mgr ctrl.Manager) error {
+	v := &recordValidator{kube: mgr.GetClient(), records: clients.NewDNSRecordValidator()}
+	return setup(mgr, &v1beta1.Record{}, v.validate)
+}
+
+// A recordValidator validates the content of DNS Records, that their names
+// belong to their zones, and that the records they manage do not conflict
+// with those of other Records and RecordSets.
+type recordValidator struct {
+	kube    client.Reader
+	records clients.DNSRecordValidator
+}
+
+func (v *recordValidator) validate(ctx context.Context, cr *v1beta1.Record) (admission.Warnings, error) {
+	var errs field.ErrorList
+	if err := v.validateContent(field.NewPath("spec", "forProvider"), cr.Spec.ForProvider); err != nil {
+		errs = append(errs, err)
+	}
+
+	zone, err := v.zoneName(ctx, cr.GetNamespace(), cr.Spec.ForProvider.Zone, cr.Spec.ForProvider.ZoneRef)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetZone)
+	}
+	if nerr := validateName(cr.Spec.ForProvider.Name, zone); nerr != nil {
+		errs = append(errs, nerr)
+	}
+
+	conflict, cerr := v.conflict(ctx, recordMember(cr), zone)
+	if cerr != nil {
+		return nil, cerr
+	}
+	if conflict != nil {
+		errs = append(errs, conflict)
+	}
+
+	if len(errs) > 0 {
+		return nil, kerrors.NewInvalid(v1beta1.RecordGroupVersionKind.GroupKind(), cr.GetName(), errs)
+	}
+	return nil, nil
+}
+
+// validateContent validates the content or data of a record, whose
+// parameters are at the supplied path.
+func (v *recordValidator) validateContent(path *field.Path, p v1beta1.RecordParameters) *field.Error {
+	rtype := ptr.Deref(p.Type, "A")
+
+	if p.Data != nil {
+		if _, err := records.ConvertData(&p); err != nil {
+			return field.Invalid(path.Child("data"), strings.ToLower(rtype), err.Error())
+		}
+		return nil
+	}
+
+	// SRV records that set priority, weight and port hold only their
+	// target in their content.
+	content := p.Content
+	if rtype == "SRV" && p.Priority != nil && p.Weight != nil && p.Port != nil {
+		content = fmt.Sprintf("%d %d %d %s", *p.Priority, *p.Weight, *p.Port, p.Content)
+	}
+
+	var priority *int
+	if p.Priority != nil {
+		priority = ptr.To(int(*p.Priority))
+	}
+	if err := v.records.ValidateRecord(rtype, content, priority); err != nil {
+		return field.Invalid(path.Child("content"), p.Content, err.Error())
+	}
+	return nil
+}
+
+// zoneName returns the name of the zone with the supplied ID or reference,
+// or an empty string if the zone is not known.
+func (v *recordValidator) zoneName(ctx context.Context, namespace string, id *string, ref *xpv1.Reference) (string, error) {
+	switch {
+	case ref != nil:
+		z := &zonev1beta1.Zone{}
+		if err := v.kube.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, z); err != nil {
+			return "", ignoreUnknown(err)
+		}
+		return z.Spec.ForProvider.Name, nil
+	case id != nil:
+		l := &zonev1beta1.ZoneList{}
+		if err := v.kube.List(ctx, l, client.InNamespace(namespace)); err != nil {
+			return "", ignoreUnknown(err)
+		}
+		for i := range l.Items {
+			if meta.GetExternalName(&l.Items[i]) == *id {
+				return l.Items[i].Spec.ForProvider.Name, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// A member is a Record or RecordSet, as far as the records it manages
+// may conflict with those of another Record or RecordSet.
+type member struct {
+	set        bool
+	namespace  string
+	name       string
+	recordName string
+	rtype      string
+	zone       *string
+	zoneRef    *xpv1.Reference
+}
+
+func recordMember(cr *v1beta1.Record) member {
+	p := cr.Spec.ForProvider
+	return member{
+		namespace:  cr.GetNamespace(),
+		name:       cr.GetName(),
+		recordName: p.Name,
+		rtype:      ptr.Deref(p.Type, "A"),
+		zone:       p.Zone,
+		zoneRef:    p.ZoneRef,
+	}
+}
+
+func setMember(cr *v1beta1.RecordSet) member {
+	p := cr.Spec.ForProvider
+	return member{
+		set:        true,
+		namespace:  cr.GetNamespace(),
+		name:       cr.GetName(),
+		recordName: p.Name,
+		rtype:      ptr.Deref(p.Type, "A"),
+		zone:       p.Zone,
+		zoneRef:    p.ZoneRef,
+	}
+}
+
+func (m member) String() string {
+	kind := "record"
+	if m.set {
+		kind = "record set"
+	}
+	return fmt.Sprintf("%s %s %s/%s", m.rtype, kind, m.namespace, m.name)
+}
+
+// A memberZone is the zone of a member, as far as it is known.
+type memberZone struct {
+	// id of the zone, if known.
+	id string
+
+	// name of the zone, if known.
+	name string
+
+	// ref is the reference to the zone, if the member has one.
+	ref *types.NamespacedName
+}
+
+// zoneOf returns the zone of the supplied member, resolving its zone ID or
+// reference with the supplied Zones. A reference resolves to the ID and
+// name of the Zone it references, and an ID to the name of the Zone with
+// that ID.
+func zoneOf(m member, zones []zonev1beta1.Zone) memberZone {
+	z := memberZone{id: ptr.Deref(m.zone, "")}
+	if m.zoneRef != nil {
+		z.ref = &types.NamespacedName{Namespace: m.namespace, Name: m.zoneRef.Name}
+	}
+	for i := range zones {
+		switch {
+		case z.ref != nil && zones[i].GetNamespace() == z.ref.Namespace && zones[i].GetName() == z.ref.Name:
+			if id := meta.GetExternalName(&zones[i]); id != "" {
+				z.id = id
+			}
+			z.name = zones[i].Spec.ForProvider.Name
+			return z
+		case z.ref == nil && z.id != "" && meta.GetExternalName(&zones[i]) == z.id:
+			z.name = zones[i].Spec.ForProvider.Name
+		}
+	}
+	return z
+}
+
+// sameZone returns true if the supplied zones are known to be the same:
+// they have the same ID, or reference the same Zone.
+func sameZone(a, b memberZone) bool {
+	switch {
+	case a.id != "" && b.id != "":
+		return a.id == b.id
+	case a.ref != nil && b.ref != nil:
+		return *a.ref == *b.ref
+	}
+	return false
+}
+
+// conflict returns an error if the records the supplied member manages
+// conflict with those of another Record or RecordSet in its zone, whose
+// name is supplied if it is known. A CNAME record must be the only record
+// with its name, and a RecordSet must be the only Record or RecordSet
+// managing the records of its name and type.
+func (v *recordValidator) conflict(ctx context.Context, m member, zone string) (*field.Error, error) {
+	rl := &v1beta1.RecordList{}
+	if err := v.kube.List(ctx, rl); err != nil {
+		return nil, errors.Wrap(err, errListRecords)
+	}
+	sl := &v1beta1.RecordSetList{}
+	if err := v.kube.List(ctx, sl); err != nil {
+		return nil, errors.Wrap(err, errListRecordSets)
+	}
+	zl := &zonev1beta1.ZoneList{}
+	if err := v.kube.List(ctx, zl); ignoreUnknown(err) != nil {
+		return nil, errors.Wrap(err, errListZones)
+	}
+
+	others := make([]member, 0, len(rl.Items)+len(sl.Items))
+	for i := range rl.Items {
+		if rl.Items[i].GetDeletionTimestamp() == nil {
+			others = append(others, recordMember(&rl.Items[i]))
+		}
+	}
+	for i := range sl.Items {
+		if sl.Items[i].GetDeletionTimestamp() == nil {
+			others = append(others, setMember(&sl.Items[i]))
+		}
+	}
+
+	mz := zoneOf(m, zl.Items)
+	if mz.name == "" {
+		mz.name = zone
+	}
+
+	path := field.NewPath("spec", "forProvider", "name")
+	for _, o := range others {
+		if o.set == m.set && o.namespace == m.namespace && o.name == m.name {
+			continue
+		}
+		oz := zoneOf(o, zl.Items)
+		if !sameZone(mz, oz) {
+			continue
+		}
+		// The zone is the same, so its name is known if either member
+		// knows it.
+		zn := oz.name
+		if zn == "" {
+			zn = mz.name
+		}
+		dnsName := records.FQDN(m.recordName, zn)
+		if records.FQDN(o.recordName, zn) != dnsName {
+			continue
+		}
+		switch {
+		case m.rtype == "CNAME" || o.rtype == "CNAME":
+			return field.Forbidden(path,
+				fmt.Sprintf("a CNAME record must be the only record named %s, but %s has that name", dnsName, o)), nil
+		case (m.set || o.set) && m.rtype == o.rtype:
+			return field.Forbidden(path,
+				fmt.Sprintf("a record set must be the only resource managing the %s records named %s, but %s has that name", m.rtype, dnsName, o)), nil
+		}
+	}
+	return nil, nil
+}
+
+// validateName returns an error if the supplied name is fully qualified,
+// ending with a dot, but is not within the supplied zone. Names that are
+// not fully qualified are relative to the zone.
+func validateName(name, zone string) *field.Error {
+	if zone == "" || !strings.HasSuffix(name, ".") {
+		return nil
+	}
+	n := strings.ToLower(strings.TrimSuffix(name, "."))
+	z := strings.ToLower(zone)
+	if n == z || strings.HasSuffix(n, "."+z) {
+		return nil
+	}
+	return field.Invalid(field.NewPath("spec", "forProvider", "name"), name, fmt.Sprintf("must be within zone %s", zone))
+}
+
+// ignoreUnknown ignores errors for resources, or kinds of resource, that
+// do not exist.
+func ignoreUnknown(err error) error {
+	if kerrors.IsNotFound(err) || kmeta.IsNoMatchError(err) {
+		return nil
+	}
+	return err
+}
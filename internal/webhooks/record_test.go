@@ -0,0 +1,238 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis"
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	zonev1beta1 "github.com/rossigee/provider-cloudflare/apis/zone/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+func TestRecordValidatorValidate(t *testing.T) {
+	zone := &zonev1beta1.Zone{}
+	zone.SetNamespace("default")
+	zone.SetName("example")
+	zone.Spec.ForProvider.Name = "example.com"
+	meta.SetExternalName(zone, "zone-id")
+
+	record := func(name, rtype, content string, m ...func(*v1beta1.Record)) *v1beta1.Record {
+		cr := &v1beta1.Record{}
+		cr.SetNamespace("default")
+		cr.SetName(name + "-" + rtype)
+		cr.Spec.ForProvider.Name = name
+		cr.Spec.ForProvider.Type = ptr.To(rtype)
+		cr.Spec.ForProvider.Content = content
+		cr.Spec.ForProvider.ZoneRef = &xpv1.Reference{Name: "example"}
+		for _, f := range m {
+			f(cr)
+		}
+		return cr
+	}
+	set := func(name, rtype, value string) *v1beta1.RecordSet {
+		cr := &v1beta1.RecordSet{}
+		cr.SetNamespace("default")
+		cr.SetName(name)
+		cr.Spec.ForProvider.Name = name
+		cr.Spec.ForProvider.Type = ptr.To(rtype)
+		cr.Spec.ForProvider.Values = []v1beta1.RecordSetValue{{Content: value}}
+		cr.Spec.ForProvider.ZoneRef = &xpv1.Reference{Name: "example"}
+		return cr
+	}
+	byZoneID := func(cr *v1beta1.Record) {
+		cr.Spec.ForProvider.ZoneRef = nil
+		cr.Spec.ForProvider.Zone = ptr.To("zone-id")
+	}
+	infraZone := zone.DeepCopy()
+	infraZone.SetNamespace("infra")
+	otherZone := infraZone.DeepCopy()
+	meta.SetExternalName(otherZone, "other-id")
+	inInfra := func(cr *v1beta1.Record) { cr.SetNamespace("infra") }
+	invalid := func(name string, errs ...*field.Error) error {
+		return kerrors.NewInvalid(v1beta1.RecordGroupVersionKind.GroupKind(), name, errs)
+	}
+	path := field.NewPath("spec", "forProvider")
+
+	type args struct {
+		objs []client.Object
+		cr   *v1beta1.Record
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"Valid": {
+			reason: "A valid record within its zone should be accepted.",
+			args: args{
+				objs: []client.Object{zone},
+				cr:   record("www", "A", "192.0.2.1"),
+			},
+		},
+		"InvalidContent": {
+			reason: "A record with invalid content should be rejected.",
+			args: args{
+				objs: []client.Object{zone},
+				cr:   record("www", "A", "not-an-ip"),
+			},
+			want: invalid("www-A", field.Invalid(path.Child("content"), "not-an-ip", "invalid IPv4 address format")),
+		},
+		"SRVFields": {
+			reason: "SRV content should be built from priority, weight and port before it is validated.",
+			args: args{
+				objs: []client.Object{zone},
+				cr: record("_sip._tcp", "SRV", "sip.example.com", func(cr *v1beta1.Record) {
+					cr.Spec.ForProvider.Priority = ptr.To[int32](10)
+					cr.Spec.ForProvider.Weight = ptr.To[int32](5)
+					cr.Spec.ForProvider.Port = ptr.To[int32](5060)
+				}),
+			},
+		},
+		"NameOutsideZone": {
+			reason: "A fully qualified name outside the zone should be rejected.",
+			args: args{
+				objs: []client.Object{zone},
+				cr:   record("www.example.org.", "A", "192.0.2.1"),
+			},
+			want: invalid("www.example.org.-A", field.Invalid(path.Child("name"), "www.example.org.", "must be within zone example.com")),
+		},
+		"RelativeName": {
+			reason: "A name that is not fully qualified is relative to the zone and should be accepted.",
+			args: args{
+				objs: []client.Object{zone},
+				cr:   record("www.example.org", "A", "192.0.2.1", byZoneID),
+			},
+		},
+		"UnknownZone": {
+			reason: "The name of a record whose zone is not known should not be validated.",
+			args: args{
+				cr: record("www.example.org.", "A", "192.0.2.1"),
+			},
+		},
+		"CNAMEConflict": {
+			reason: "A CNAME record should be rejected if another record in its zone has the same name.",
+			args: args{
+				objs: []client.Object{zone, record("www.example.com", "A", "192.0.2.1", byZoneID)},
+				cr:   record("www", "CNAME", "origin.example.net", byZoneID),
+			},
+			want: invalid("www-CNAME", field.Forbidden(path.Child("name"), "a CNAME record must be the only record named www.example.com, but A record default/www.example.com-A has that name")),
+		},
+		"ConflictWithCNAME": {
+			reason: "A record should be rejected if a CNAME record in its zone has the same name.",
+			args: args{
+				objs: []client.Object{zone, record("www", "CNAME", "origin.example.net")},
+				cr:   record("www", "TXT", "example"),
+			},
+			want: invalid("www-TXT", field.Forbidden(path.Child("name"), "a CNAME record must be the only record named www.example.com, but CNAME record default/www-CNAME has that name")),
+		},
+		"OverlapsRecordSet": {
+			reason: "A record should be rejected if a record set in its zone manages the records of its name and type.",
+			args: args{
+				objs: []client.Object{zone, set("www", "A", "192.0.2.2")},
+				cr:   record("www", "A", "192.0.2.1"),
+			},
+			want: invalid("www-A", field.Forbidden(path.Child("name"), "a record set must be the only resource managing the A records named www.example.com, but A record set default/www has that name")),
+		},
+		"ConflictWithCNAMERecordSet": {
+			reason: "A record should be rejected if a CNAME record set in its zone has the same name.",
+			args: args{
+				objs: []client.Object{zone, set("www", "CNAME", "origin.example.net")},
+				cr:   record("www", "TXT", "example"),
+			},
+			want: invalid("www-TXT", field.Forbidden(path.Child("name"), "a CNAME record must be the only record named www.example.com, but CNAME record set default/www has that name")),
+		},
+		"NoConflictWithRecordSetOfOtherType": {
+			reason: "A record may share its name with a record set of another type.",
+			args: args{
+				objs: []client.Object{zone, set("www", "AAAA", "2001:db8::1")},
+				cr:   record("www", "A", "192.0.2.1"),
+			},
+		},
+		"NoConflictAcrossZones": {
+			reason: "Records in different zones may share a name.",
+			args: args{
+				objs: []client.Object{zone, record("www", "A", "192.0.2.1", func(cr *v1beta1.Record) {
+					cr.Spec.ForProvider.ZoneRef = &xpv1.Reference{Name: "other"}
+				})},
+				cr: record("www", "CNAME", "origin.example.net"),
+			},
+		},
+		"ConflictByZoneIDAndReference": {
+			reason: "A record whose zone is set by ID should conflict with a record that references the Zone with that ID.",
+			args: args{
+				objs: []client.Object{zone, record("www", "CNAME", "origin.example.net")},
+				cr:   record("www.example.com", "A", "192.0.2.1", byZoneID),
+			},
+			want: invalid("www.example.com-A", field.Forbidden(path.Child("name"), "a CNAME record must be the only record named www.example.com, but CNAME record default/www-CNAME has that name")),
+		},
+		"ConflictWithZoneInOtherNamespace": {
+			reason: "The name of another record should be qualified with its own zone, even if the zone of the record is not known in its namespace.",
+			args: args{
+				objs: []client.Object{infraZone, record("www", "CNAME", "origin.example.net", inInfra)},
+				cr:   record("www.example.com", "A", "192.0.2.1", byZoneID),
+			},
+			want: invalid("www.example.com-A", field.Forbidden(path.Child("name"), "a CNAME record must be the only record named www.example.com, but CNAME record infra/www-CNAME has that name")),
+		},
+		"NoConflictAcrossReferencedZones": {
+			reason: "Records that reference Zones with different IDs may share a name.",
+			args: args{
+				objs: []client.Object{zone, otherZone, record("www", "CNAME", "origin.example.net", inInfra)},
+				cr:   record("www", "A", "192.0.2.1"),
+			},
+		},
+		"NoConflictWithoutCNAME": {
+			reason: "Records that are not CNAME records may share a name.",
+			args: args{
+				objs: []client.Object{zone, record("www", "A", "192.0.2.1")},
+				cr:   record("www", "AAAA", "2001:db8::1"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := apis.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			v := &recordValidator{
+				kube:    fake.NewClientBuilder().WithScheme(s).WithObjects(tc.args.objs...).Build(),
+				records: clients.NewDNSRecordValidator(),
+			}
+			_, err := v.validate(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nvalidate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+	"github.com/rossigee/provider-cloudflare/internal/clients/records"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-dns-cloudflare-m-crossplane-io-v1beta1-recordset,mutating=false,failurePolicy=fail,sideEffects=None,groups=dns.cloudflare.m.crossplane.io,resources=recordsets,versions=v1beta1,name=recordsets.dns.cloudflare.m.crossplane.io,admissionReviewVersions=v1
+
+// SetupRecordSet adds a webhook that validates DNS RecordSets.
+func SetupRecordSet(mgr ctrl.Manager) error {
+	v := &recordValidator{kube: mgr.GetClient(), records: clients.NewDNSRecordValidator()}
+	return setup(mgr, &v1beta1.RecordSet{}, v.validateSet)
+}
+
+// validateSet validates the values of a DNS RecordSet, that they are
+// unique, that a CNAME set has only one value, that the name of the set
+// belongs to its zone, and that no other Record or RecordSet manages its
+// records.
+func (v *recordValidator) validateSet(ctx context.Context, cr *v1beta1.RecordSet) (admission.Warnings, error) {
+	var errs field.ErrorList
+	p := cr.Spec.ForProvider
+	path := field.NewPath("spec", "forProvider", "values")
+
+	if ptr.Deref(p.Type, "A") == "CNAME" && len(p.Values) > 1 {
+		errs = append(errs, field.TooMany(path, len(p.Values), 1))
+	}
+
+	seen := map[string]bool{}
+	for i, val := range p.Values {
+		if err := v.validateContent(path.Index(i), records.MemberParameters(&p, val)); err != nil {
+			errs = append(errs, err)
+		}
+		key, _ := json.Marshal(val)
+		if seen[string(key)] {
+			errs = append(errs, field.Duplicate(path.Index(i), string(key)))
+		}
+		seen[string(key)] = true
+	}
+
+	zone, err := v.zoneName(ctx, cr.GetNamespace(), p.Zone, p.ZoneRef)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetZone)
+	}
+	if nerr := validateName(p.Name, zone); nerr != nil {
+		errs = append(errs, nerr)
+	}
+
+	conflict, cerr := v.conflict(ctx, setMember(cr), zone)
+	if cerr != nil {
+		return nil, cerr
+	}
+	if conflict != nil {
+		errs = append(errs, conflict)
+	}
+
+	if len(errs) > 0 {
+		return nil, kerrors.NewInvalid(v1beta1.RecordSetGroupVersionKind.GroupKind(), cr.GetName(), errs)
+	}
+	return nil, nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis"
+	"github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	zonev1beta1 "github.com/rossigee/provider-cloudflare/apis/zone/v1beta1"
+	clients "github.com/rossigee/provider-cloudflare/internal/clients"
+)
+
+func TestRecordValidatorValidateSet(t *testing.T) {
+	zone := &zonev1beta1.Zone{}
+	zone.SetNamespace("default")
+	zone.SetName("example")
+	zone.Spec.ForProvider.Name = "example.com"
+
+	set := func(name, rtype string, values ...string) *v1beta1.RecordSet {
+		cr := &v1beta1.RecordSet{}
+		cr.SetNamespace("default")
+		cr.SetName("example")
+		cr.Spec.ForProvider.Name = name
+		cr.Spec.ForProvider.Type = ptr.To(rtype)
+		cr.Spec.ForProvider.ZoneRef = &xpv1.Reference{Name: "example"}
+		for _, v := range values {
+			cr.Spec.ForProvider.Values = append(cr.Spec.ForProvider.Values, v1beta1.RecordSetValue{Content: v})
+		}
+		return cr
+	}
+	invalid := func(errs ...*field.Error) error {
+		return kerrors.NewInvalid(v1beta1.RecordSetGroupVersionKind.GroupKind(), "example", errs)
+	}
+	path := field.NewPath("spec", "forProvider")
+
+	record := func(name, rtype, content string) *v1beta1.Record {
+		cr := &v1beta1.Record{}
+		cr.SetNamespace("default")
+		cr.SetName(name + "-" + rtype)
+		cr.Spec.ForProvider.Name = name
+		cr.Spec.ForProvider.Type = ptr.To(rtype)
+		cr.Spec.ForProvider.Content = content
+		cr.Spec.ForProvider.ZoneRef = &xpv1.Reference{Name: "example"}
+		return cr
+	}
+	named := func(name string, cr *v1beta1.RecordSet) *v1beta1.RecordSet {
+		cr.SetName(name)
+		return cr
+	}
+
+	cases := map[string]struct {
+		reason string
+		objs   []client.Object
+		cr     *v1beta1.RecordSet
+		want   error
+	}{
+		"Valid": {
+			reason: "A set of valid, unique values within its zone should be accepted.",
+			cr:     set("www", "A", "192.0.2.1", "192.0.2.2"),
+		},
+		"InvalidValue": {
+			reason: "Each invalid value should be rejected.",
+			cr:     set("www", "A", "192.0.2.1", "not-an-ip"),
+			want:   invalid(field.Invalid(path.Child("values").Index(1).Child("content"), "not-an-ip", "invalid IPv4 address format")),
+		},
+		"DuplicateValue": {
+			reason: "Values should be unique.",
+			cr:     set("www", "A", "192.0.2.1", "192.0.2.1"),
+			want:   invalid(field.Duplicate(path.Child("values").Index(1), `{"content":"192.0.2.1"}`)),
+		},
+		"MultipleCNAME": {
+			reason: "A CNAME set should have only one value.",
+			cr:     set("www", "CNAME", "a.example.net", "b.example.net"),
+			want:   invalid(field.TooMany(path.Child("values"), 2, 1)),
+		},
+		"NameOutsideZone": {
+			reason: "A fully qualified name outside the zone should be rejected.",
+			cr:     set("www.example.org.", "A", "192.0.2.1"),
+			want:   invalid(field.Invalid(path.Child("name"), "www.example.org.", "must be within zone example.com")),
+		},
+		"OverlapsRecord": {
+			reason: "A set should be rejected if a record in its zone has its name and type.",
+			objs:   []client.Object{record("www", "A", "192.0.2.3")},
+			cr:     set("www", "A", "192.0.2.1"),
+			want:   invalid(field.Forbidden(path.Child("name"), "a record set must be the only resource managing the A records named www.example.com, but A record default/www-A has that name")),
+		},
+		"OverlapsRecordSet": {
+			reason: "A set should be rejected if another set in its zone has its name and type.",
+			objs:   []client.Object{named("other", set("www.example.com", "A", "192.0.2.3"))},
+			cr:     set("www", "A", "192.0.2.1"),
+			want:   invalid(field.Forbidden(path.Child("name"), "a record set must be the only resource managing the A records named www.example.com, but A record set default/other has that name")),
+		},
+		"CNAMEConflict": {
+			reason: "A CNAME set should be rejected if a record in its zone has the same name.",
+			objs:   []client.Object{record("www", "TXT", "example")},
+			cr:     set("www", "CNAME", "origin.example.net"),
+			want:   invalid(field.Forbidden(path.Child("name"), "a CNAME record must be the only record named www.example.com, but TXT record default/www-TXT has that name")),
+		},
+		"NoConflictWithOtherType": {
+			reason: "A set may share its name with records of another type.",
+			objs:   []client.Object{record("www", "AAAA", "2001:db8::1"), named("other", set("www", "TXT", "example"))},
+			cr:     set("www", "A", "192.0.2.1"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := apis.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			v := &recordValidator{
+				kube:    fake.NewClientBuilder().WithScheme(s).WithObjects(append(tc.objs, zone)...).Build(),
+				records: clients.NewDNSRecordValidator(),
+			}
+			_, err := v.validateSet(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nvalidateSet(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+	ruleset "github.com/rossigee/provider-cloudflare/internal/clients/rulesets"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-rulesets-cloudflare-m-crossplane-io-v1beta1-ruleset,mutating=false,failurePolicy=fail,sideEffects=None,groups=rulesets.cloudflare.m.crossplane.io,resources=rulesets,versions=v1beta1,name=rulesets.rulesets.cloudflare.m.crossplane.io,admissionReviewVersions=v1
+
+// SetupRuleset adds a webhook that validates Rulesets.
+func SetupRuleset(mgr ctrl.Manager) error {
+	return setup(mgr, &v1beta1.Ruleset{}, validateRuleset)
+}
+
+// validateRuleset validates the syntax of the expressions of the rules of
+// a Ruleset.
+func validateRuleset(_ context.Context, cr *v1beta1.Ruleset) (admission.Warnings, error) {
+	var errs field.ErrorList
+	path := field.NewPath("spec", "forProvider", "rules")
+	for i, r := range cr.Spec.ForProvider.Rules {
+		if err := ruleset.ValidateExpression(r.Expression); err != nil {
+			errs = append(errs, field.Invalid(path.Index(i).Child("expression"), r.Expression, err.Error()))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, kerrors.NewInvalid(v1beta1.RulesetGroupVersionKind.GroupKind(), cr.GetName(), errs)
+	}
+	return nil, nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+)
+
+func TestValidateRuleset(t *testing.T) {
+	ruleset := func(expressions ...string) *v1beta1.Ruleset {
+		cr := &v1beta1.Ruleset{}
+		cr.SetName("example")
+		for _, e := range expressions {
+			cr.Spec.ForProvider.Rules = append(cr.Spec.ForProvider.Rules, v1beta1.RulesetRule{Action: "block", Expression: e})
+		}
+		return cr
+	}
+
+	cases := map[string]struct {
+		reason string
+		cr     *v1beta1.Ruleset
+		want   error
+	}{
+		"Valid": {
+			reason: "Rules with valid expressions should be accepted.",
+			cr:     ruleset(`(http.host eq "example.com")`, `ip.src in {192.0.2.0/24}`),
+		},
+		"Invalid": {
+			reason: "Each rule with an invalid expression should be rejected.",
+			cr:     ruleset(`(http.host eq "example.com")`, `(http.host eq "example.com"`, `http.host eq "example.com" and`),
+			want: kerrors.NewInvalid(v1beta1.RulesetGroupVersionKind.GroupKind(), "example", field.ErrorList{
+				field.Invalid(field.NewPath("spec", "forProvider", "rules").Index(1).Child("expression"), `(http.host eq "example.com"`, `unclosed "(" at offset 0`),
+				field.Invalid(field.NewPath("spec", "forProvider", "rules").Index(2).Child("expression"), `http.host eq "example.com" and`, `"and" at offset 27 is missing an operand`),
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := validateRuleset(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nvalidateRuleset(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
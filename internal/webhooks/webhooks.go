@@ -0,0 +1,147 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhooks serves the validating admission webhooks of the provider,
+// which reject invalid resources when they are applied rather than when
+// they are reconciled.
+package webhooks
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	errUnexpectedType = "unexpected type %T"
+	errGetSpec        = "cannot get spec"
+)
+
+// Setup adds the validating webhooks of the provider to the supplied
+// manager.
+func Setup(mgr ctrl.Manager) error {
+	for _, setup := range []func(ctrl.Manager) error{
+		SetupRecord,
+		SetupRecordSet,
+		SetupRuleset,
+		SetupLoadBalancerPool,
+	} {
+		if err := setup(mgr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A validateFn validates a resource, returning any warnings.
+type validateFn[T client.Object] func(ctx context.Context, obj T) (admission.Warnings, error)
+
+// A validator validates resources of one kind when they are created or
+// updated. Updates that do not change the spec of a resource, such as those
+// that set its annotations or remove its finalizers, are not validated, so
+// that resources which were created before they were validated can still be
+// reconciled and deleted. Nor are updates that only resolve references.
+type validator[T client.Object] struct {
+	validate validateFn[T]
+}
+
+// setup adds a webhook that validates resources like the supplied one.
+func setup[T client.Object](mgr ctrl.Manager, obj T, fn validateFn[T]) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(obj).
+		WithValidator(&validator[T]{validate: fn}).
+		Complete()
+}
+
+// ValidateCreate validates a resource when it is created.
+func (v *validator[T]) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	o, ok := obj.(T)
+	if !ok {
+		return nil, errors.Errorf(errUnexpectedType, obj)
+	}
+	return v.validate(ctx, o)
+}
+
+// ValidateUpdate validates a resource when it is updated, if its spec
+// changed.
+func (v *validator[T]) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	o, ok := newObj.(T)
+	if !ok {
+		return nil, errors.Errorf(errUnexpectedType, newObj)
+	}
+	if o.GetDeletionTimestamp() != nil {
+		return nil, nil
+	}
+	changed, err := specChanged(oldObj, newObj)
+	if err != nil || !changed {
+		return nil, err
+	}
+	return v.validate(ctx, o)
+}
+
+// ValidateDelete does not validate a resource when it is deleted.
+func (v *validator[T]) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// specChanged returns true if the spec of the supplied resources differs,
+// other than in the fields that the references and selectors of the new
+// spec resolve, so that resolving them is not rejected.
+func specChanged(oldObj, newObj runtime.Object) (bool, error) {
+	o, err := runtime.DefaultUnstructuredConverter.ToUnstructured(oldObj)
+	if err != nil {
+		return false, errors.Wrap(err, errGetSpec)
+	}
+	n, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newObj)
+	if err != nil {
+		return false, errors.Wrap(err, errGetSpec)
+	}
+	if p, ok, _ := unstructured.NestedMap(n, "spec", "forProvider"); ok {
+		for _, f := range resolvedFields(p) {
+			unstructured.RemoveNestedField(o, "spec", "forProvider", f)
+			unstructured.RemoveNestedField(n, "spec", "forProvider", f)
+		}
+	}
+	return !equality.Semantic.DeepEqual(o["spec"], n["spec"]), nil
+}
+
+// resolvedFields returns the fields of the supplied parameters that are
+// resolved from a reference or selector they set. A reference such as
+// zoneRef resolves the zone field, and a selector such as zoneSelector
+// resolves both the zone and zoneRef fields.
+func resolvedFields(p map[string]interface{}) []string {
+	var fields []string
+	for k, v := range p {
+		if v == nil {
+			continue
+		}
+		switch {
+		case strings.HasSuffix(k, "Ref"):
+			fields = append(fields, strings.TrimSuffix(k, "Ref"))
+		case strings.HasSuffix(k, "Selector"):
+			f := strings.TrimSuffix(k, "Selector")
+			fields = append(fields, f, f+"Ref")
+		}
+	}
+	return fields
+}
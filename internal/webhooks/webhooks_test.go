@@ -0,0 +1,201 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	dnsv1beta1 "github.com/rossigee/provider-cloudflare/apis/dns/v1beta1"
+	"github.com/rossigee/provider-cloudflare/apis/rulesets/v1beta1"
+)
+
+func TestValidatorValidateUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := metav1.Now()
+
+	ruleset := func(m ...func(*v1beta1.Ruleset)) *v1beta1.Ruleset {
+		cr := &v1beta1.Ruleset{}
+		cr.Spec.ForProvider.Name = "example"
+		for _, f := range m {
+			f(cr)
+		}
+		return cr
+	}
+
+	type args struct {
+		oldObj runtime.Object
+		newObj runtime.Object
+	}
+	type want struct {
+		validated bool
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"UnexpectedType": {
+			reason: "Resources of an unexpected type should return an error.",
+			args: args{
+				oldObj: ruleset(),
+				newObj: &v1beta1.RulesetList{},
+			},
+			want: want{
+				err: errors.Errorf(errUnexpectedType, &v1beta1.RulesetList{}),
+			},
+		},
+		"SpecUnchanged": {
+			reason: "Updates that do not change the spec should not be validated.",
+			args: args{
+				oldObj: ruleset(),
+				newObj: ruleset(func(cr *v1beta1.Ruleset) {
+					cr.SetAnnotations(map[string]string{"example": "true"})
+				}),
+			},
+			want: want{},
+		},
+		"Deleting": {
+			reason: "Resources that are being deleted should not be validated.",
+			args: args{
+				oldObj: ruleset(),
+				newObj: ruleset(func(cr *v1beta1.Ruleset) {
+					cr.SetDeletionTimestamp(&now)
+					cr.Spec.ForProvider.Name = "changed"
+				}),
+			},
+			want: want{},
+		},
+		"SpecChanged": {
+			reason: "Updates that change the spec should be validated.",
+			args: args{
+				oldObj: ruleset(),
+				newObj: ruleset(func(cr *v1beta1.Ruleset) {
+					cr.Spec.ForProvider.Name = "changed"
+				}),
+			},
+			want: want{
+				validated: true,
+				err:       errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			validated := false
+			v := &validator[*v1beta1.Ruleset]{validate: func(_ context.Context, _ *v1beta1.Ruleset) (admission.Warnings, error) {
+				validated = true
+				return nil, errBoom
+			}}
+			_, err := v.ValidateUpdate(context.Background(), tc.args.oldObj, tc.args.newObj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateUpdate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.validated, validated); diff != "" {
+				t.Errorf("\n%s\nValidateUpdate(...): -want validated, +got validated:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSpecChanged(t *testing.T) {
+	record := func(m ...func(*dnsv1beta1.Record)) *dnsv1beta1.Record {
+		cr := &dnsv1beta1.Record{}
+		cr.Spec.ForProvider.Name = "www"
+		for _, f := range m {
+			f(cr)
+		}
+		return cr
+	}
+	zone := func(id string) func(*dnsv1beta1.Record) {
+		return func(cr *dnsv1beta1.Record) { cr.Spec.ForProvider.Zone = &id }
+	}
+	zoneRef := func(name string) func(*dnsv1beta1.Record) {
+		return func(cr *dnsv1beta1.Record) { cr.Spec.ForProvider.ZoneRef = &xpv1.Reference{Name: name} }
+	}
+	zoneSelector := func(cr *dnsv1beta1.Record) {
+		cr.Spec.ForProvider.ZoneSelector = &xpv1.Selector{MatchLabels: map[string]string{"zone": "example"}}
+	}
+
+	cases := map[string]struct {
+		reason string
+		oldObj runtime.Object
+		newObj runtime.Object
+		want   bool
+	}{
+		"Unchanged": {
+			reason: "A spec that did not change should not be changed",
+			oldObj: record(zone("a")),
+			newObj: record(zone("a")),
+			want:   false,
+		},
+		"Changed": {
+			reason: "A changed field should be detected",
+			oldObj: record(zone("a")),
+			newObj: record(zone("a"), func(cr *dnsv1beta1.Record) { cr.Spec.ForProvider.Name = "api" }),
+			want:   true,
+		},
+		"ReferenceResolved": {
+			reason: "A field resolved from a reference should be ignored",
+			oldObj: record(zoneRef("example")),
+			newObj: record(zoneRef("example"), zone("a")),
+			want:   false,
+		},
+		"SelectorResolved": {
+			reason: "The reference and field resolved from a selector should be ignored",
+			oldObj: record(zoneSelector),
+			newObj: record(zoneSelector, zoneRef("example"), zone("a")),
+			want:   false,
+		},
+		"ReferenceChanged": {
+			reason: "A changed reference should be detected",
+			oldObj: record(zoneRef("example"), zone("a")),
+			newObj: record(zoneRef("other"), zone("a")),
+			want:   true,
+		},
+		"FieldChangedWithoutReference": {
+			reason: "A changed field that no reference resolves should be detected",
+			oldObj: record(zone("a")),
+			newObj: record(zone("b")),
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := specChanged(tc.oldObj, tc.newObj)
+			if err != nil {
+				t.Fatalf("\n%s\nspecChanged(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nspecChanged(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}